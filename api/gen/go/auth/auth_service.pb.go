@@ -23,6 +23,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UserStatusFilter int32
+
+const (
+	UserStatusFilter_USER_STATUS_FILTER_UNSPECIFIED UserStatusFilter = 0 // фильтр задает устаревшее поле is_active
+	UserStatusFilter_USER_STATUS_ALL                UserStatusFilter = 1
+	UserStatusFilter_USER_STATUS_ACTIVE             UserStatusFilter = 2
+	UserStatusFilter_USER_STATUS_INACTIVE           UserStatusFilter = 3
+)
+
+// Enum value maps for UserStatusFilter.
+var (
+	UserStatusFilter_name = map[int32]string{
+		0: "USER_STATUS_FILTER_UNSPECIFIED",
+		1: "USER_STATUS_ALL",
+		2: "USER_STATUS_ACTIVE",
+		3: "USER_STATUS_INACTIVE",
+	}
+	UserStatusFilter_value = map[string]int32{
+		"USER_STATUS_FILTER_UNSPECIFIED": 0,
+		"USER_STATUS_ALL":                1,
+		"USER_STATUS_ACTIVE":             2,
+		"USER_STATUS_INACTIVE":           3,
+	}
+)
+
+func (x UserStatusFilter) Enum() *UserStatusFilter {
+	p := new(UserStatusFilter)
+	*p = x
+	return p
+}
+
+func (x UserStatusFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatusFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UserStatusFilter) Type() protoreflect.EnumType {
+	return &file_auth_auth_service_proto_enumTypes[0]
+}
+
+func (x UserStatusFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatusFilter.Descriptor instead.
+func (UserStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{0}
+}
+
+type UserSortField int32
+
+const (
+	UserSortField_USER_SORT_CREATED_AT UserSortField = 0
+	UserSortField_USER_SORT_EMAIL      UserSortField = 1
+)
+
+// Enum value maps for UserSortField.
+var (
+	UserSortField_name = map[int32]string{
+		0: "USER_SORT_CREATED_AT",
+		1: "USER_SORT_EMAIL",
+	}
+	UserSortField_value = map[string]int32{
+		"USER_SORT_CREATED_AT": 0,
+		"USER_SORT_EMAIL":      1,
+	}
+)
+
+func (x UserSortField) Enum() *UserSortField {
+	p := new(UserSortField)
+	*p = x
+	return p
+}
+
+func (x UserSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_service_proto_enumTypes[1].Descriptor()
+}
+
+func (UserSortField) Type() protoreflect.EnumType {
+	return &file_auth_auth_service_proto_enumTypes[1]
+}
+
+func (x UserSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSortField.Descriptor instead.
+func (UserSortField) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{1}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // created_at по убыванию, email по возрастанию
+	SortDirection_SORT_ASC                   SortDirection = 1
+	SortDirection_SORT_DESC                  SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_ASC",
+		2: "SORT_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_ASC":                   1,
+		"SORT_DESC":                  2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_auth_service_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_auth_auth_service_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{2}
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -846,6 +993,104 @@ func (x *ResetPasswordRequest) GetConfirmPassword() string {
 	return ""
 }
 
+// Смена email
+// Пользователь берется из x-user-id, пароль подтверждает, что запрос делает владелец аккаунта
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_auth_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_auth_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 // CRUD методы
 type GetUserByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -856,7 +1101,7 @@ type GetUserByIDRequest struct {
 
 func (x *GetUserByIDRequest) Reset() {
 	*x = GetUserByIDRequest{}
-	mi := &file_auth_auth_service_proto_msgTypes[15]
+	mi := &file_auth_auth_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -868,7 +1113,7 @@ func (x *GetUserByIDRequest) String() string {
 func (*GetUserByIDRequest) ProtoMessage() {}
 
 func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[15]
+	mi := &file_auth_auth_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1126,7 @@ func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByIDRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{15}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserByIDRequest) GetUserId() string {
@@ -900,7 +1145,7 @@ type GetUserByEmailRequest struct {
 
 func (x *GetUserByEmailRequest) Reset() {
 	*x = GetUserByEmailRequest{}
-	mi := &file_auth_auth_service_proto_msgTypes[16]
+	mi := &file_auth_auth_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1157,7 @@ func (x *GetUserByEmailRequest) String() string {
 func (*GetUserByEmailRequest) ProtoMessage() {}
 
 func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[16]
+	mi := &file_auth_auth_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1170,7 @@ func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{16}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserByEmailRequest) GetEmail() string {
@@ -944,7 +1189,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_auth_auth_service_proto_msgTypes[17]
+	mi := &file_auth_auth_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1201,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[17]
+	mi := &file_auth_auth_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1214,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{17}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -989,7 +1234,7 @@ type UpdateUserStatusRequest struct {
 
 func (x *UpdateUserStatusRequest) Reset() {
 	*x = UpdateUserStatusRequest{}
-	mi := &file_auth_auth_service_proto_msgTypes[18]
+	mi := &file_auth_auth_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1246,7 @@ func (x *UpdateUserStatusRequest) String() string {
 func (*UpdateUserStatusRequest) ProtoMessage() {}
 
 func (x *UpdateUserStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[18]
+	mi := &file_auth_auth_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1259,7 @@ func (x *UpdateUserStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserStatusRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{18}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateUserStatusRequest) GetUserId() string {
@@ -1032,17 +1277,25 @@ func (x *UpdateUserStatusRequest) GetIsActive() bool {
 }
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // игнорируется, если передан page_token
+	// Deprecated: Marked as deprecated in auth/auth_service.proto.
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"` // используйте status
+	Status        UserStatusFilter       `protobuf:"varint,4,opt,name=status,proto3,enum=auth.UserStatusFilter" json:"status,omitempty"`
+	EmailPrefix   string                 `protobuf:"bytes,5,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // включительно
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // не включительно
+	SortBy        UserSortField          `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=auth.UserSortField" json:"sort_by,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,9,opt,name=sort_direction,json=sortDirection,proto3,enum=auth.SortDirection" json:"sort_direction,omitempty"`
+	PageToken     string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_auth_auth_service_proto_msgTypes[19]
+	mi := &file_auth_auth_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1054,7 +1307,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[19]
+	mi := &file_auth_auth_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1320,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{19}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListUsersRequest) GetLimit() int32 {
@@ -1084,6 +1337,7 @@ func (x *ListUsersRequest) GetOffset() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in auth/auth_service.proto.
 func (x *ListUsersRequest) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
@@ -1091,18 +1345,69 @@ func (x *ListUsersRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *ListUsersRequest) GetStatus() UserStatusFilter {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatusFilter_USER_STATUS_FILTER_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetEmailPrefix() string {
+	if x != nil {
+		return x.EmailPrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetSortBy() UserSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return UserSortField_USER_SORT_CREATED_AT
+}
+
+func (x *ListUsersRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                                       // число пользователей по фильтру без учета пагинации
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // пустой на последней странице
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_auth_auth_service_proto_msgTypes[20]
+	mi := &file_auth_auth_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1419,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[20]
+	mi := &file_auth_auth_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1432,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{20}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -1151,6 +1456,20 @@ func (x *ListUsersResponse) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1160,7 +1479,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_auth_auth_service_proto_msgTypes[21]
+	mi := &file_auth_auth_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1172,7 +1491,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_service_proto_msgTypes[21]
+	mi := &file_auth_auth_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,7 +1504,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_auth_auth_service_proto_rawDescGZIP(), []int{21}
+	return file_auth_auth_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteUserRequest) GetUserId() string {
@@ -1254,7 +1573,12 @@ const file_auth_auth_service_proto_rawDesc = "" +
 	"\x14ResetPasswordRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
 	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12)\n" +
-	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"-\n" +
+	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"p\n" +
+	"\x19RequestEmailChangeRequest\x12\x1b\n" +
+	"\tnew_email\x18\x01 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpasswordJ\x04\b\x03\x10\x04R\x14confirm_url_template\"1\n" +
+	"\x19ConfirmEmailChangeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"-\n" +
 	"\x12GetUserByIDRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"-\n" +
 	"\x15GetUserByEmailRequest\x12\x14\n" +
@@ -1264,18 +1588,42 @@ const file_auth_auth_service_proto_rawDesc = "" +
 	".auth.UserR\x04user\"O\n" +
 	"\x17UpdateUserStatusRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tis_active\x18\x02 \x01(\bR\bisActive\"]\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"\xb7\x03\n" +
 	"\x10ListUsersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1b\n" +
-	"\tis_active\x18\x03 \x01(\bR\bisActive\"c\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\tis_active\x18\x03 \x01(\bB\x02\x18\x01R\bisActive\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.auth.UserStatusFilterR\x06status\x12!\n" +
+	"\femail_prefix\x18\x05 \x01(\tR\vemailPrefix\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12,\n" +
+	"\asort_by\x18\b \x01(\x0e2\x13.auth.UserSortFieldR\x06sortBy\x12:\n" +
+	"\x0esort_direction\x18\t \x01(\x0e2\x13.auth.SortDirectionR\rsortDirection\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\"\xa1\x01\n" +
 	"\x11ListUsersResponse\x12 \n" +
 	"\x05users\x18\x01 \x03(\v2\n" +
 	".auth.UserR\x05users\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\",\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\",\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId2\xe9\t\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId*}\n" +
+	"\x10UserStatusFilter\x12\"\n" +
+	"\x1eUSER_STATUS_FILTER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fUSER_STATUS_ALL\x10\x01\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x02\x12\x18\n" +
+	"\x14USER_STATUS_INACTIVE\x10\x03*>\n" +
+	"\rUserSortField\x12\x18\n" +
+	"\x14USER_SORT_CREATED_AT\x10\x00\x12\x13\n" +
+	"\x0fUSER_SORT_EMAIL\x10\x01*L\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSORT_ASC\x10\x01\x12\r\n" +
+	"\tSORT_DESC\x10\x022\xc6\v\n" +
 	"\vAuthService\x12W\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12K\n" +
 	"\x05Login\x12\x12.auth.LoginRequest\x1a\x13.auth.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12N\n" +
@@ -1284,7 +1632,9 @@ const file_auth_auth_service_proto_rawDesc = "" +
 	"\rValidateToken\x12\x1a.auth.ValidateTokenRequest\x1a\x1b.auth.ValidateTokenResponse\x12g\n" +
 	"\x0eChangePassword\x12\x1b.auth.ChangePasswordRequest\x1a\x13.auth.EmptyResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/change-password\x12g\n" +
 	"\x0eForgotPassword\x12\x1b.auth.ForgotPasswordRequest\x1a\x13.auth.EmptyResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/forgot-password\x12d\n" +
-	"\rResetPassword\x12\x1a.auth.ResetPasswordRequest\x1a\x13.auth.EmptyResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reset-password\x12`\n" +
+	"\rResetPassword\x12\x1a.auth.ResetPasswordRequest\x1a\x13.auth.EmptyResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reset-password\x12l\n" +
+	"\x12RequestEmailChange\x12\x1f.auth.RequestEmailChangeRequest\x1a\x13.auth.EmptyResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/change\x12m\n" +
+	"\x12ConfirmEmailChange\x12\x1f.auth.ConfirmEmailChangeRequest\x1a\x13.auth.EmptyResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/email/confirm\x12`\n" +
 	"\vGetUserByID\x12\x18.auth.GetUserByIDRequest\x1a\x15.auth.GetUserResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/auth/users/{user_id}\x12j\n" +
 	"\x0eGetUserByEmail\x12\x1b.auth.GetUserByEmailRequest\x1a\x15.auth.GetUserResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/auth/users/email/{email}\x12z\n" +
 	"\x10UpdateUserStatus\x12\x1d.auth.UpdateUserStatusRequest\x1a\x13.auth.EmptyResponse\"2\x82\xd3\xe4\x93\x02,:\tis_active2\x1f/v1/auth/users/{user_id}/status\x12T\n" +
@@ -1304,69 +1654,84 @@ func file_auth_auth_service_proto_rawDescGZIP() []byte {
 	return file_auth_auth_service_proto_rawDescData
 }
 
-var file_auth_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_auth_auth_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_auth_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_auth_auth_service_proto_goTypes = []any{
-	(*EmptyResponse)(nil),           // 0: auth.EmptyResponse
-	(*Error)(nil),                   // 1: auth.Error
-	(*User)(nil),                    // 2: auth.User
-	(*RegisterRequest)(nil),         // 3: auth.RegisterRequest
-	(*RegisterResponse)(nil),        // 4: auth.RegisterResponse
-	(*LoginRequest)(nil),            // 5: auth.LoginRequest
-	(*LoginResponse)(nil),           // 6: auth.LoginResponse
-	(*LogoutRequest)(nil),           // 7: auth.LogoutRequest
-	(*RefreshTokensRequest)(nil),    // 8: auth.RefreshTokensRequest
-	(*RefreshTokenResponse)(nil),    // 9: auth.RefreshTokenResponse
-	(*ValidateTokenRequest)(nil),    // 10: auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),   // 11: auth.ValidateTokenResponse
-	(*ChangePasswordRequest)(nil),   // 12: auth.ChangePasswordRequest
-	(*ForgotPasswordRequest)(nil),   // 13: auth.ForgotPasswordRequest
-	(*ResetPasswordRequest)(nil),    // 14: auth.ResetPasswordRequest
-	(*GetUserByIDRequest)(nil),      // 15: auth.GetUserByIDRequest
-	(*GetUserByEmailRequest)(nil),   // 16: auth.GetUserByEmailRequest
-	(*GetUserResponse)(nil),         // 17: auth.GetUserResponse
-	(*UpdateUserStatusRequest)(nil), // 18: auth.UpdateUserStatusRequest
-	(*ListUsersRequest)(nil),        // 19: auth.ListUsersRequest
-	(*ListUsersResponse)(nil),       // 20: auth.ListUsersResponse
-	(*DeleteUserRequest)(nil),       // 21: auth.DeleteUserRequest
-	(*timestamppb.Timestamp)(nil),   // 22: google.protobuf.Timestamp
+	(UserStatusFilter)(0),             // 0: auth.UserStatusFilter
+	(UserSortField)(0),                // 1: auth.UserSortField
+	(SortDirection)(0),                // 2: auth.SortDirection
+	(*EmptyResponse)(nil),             // 3: auth.EmptyResponse
+	(*Error)(nil),                     // 4: auth.Error
+	(*User)(nil),                      // 5: auth.User
+	(*RegisterRequest)(nil),           // 6: auth.RegisterRequest
+	(*RegisterResponse)(nil),          // 7: auth.RegisterResponse
+	(*LoginRequest)(nil),              // 8: auth.LoginRequest
+	(*LoginResponse)(nil),             // 9: auth.LoginResponse
+	(*LogoutRequest)(nil),             // 10: auth.LogoutRequest
+	(*RefreshTokensRequest)(nil),      // 11: auth.RefreshTokensRequest
+	(*RefreshTokenResponse)(nil),      // 12: auth.RefreshTokenResponse
+	(*ValidateTokenRequest)(nil),      // 13: auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),     // 14: auth.ValidateTokenResponse
+	(*ChangePasswordRequest)(nil),     // 15: auth.ChangePasswordRequest
+	(*ForgotPasswordRequest)(nil),     // 16: auth.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),      // 17: auth.ResetPasswordRequest
+	(*RequestEmailChangeRequest)(nil), // 18: auth.RequestEmailChangeRequest
+	(*ConfirmEmailChangeRequest)(nil), // 19: auth.ConfirmEmailChangeRequest
+	(*GetUserByIDRequest)(nil),        // 20: auth.GetUserByIDRequest
+	(*GetUserByEmailRequest)(nil),     // 21: auth.GetUserByEmailRequest
+	(*GetUserResponse)(nil),           // 22: auth.GetUserResponse
+	(*UpdateUserStatusRequest)(nil),   // 23: auth.UpdateUserStatusRequest
+	(*ListUsersRequest)(nil),          // 24: auth.ListUsersRequest
+	(*ListUsersResponse)(nil),         // 25: auth.ListUsersResponse
+	(*DeleteUserRequest)(nil),         // 26: auth.DeleteUserRequest
+	(*timestamppb.Timestamp)(nil),     // 27: google.protobuf.Timestamp
 }
 var file_auth_auth_service_proto_depIdxs = []int32{
-	22, // 0: auth.User.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 1: auth.RegisterResponse.user:type_name -> auth.User
-	2,  // 2: auth.LoginResponse.user:type_name -> auth.User
-	2,  // 3: auth.GetUserResponse.user:type_name -> auth.User
-	2,  // 4: auth.ListUsersResponse.users:type_name -> auth.User
-	3,  // 5: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	5,  // 6: auth.AuthService.Login:input_type -> auth.LoginRequest
-	7,  // 7: auth.AuthService.Logout:input_type -> auth.LogoutRequest
-	8,  // 8: auth.AuthService.RefreshTokens:input_type -> auth.RefreshTokensRequest
-	10, // 9: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
-	12, // 10: auth.AuthService.ChangePassword:input_type -> auth.ChangePasswordRequest
-	13, // 11: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
-	14, // 12: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
-	15, // 13: auth.AuthService.GetUserByID:input_type -> auth.GetUserByIDRequest
-	16, // 14: auth.AuthService.GetUserByEmail:input_type -> auth.GetUserByEmailRequest
-	18, // 15: auth.AuthService.UpdateUserStatus:input_type -> auth.UpdateUserStatusRequest
-	19, // 16: auth.AuthService.ListUsers:input_type -> auth.ListUsersRequest
-	21, // 17: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
-	4,  // 18: auth.AuthService.Register:output_type -> auth.RegisterResponse
-	6,  // 19: auth.AuthService.Login:output_type -> auth.LoginResponse
-	0,  // 20: auth.AuthService.Logout:output_type -> auth.EmptyResponse
-	9,  // 21: auth.AuthService.RefreshTokens:output_type -> auth.RefreshTokenResponse
-	11, // 22: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
-	0,  // 23: auth.AuthService.ChangePassword:output_type -> auth.EmptyResponse
-	0,  // 24: auth.AuthService.ForgotPassword:output_type -> auth.EmptyResponse
-	0,  // 25: auth.AuthService.ResetPassword:output_type -> auth.EmptyResponse
-	17, // 26: auth.AuthService.GetUserByID:output_type -> auth.GetUserResponse
-	17, // 27: auth.AuthService.GetUserByEmail:output_type -> auth.GetUserResponse
-	0,  // 28: auth.AuthService.UpdateUserStatus:output_type -> auth.EmptyResponse
-	20, // 29: auth.AuthService.ListUsers:output_type -> auth.ListUsersResponse
-	0,  // 30: auth.AuthService.DeleteUser:output_type -> auth.EmptyResponse
-	18, // [18:31] is the sub-list for method output_type
-	5,  // [5:18] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	27, // 0: auth.User.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: auth.RegisterResponse.user:type_name -> auth.User
+	5,  // 2: auth.LoginResponse.user:type_name -> auth.User
+	5,  // 3: auth.GetUserResponse.user:type_name -> auth.User
+	0,  // 4: auth.ListUsersRequest.status:type_name -> auth.UserStatusFilter
+	27, // 5: auth.ListUsersRequest.created_from:type_name -> google.protobuf.Timestamp
+	27, // 6: auth.ListUsersRequest.created_to:type_name -> google.protobuf.Timestamp
+	1,  // 7: auth.ListUsersRequest.sort_by:type_name -> auth.UserSortField
+	2,  // 8: auth.ListUsersRequest.sort_direction:type_name -> auth.SortDirection
+	5,  // 9: auth.ListUsersResponse.users:type_name -> auth.User
+	6,  // 10: auth.AuthService.Register:input_type -> auth.RegisterRequest
+	8,  // 11: auth.AuthService.Login:input_type -> auth.LoginRequest
+	10, // 12: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	11, // 13: auth.AuthService.RefreshTokens:input_type -> auth.RefreshTokensRequest
+	13, // 14: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
+	15, // 15: auth.AuthService.ChangePassword:input_type -> auth.ChangePasswordRequest
+	16, // 16: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
+	17, // 17: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
+	18, // 18: auth.AuthService.RequestEmailChange:input_type -> auth.RequestEmailChangeRequest
+	19, // 19: auth.AuthService.ConfirmEmailChange:input_type -> auth.ConfirmEmailChangeRequest
+	20, // 20: auth.AuthService.GetUserByID:input_type -> auth.GetUserByIDRequest
+	21, // 21: auth.AuthService.GetUserByEmail:input_type -> auth.GetUserByEmailRequest
+	23, // 22: auth.AuthService.UpdateUserStatus:input_type -> auth.UpdateUserStatusRequest
+	24, // 23: auth.AuthService.ListUsers:input_type -> auth.ListUsersRequest
+	26, // 24: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
+	7,  // 25: auth.AuthService.Register:output_type -> auth.RegisterResponse
+	9,  // 26: auth.AuthService.Login:output_type -> auth.LoginResponse
+	3,  // 27: auth.AuthService.Logout:output_type -> auth.EmptyResponse
+	12, // 28: auth.AuthService.RefreshTokens:output_type -> auth.RefreshTokenResponse
+	14, // 29: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
+	3,  // 30: auth.AuthService.ChangePassword:output_type -> auth.EmptyResponse
+	3,  // 31: auth.AuthService.ForgotPassword:output_type -> auth.EmptyResponse
+	3,  // 32: auth.AuthService.ResetPassword:output_type -> auth.EmptyResponse
+	3,  // 33: auth.AuthService.RequestEmailChange:output_type -> auth.EmptyResponse
+	3,  // 34: auth.AuthService.ConfirmEmailChange:output_type -> auth.EmptyResponse
+	22, // 35: auth.AuthService.GetUserByID:output_type -> auth.GetUserResponse
+	22, // 36: auth.AuthService.GetUserByEmail:output_type -> auth.GetUserResponse
+	3,  // 37: auth.AuthService.UpdateUserStatus:output_type -> auth.EmptyResponse
+	25, // 38: auth.AuthService.ListUsers:output_type -> auth.ListUsersResponse
+	3,  // 39: auth.AuthService.DeleteUser:output_type -> auth.EmptyResponse
+	25, // [25:40] is the sub-list for method output_type
+	10, // [10:25] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_auth_auth_service_proto_init() }
@@ -1379,13 +1744,14 @@ func file_auth_auth_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_auth_service_proto_rawDesc), len(file_auth_auth_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_auth_auth_service_proto_goTypes,
 		DependencyIndexes: file_auth_auth_service_proto_depIdxs,
+		EnumInfos:         file_auth_auth_service_proto_enumTypes,
 		MessageInfos:      file_auth_auth_service_proto_msgTypes,
 	}.Build()
 	File_auth_auth_service_proto = out.File
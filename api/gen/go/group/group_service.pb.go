@@ -23,6 +23,609 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Как ученик может попасть в группу. Приглашения и добавление репетитором работают при любой политике
+type JoinPolicy int32
+
+const (
+	JoinPolicy_JOIN_POLICY_UNSPECIFIED JoinPolicy = 0
+	JoinPolicy_JOIN_POLICY_OPEN        JoinPolicy = 1 // Вступление без одобрения
+	JoinPolicy_JOIN_POLICY_REQUEST     JoinPolicy = 2 // Заявка, которую одобряет репетитор
+	JoinPolicy_JOIN_POLICY_INVITE_ONLY JoinPolicy = 3 // Только по приглашению
+)
+
+// Enum value maps for JoinPolicy.
+var (
+	JoinPolicy_name = map[int32]string{
+		0: "JOIN_POLICY_UNSPECIFIED",
+		1: "JOIN_POLICY_OPEN",
+		2: "JOIN_POLICY_REQUEST",
+		3: "JOIN_POLICY_INVITE_ONLY",
+	}
+	JoinPolicy_value = map[string]int32{
+		"JOIN_POLICY_UNSPECIFIED": 0,
+		"JOIN_POLICY_OPEN":        1,
+		"JOIN_POLICY_REQUEST":     2,
+		"JOIN_POLICY_INVITE_ONLY": 3,
+	}
+)
+
+func (x JoinPolicy) Enum() *JoinPolicy {
+	p := new(JoinPolicy)
+	*p = x
+	return p
+}
+
+func (x JoinPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[0].Descriptor()
+}
+
+func (JoinPolicy) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[0]
+}
+
+func (x JoinPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinPolicy.Descriptor instead.
+func (JoinPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{0}
+}
+
+type GroupSortField int32
+
+const (
+	GroupSortField_GROUP_SORT_FIELD_UNSPECIFIED GroupSortField = 0 // created_at
+	GroupSortField_GROUP_SORT_CREATED_AT        GroupSortField = 1
+	GroupSortField_GROUP_SORT_NAME              GroupSortField = 2
+	GroupSortField_GROUP_SORT_MEMBER_COUNT      GroupSortField = 3
+)
+
+// Enum value maps for GroupSortField.
+var (
+	GroupSortField_name = map[int32]string{
+		0: "GROUP_SORT_FIELD_UNSPECIFIED",
+		1: "GROUP_SORT_CREATED_AT",
+		2: "GROUP_SORT_NAME",
+		3: "GROUP_SORT_MEMBER_COUNT",
+	}
+	GroupSortField_value = map[string]int32{
+		"GROUP_SORT_FIELD_UNSPECIFIED": 0,
+		"GROUP_SORT_CREATED_AT":        1,
+		"GROUP_SORT_NAME":              2,
+		"GROUP_SORT_MEMBER_COUNT":      3,
+	}
+)
+
+func (x GroupSortField) Enum() *GroupSortField {
+	p := new(GroupSortField)
+	*p = x
+	return p
+}
+
+func (x GroupSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupSortField) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[1]
+}
+
+func (x GroupSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupSortField.Descriptor instead.
+func (GroupSortField) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{1}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // name по возрастанию, остальные поля по убыванию
+	SortDirection_SORT_ASC                   SortDirection = 1
+	SortDirection_SORT_DESC                  SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_ASC",
+		2: "SORT_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_ASC":                   1,
+		"SORT_DESC":                  2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ArchiveFilter int32
+
+const (
+	ArchiveFilter_ARCHIVE_FILTER_UNSPECIFIED ArchiveFilter = 0 // по include_archived
+	ArchiveFilter_ARCHIVE_FILTER_ACTIVE      ArchiveFilter = 1
+	ArchiveFilter_ARCHIVE_FILTER_ARCHIVED    ArchiveFilter = 2
+	ArchiveFilter_ARCHIVE_FILTER_ALL         ArchiveFilter = 3
+)
+
+// Enum value maps for ArchiveFilter.
+var (
+	ArchiveFilter_name = map[int32]string{
+		0: "ARCHIVE_FILTER_UNSPECIFIED",
+		1: "ARCHIVE_FILTER_ACTIVE",
+		2: "ARCHIVE_FILTER_ARCHIVED",
+		3: "ARCHIVE_FILTER_ALL",
+	}
+	ArchiveFilter_value = map[string]int32{
+		"ARCHIVE_FILTER_UNSPECIFIED": 0,
+		"ARCHIVE_FILTER_ACTIVE":      1,
+		"ARCHIVE_FILTER_ARCHIVED":    2,
+		"ARCHIVE_FILTER_ALL":         3,
+	}
+)
+
+func (x ArchiveFilter) Enum() *ArchiveFilter {
+	p := new(ArchiveFilter)
+	*p = x
+	return p
+}
+
+func (x ArchiveFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ArchiveFilter) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[3]
+}
+
+func (x ArchiveFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFilter.Descriptor instead.
+func (ArchiveFilter) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{3}
+}
+
+type AddMemberStatus int32
+
+const (
+	AddMemberStatus_ADD_MEMBER_STATUS_UNSPECIFIED    AddMemberStatus = 0
+	AddMemberStatus_ADD_MEMBER_STATUS_ADDED          AddMemberStatus = 1
+	AddMemberStatus_ADD_MEMBER_STATUS_WAITLISTED     AddMemberStatus = 2 // Группа заполнена, ученик в очереди
+	AddMemberStatus_ADD_MEMBER_STATUS_ALREADY_MEMBER AddMemberStatus = 3 // Уже участник группы
+	AddMemberStatus_ADD_MEMBER_STATUS_NOT_FOUND      AddMemberStatus = 4 // Пользователь с таким ID или email не найден
+	AddMemberStatus_ADD_MEMBER_STATUS_NOT_A_STUDENT  AddMemberStatus = 5 // Пользователь не зарегистрирован как ученик
+)
+
+// Enum value maps for AddMemberStatus.
+var (
+	AddMemberStatus_name = map[int32]string{
+		0: "ADD_MEMBER_STATUS_UNSPECIFIED",
+		1: "ADD_MEMBER_STATUS_ADDED",
+		2: "ADD_MEMBER_STATUS_WAITLISTED",
+		3: "ADD_MEMBER_STATUS_ALREADY_MEMBER",
+		4: "ADD_MEMBER_STATUS_NOT_FOUND",
+		5: "ADD_MEMBER_STATUS_NOT_A_STUDENT",
+	}
+	AddMemberStatus_value = map[string]int32{
+		"ADD_MEMBER_STATUS_UNSPECIFIED":    0,
+		"ADD_MEMBER_STATUS_ADDED":          1,
+		"ADD_MEMBER_STATUS_WAITLISTED":     2,
+		"ADD_MEMBER_STATUS_ALREADY_MEMBER": 3,
+		"ADD_MEMBER_STATUS_NOT_FOUND":      4,
+		"ADD_MEMBER_STATUS_NOT_A_STUDENT":  5,
+	}
+)
+
+func (x AddMemberStatus) Enum() *AddMemberStatus {
+	p := new(AddMemberStatus)
+	*p = x
+	return p
+}
+
+func (x AddMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[4].Descriptor()
+}
+
+func (AddMemberStatus) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[4]
+}
+
+func (x AddMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddMemberStatus.Descriptor instead.
+func (AddMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{4}
+}
+
+type RosterImportStatus int32
+
+const (
+	RosterImportStatus_ROSTER_IMPORT_STATUS_UNSPECIFIED RosterImportStatus = 0
+	RosterImportStatus_ROSTER_IMPORT_STATUS_PENDING     RosterImportStatus = 1
+	RosterImportStatus_ROSTER_IMPORT_STATUS_PROCESSING  RosterImportStatus = 2
+	RosterImportStatus_ROSTER_IMPORT_STATUS_COMPLETED   RosterImportStatus = 3
+	RosterImportStatus_ROSTER_IMPORT_STATUS_FAILED      RosterImportStatus = 4 // Импорт не выполнен, причина в error_message
+)
+
+// Enum value maps for RosterImportStatus.
+var (
+	RosterImportStatus_name = map[int32]string{
+		0: "ROSTER_IMPORT_STATUS_UNSPECIFIED",
+		1: "ROSTER_IMPORT_STATUS_PENDING",
+		2: "ROSTER_IMPORT_STATUS_PROCESSING",
+		3: "ROSTER_IMPORT_STATUS_COMPLETED",
+		4: "ROSTER_IMPORT_STATUS_FAILED",
+	}
+	RosterImportStatus_value = map[string]int32{
+		"ROSTER_IMPORT_STATUS_UNSPECIFIED": 0,
+		"ROSTER_IMPORT_STATUS_PENDING":     1,
+		"ROSTER_IMPORT_STATUS_PROCESSING":  2,
+		"ROSTER_IMPORT_STATUS_COMPLETED":   3,
+		"ROSTER_IMPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x RosterImportStatus) Enum() *RosterImportStatus {
+	p := new(RosterImportStatus)
+	*p = x
+	return p
+}
+
+func (x RosterImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[5].Descriptor()
+}
+
+func (RosterImportStatus) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[5]
+}
+
+func (x RosterImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterImportStatus.Descriptor instead.
+func (RosterImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{5}
+}
+
+type RosterRowStatus int32
+
+const (
+	RosterRowStatus_ROSTER_ROW_STATUS_UNSPECIFIED     RosterRowStatus = 0 // Строка не обработана
+	RosterRowStatus_ROSTER_ROW_STATUS_ADDED           RosterRowStatus = 1
+	RosterRowStatus_ROSTER_ROW_STATUS_WAITLISTED      RosterRowStatus = 2 // Группа заполнена, ученик в очереди
+	RosterRowStatus_ROSTER_ROW_STATUS_INVITED         RosterRowStatus = 3 // Аккаунта нет, отправлено приглашение по email
+	RosterRowStatus_ROSTER_ROW_STATUS_ALREADY_MEMBER  RosterRowStatus = 4
+	RosterRowStatus_ROSTER_ROW_STATUS_ALREADY_INVITED RosterRowStatus = 5 // Уже есть действующее приглашение на этот email
+	RosterRowStatus_ROSTER_ROW_STATUS_NOT_A_STUDENT   RosterRowStatus = 6 // Аккаунт не зарегистрирован как ученик
+	RosterRowStatus_ROSTER_ROW_STATUS_INVALID         RosterRowStatus = 7 // Неверный или повторный email
+	RosterRowStatus_ROSTER_ROW_STATUS_FAILED          RosterRowStatus = 8
+)
+
+// Enum value maps for RosterRowStatus.
+var (
+	RosterRowStatus_name = map[int32]string{
+		0: "ROSTER_ROW_STATUS_UNSPECIFIED",
+		1: "ROSTER_ROW_STATUS_ADDED",
+		2: "ROSTER_ROW_STATUS_WAITLISTED",
+		3: "ROSTER_ROW_STATUS_INVITED",
+		4: "ROSTER_ROW_STATUS_ALREADY_MEMBER",
+		5: "ROSTER_ROW_STATUS_ALREADY_INVITED",
+		6: "ROSTER_ROW_STATUS_NOT_A_STUDENT",
+		7: "ROSTER_ROW_STATUS_INVALID",
+		8: "ROSTER_ROW_STATUS_FAILED",
+	}
+	RosterRowStatus_value = map[string]int32{
+		"ROSTER_ROW_STATUS_UNSPECIFIED":     0,
+		"ROSTER_ROW_STATUS_ADDED":           1,
+		"ROSTER_ROW_STATUS_WAITLISTED":      2,
+		"ROSTER_ROW_STATUS_INVITED":         3,
+		"ROSTER_ROW_STATUS_ALREADY_MEMBER":  4,
+		"ROSTER_ROW_STATUS_ALREADY_INVITED": 5,
+		"ROSTER_ROW_STATUS_NOT_A_STUDENT":   6,
+		"ROSTER_ROW_STATUS_INVALID":         7,
+		"ROSTER_ROW_STATUS_FAILED":          8,
+	}
+)
+
+func (x RosterRowStatus) Enum() *RosterRowStatus {
+	p := new(RosterRowStatus)
+	*p = x
+	return p
+}
+
+func (x RosterRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[6].Descriptor()
+}
+
+func (RosterRowStatus) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[6]
+}
+
+func (x RosterRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterRowStatus.Descriptor instead.
+func (RosterRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{6}
+}
+
+type JoinRequestStatus int32
+
+const (
+	JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED JoinRequestStatus = 0
+	JoinRequestStatus_JOIN_REQUEST_PENDING            JoinRequestStatus = 1
+	JoinRequestStatus_JOIN_REQUEST_APPROVED           JoinRequestStatus = 2
+	JoinRequestStatus_JOIN_REQUEST_REJECTED           JoinRequestStatus = 3
+)
+
+// Enum value maps for JoinRequestStatus.
+var (
+	JoinRequestStatus_name = map[int32]string{
+		0: "JOIN_REQUEST_STATUS_UNSPECIFIED",
+		1: "JOIN_REQUEST_PENDING",
+		2: "JOIN_REQUEST_APPROVED",
+		3: "JOIN_REQUEST_REJECTED",
+	}
+	JoinRequestStatus_value = map[string]int32{
+		"JOIN_REQUEST_STATUS_UNSPECIFIED": 0,
+		"JOIN_REQUEST_PENDING":            1,
+		"JOIN_REQUEST_APPROVED":           2,
+		"JOIN_REQUEST_REJECTED":           3,
+	}
+)
+
+func (x JoinRequestStatus) Enum() *JoinRequestStatus {
+	p := new(JoinRequestStatus)
+	*p = x
+	return p
+}
+
+func (x JoinRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[7].Descriptor()
+}
+
+func (JoinRequestStatus) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[7]
+}
+
+func (x JoinRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinRequestStatus.Descriptor instead.
+func (JoinRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{7}
+}
+
+type StaffRole int32
+
+const (
+	StaffRole_STAFF_ROLE_UNSPECIFIED StaffRole = 0
+	StaffRole_STAFF_ROLE_OWNER       StaffRole = 1
+	StaffRole_STAFF_ROLE_CO_TUTOR    StaffRole = 2 // Ведет группу наравне с владельцем, кроме удаления группы и управления персоналом
+	StaffRole_STAFF_ROLE_ASSISTANT   StaffRole = 3 // Только проверка работ
+)
+
+// Enum value maps for StaffRole.
+var (
+	StaffRole_name = map[int32]string{
+		0: "STAFF_ROLE_UNSPECIFIED",
+		1: "STAFF_ROLE_OWNER",
+		2: "STAFF_ROLE_CO_TUTOR",
+		3: "STAFF_ROLE_ASSISTANT",
+	}
+	StaffRole_value = map[string]int32{
+		"STAFF_ROLE_UNSPECIFIED": 0,
+		"STAFF_ROLE_OWNER":       1,
+		"STAFF_ROLE_CO_TUTOR":    2,
+		"STAFF_ROLE_ASSISTANT":   3,
+	}
+)
+
+func (x StaffRole) Enum() *StaffRole {
+	p := new(StaffRole)
+	*p = x
+	return p
+}
+
+func (x StaffRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[8].Descriptor()
+}
+
+func (StaffRole) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[8]
+}
+
+func (x StaffRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffRole.Descriptor instead.
+func (StaffRole) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{8}
+}
+
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED       Permission = 0
+	Permission_PERMISSION_UPDATE_GROUP      Permission = 1
+	Permission_PERMISSION_DELETE_GROUP      Permission = 2
+	Permission_PERMISSION_MANAGE_STAFF      Permission = 3
+	Permission_PERMISSION_MANAGE_MEMBERS    Permission = 4
+	Permission_PERMISSION_MANAGE_TASKS      Permission = 5
+	Permission_PERMISSION_GRADE_SUBMISSIONS Permission = 6
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "PERMISSION_UPDATE_GROUP",
+		2: "PERMISSION_DELETE_GROUP",
+		3: "PERMISSION_MANAGE_STAFF",
+		4: "PERMISSION_MANAGE_MEMBERS",
+		5: "PERMISSION_MANAGE_TASKS",
+		6: "PERMISSION_GRADE_SUBMISSIONS",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":       0,
+		"PERMISSION_UPDATE_GROUP":      1,
+		"PERMISSION_DELETE_GROUP":      2,
+		"PERMISSION_MANAGE_STAFF":      3,
+		"PERMISSION_MANAGE_MEMBERS":    4,
+		"PERMISSION_MANAGE_TASKS":      5,
+		"PERMISSION_GRADE_SUBMISSIONS": 6,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[9].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[9]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{9}
+}
+
+type GroupMessageEventType int32
+
+const (
+	GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED GroupMessageEventType = 0
+	GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_CREATED     GroupMessageEventType = 1
+	GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_EDITED      GroupMessageEventType = 2
+	GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_DELETED     GroupMessageEventType = 3
+)
+
+// Enum value maps for GroupMessageEventType.
+var (
+	GroupMessageEventType_name = map[int32]string{
+		0: "GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED",
+		1: "GROUP_MESSAGE_EVENT_TYPE_CREATED",
+		2: "GROUP_MESSAGE_EVENT_TYPE_EDITED",
+		3: "GROUP_MESSAGE_EVENT_TYPE_DELETED",
+	}
+	GroupMessageEventType_value = map[string]int32{
+		"GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED": 0,
+		"GROUP_MESSAGE_EVENT_TYPE_CREATED":     1,
+		"GROUP_MESSAGE_EVENT_TYPE_EDITED":      2,
+		"GROUP_MESSAGE_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x GroupMessageEventType) Enum() *GroupMessageEventType {
+	p := new(GroupMessageEventType)
+	*p = x
+	return p
+}
+
+func (x GroupMessageEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupMessageEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_group_service_proto_enumTypes[10].Descriptor()
+}
+
+func (GroupMessageEventType) Type() protoreflect.EnumType {
+	return &file_group_group_service_proto_enumTypes[10]
+}
+
+func (x GroupMessageEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupMessageEventType.Descriptor instead.
+func (GroupMessageEventType) EnumDescriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{10}
+}
+
 type Error struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -31,21 +634,6708 @@ type Error struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
-	mi := &file_group_group_service_proto_msgTypes[0]
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_group_group_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // Вычисляемое поле - количество участников
+	Members       []*GroupMember         `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`                             // Участники группы (опционально)
+	JoinPolicy    JoinPolicy             `protobuf:"varint,8,opt,name=join_policy,json=joinPolicy,proto3,enum=group.JoinPolicy" json:"join_policy,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`   // Заполнено у архивной группы
+	MaxMembers    int32                  `protobuf:"varint,10,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"` // 0 - без ограничения
+	Term          *Term                  `protobuf:"bytes,11,opt,name=term,proto3" json:"term,omitempty"`                                // Учебный период, если группа к нему привязана
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_group_group_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Group) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Group) GetJoinPolicy() JoinPolicy {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_UNSPECIFIED
+}
+
+func (x *Group) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Group) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *Group) GetTerm() *Term {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type Term struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // Группы периода архивируются в этот момент
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GroupCount    int32                  `protobuf:"varint,7,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"` // Активные группы периода, в описании группы не заполняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	mi := &file_group_group_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Term) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Term) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Term) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Term) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Term) GetGroupCount() int32 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+type GroupMember struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId   string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	JoinedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// Заполняются только при include_profiles
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Surname       string `protobuf:"bytes,5,opt,name=surname,proto3" json:"surname,omitempty"`
+	AvatarUrl     string `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_group_group_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupMember) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMember) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GroupMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *GroupMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupMember) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *GroupMember) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	JoinPolicy    JoinPolicy             `protobuf:"varint,4,opt,name=join_policy,json=joinPolicy,proto3,enum=group.JoinPolicy" json:"join_policy,omitempty"` // По умолчанию только по приглашению
+	MaxMembers    int32                  `protobuf:"varint,5,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`                       // 0 - без ограничения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateGroupRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetJoinPolicy() JoinPolicy {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_UNSPECIFIED
+}
+
+func (x *CreateGroupRequest) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+type CreateGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*CreateGroupResponse_Group
+	//	*CreateGroupResponse_Error
+	Result        isCreateGroupResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateGroupResponse) GetResult() isCreateGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CreateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*CreateGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *CreateGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*CreateGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isCreateGroupResponse_Result interface {
+	isCreateGroupResponse_Result()
+}
+
+type CreateGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type CreateGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateGroupResponse_Group) isCreateGroupResponse_Result() {}
+
+func (*CreateGroupResponse_Error) isCreateGroupResponse_Result() {}
+
+// Без filter - все группы вызывающего: где он владелец, персонал или ученик
+type ListGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*ListGroupsRequest_TutorId
+	//	*ListGroupsRequest_StudentId
+	Filter          isListGroupsRequest_Filter `protobuf_oneof:"filter"`
+	IncludeMembers  bool                       `protobuf:"varint,3,opt,name=include_members,json=includeMembers,proto3" json:"include_members,omitempty"`    // Включать ли список участников
+	IncludeArchived bool                       `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Включать ли архивные группы, если archive_filter не задан
+	Search          string                     `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`                                           // Подстрока названия без учета регистра
+	SortBy          GroupSortField             `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=group.GroupSortField" json:"sort_by,omitempty"`
+	SortDirection   SortDirection              `protobuf:"varint,7,opt,name=sort_direction,json=sortDirection,proto3,enum=group.SortDirection" json:"sort_direction,omitempty"`
+	PageSize        int32                      `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // По умолчанию 50, не больше 100
+	PageToken       string                     `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
+	ArchiveFilter   ArchiveFilter              `protobuf:"varint,10,opt,name=archive_filter,json=archiveFilter,proto3,enum=group.ArchiveFilter" json:"archive_filter,omitempty"`
+	TermId          string                     `protobuf:"bytes,11,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"` // Только группы учебного периода
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGroupsRequest) GetFilter() isListGroupsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListGroupsRequest) GetTutorId() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListGroupsRequest_TutorId); ok {
+			return x.TutorId
+		}
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetStudentId() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListGroupsRequest_StudentId); ok {
+			return x.StudentId
+		}
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetIncludeMembers() bool {
+	if x != nil {
+		return x.IncludeMembers
+	}
+	return false
+}
+
+func (x *ListGroupsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListGroupsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetSortBy() GroupSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return GroupSortField_GROUP_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetArchiveFilter() ArchiveFilter {
+	if x != nil {
+		return x.ArchiveFilter
+	}
+	return ArchiveFilter_ARCHIVE_FILTER_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+type isListGroupsRequest_Filter interface {
+	isListGroupsRequest_Filter()
+}
+
+type ListGroupsRequest_TutorId struct {
+	TutorId string `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3,oneof"` // Группы конкретного репетитора
+}
+
+type ListGroupsRequest_StudentId struct {
+	StudentId string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3,oneof"` // Группы конкретного студента
+}
+
+func (*ListGroupsRequest_TutorId) isListGroupsRequest_Filter() {}
+
+func (*ListGroupsRequest_StudentId) isListGroupsRequest_Filter() {}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто на последней странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ListGroupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetGroupRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                // ID группы
+	IncludeMembers bool                   `protobuf:"varint,2,opt,name=include_members,json=includeMembers,proto3" json:"include_members,omitempty"` // Включать ли список участников
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupRequest) GetIncludeMembers() bool {
+	if x != nil {
+		return x.IncludeMembers
+	}
+	return false
+}
+
+type GetGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GetGroupResponse_Group
+	//	*GetGroupResponse_Error
+	Result        isGetGroupResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGroupResponse) GetResult() isGetGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*GetGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *GetGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GetGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGetGroupResponse_Result interface {
+	isGetGroupResponse_Result()
+}
+
+type GetGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type GetGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetGroupResponse_Group) isGetGroupResponse_Result() {}
+
+func (*GetGroupResponse_Error) isGetGroupResponse_Result() {}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                         // ID группы для обновления
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`               // Новое название (если нужно обновить)
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"` // Новое описание (если нужно обновить)
+	JoinPolicy    *JoinPolicy            `protobuf:"varint,4,opt,name=join_policy,json=joinPolicy,proto3,enum=group.JoinPolicy,oneof" json:"join_policy,omitempty"`
+	MaxMembers    *int32                 `protobuf:"varint,5,opt,name=max_members,json=maxMembers,proto3,oneof" json:"max_members,omitempty"` // 0 снимает ограничение
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetJoinPolicy() JoinPolicy {
+	if x != nil && x.JoinPolicy != nil {
+		return *x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateGroupRequest) GetMaxMembers() int32 {
+	if x != nil && x.MaxMembers != nil {
+		return *x.MaxMembers
+	}
+	return 0
+}
+
+type UpdateGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*UpdateGroupResponse_Group
+	//	*UpdateGroupResponse_Error
+	Result        isUpdateGroupResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateGroupResponse) GetResult() isUpdateGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *UpdateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*UpdateGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *UpdateGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*UpdateGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isUpdateGroupResponse_Result interface {
+	isUpdateGroupResponse_Result()
+}
+
+type UpdateGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type UpdateGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateGroupResponse_Group) isUpdateGroupResponse_Result() {}
+
+func (*UpdateGroupResponse_Error) isUpdateGroupResponse_Result() {}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID группы для удаления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteGroupResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTermRequest) Reset() {
+	*x = CreateTermRequest{}
+	mi := &file_group_group_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTermRequest) ProtoMessage() {}
+
+func (x *CreateTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTermRequest.ProtoReflect.Descriptor instead.
+func (*CreateTermRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTermRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTermRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateTermRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type TermResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*TermResponse_Term
+	//	*TermResponse_Error
+	Result        isTermResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermResponse) Reset() {
+	*x = TermResponse{}
+	mi := &file_group_group_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermResponse) ProtoMessage() {}
+
+func (x *TermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermResponse.ProtoReflect.Descriptor instead.
+func (*TermResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TermResponse) GetResult() isTermResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TermResponse) GetTerm() *Term {
+	if x != nil {
+		if x, ok := x.Result.(*TermResponse_Term); ok {
+			return x.Term
+		}
+	}
+	return nil
+}
+
+func (x *TermResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*TermResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isTermResponse_Result interface {
+	isTermResponse_Result()
+}
+
+type TermResponse_Term struct {
+	Term *Term `protobuf:"bytes,1,opt,name=term,proto3,oneof"`
+}
+
+type TermResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*TermResponse_Term) isTermResponse_Result() {}
+
+func (*TermResponse_Error) isTermResponse_Result() {}
+
+type ListTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTermsRequest) Reset() {
+	*x = ListTermsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTermsRequest) ProtoMessage() {}
+
+func (x *ListTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTermsRequest.ProtoReflect.Descriptor instead.
+func (*ListTermsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{16}
+}
+
+type ListTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"` // Последние по началу первыми
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTermsResponse) Reset() {
+	*x = ListTermsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTermsResponse) ProtoMessage() {}
+
+func (x *ListTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTermsResponse.ProtoReflect.Descriptor instead.
+func (*ListTermsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTermsResponse) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *ListTermsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermId        string                 `protobuf:"bytes,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTermRequest) Reset() {
+	*x = GetTermRequest{}
+	mi := &file_group_group_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermRequest) ProtoMessage() {}
+
+func (x *GetTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermRequest.ProtoReflect.Descriptor instead.
+func (*GetTermRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTermRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+type UpdateTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermId        string                 `protobuf:"bytes,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Не задано - без изменений
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`       // Не задано - без изменений
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTermRequest) Reset() {
+	*x = UpdateTermRequest{}
+	mi := &file_group_group_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTermRequest) ProtoMessage() {}
+
+func (x *UpdateTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTermRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTermRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTermRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+func (x *UpdateTermRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTermRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *UpdateTermRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type DeleteTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermId        string                 `protobuf:"bytes,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermRequest) Reset() {
+	*x = DeleteTermRequest{}
+	mi := &file_group_group_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermRequest) ProtoMessage() {}
+
+func (x *DeleteTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTermRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteTermRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+type DeleteTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermResponse) Reset() {
+	*x = DeleteTermResponse{}
+	mi := &file_group_group_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermResponse) ProtoMessage() {}
+
+func (x *DeleteTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTermResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTermResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SetGroupTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	TermId        string                 `protobuf:"bytes,2,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupTermRequest) Reset() {
+	*x = SetGroupTermRequest{}
+	mi := &file_group_group_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupTermRequest) ProtoMessage() {}
+
+func (x *SetGroupTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupTermRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupTermRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetGroupTermRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGroupTermRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+type SetGroupTermResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*SetGroupTermResponse_Group
+	//	*SetGroupTermResponse_Error
+	Result        isSetGroupTermResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupTermResponse) Reset() {
+	*x = SetGroupTermResponse{}
+	mi := &file_group_group_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupTermResponse) ProtoMessage() {}
+
+func (x *SetGroupTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupTermResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupTermResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetGroupTermResponse) GetResult() isSetGroupTermResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SetGroupTermResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*SetGroupTermResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *SetGroupTermResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*SetGroupTermResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isSetGroupTermResponse_Result interface {
+	isSetGroupTermResponse_Result()
+}
+
+type SetGroupTermResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type SetGroupTermResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SetGroupTermResponse_Group) isSetGroupTermResponse_Result() {}
+
+func (*SetGroupTermResponse_Error) isSetGroupTermResponse_Result() {}
+
+type ArchiveGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveGroupRequest) Reset() {
+	*x = ArchiveGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveGroupRequest) ProtoMessage() {}
+
+func (x *ArchiveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveGroupRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ArchiveGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchiveGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ArchiveGroupResponse_Group
+	//	*ArchiveGroupResponse_Error
+	Result        isArchiveGroupResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveGroupResponse) Reset() {
+	*x = ArchiveGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveGroupResponse) ProtoMessage() {}
+
+func (x *ArchiveGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveGroupResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArchiveGroupResponse) GetResult() isArchiveGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ArchiveGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*ArchiveGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *ArchiveGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ArchiveGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isArchiveGroupResponse_Result interface {
+	isArchiveGroupResponse_Result()
+}
+
+type ArchiveGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type ArchiveGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ArchiveGroupResponse_Group) isArchiveGroupResponse_Result() {}
+
+func (*ArchiveGroupResponse_Error) isArchiveGroupResponse_Result() {}
+
+type RestoreGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGroupRequest) Reset() {
+	*x = RestoreGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGroupRequest) ProtoMessage() {}
+
+func (x *RestoreGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGroupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*RestoreGroupResponse_Group
+	//	*RestoreGroupResponse_Error
+	Result        isRestoreGroupResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGroupResponse) Reset() {
+	*x = RestoreGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGroupResponse) ProtoMessage() {}
+
+func (x *RestoreGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGroupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreGroupResponse) GetResult() isRestoreGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RestoreGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*RestoreGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *RestoreGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*RestoreGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isRestoreGroupResponse_Result interface {
+	isRestoreGroupResponse_Result()
+}
+
+type RestoreGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type RestoreGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RestoreGroupResponse_Group) isRestoreGroupResponse_Result() {}
+
+func (*RestoreGroupResponse_Error) isRestoreGroupResponse_Result() {}
+
+type ListGroupMembersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupId         string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                          // ID группы
+	IncludeProfiles bool                   `protobuf:"varint,2,opt,name=include_profiles,json=includeProfiles,proto3" json:"include_profiles,omitempty"` // Добавить имена и аватары, доступно персоналу и ученикам группы
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_group_group_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListGroupMembersRequest) GetIncludeProfiles() bool {
+	if x != nil {
+		return x.IncludeProfiles
+	}
+	return false
+}
+
+type ListGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_group_group_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListGroupMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListGroupMembersResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type AddGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // ID группы
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // ID или email студентов для добавления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMembersRequest) Reset() {
+	*x = AddGroupMembersRequest{}
+	mi := &file_group_group_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersRequest) ProtoMessage() {}
+
+func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddGroupMembersRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type AddMemberResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`                          // ID или email из запроса
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // Пусто, если пользователь не найден
+	Status        AddMemberStatus        `protobuf:"varint,3,opt,name=status,proto3,enum=group.AddMemberStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberResult) Reset() {
+	*x = AddMemberResult{}
+	mi := &file_group_group_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberResult) ProtoMessage() {}
+
+func (x *AddMemberResult) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberResult.ProtoReflect.Descriptor instead.
+func (*AddMemberResult) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddMemberResult) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *AddMemberResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AddMemberResult) GetStatus() AddMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddMemberStatus_ADD_MEMBER_STATUS_UNSPECIFIED
+}
+
+type AddGroupMembersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AddedCount      int32                  `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"` // Сколько студентов успешно добавлено
+	Error           *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	WaitlistedCount int32                  `protobuf:"varint,3,opt,name=waitlisted_count,json=waitlistedCount,proto3" json:"waitlisted_count,omitempty"` // Сколько поставлено в очередь заполненной группы
+	Results         []*AddMemberResult     `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`                                         // Результат по каждому элементу запроса
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddGroupMembersResponse) Reset() {
+	*x = AddGroupMembersResponse{}
+	mi := &file_group_group_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMembersResponse) ProtoMessage() {}
+
+func (x *AddGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddGroupMembersResponse) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *AddGroupMembersResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *AddGroupMembersResponse) GetWaitlistedCount() int32 {
+	if x != nil {
+		return x.WaitlistedCount
+	}
+	return 0
+}
+
+func (x *AddGroupMembersResponse) GetResults() []*AddMemberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RemoveGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // ID группы
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // Массив ID студентов для удаления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMembersRequest) Reset() {
+	*x = RemoveGroupMembersRequest{}
+	mi := &file_group_group_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMembersRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveGroupMembersRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type RemoveGroupMembersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount       int32                  `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"` // Сколько студентов успешно удалено
+	Error              *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PromotedStudentIds []string               `protobuf:"bytes,3,rep,name=promoted_student_ids,json=promotedStudentIds,proto3" json:"promoted_student_ids,omitempty"` // Переведены из очереди на освободившиеся места
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMembersResponse) Reset() {
+	*x = RemoveGroupMembersResponse{}
+	mi := &file_group_group_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMembersResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveGroupMembersResponse) GetRemovedCount() int32 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+func (x *RemoveGroupMembersResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RemoveGroupMembersResponse) GetPromotedStudentIds() []string {
+	if x != nil {
+		return x.PromotedStudentIds
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // Место в очереди, начиная с 1
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_group_group_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WaitlistEntry) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListGroupWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupWaitlistRequest) Reset() {
+	*x = ListGroupWaitlistRequest{}
+	mi := &file_group_group_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupWaitlistRequest) ProtoMessage() {}
+
+func (x *ListGroupWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListGroupWaitlistRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListGroupWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupWaitlistResponse) Reset() {
+	*x = ListGroupWaitlistResponse{}
+	mi := &file_group_group_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupWaitlistResponse) ProtoMessage() {}
+
+func (x *ListGroupWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListGroupWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListGroupWaitlistResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Ученик может покинуть очередь сам, других убирает репетитор
+type RemoveFromGroupWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromGroupWaitlistRequest) Reset() {
+	*x = RemoveFromGroupWaitlistRequest{}
+	mi := &file_group_group_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromGroupWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromGroupWaitlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromGroupWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromGroupWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromGroupWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveFromGroupWaitlistRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveFromGroupWaitlistRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type RemoveFromGroupWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromGroupWaitlistResponse) Reset() {
+	*x = RemoveFromGroupWaitlistResponse{}
+	mi := &file_group_group_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromGroupWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromGroupWaitlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromGroupWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromGroupWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromGroupWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveFromGroupWaitlistResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RosterRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Номер строки в CSV
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	StudentId     string                 `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`      // Пусто, если аккаунт не найден
+	Status        RosterRowStatus        `protobuf:"varint,6,opt,name=status,proto3,enum=group.RosterRowStatus" json:"status,omitempty"` // При предпросмотре - ожидаемый итог
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterRow) Reset() {
+	*x = RosterRow{}
+	mi := &file_group_group_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterRow) ProtoMessage() {}
+
+func (x *RosterRow) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterRow.ProtoReflect.Descriptor instead.
+func (*RosterRow) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RosterRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *RosterRow) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RosterRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RosterRow) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *RosterRow) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RosterRow) GetStatus() RosterRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RosterRowStatus_ROSTER_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *RosterRow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RosterImport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Пусто при предпросмотре
+	GroupId         string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Status          RosterImportStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=group.RosterImportStatus" json:"status,omitempty"`
+	TotalRows       int32                  `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	AddedCount      int32                  `protobuf:"varint,6,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"`
+	WaitlistedCount int32                  `protobuf:"varint,7,opt,name=waitlisted_count,json=waitlistedCount,proto3" json:"waitlisted_count,omitempty"`
+	InvitedCount    int32                  `protobuf:"varint,8,opt,name=invited_count,json=invitedCount,proto3" json:"invited_count,omitempty"`
+	SkippedCount    int32                  `protobuf:"varint,9,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"` // Уже в группе, уже приглашены или не ученики
+	FailedCount     int32                  `protobuf:"varint,10,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Rows            []*RosterRow           `protobuf:"bytes,11,rep,name=rows,proto3" json:"rows,omitempty"`
+	ErrorMessage    string                 `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RosterImport) Reset() {
+	*x = RosterImport{}
+	mi := &file_group_group_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterImport) ProtoMessage() {}
+
+func (x *RosterImport) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterImport.ProtoReflect.Descriptor instead.
+func (*RosterImport) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RosterImport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RosterImport) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RosterImport) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RosterImport) GetStatus() RosterImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RosterImportStatus_ROSTER_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *RosterImport) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *RosterImport) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *RosterImport) GetWaitlistedCount() int32 {
+	if x != nil {
+		return x.WaitlistedCount
+	}
+	return 0
+}
+
+func (x *RosterImport) GetInvitedCount() int32 {
+	if x != nil {
+		return x.InvitedCount
+	}
+	return 0
+}
+
+func (x *RosterImport) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *RosterImport) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *RosterImport) GetRows() []*RosterRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *RosterImport) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RosterImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RosterImport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// CSV с заголовком: колонка email обязательна, name и surname необязательны. До 500 строк.
+type ImportGroupRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Csv           string                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Только предпросмотр: ничего не меняется, ответ сразу с итогами
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGroupRosterRequest) Reset() {
+	*x = ImportGroupRosterRequest{}
+	mi := &file_group_group_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGroupRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGroupRosterRequest) ProtoMessage() {}
+
+func (x *ImportGroupRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGroupRosterRequest.ProtoReflect.Descriptor instead.
+func (*ImportGroupRosterRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportGroupRosterRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ImportGroupRosterRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *ImportGroupRosterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type GetGroupRosterImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ImportId      string                 `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRosterImportRequest) Reset() {
+	*x = GetGroupRosterImportRequest{}
+	mi := &file_group_group_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRosterImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRosterImportRequest) ProtoMessage() {}
+
+func (x *GetGroupRosterImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRosterImportRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRosterImportRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupRosterImportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupRosterImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+type RosterImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*RosterImportResponse_RosterImport
+	//	*RosterImportResponse_Error
+	Result        isRosterImportResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterImportResponse) Reset() {
+	*x = RosterImportResponse{}
+	mi := &file_group_group_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterImportResponse) ProtoMessage() {}
+
+func (x *RosterImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterImportResponse.ProtoReflect.Descriptor instead.
+func (*RosterImportResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RosterImportResponse) GetResult() isRosterImportResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RosterImportResponse) GetRosterImport() *RosterImport {
+	if x != nil {
+		if x, ok := x.Result.(*RosterImportResponse_RosterImport); ok {
+			return x.RosterImport
+		}
+	}
+	return nil
+}
+
+func (x *RosterImportResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*RosterImportResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isRosterImportResponse_Result interface {
+	isRosterImportResponse_Result()
+}
+
+type RosterImportResponse_RosterImport struct {
+	RosterImport *RosterImport `protobuf:"bytes,1,opt,name=roster_import,json=rosterImport,proto3,oneof"`
+}
+
+type RosterImportResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RosterImportResponse_RosterImport) isRosterImportResponse_Result() {}
+
+func (*RosterImportResponse_Error) isRosterImportResponse_Result() {}
+
+type GetGroupRosterImportReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // text/csv
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                            // Строки CSV с итогами: line, email, name, surname, student_id, status, message
+	Error         *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRosterImportReportResponse) Reset() {
+	*x = GetGroupRosterImportReportResponse{}
+	mi := &file_group_group_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRosterImportReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRosterImportReportResponse) ProtoMessage() {}
+
+func (x *GetGroupRosterImportReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRosterImportReportResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupRosterImportReportResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetGroupRosterImportReportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetGroupRosterImportReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetGroupRosterImportReportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetGroupRosterImportReportResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GroupInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Link          string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`                       // Ссылка с кодом для отправки ученикам
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                     // Адрес для приглашения по email, пусто для общего кода
+	MaxUses       int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 - без ограничения
+	UseCount      int32                  `protobuf:"varint,7,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,10,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInvitation) Reset() {
+	*x = GroupInvitation{}
+	mi := &file_group_group_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInvitation) ProtoMessage() {}
+
+func (x *GroupInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInvitation.ProtoReflect.Descriptor instead.
+func (*GroupInvitation) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GroupInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupInvitation) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupInvitation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GroupInvitation) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *GroupInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GroupInvitation) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GroupInvitation) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *GroupInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GroupInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupInvitation) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type CreateGroupInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`      // 0 - без ограничения, для приглашения по email всегда 1
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // По умолчанию через 7 дней
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                          // Отправить приглашение на адрес, пользователь может быть еще не зарегистрирован
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupInvitationRequest) Reset() {
+	*x = CreateGroupInvitationRequest{}
+	mi := &file_group_group_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInvitationRequest) ProtoMessage() {}
+
+func (x *CreateGroupInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateGroupInvitationRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupInvitationRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateGroupInvitationRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateGroupInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateGroupInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*CreateGroupInvitationResponse_Invitation
+	//	*CreateGroupInvitationResponse_Error
+	Result        isCreateGroupInvitationResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupInvitationResponse) Reset() {
+	*x = CreateGroupInvitationResponse{}
+	mi := &file_group_group_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInvitationResponse) ProtoMessage() {}
+
+func (x *CreateGroupInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateGroupInvitationResponse) GetResult() isCreateGroupInvitationResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CreateGroupInvitationResponse) GetInvitation() *GroupInvitation {
+	if x != nil {
+		if x, ok := x.Result.(*CreateGroupInvitationResponse_Invitation); ok {
+			return x.Invitation
+		}
+	}
+	return nil
+}
+
+func (x *CreateGroupInvitationResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*CreateGroupInvitationResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isCreateGroupInvitationResponse_Result interface {
+	isCreateGroupInvitationResponse_Result()
+}
+
+type CreateGroupInvitationResponse_Invitation struct {
+	Invitation *GroupInvitation `protobuf:"bytes,1,opt,name=invitation,proto3,oneof"`
+}
+
+type CreateGroupInvitationResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateGroupInvitationResponse_Invitation) isCreateGroupInvitationResponse_Result() {}
+
+func (*CreateGroupInvitationResponse_Error) isCreateGroupInvitationResponse_Result() {}
+
+type ListGroupInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupInvitationsRequest) Reset() {
+	*x = ListGroupInvitationsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupInvitationsRequest) ProtoMessage() {}
+
+func (x *ListGroupInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListGroupInvitationsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListGroupInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*GroupInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupInvitationsResponse) Reset() {
+	*x = ListGroupInvitationsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupInvitationsResponse) ProtoMessage() {}
+
+func (x *ListGroupInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListGroupInvitationsResponse) GetInvitations() []*GroupInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListGroupInvitationsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RevokeGroupInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	InvitationId  string                 `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGroupInvitationRequest) Reset() {
+	*x = RevokeGroupInvitationRequest{}
+	mi := &file_group_group_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGroupInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeGroupInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RevokeGroupInvitationRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RevokeGroupInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeGroupInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGroupInvitationResponse) Reset() {
+	*x = RevokeGroupInvitationResponse{}
+	mi := &file_group_group_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGroupInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeGroupInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeGroupInvitationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type JoinGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Код приглашения, регистр не важен
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupRequest) Reset() {
+	*x = JoinGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupRequest) ProtoMessage() {}
+
+func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
+func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *JoinGroupRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*JoinGroupResponse_Group
+	//	*JoinGroupResponse_Error
+	Result        isJoinGroupResponse_Result `protobuf_oneof:"result"`
+	Waitlisted    bool                       `protobuf:"varint,3,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"` // Группа заполнена: приглашение использовано, ученик в очереди
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupResponse) Reset() {
+	*x = JoinGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupResponse) ProtoMessage() {}
+
+func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupResponse.ProtoReflect.Descriptor instead.
+func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JoinGroupResponse) GetResult() isJoinGroupResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *JoinGroupResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*JoinGroupResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *JoinGroupResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*JoinGroupResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *JoinGroupResponse) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+type isJoinGroupResponse_Result interface {
+	isJoinGroupResponse_Result()
+}
+
+type JoinGroupResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type JoinGroupResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*JoinGroupResponse_Group) isJoinGroupResponse_Result() {}
+
+func (*JoinGroupResponse_Error) isJoinGroupResponse_Result() {}
+
+type JoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // Сообщение ученика репетитору
+	Status        JoinRequestStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=group.JoinRequestStatus" json:"status,omitempty"`
+	DecidedBy     string                 `protobuf:"bytes,6,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"` // Пусто для автоматического одобрения в открытой группе
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,8,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Waitlisted    bool                   `protobuf:"varint,10,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"` // Заявка одобрена, но группа заполнена и ученик в очереди. Только в ответе на вступление
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_group_group_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *JoinRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *JoinRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *JoinRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JoinRequest) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+type RequestToJoinGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Необязательно, до 500 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestToJoinGroupRequest) Reset() {
+	*x = RequestToJoinGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestToJoinGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestToJoinGroupRequest) ProtoMessage() {}
+
+func (x *RequestToJoinGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestToJoinGroupRequest.ProtoReflect.Descriptor instead.
+func (*RequestToJoinGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RequestToJoinGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RequestToJoinGroupRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JoinRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*JoinRequestResponse_Request
+	//	*JoinRequestResponse_Error
+	Result        isJoinRequestResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequestResponse) Reset() {
+	*x = JoinRequestResponse{}
+	mi := &file_group_group_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestResponse) ProtoMessage() {}
+
+func (x *JoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*JoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *JoinRequestResponse) GetResult() isJoinRequestResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *JoinRequestResponse) GetRequest() *JoinRequest {
+	if x != nil {
+		if x, ok := x.Result.(*JoinRequestResponse_Request); ok {
+			return x.Request
+		}
+	}
+	return nil
+}
+
+func (x *JoinRequestResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*JoinRequestResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isJoinRequestResponse_Result interface {
+	isJoinRequestResponse_Result()
+}
+
+type JoinRequestResponse_Request struct {
+	Request *JoinRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type JoinRequestResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*JoinRequestResponse_Request) isJoinRequestResponse_Result() {}
+
+func (*JoinRequestResponse_Error) isJoinRequestResponse_Result() {}
+
+type ListJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Status        JoinRequestStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=group.JoinRequestStatus" json:"status,omitempty"` // По умолчанию ожидающие решения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListJoinRequestsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListJoinRequestsRequest) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*JoinRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListJoinRequestsResponse) GetRequests() []*JoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListJoinRequestsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ApproveJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_group_group_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
+
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApproveJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ApproveJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RejectJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestRequest) Reset() {
+	*x = RejectJoinRequestRequest{}
+	mi := &file_group_group_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestRequest) ProtoMessage() {}
+
+func (x *RejectJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RejectJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RejectJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RejectJoinRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GroupStaffMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=group.StaffRole" json:"role,omitempty"`
+	AddedBy       string                 `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"` // Пусто для владельца
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupStaffMember) Reset() {
+	*x = GroupStaffMember{}
+	mi := &file_group_group_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStaffMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStaffMember) ProtoMessage() {}
+
+func (x *GroupStaffMember) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupStaffMember.ProtoReflect.Descriptor instead.
+func (*GroupStaffMember) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GroupStaffMember) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupStaffMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GroupStaffMember) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *GroupStaffMember) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *GroupStaffMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddGroupStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=group.StaffRole" json:"role,omitempty"` // Соведущий или ассистент
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupStaffRequest) Reset() {
+	*x = AddGroupStaffRequest{}
+	mi := &file_group_group_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupStaffRequest) ProtoMessage() {}
+
+func (x *AddGroupStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupStaffRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupStaffRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AddGroupStaffRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddGroupStaffRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddGroupStaffRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+type GroupStaffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GroupStaffResponse_Member
+	//	*GroupStaffResponse_Error
+	Result        isGroupStaffResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupStaffResponse) Reset() {
+	*x = GroupStaffResponse{}
+	mi := &file_group_group_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStaffResponse) ProtoMessage() {}
+
+func (x *GroupStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupStaffResponse.ProtoReflect.Descriptor instead.
+func (*GroupStaffResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GroupStaffResponse) GetResult() isGroupStaffResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GroupStaffResponse) GetMember() *GroupStaffMember {
+	if x != nil {
+		if x, ok := x.Result.(*GroupStaffResponse_Member); ok {
+			return x.Member
+		}
+	}
+	return nil
+}
+
+func (x *GroupStaffResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GroupStaffResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGroupStaffResponse_Result interface {
+	isGroupStaffResponse_Result()
+}
+
+type GroupStaffResponse_Member struct {
+	Member *GroupStaffMember `protobuf:"bytes,1,opt,name=member,proto3,oneof"`
+}
+
+type GroupStaffResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GroupStaffResponse_Member) isGroupStaffResponse_Result() {}
+
+func (*GroupStaffResponse_Error) isGroupStaffResponse_Result() {}
+
+type ListGroupStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupStaffRequest) Reset() {
+	*x = ListGroupStaffRequest{}
+	mi := &file_group_group_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupStaffRequest) ProtoMessage() {}
+
+func (x *ListGroupStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupStaffRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListGroupStaffRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListGroupStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*GroupStaffMember    `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupStaffResponse) Reset() {
+	*x = ListGroupStaffResponse{}
+	mi := &file_group_group_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupStaffResponse) ProtoMessage() {}
+
+func (x *ListGroupStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupStaffResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListGroupStaffResponse) GetStaff() []*GroupStaffMember {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *ListGroupStaffResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateGroupStaffRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=group.StaffRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupStaffRoleRequest) Reset() {
+	*x = UpdateGroupStaffRoleRequest{}
+	mi := &file_group_group_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupStaffRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupStaffRoleRequest) ProtoMessage() {}
+
+func (x *UpdateGroupStaffRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupStaffRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupStaffRoleRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateGroupStaffRoleRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupStaffRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateGroupStaffRoleRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+type RemoveGroupStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupStaffRequest) Reset() {
+	*x = RemoveGroupStaffRequest{}
+	mi := &file_group_group_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupStaffRequest) ProtoMessage() {}
+
+func (x *RemoveGroupStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupStaffRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveGroupStaffRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveGroupStaffRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveGroupStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupStaffResponse) Reset() {
+	*x = RemoveGroupStaffResponse{}
+	mi := &file_group_group_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupStaffResponse) ProtoMessage() {}
+
+func (x *RemoveGroupStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupStaffResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupStaffResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveGroupStaffResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission    Permission             `protobuf:"varint,3,opt,name=permission,proto3,enum=group.Permission" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_group_group_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CheckPermissionRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Role          StaffRole              `protobuf:"varint,2,opt,name=role,proto3,enum=group.StaffRole" json:"role,omitempty"` // UNSPECIFIED, если пользователь не в персонале группы
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_group_group_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *CheckPermissionResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type HasSharedGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasSharedGroupRequest) Reset() {
+	*x = HasSharedGroupRequest{}
+	mi := &file_group_group_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasSharedGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSharedGroupRequest) ProtoMessage() {}
+
+func (x *HasSharedGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSharedGroupRequest.ProtoReflect.Descriptor instead.
+func (*HasSharedGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *HasSharedGroupRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *HasSharedGroupRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// shared учитывает архивные группы, группы, где репетитор в персонале или был владельцем
+type HasSharedGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shared        bool                   `protobuf:"varint,1,opt,name=shared,proto3" json:"shared,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasSharedGroupResponse) Reset() {
+	*x = HasSharedGroupResponse{}
+	mi := &file_group_group_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasSharedGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSharedGroupResponse) ProtoMessage() {}
+
+func (x *HasSharedGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSharedGroupResponse.ProtoReflect.Descriptor instead.
+func (*HasSharedGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HasSharedGroupResponse) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *HasSharedGroupResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type OwnershipTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	KeepAsCoTutor bool                   `protobuf:"varint,4,opt,name=keep_as_co_tutor,json=keepAsCoTutor,proto3" json:"keep_as_co_tutor,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipTransfer) Reset() {
+	*x = OwnershipTransfer{}
+	mi := &file_group_group_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransfer) ProtoMessage() {}
+
+func (x *OwnershipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *OwnershipTransfer) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetKeepAsCoTutor() bool {
+	if x != nil {
+		return x.KeepAsCoTutor
+	}
+	return false
+}
+
+func (x *OwnershipTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OwnershipTransfer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type TransferGroupOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`             // Репетитор, который станет владельцем после согласия
+	KeepAsCoTutor bool                   `protobuf:"varint,3,opt,name=keep_as_co_tutor,json=keepAsCoTutor,proto3" json:"keep_as_co_tutor,omitempty"` // Прежний владелец остается соведущим
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGroupOwnershipRequest) Reset() {
+	*x = TransferGroupOwnershipRequest{}
+	mi := &file_group_group_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGroupOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGroupOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferGroupOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGroupOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferGroupOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TransferGroupOwnershipRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *TransferGroupOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+func (x *TransferGroupOwnershipRequest) GetKeepAsCoTutor() bool {
+	if x != nil {
+		return x.KeepAsCoTutor
+	}
+	return false
+}
+
+type OwnershipTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*OwnershipTransferResponse_Transfer
+	//	*OwnershipTransferResponse_Error
+	Result        isOwnershipTransferResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipTransferResponse) Reset() {
+	*x = OwnershipTransferResponse{}
+	mi := &file_group_group_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransferResponse) ProtoMessage() {}
+
+func (x *OwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*OwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *OwnershipTransferResponse) GetResult() isOwnershipTransferResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *OwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		if x, ok := x.Result.(*OwnershipTransferResponse_Transfer); ok {
+			return x.Transfer
+		}
+	}
+	return nil
+}
+
+func (x *OwnershipTransferResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*OwnershipTransferResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isOwnershipTransferResponse_Result interface {
+	isOwnershipTransferResponse_Result()
+}
+
+type OwnershipTransferResponse_Transfer struct {
+	Transfer *OwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3,oneof"`
+}
+
+type OwnershipTransferResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*OwnershipTransferResponse_Transfer) isOwnershipTransferResponse_Result() {}
+
+func (*OwnershipTransferResponse_Error) isOwnershipTransferResponse_Result() {}
+
+type GetGroupOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupOwnershipTransferRequest) Reset() {
+	*x = GetGroupOwnershipTransferRequest{}
+	mi := &file_group_group_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *GetGroupOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetGroupOwnershipTransferRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type AcceptGroupOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGroupOwnershipTransferRequest) Reset() {
+	*x = AcceptGroupOwnershipTransferRequest{}
+	mi := &file_group_group_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGroupOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGroupOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *AcceptGroupOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGroupOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptGroupOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AcceptGroupOwnershipTransferRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type AcceptGroupOwnershipTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*AcceptGroupOwnershipTransferResponse_Group
+	//	*AcceptGroupOwnershipTransferResponse_Error
+	Result        isAcceptGroupOwnershipTransferResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGroupOwnershipTransferResponse) Reset() {
+	*x = AcceptGroupOwnershipTransferResponse{}
+	mi := &file_group_group_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGroupOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGroupOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *AcceptGroupOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGroupOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptGroupOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AcceptGroupOwnershipTransferResponse) GetResult() isAcceptGroupOwnershipTransferResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AcceptGroupOwnershipTransferResponse) GetGroup() *Group {
+	if x != nil {
+		if x, ok := x.Result.(*AcceptGroupOwnershipTransferResponse_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *AcceptGroupOwnershipTransferResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*AcceptGroupOwnershipTransferResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isAcceptGroupOwnershipTransferResponse_Result interface {
+	isAcceptGroupOwnershipTransferResponse_Result()
+}
+
+type AcceptGroupOwnershipTransferResponse_Group struct {
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+
+type AcceptGroupOwnershipTransferResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*AcceptGroupOwnershipTransferResponse_Group) isAcceptGroupOwnershipTransferResponse_Result() {}
+
+func (*AcceptGroupOwnershipTransferResponse_Error) isAcceptGroupOwnershipTransferResponse_Result() {}
+
+type CancelGroupOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupOwnershipTransferRequest) Reset() {
+	*x = CancelGroupOwnershipTransferRequest{}
+	mi := &file_group_group_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *CancelGroupOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelGroupOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CancelGroupOwnershipTransferRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type CancelGroupOwnershipTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupOwnershipTransferResponse) Reset() {
+	*x = CancelGroupOwnershipTransferResponse{}
+	mi := &file_group_group_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *CancelGroupOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelGroupOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CancelGroupOwnershipTransferResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"` // Markdown
+	Pinned        bool                   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // Пусто у отложенного объявления
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReadCount     int32                  `protobuf:"varint,10,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`       // Прочитали участники, только для персонала
+	MemberCount   int32                  `protobuf:"varint,11,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // Участников в группе, только для персонала
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`                 // Когда прочитал запросивший ученик
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_group_group_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Announcement) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Announcement) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetReadCount() int32 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *Announcement) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Announcement) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+type AnnouncementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*AnnouncementResponse_Announcement
+	//	*AnnouncementResponse_Error
+	Result        isAnnouncementResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementResponse) Reset() {
+	*x = AnnouncementResponse{}
+	mi := &file_group_group_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementResponse) ProtoMessage() {}
+
+func (x *AnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*AnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AnnouncementResponse) GetResult() isAnnouncementResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		if x, ok := x.Result.(*AnnouncementResponse_Announcement); ok {
+			return x.Announcement
+		}
+	}
+	return nil
+}
+
+func (x *AnnouncementResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*AnnouncementResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isAnnouncementResponse_Result interface {
+	isAnnouncementResponse_Result()
+}
+
+type AnnouncementResponse_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3,oneof"`
+}
+
+type AnnouncementResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*AnnouncementResponse_Announcement) isAnnouncementResponse_Result() {}
+
+func (*AnnouncementResponse_Error) isAnnouncementResponse_Result() {}
+
+type CreateGroupAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // До 10000 символов
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Отложенная публикация, пусто - сразу
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupAnnouncementRequest) Reset() {
+	*x = CreateGroupAnnouncementRequest{}
+	mi := &file_group_group_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateGroupAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateGroupAnnouncementRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupAnnouncementRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateGroupAnnouncementRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *CreateGroupAnnouncementRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type ListGroupAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupAnnouncementsRequest) Reset() {
+	*x = ListGroupAnnouncementsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListGroupAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListGroupAnnouncementsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListGroupAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"` // Закрепленные первыми, затем новые
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupAnnouncementsResponse) Reset() {
+	*x = ListGroupAnnouncementsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListGroupAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListGroupAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *ListGroupAnnouncementsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetGroupAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AnnouncementId string                 `protobuf:"bytes,2,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupAnnouncementRequest) Reset() {
+	*x = GetGroupAnnouncementRequest{}
+	mi := &file_group_group_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupAnnouncementRequest) ProtoMessage() {}
+
+func (x *GetGroupAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGroupAnnouncementRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupAnnouncementRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+type UpdateGroupAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AnnouncementId string                 `protobuf:"bytes,2,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	Body           *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	Pinned         *bool                  `protobuf:"varint,4,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	PublishAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Только до публикации
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateGroupAnnouncementRequest) Reset() {
+	*x = UpdateGroupAnnouncementRequest{}
+	mi := &file_group_group_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupAnnouncementRequest) ProtoMessage() {}
+
+func (x *UpdateGroupAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateGroupAnnouncementRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupAnnouncementRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+func (x *UpdateGroupAnnouncementRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *UpdateGroupAnnouncementRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateGroupAnnouncementRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type DeleteGroupAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AnnouncementId string                 `protobuf:"bytes,2,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteGroupAnnouncementRequest) Reset() {
+	*x = DeleteGroupAnnouncementRequest{}
+	mi := &file_group_group_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteGroupAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteGroupAnnouncementRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteGroupAnnouncementRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+type DeleteGroupAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupAnnouncementResponse) Reset() {
+	*x = DeleteGroupAnnouncementResponse{}
+	mi := &file_group_group_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupAnnouncementResponse) ProtoMessage() {}
+
+func (x *DeleteGroupAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteGroupAnnouncementResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type MarkGroupAnnouncementReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AnnouncementId string                 `protobuf:"bytes,2,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkGroupAnnouncementReadRequest) Reset() {
+	*x = MarkGroupAnnouncementReadRequest{}
+	mi := &file_group_group_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkGroupAnnouncementReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkGroupAnnouncementReadRequest) ProtoMessage() {}
+
+func (x *MarkGroupAnnouncementReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkGroupAnnouncementReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkGroupAnnouncementReadRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MarkGroupAnnouncementReadRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MarkGroupAnnouncementReadRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+type MarkGroupAnnouncementReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkGroupAnnouncementReadResponse) Reset() {
+	*x = MarkGroupAnnouncementReadResponse{}
+	mi := &file_group_group_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkGroupAnnouncementReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkGroupAnnouncementReadResponse) ProtoMessage() {}
+
+func (x *MarkGroupAnnouncementReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkGroupAnnouncementReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkGroupAnnouncementReadResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MarkGroupAnnouncementReadResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GroupMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"` // Пусто у удаленного сообщения
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMessage) Reset() {
+	*x = GroupMessage{}
+	mi := &file_group_group_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMessage) ProtoMessage() {}
+
+func (x *GroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMessage.ProtoReflect.Descriptor instead.
+func (*GroupMessage) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GroupMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupMessage) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMessage) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *GroupMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *GroupMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupMessage) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *GroupMessage) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type GroupMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GroupMessageResponse_Message
+	//	*GroupMessageResponse_Error
+	Result        isGroupMessageResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMessageResponse) Reset() {
+	*x = GroupMessageResponse{}
+	mi := &file_group_group_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMessageResponse) ProtoMessage() {}
+
+func (x *GroupMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMessageResponse.ProtoReflect.Descriptor instead.
+func (*GroupMessageResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GroupMessageResponse) GetResult() isGroupMessageResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GroupMessageResponse) GetMessage() *GroupMessage {
+	if x != nil {
+		if x, ok := x.Result.(*GroupMessageResponse_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *GroupMessageResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GroupMessageResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGroupMessageResponse_Result interface {
+	isGroupMessageResponse_Result()
+}
+
+type GroupMessageResponse_Message struct {
+	Message *GroupMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type GroupMessageResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GroupMessageResponse_Message) isGroupMessageResponse_Result() {}
+
+func (*GroupMessageResponse_Error) isGroupMessageResponse_Result() {}
+
+type SendGroupMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // До 4000 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendGroupMessageRequest) Reset() {
+	*x = SendGroupMessageRequest{}
+	mi := &file_group_group_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendGroupMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGroupMessageRequest) ProtoMessage() {}
+
+func (x *SendGroupMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGroupMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendGroupMessageRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SendGroupMessageRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SendGroupMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListGroupMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // По умолчанию 50, не больше 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMessagesRequest) Reset() {
+	*x = ListGroupMessagesRequest{}
+	mi := &file_group_group_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMessagesRequest) ProtoMessage() {}
+
+func (x *ListGroupMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListGroupMessagesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListGroupMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGroupMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*GroupMessage        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                                  // От новых к старым
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто - история закончилась
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMessagesResponse) Reset() {
+	*x = ListGroupMessagesResponse{}
+	mi := &file_group_group_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMessagesResponse) ProtoMessage() {}
+
+func (x *ListGroupMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListGroupMessagesResponse) GetMessages() []*GroupMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListGroupMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListGroupMessagesResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type EditGroupMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditGroupMessageRequest) Reset() {
+	*x = EditGroupMessageRequest{}
+	mi := &file_group_group_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditGroupMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditGroupMessageRequest) ProtoMessage() {}
+
+func (x *EditGroupMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditGroupMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditGroupMessageRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *EditGroupMessageRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *EditGroupMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EditGroupMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteGroupMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupMessageRequest) Reset() {
+	*x = DeleteGroupMessageRequest{}
+	mi := &file_group_group_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupMessageRequest) ProtoMessage() {}
+
+func (x *DeleteGroupMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupMessageRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteGroupMessageRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteGroupMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type DeleteGroupMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupMessageResponse) Reset() {
+	*x = DeleteGroupMessageResponse{}
+	mi := &file_group_group_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupMessageResponse) ProtoMessage() {}
+
+func (x *DeleteGroupMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupMessageResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteGroupMessageResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SubscribeGroupMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGroupMessagesRequest) Reset() {
+	*x = SubscribeGroupMessagesRequest{}
+	mi := &file_group_group_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGroupMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGroupMessagesRequest) ProtoMessage() {}
+
+func (x *SubscribeGroupMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGroupMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGroupMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SubscribeGroupMessagesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GroupMessageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          GroupMessageEventType  `protobuf:"varint,1,opt,name=type,proto3,enum=group.GroupMessageEventType" json:"type,omitempty"`
+	Message       *GroupMessage          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMessageEvent) Reset() {
+	*x = GroupMessageEvent{}
+	mi := &file_group_group_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMessageEvent) ProtoMessage() {}
+
+func (x *GroupMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMessageEvent.ProtoReflect.Descriptor instead.
+func (*GroupMessageEvent) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GroupMessageEvent) GetType() GroupMessageEventType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *GroupMessageEvent) GetMessage() *GroupMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // Файл загружается заранее, https на хост сервиса загрузок
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"` // Байт
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_group_group_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type DirectMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	SenderId       string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments    []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
+	mi := &file_group_group_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Error) String() string {
+func (x *DirectMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*DirectMessage) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[0]
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,53 +7346,81 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *Error) GetCode() string {
+func (x *DirectMessage) GetId() string {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Error) GetMessage() string {
+func (x *DirectMessage) GetConversationId() string {
 	if x != nil {
-		return x.Message
+		return x.ConversationId
 	}
 	return ""
 }
 
-type Group struct {
+func (x *DirectMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Conversation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // Вычисляемое поле - количество участников
-	Members       []*GroupMember         `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`                             // Участники группы (опционально)
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	PeerId        string                 `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"` // Собеседник запросившего пользователя
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastMessageAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+	LastMessage   *DirectMessage         `protobuf:"bytes,6,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`  // Только в списке переписок
+	UnreadCount   int32                  `protobuf:"varint,7,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // Только в списке переписок
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Group) Reset() {
-	*x = Group{}
-	mi := &file_group_group_service_proto_msgTypes[1]
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_group_group_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) String() string {
+func (x *Conversation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Group) ProtoMessage() {}
+func (*Conversation) ProtoMessage() {}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[1]
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,84 +7431,82 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *Group) GetId() string {
+func (x *Conversation) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Group) GetTutorId() string {
+func (x *Conversation) GetUserIds() []string {
 	if x != nil {
-		return x.TutorId
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Group) GetName() string {
+func (x *Conversation) GetPeerId() string {
 	if x != nil {
-		return x.Name
+		return x.PeerId
 	}
 	return ""
 }
 
-func (x *Group) GetDescription() string {
+func (x *Conversation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Conversation) GetLastMessageAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.LastMessageAt
 	}
 	return nil
 }
 
-func (x *Group) GetMemberCount() int32 {
+func (x *Conversation) GetLastMessage() *DirectMessage {
 	if x != nil {
-		return x.MemberCount
+		return x.LastMessage
 	}
-	return 0
+	return nil
 }
 
-func (x *Group) GetMembers() []*GroupMember {
+func (x *Conversation) GetUnreadCount() int32 {
 	if x != nil {
-		return x.Members
+		return x.UnreadCount
 	}
-	return nil
+	return 0
 }
 
-type GroupMember struct {
+type StartConversationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupMember) Reset() {
-	*x = GroupMember{}
-	mi := &file_group_group_service_proto_msgTypes[2]
+func (x *StartConversationRequest) Reset() {
+	*x = StartConversationRequest{}
+	mi := &file_group_group_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupMember) String() string {
+func (x *StartConversationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupMember) ProtoMessage() {}
+func (*StartConversationRequest) ProtoMessage() {}
 
-func (x *GroupMember) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[2]
+func (x *StartConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,56 +7517,121 @@ func (x *GroupMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
-func (*GroupMember) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use StartConversationRequest.ProtoReflect.Descriptor instead.
+func (*StartConversationRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GroupMember) GetGroupId() string {
+func (x *StartConversationRequest) GetUserId() string {
 	if x != nil {
-		return x.GroupId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GroupMember) GetStudentId() string {
+type ConversationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ConversationResponse_Conversation
+	//	*ConversationResponse_Error
+	Result        isConversationResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationResponse) Reset() {
+	*x = ConversationResponse{}
+	mi := &file_group_group_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationResponse) ProtoMessage() {}
+
+func (x *ConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[107]
 	if x != nil {
-		return x.StudentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GroupMember) GetJoinedAt() *timestamppb.Timestamp {
+// Deprecated: Use ConversationResponse.ProtoReflect.Descriptor instead.
+func (*ConversationResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ConversationResponse) GetResult() isConversationResponse_Result {
 	if x != nil {
-		return x.JoinedAt
+		return x.Result
 	}
 	return nil
 }
 
-type CreateGroupRequest struct {
+func (x *ConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		if x, ok := x.Result.(*ConversationResponse_Conversation); ok {
+			return x.Conversation
+		}
+	}
+	return nil
+}
+
+func (x *ConversationResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ConversationResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isConversationResponse_Result interface {
+	isConversationResponse_Result()
+}
+
+type ConversationResponse_Conversation struct {
+	Conversation *Conversation `protobuf:"bytes,1,opt,name=conversation,proto3,oneof"`
+}
+
+type ConversationResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ConversationResponse_Conversation) isConversationResponse_Result() {}
+
+func (*ConversationResponse_Error) isConversationResponse_Result() {}
+
+type ListConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_group_group_service_proto_msgTypes[3]
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_group_group_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *ListConversationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*ListConversationsRequest) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[3]
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,58 +7642,157 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateGroupRequest) GetTutorId() string {
+type ListConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`                 // Сначала с новыми сообщениями
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // Непрочитанных во всех переписках
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_group_group_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[109]
 	if x != nil {
-		return x.TutorId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGroupRequest) GetName() string {
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*Conversation {
 	if x != nil {
-		return x.Name
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ListConversationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ListConversationsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SendDirectMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Body           string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`               // До 4000 символов, можно пусто при вложениях
+	Attachments    []*Attachment          `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"` // Не больше 10
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendDirectMessageRequest) Reset() {
+	*x = SendDirectMessageRequest{}
+	mi := &file_group_group_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDirectMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDirectMessageRequest) ProtoMessage() {}
+
+func (x *SendDirectMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDirectMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SendDirectMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDescription() string {
+func (x *SendDirectMessageRequest) GetBody() string {
 	if x != nil {
-		return x.Description
+		return x.Body
 	}
 	return ""
 }
 
-type CreateGroupResponse struct {
+func (x *SendDirectMessageRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DirectMessageResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Result:
 	//
-	//	*CreateGroupResponse_Group
-	//	*CreateGroupResponse_Error
-	Result        isCreateGroupResponse_Result `protobuf_oneof:"result"`
+	//	*DirectMessageResponse_Message
+	//	*DirectMessageResponse_Error
+	Result        isDirectMessageResponse_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupResponse) Reset() {
-	*x = CreateGroupResponse{}
-	mi := &file_group_group_service_proto_msgTypes[4]
+func (x *DirectMessageResponse) Reset() {
+	*x = DirectMessageResponse{}
+	mi := &file_group_group_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupResponse) String() string {
+func (x *DirectMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupResponse) ProtoMessage() {}
+func (*DirectMessageResponse) ProtoMessage() {}
 
-func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[4]
+func (x *DirectMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,79 +7803,76 @@ func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DirectMessageResponse.ProtoReflect.Descriptor instead.
+func (*DirectMessageResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *CreateGroupResponse) GetResult() isCreateGroupResponse_Result {
+func (x *DirectMessageResponse) GetResult() isDirectMessageResponse_Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-func (x *CreateGroupResponse) GetGroup() *Group {
+func (x *DirectMessageResponse) GetMessage() *DirectMessage {
 	if x != nil {
-		if x, ok := x.Result.(*CreateGroupResponse_Group); ok {
-			return x.Group
+		if x, ok := x.Result.(*DirectMessageResponse_Message); ok {
+			return x.Message
 		}
 	}
 	return nil
 }
 
-func (x *CreateGroupResponse) GetError() *Error {
+func (x *DirectMessageResponse) GetError() *Error {
 	if x != nil {
-		if x, ok := x.Result.(*CreateGroupResponse_Error); ok {
+		if x, ok := x.Result.(*DirectMessageResponse_Error); ok {
 			return x.Error
 		}
 	}
 	return nil
 }
-
-type isCreateGroupResponse_Result interface {
-	isCreateGroupResponse_Result()
+
+type isDirectMessageResponse_Result interface {
+	isDirectMessageResponse_Result()
 }
 
-type CreateGroupResponse_Group struct {
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+type DirectMessageResponse_Message struct {
+	Message *DirectMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
 }
 
-type CreateGroupResponse_Error struct {
+type DirectMessageResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*CreateGroupResponse_Group) isCreateGroupResponse_Result() {}
+func (*DirectMessageResponse_Message) isDirectMessageResponse_Result() {}
 
-func (*CreateGroupResponse_Error) isCreateGroupResponse_Result() {}
+func (*DirectMessageResponse_Error) isDirectMessageResponse_Result() {}
 
-type ListGroupsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Filter:
-	//
-	//	*ListGroupsRequest_TutorId
-	//	*ListGroupsRequest_StudentId
-	Filter         isListGroupsRequest_Filter `protobuf_oneof:"filter"`
-	IncludeMembers bool                       `protobuf:"varint,3,opt,name=include_members,json=includeMembers,proto3" json:"include_members,omitempty"` // Включать ли список участников
+type ListDirectMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // По умолчанию 50, не больше 100
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListGroupsRequest) Reset() {
-	*x = ListGroupsRequest{}
-	mi := &file_group_group_service_proto_msgTypes[5]
+func (x *ListDirectMessagesRequest) Reset() {
+	*x = ListDirectMessagesRequest{}
+	mi := &file_group_group_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsRequest) String() string {
+func (x *ListDirectMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsRequest) ProtoMessage() {}
+func (*ListDirectMessagesRequest) ProtoMessage() {}
 
-func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[5]
+func (x *ListDirectMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,82 +7883,56 @@ func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListGroupsRequest) GetFilter() isListGroupsRequest_Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
+// Deprecated: Use ListDirectMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListGroupsRequest) GetTutorId() string {
+func (x *ListDirectMessagesRequest) GetConversationId() string {
 	if x != nil {
-		if x, ok := x.Filter.(*ListGroupsRequest_TutorId); ok {
-			return x.TutorId
-		}
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *ListGroupsRequest) GetStudentId() string {
+func (x *ListDirectMessagesRequest) GetPageSize() int32 {
 	if x != nil {
-		if x, ok := x.Filter.(*ListGroupsRequest_StudentId); ok {
-			return x.StudentId
-		}
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ListGroupsRequest) GetIncludeMembers() bool {
+func (x *ListDirectMessagesRequest) GetPageToken() string {
 	if x != nil {
-		return x.IncludeMembers
+		return x.PageToken
 	}
-	return false
-}
-
-type isListGroupsRequest_Filter interface {
-	isListGroupsRequest_Filter()
-}
-
-type ListGroupsRequest_TutorId struct {
-	TutorId string `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3,oneof"` // Группы конкретного репетитора
-}
-
-type ListGroupsRequest_StudentId struct {
-	StudentId string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3,oneof"` // Группы конкретного студента
+	return ""
 }
 
-func (*ListGroupsRequest_TutorId) isListGroupsRequest_Filter() {}
-
-func (*ListGroupsRequest_StudentId) isListGroupsRequest_Filter() {}
-
-type ListGroupsResponse struct {
+type ListDirectMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Messages      []*DirectMessage       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // От новых к старым
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGroupsResponse) Reset() {
-	*x = ListGroupsResponse{}
-	mi := &file_group_group_service_proto_msgTypes[6]
+func (x *ListDirectMessagesResponse) Reset() {
+	*x = ListDirectMessagesResponse{}
+	mi := &file_group_group_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsResponse) String() string {
+func (x *ListDirectMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsResponse) ProtoMessage() {}
+func (*ListDirectMessagesResponse) ProtoMessage() {}
 
-func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[6]
+func (x *ListDirectMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,48 +7943,54 @@ func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListDirectMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListGroupsResponse) GetGroups() []*Group {
+func (x *ListDirectMessagesResponse) GetMessages() []*DirectMessage {
 	if x != nil {
-		return x.Groups
+		return x.Messages
 	}
 	return nil
 }
 
-func (x *ListGroupsResponse) GetError() *Error {
+func (x *ListDirectMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDirectMessagesResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type GetGroupRequest struct {
+type MarkConversationReadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                // ID группы
-	IncludeMembers bool                   `protobuf:"varint,2,opt,name=include_members,json=includeMembers,proto3" json:"include_members,omitempty"` // Включать ли список участников
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
-	mi := &file_group_group_service_proto_msgTypes[7]
+func (x *MarkConversationReadRequest) Reset() {
+	*x = MarkConversationReadRequest{}
+	mi := &file_group_group_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *MarkConversationReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*MarkConversationReadRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[7]
+func (x *MarkConversationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,51 +8001,40 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MarkConversationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetGroupRequest) GetId() string {
+func (x *MarkConversationReadRequest) GetConversationId() string {
 	if x != nil {
-		return x.Id
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *GetGroupRequest) GetIncludeMembers() bool {
-	if x != nil {
-		return x.IncludeMembers
-	}
-	return false
-}
-
-type GetGroupResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*GetGroupResponse_Group
-	//	*GetGroupResponse_Error
-	Result        isGetGroupResponse_Result `protobuf_oneof:"result"`
+type MarkConversationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupResponse) Reset() {
-	*x = GetGroupResponse{}
-	mi := &file_group_group_service_proto_msgTypes[8]
+func (x *MarkConversationReadResponse) Reset() {
+	*x = MarkConversationReadResponse{}
+	mi := &file_group_group_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupResponse) String() string {
+func (x *MarkConversationReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupResponse) ProtoMessage() {}
+func (*MarkConversationReadResponse) ProtoMessage() {}
 
-func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[8]
+func (x *MarkConversationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,76 +8045,43 @@ func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetGroupResponse) GetResult() isGetGroupResponse_Result {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-func (x *GetGroupResponse) GetGroup() *Group {
-	if x != nil {
-		if x, ok := x.Result.(*GetGroupResponse_Group); ok {
-			return x.Group
-		}
-	}
-	return nil
+// Deprecated: Use MarkConversationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetGroupResponse) GetError() *Error {
+func (x *MarkConversationReadResponse) GetError() *Error {
 	if x != nil {
-		if x, ok := x.Result.(*GetGroupResponse_Error); ok {
-			return x.Error
-		}
+		return x.Error
 	}
 	return nil
 }
 
-type isGetGroupResponse_Result interface {
-	isGetGroupResponse_Result()
-}
-
-type GetGroupResponse_Group struct {
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
-}
-
-type GetGroupResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
-
-func (*GetGroupResponse_Group) isGetGroupResponse_Result() {}
-
-func (*GetGroupResponse_Error) isGetGroupResponse_Result() {}
-
-type UpdateGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                         // ID группы для обновления
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`               // Новое название (если нужно обновить)
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"` // Новое описание (если нужно обновить)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SearchDirectMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                         // Слова для поиска, до 200 символов
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // Пусто - во всех переписках
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
-	mi := &file_group_group_service_proto_msgTypes[9]
+func (x *SearchDirectMessagesRequest) Reset() {
+	*x = SearchDirectMessagesRequest{}
+	mi := &file_group_group_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *SearchDirectMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*SearchDirectMessagesRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[9]
+func (x *SearchDirectMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,58 +8092,64 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SearchDirectMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchDirectMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *UpdateGroupRequest) GetId() string {
+func (x *SearchDirectMessagesRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *SearchDirectMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *SearchDirectMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchDirectMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type UpdateGroupResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*UpdateGroupResponse_Group
-	//	*UpdateGroupResponse_Error
-	Result        isUpdateGroupResponse_Result `protobuf_oneof:"result"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReportConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Необязательно, сообщение собеседника
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                        // До 1000 символов
+	Block          bool                   `protobuf:"varint,4,opt,name=block,proto3" json:"block,omitempty"`                         // Сразу заблокировать собеседника
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateGroupResponse) Reset() {
-	*x = UpdateGroupResponse{}
-	mi := &file_group_group_service_proto_msgTypes[10]
+func (x *ReportConversationRequest) Reset() {
+	*x = ReportConversationRequest{}
+	mi := &file_group_group_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupResponse) String() string {
+func (x *ReportConversationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupResponse) ProtoMessage() {}
+func (*ReportConversationRequest) ProtoMessage() {}
 
-func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[10]
+func (x *ReportConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,74 +8160,62 @@ func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReportConversationRequest.ProtoReflect.Descriptor instead.
+func (*ReportConversationRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UpdateGroupResponse) GetResult() isUpdateGroupResponse_Result {
+func (x *ReportConversationRequest) GetConversationId() string {
 	if x != nil {
-		return x.Result
+		return x.ConversationId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateGroupResponse) GetGroup() *Group {
+func (x *ReportConversationRequest) GetMessageId() string {
 	if x != nil {
-		if x, ok := x.Result.(*UpdateGroupResponse_Group); ok {
-			return x.Group
-		}
+		return x.MessageId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateGroupResponse) GetError() *Error {
+func (x *ReportConversationRequest) GetReason() string {
 	if x != nil {
-		if x, ok := x.Result.(*UpdateGroupResponse_Error); ok {
-			return x.Error
-		}
+		return x.Reason
 	}
-	return nil
-}
-
-type isUpdateGroupResponse_Result interface {
-	isUpdateGroupResponse_Result()
-}
-
-type UpdateGroupResponse_Group struct {
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+	return ""
 }
 
-type UpdateGroupResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *ReportConversationRequest) GetBlock() bool {
+	if x != nil {
+		return x.Block
+	}
+	return false
 }
 
-func (*UpdateGroupResponse_Group) isUpdateGroupResponse_Result() {}
-
-func (*UpdateGroupResponse_Error) isUpdateGroupResponse_Result() {}
-
-type DeleteGroupRequest struct {
+type ReportConversationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID группы для удаления
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGroupRequest) Reset() {
-	*x = DeleteGroupRequest{}
-	mi := &file_group_group_service_proto_msgTypes[11]
+func (x *ReportConversationResponse) Reset() {
+	*x = ReportConversationResponse{}
+	mi := &file_group_group_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupRequest) String() string {
+func (x *ReportConversationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupRequest) ProtoMessage() {}
+func (*ReportConversationResponse) ProtoMessage() {}
 
-func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[11]
+func (x *ReportConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,40 +8226,48 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReportConversationResponse.ProtoReflect.Descriptor instead.
+func (*ReportConversationResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *DeleteGroupRequest) GetId() string {
+func (x *ReportConversationResponse) GetReportId() string {
 	if x != nil {
-		return x.Id
+		return x.ReportId
 	}
 	return ""
 }
 
-type DeleteGroupResponse struct {
+func (x *ReportConversationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BlockedUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGroupResponse) Reset() {
-	*x = DeleteGroupResponse{}
-	mi := &file_group_group_service_proto_msgTypes[12]
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_group_group_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupResponse) String() string {
+func (x *BlockedUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupResponse) ProtoMessage() {}
+func (*BlockedUser) ProtoMessage() {}
 
-func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[12]
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,40 +8278,47 @@ func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *DeleteGroupResponse) GetError() *Error {
+func (x *BlockedUser) GetUserId() string {
 	if x != nil {
-		return x.Error
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListGroupMembersRequest struct {
+type BlockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // ID группы
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGroupMembersRequest) Reset() {
-	*x = ListGroupMembersRequest{}
-	mi := &file_group_group_service_proto_msgTypes[13]
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_group_group_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupMembersRequest) String() string {
+func (x *BlockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupMembersRequest) ProtoMessage() {}
+func (*BlockUserRequest) ProtoMessage() {}
 
-func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[13]
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,41 +8329,40 @@ func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *ListGroupMembersRequest) GetGroupId() string {
+func (x *BlockUserRequest) GetUserId() string {
 	if x != nil {
-		return x.GroupId
+		return x.UserId
 	}
 	return ""
 }
 
-type ListGroupMembersResponse struct {
+type BlockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGroupMembersResponse) Reset() {
-	*x = ListGroupMembersResponse{}
-	mi := &file_group_group_service_proto_msgTypes[14]
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_group_group_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupMembersResponse) String() string {
+func (x *BlockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupMembersResponse) ProtoMessage() {}
+func (*BlockUserResponse) ProtoMessage() {}
 
-func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[14]
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,48 +8373,40 @@ func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ListGroupMembersResponse) GetMembers() []*GroupMember {
-	if x != nil {
-		return x.Members
-	}
-	return nil
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *ListGroupMembersResponse) GetError() *Error {
+func (x *BlockUserResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type AddGroupMembersRequest struct {
+type UnblockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // ID группы
-	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // Массив ID студентов для добавления
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddGroupMembersRequest) Reset() {
-	*x = AddGroupMembersRequest{}
-	mi := &file_group_group_service_proto_msgTypes[15]
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_group_group_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddGroupMembersRequest) String() string {
+func (x *UnblockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddGroupMembersRequest) ProtoMessage() {}
+func (*UnblockUserRequest) ProtoMessage() {}
 
-func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[15]
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,48 +8417,40 @@ func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddGroupMembersRequest.ProtoReflect.Descriptor instead.
-func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *AddGroupMembersRequest) GetGroupId() string {
+func (x *UnblockUserRequest) GetUserId() string {
 	if x != nil {
-		return x.GroupId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddGroupMembersRequest) GetStudentIds() []string {
-	if x != nil {
-		return x.StudentIds
-	}
-	return nil
-}
-
-type AddGroupMembersResponse struct {
+type UnblockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddedCount    int32                  `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"` // Сколько студентов успешно добавлено
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddGroupMembersResponse) Reset() {
-	*x = AddGroupMembersResponse{}
-	mi := &file_group_group_service_proto_msgTypes[16]
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_group_group_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddGroupMembersResponse) String() string {
+func (x *UnblockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddGroupMembersResponse) ProtoMessage() {}
+func (*UnblockUserResponse) ProtoMessage() {}
 
-func (x *AddGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[16]
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,48 +8461,39 @@ func (x *AddGroupMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddGroupMembersResponse.ProtoReflect.Descriptor instead.
-func (*AddGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *AddGroupMembersResponse) GetAddedCount() int32 {
-	if x != nil {
-		return x.AddedCount
-	}
-	return 0
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *AddGroupMembersResponse) GetError() *Error {
+func (x *UnblockUserResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type RemoveGroupMembersRequest struct {
+type ListBlockedUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // ID группы
-	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // Массив ID студентов для удаления
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveGroupMembersRequest) Reset() {
-	*x = RemoveGroupMembersRequest{}
-	mi := &file_group_group_service_proto_msgTypes[17]
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_group_group_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveGroupMembersRequest) String() string {
+func (x *ListBlockedUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGroupMembersRequest) ProtoMessage() {}
+func (*ListBlockedUsersRequest) ProtoMessage() {}
 
-func (x *RemoveGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[17]
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,48 +8504,34 @@ func (x *RemoveGroupMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGroupMembersRequest.ProtoReflect.Descriptor instead.
-func (*RemoveGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *RemoveGroupMembersRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *RemoveGroupMembersRequest) GetStudentIds() []string {
-	if x != nil {
-		return x.StudentIds
-	}
-	return nil
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{124}
 }
 
-type RemoveGroupMembersResponse struct {
+type ListBlockedUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RemovedCount  int32                  `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"` // Сколько студентов успешно удалено
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveGroupMembersResponse) Reset() {
-	*x = RemoveGroupMembersResponse{}
-	mi := &file_group_group_service_proto_msgTypes[18]
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_group_group_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveGroupMembersResponse) String() string {
+func (x *ListBlockedUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGroupMembersResponse) ProtoMessage() {}
+func (*ListBlockedUsersResponse) ProtoMessage() {}
 
-func (x *RemoveGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_group_service_proto_msgTypes[18]
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,19 +8542,19 @@ func (x *RemoveGroupMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGroupMembersResponse.ProtoReflect.Descriptor instead.
-func (*RemoveGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_group_group_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_group_group_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *RemoveGroupMembersResponse) GetRemovedCount() int32 {
+func (x *ListBlockedUsersResponse) GetUsers() []*BlockedUser {
 	if x != nil {
-		return x.RemovedCount
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-func (x *RemoveGroupMembersResponse) GetError() *Error {
+func (x *ListBlockedUsersResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
@@ -1186,7 +8568,7 @@ const file_group_group_service_proto_rawDesc = "" +
 	"\x19group/group_service.proto\x12\x05group\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"5\n" +
 	"\x05Error\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xf4\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa7\x03\n" +
 	"\x05Group\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x12\n" +
@@ -1195,42 +8577,85 @@ const file_group_group_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
 	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x12,\n" +
-	"\amembers\x18\a \x03(\v2\x12.group.GroupMemberR\amembers\"\x80\x01\n" +
+	"\amembers\x18\a \x03(\v2\x12.group.GroupMemberR\amembers\x122\n" +
+	"\vjoin_policy\x18\b \x01(\x0e2\x11.group.JoinPolicyR\n" +
+	"joinPolicy\x12;\n" +
+	"\varchived_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x1f\n" +
+	"\vmax_members\x18\n" +
+	" \x01(\x05R\n" +
+	"maxMembers\x12\x1f\n" +
+	"\x04term\x18\v \x01(\v2\v.group.TermR\x04term\"\x8f\x02\n" +
+	"\x04Term\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vgroup_count\x18\a \x01(\x05R\n" +
+	"groupCount\"\xcd\x01\n" +
 	"\vGroupMember\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x02 \x01(\tR\tstudentId\x127\n" +
-	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"e\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x05 \x01(\tR\asurname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x06 \x01(\tR\tavatarUrl\"\xba\x01\n" +
 	"\x12CreateGroupRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"k\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\vjoin_policy\x18\x04 \x01(\x0e2\x11.group.JoinPolicyR\n" +
+	"joinPolicy\x12\x1f\n" +
+	"\vmax_members\x18\x05 \x01(\x05R\n" +
+	"maxMembers\"k\n" +
 	"\x13CreateGroupResponse\x12$\n" +
 	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
 	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
-	"\x06result\"\x84\x01\n" +
+	"\x06result\"\xc6\x03\n" +
 	"\x11ListGroupsRequest\x12\x1b\n" +
 	"\btutor_id\x18\x01 \x01(\tH\x00R\atutorId\x12\x1f\n" +
 	"\n" +
 	"student_id\x18\x02 \x01(\tH\x00R\tstudentId\x12'\n" +
-	"\x0finclude_members\x18\x03 \x01(\bR\x0eincludeMembersB\b\n" +
-	"\x06filter\"^\n" +
+	"\x0finclude_members\x18\x03 \x01(\bR\x0eincludeMembers\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12.\n" +
+	"\asort_by\x18\x06 \x01(\x0e2\x15.group.GroupSortFieldR\x06sortBy\x12;\n" +
+	"\x0esort_direction\x18\a \x01(\x0e2\x14.group.SortDirectionR\rsortDirection\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageToken\x12;\n" +
+	"\x0earchive_filter\x18\n" +
+	" \x01(\x0e2\x14.group.ArchiveFilterR\rarchiveFilter\x12\x17\n" +
+	"\aterm_id\x18\v \x01(\tR\x06termIdB\b\n" +
+	"\x06filter\"\x86\x01\n" +
 	"\x12ListGroupsResponse\x12$\n" +
 	"\x06groups\x18\x01 \x03(\v2\f.group.GroupR\x06groups\x12\"\n" +
-	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"J\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"J\n" +
 	"\x0fGetGroupRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0finclude_members\x18\x02 \x01(\bR\x0eincludeMembers\"h\n" +
 	"\x10GetGroupResponse\x12$\n" +
 	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
 	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
-	"\x06result\"}\n" +
+	"\x06result\"\xfc\x01\n" +
 	"\x12UpdateGroupRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x127\n" +
+	"\vjoin_policy\x18\x04 \x01(\x0e2\x11.group.JoinPolicyH\x02R\n" +
+	"joinPolicy\x88\x01\x01\x12$\n" +
+	"\vmax_members\x18\x05 \x01(\x05H\x03R\n" +
+	"maxMembers\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\"k\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_join_policyB\x0e\n" +
+	"\f_max_members\"k\n" +
 	"\x13UpdateGroupResponse\x12$\n" +
 	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
 	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
@@ -1238,27 +8663,556 @@ const file_group_group_service_proto_rawDesc = "" +
 	"\x12DeleteGroupRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
 	"\x13DeleteGroupResponse\x12\"\n" +
-	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"4\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\x95\x01\n" +
+	"\x11CreateTermRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"a\n" +
+	"\fTermResponse\x12!\n" +
+	"\x04term\x18\x01 \x01(\v2\v.group.TermH\x00R\x04term\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\x12\n" +
+	"\x10ListTermsRequest\"Z\n" +
+	"\x11ListTermsResponse\x12!\n" +
+	"\x05terms\x18\x01 \x03(\v2\v.group.TermR\x05terms\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\")\n" +
+	"\x0eGetTermRequest\x12\x17\n" +
+	"\aterm_id\x18\x01 \x01(\tR\x06termId\"\xbc\x01\n" +
+	"\x11UpdateTermRequest\x12\x17\n" +
+	"\aterm_id\x18\x01 \x01(\tR\x06termId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAtB\a\n" +
+	"\x05_name\",\n" +
+	"\x11DeleteTermRequest\x12\x17\n" +
+	"\aterm_id\x18\x01 \x01(\tR\x06termId\"8\n" +
+	"\x12DeleteTermResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"I\n" +
+	"\x13SetGroupTermRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\aterm_id\x18\x02 \x01(\tR\x06termId\"l\n" +
+	"\x14SetGroupTermResponse\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"%\n" +
+	"\x13ArchiveGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"l\n" +
+	"\x14ArchiveGroupResponse\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"%\n" +
+	"\x13RestoreGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"l\n" +
+	"\x14RestoreGroupResponse\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"_\n" +
 	"\x17ListGroupMembersRequest\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"l\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12)\n" +
+	"\x10include_profiles\x18\x02 \x01(\bR\x0fincludeProfiles\"l\n" +
 	"\x18ListGroupMembersResponse\x12,\n" +
 	"\amembers\x18\x01 \x03(\v2\x12.group.GroupMemberR\amembers\x12\"\n" +
 	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"T\n" +
 	"\x16AddGroupMembersRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x02 \x03(\tR\n" +
-	"studentIds\"^\n" +
+	"studentIds\"v\n" +
+	"\x0fAddMemberResult\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.group.AddMemberStatusR\x06status\"\xbb\x01\n" +
 	"\x17AddGroupMembersResponse\x12\x1f\n" +
 	"\vadded_count\x18\x01 \x01(\x05R\n" +
 	"addedCount\x12\"\n" +
-	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"W\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\x12)\n" +
+	"\x10waitlisted_count\x18\x03 \x01(\x05R\x0fwaitlistedCount\x120\n" +
+	"\aresults\x18\x04 \x03(\v2\x16.group.AddMemberResultR\aresults\"W\n" +
 	"\x19RemoveGroupMembersRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x02 \x03(\tR\n" +
-	"studentIds\"e\n" +
+	"studentIds\"\x97\x01\n" +
 	"\x1aRemoveGroupMembersResponse\x12#\n" +
 	"\rremoved_count\x18\x01 \x01(\x05R\fremovedCount\x12\"\n" +
-	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error2\xea\x06\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\x120\n" +
+	"\x14promoted_student_ids\x18\x03 \x03(\tR\x12promotedStudentIds\"\xa0\x01\n" +
+	"\rWaitlistEntry\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"5\n" +
+	"\x18ListGroupWaitlistRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"o\n" +
+	"\x19ListGroupWaitlistResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.group.WaitlistEntryR\aentries\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"Z\n" +
+	"\x1eRemoveFromGroupWaitlistRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"E\n" +
+	"\x1fRemoveFromGroupWaitlistResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\xcc\x01\n" +
+	"\tRosterRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x04 \x01(\tR\asurname\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x05 \x01(\tR\tstudentId\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.group.RosterRowStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xa6\x04\n" +
+	"\fRosterImport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.group.RosterImportStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x05 \x01(\x05R\ttotalRows\x12\x1f\n" +
+	"\vadded_count\x18\x06 \x01(\x05R\n" +
+	"addedCount\x12)\n" +
+	"\x10waitlisted_count\x18\a \x01(\x05R\x0fwaitlistedCount\x12#\n" +
+	"\rinvited_count\x18\b \x01(\x05R\finvitedCount\x12#\n" +
+	"\rskipped_count\x18\t \x01(\x05R\fskippedCount\x12!\n" +
+	"\ffailed_count\x18\n" +
+	" \x01(\x05R\vfailedCount\x12$\n" +
+	"\x04rows\x18\v \x03(\v2\x10.group.RosterRowR\x04rows\x12#\n" +
+	"\rerror_message\x18\f \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vfinished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"`\n" +
+	"\x18ImportGroupRosterRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\tR\x03csv\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"U\n" +
+	"\x1bGetGroupRosterImportRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\timport_id\x18\x02 \x01(\tR\bimportId\"\x82\x01\n" +
+	"\x14RosterImportResponse\x12:\n" +
+	"\rroster_import\x18\x01 \x01(\v2\x13.group.RosterImportH\x00R\frosterImport\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\xa2\x01\n" +
+	"\"GetGroupRosterImportReportResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\"\n" +
+	"\x05error\x18\x04 \x01(\v2\f.group.ErrorR\x05error\"\xc2\x02\n" +
+	"\x0fGroupInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x1b\n" +
+	"\tuse_count\x18\a \x01(\x05R\buseCount\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\arevoked\x18\n" +
+	" \x01(\bR\arevoked\"\xa5\x01\n" +
+	"\x1cCreateGroupInvitationRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x05R\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"\x89\x01\n" +
+	"\x1dCreateGroupInvitationResponse\x128\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x16.group.GroupInvitationH\x00R\n" +
+	"invitation\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"8\n" +
+	"\x1bListGroupInvitationsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"|\n" +
+	"\x1cListGroupInvitationsResponse\x128\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x16.group.GroupInvitationR\vinvitations\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"^\n" +
+	"\x1cRevokeGroupInvitationRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\tR\finvitationId\"C\n" +
+	"\x1dRevokeGroupInvitationResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"&\n" +
+	"\x10JoinGroupRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x89\x01\n" +
+	"\x11JoinGroupResponse\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05error\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\x03 \x01(\bR\n" +
+	"waitlistedB\b\n" +
+	"\x06result\"\xfd\x02\n" +
+	"\vJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x120\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x18.group.JoinRequestStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x06 \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12#\n" +
+	"\rreject_reason\x18\b \x01(\tR\frejectReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\n" +
+	" \x01(\bR\n" +
+	"waitlisted\"P\n" +
+	"\x19RequestToJoinGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"u\n" +
+	"\x13JoinRequestResponse\x12.\n" +
+	"\arequest\x18\x01 \x01(\v2\x12.group.JoinRequestH\x00R\arequest\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"f\n" +
+	"\x17ListJoinRequestsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.group.JoinRequestStatusR\x06status\"n\n" +
+	"\x18ListJoinRequestsResponse\x12.\n" +
+	"\brequests\x18\x01 \x03(\v2\x12.group.JoinRequestR\brequests\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"U\n" +
+	"\x19ApproveJoinRequestRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"l\n" +
+	"\x18RejectJoinRequestRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc2\x01\n" +
+	"\x10GroupStaffMember\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.group.StaffRoleR\x04role\x12\x19\n" +
+	"\badded_by\x18\x04 \x01(\tR\aaddedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"p\n" +
+	"\x14AddGroupStaffRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.group.StaffRoleR\x04role\"w\n" +
+	"\x12GroupStaffResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.group.GroupStaffMemberH\x00R\x06member\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"2\n" +
+	"\x15ListGroupStaffRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"k\n" +
+	"\x16ListGroupStaffResponse\x12-\n" +
+	"\x05staff\x18\x01 \x03(\v2\x17.group.GroupStaffMemberR\x05staff\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"w\n" +
+	"\x1bUpdateGroupStaffRoleRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.group.StaffRoleR\x04role\"M\n" +
+	"\x17RemoveGroupStaffRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x18RemoveGroupStaffResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\x7f\n" +
+	"\x16CheckPermissionRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x121\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\x0e2\x11.group.PermissionR\n" +
+	"permission\"}\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.group.StaffRoleR\x04role\x12\"\n" +
+	"\x05error\x18\x03 \x01(\v2\f.group.ErrorR\x05error\"Q\n" +
+	"\x15HasSharedGroupRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"T\n" +
+	"\x16HasSharedGroupResponse\x12\x16\n" +
+	"\x06shared\x18\x01 \x01(\bR\x06shared\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"\x8d\x02\n" +
+	"\x11OwnershipTransfer\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\x12'\n" +
+	"\x10keep_as_co_tutor\x18\x04 \x01(\bR\rkeepAsCoTutor\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x85\x01\n" +
+	"\x1dTransferGroupOwnershipRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\x12'\n" +
+	"\x10keep_as_co_tutor\x18\x03 \x01(\bR\rkeepAsCoTutor\"\x83\x01\n" +
+	"\x19OwnershipTransferResponse\x126\n" +
+	"\btransfer\x18\x01 \x01(\v2\x18.group.OwnershipTransferH\x00R\btransfer\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"=\n" +
+	" GetGroupOwnershipTransferRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"@\n" +
+	"#AcceptGroupOwnershipTransferRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"|\n" +
+	"$AcceptGroupOwnershipTransferResponse\x12$\n" +
+	"\x05group\x18\x01 \x01(\v2\f.group.GroupH\x00R\x05group\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"@\n" +
+	"#CancelGroupOwnershipTransferRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"J\n" +
+	"$CancelGroupOwnershipTransferResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\xe9\x03\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\x129\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\fpublished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"read_count\x18\n" +
+	" \x01(\x05R\treadCount\x12!\n" +
+	"\fmember_count\x18\v \x01(\x05R\vmemberCount\x123\n" +
+	"\aread_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\x81\x01\n" +
+	"\x14AnnouncementResponse\x129\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x13.group.AnnouncementH\x00R\fannouncement\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\xa2\x01\n" +
+	"\x1eCreateGroupAnnouncementRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\x129\n" +
+	"\n" +
+	"publish_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\":\n" +
+	"\x1dListGroupAnnouncementsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\x7f\n" +
+	"\x1eListGroupAnnouncementsResponse\x129\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x13.group.AnnouncementR\rannouncements\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"a\n" +
+	"\x1bGetGroupAnnouncementRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12'\n" +
+	"\x0fannouncement_id\x18\x02 \x01(\tR\x0eannouncementId\"\xe9\x01\n" +
+	"\x1eUpdateGroupAnnouncementRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12'\n" +
+	"\x0fannouncement_id\x18\x02 \x01(\tR\x0eannouncementId\x12\x17\n" +
+	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01\x12\x1b\n" +
+	"\x06pinned\x18\x04 \x01(\bH\x01R\x06pinned\x88\x01\x01\x129\n" +
+	"\n" +
+	"publish_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAtB\a\n" +
+	"\x05_bodyB\t\n" +
+	"\a_pinned\"d\n" +
+	"\x1eDeleteGroupAnnouncementRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12'\n" +
+	"\x0fannouncement_id\x18\x02 \x01(\tR\x0eannouncementId\"E\n" +
+	"\x1fDeleteGroupAnnouncementResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"f\n" +
+	" MarkGroupAnnouncementReadRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12'\n" +
+	"\x0fannouncement_id\x18\x02 \x01(\tR\x0eannouncementId\"G\n" +
+	"!MarkGroupAnnouncementReadResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\x99\x02\n" +
+	"\fGroupMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"w\n" +
+	"\x14GroupMessageResponse\x12/\n" +
+	"\amessage\x18\x01 \x01(\v2\x13.group.GroupMessageH\x00R\amessage\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"H\n" +
+	"\x17SendGroupMessageRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"q\n" +
+	"\x18ListGroupMessagesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x98\x01\n" +
+	"\x19ListGroupMessagesResponse\x12/\n" +
+	"\bmessages\x18\x01 \x03(\v2\x13.group.GroupMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\"\n" +
+	"\x05error\x18\x03 \x01(\v2\f.group.ErrorR\x05error\"g\n" +
+	"\x17EditGroupMessageRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"U\n" +
+	"\x19DeleteGroupMessageRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"@\n" +
+	"\x1aDeleteGroupMessageResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\":\n" +
+	"\x1dSubscribeGroupMessagesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"t\n" +
+	"\x11GroupMessageEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.group.GroupMessageEventTypeR\x04type\x12-\n" +
+	"\amessage\x18\x02 \x01(\v2\x13.group.GroupMessageR\amessage\"i\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"\xe9\x01\n" +
+	"\rDirectMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\tR\bsenderId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x123\n" +
+	"\vattachments\x18\x05 \x03(\v2\x11.group.AttachmentR\vattachments\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xad\x02\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x17\n" +
+	"\apeer_id\x18\x03 \x01(\tR\x06peerId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0flast_message_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastMessageAt\x127\n" +
+	"\flast_message\x18\x06 \x01(\v2\x14.group.DirectMessageR\vlastMessage\x12!\n" +
+	"\funread_count\x18\a \x01(\x05R\vunreadCount\"3\n" +
+	"\x18StartConversationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x81\x01\n" +
+	"\x14ConversationResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x13.group.ConversationH\x00R\fconversation\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\x1a\n" +
+	"\x18ListConversationsRequest\"\x9d\x01\n" +
+	"\x19ListConversationsResponse\x129\n" +
+	"\rconversations\x18\x01 \x03(\v2\x13.group.ConversationR\rconversations\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\x12\"\n" +
+	"\x05error\x18\x03 \x01(\v2\f.group.ErrorR\x05error\"\x8c\x01\n" +
+	"\x18SendDirectMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x123\n" +
+	"\vattachments\x18\x03 \x03(\v2\x11.group.AttachmentR\vattachments\"y\n" +
+	"\x15DirectMessageResponse\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x14.group.DirectMessageH\x00R\amessage\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\x80\x01\n" +
+	"\x19ListDirectMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9a\x01\n" +
+	"\x1aListDirectMessagesResponse\x120\n" +
+	"\bmessages\x18\x01 \x03(\v2\x14.group.DirectMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\"\n" +
+	"\x05error\x18\x03 \x01(\v2\f.group.ErrorR\x05error\"F\n" +
+	"\x1bMarkConversationReadRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"B\n" +
+	"\x1cMarkConversationReadResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\x98\x01\n" +
+	"\x1bSearchDirectMessagesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x91\x01\n" +
+	"\x19ReportConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05block\x18\x04 \x01(\bR\x05block\"]\n" +
+	"\x1aReportConversationResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error\"a\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x11BlockUserResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"-\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"9\n" +
+	"\x13UnblockUserResponse\x12\"\n" +
+	"\x05error\x18\x01 \x01(\v2\f.group.ErrorR\x05error\"\x19\n" +
+	"\x17ListBlockedUsersRequest\"h\n" +
+	"\x18ListBlockedUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.group.BlockedUserR\x05users\x12\"\n" +
+	"\x05error\x18\x02 \x01(\v2\f.group.ErrorR\x05error*u\n" +
+	"\n" +
+	"JoinPolicy\x12\x1b\n" +
+	"\x17JOIN_POLICY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10JOIN_POLICY_OPEN\x10\x01\x12\x17\n" +
+	"\x13JOIN_POLICY_REQUEST\x10\x02\x12\x1b\n" +
+	"\x17JOIN_POLICY_INVITE_ONLY\x10\x03*\x7f\n" +
+	"\x0eGroupSortField\x12 \n" +
+	"\x1cGROUP_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15GROUP_SORT_CREATED_AT\x10\x01\x12\x13\n" +
+	"\x0fGROUP_SORT_NAME\x10\x02\x12\x1b\n" +
+	"\x17GROUP_SORT_MEMBER_COUNT\x10\x03*L\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSORT_ASC\x10\x01\x12\r\n" +
+	"\tSORT_DESC\x10\x02*\x7f\n" +
+	"\rArchiveFilter\x12\x1e\n" +
+	"\x1aARCHIVE_FILTER_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ARCHIVE_FILTER_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17ARCHIVE_FILTER_ARCHIVED\x10\x02\x12\x16\n" +
+	"\x12ARCHIVE_FILTER_ALL\x10\x03*\xdf\x01\n" +
+	"\x0fAddMemberStatus\x12!\n" +
+	"\x1dADD_MEMBER_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ADD_MEMBER_STATUS_ADDED\x10\x01\x12 \n" +
+	"\x1cADD_MEMBER_STATUS_WAITLISTED\x10\x02\x12$\n" +
+	" ADD_MEMBER_STATUS_ALREADY_MEMBER\x10\x03\x12\x1f\n" +
+	"\x1bADD_MEMBER_STATUS_NOT_FOUND\x10\x04\x12#\n" +
+	"\x1fADD_MEMBER_STATUS_NOT_A_STUDENT\x10\x05*\xc6\x01\n" +
+	"\x12RosterImportStatus\x12$\n" +
+	" ROSTER_IMPORT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cROSTER_IMPORT_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fROSTER_IMPORT_STATUS_PROCESSING\x10\x02\x12\"\n" +
+	"\x1eROSTER_IMPORT_STATUS_COMPLETED\x10\x03\x12\x1f\n" +
+	"\x1bROSTER_IMPORT_STATUS_FAILED\x10\x04*\xc1\x02\n" +
+	"\x0fRosterRowStatus\x12!\n" +
+	"\x1dROSTER_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ROSTER_ROW_STATUS_ADDED\x10\x01\x12 \n" +
+	"\x1cROSTER_ROW_STATUS_WAITLISTED\x10\x02\x12\x1d\n" +
+	"\x19ROSTER_ROW_STATUS_INVITED\x10\x03\x12$\n" +
+	" ROSTER_ROW_STATUS_ALREADY_MEMBER\x10\x04\x12%\n" +
+	"!ROSTER_ROW_STATUS_ALREADY_INVITED\x10\x05\x12#\n" +
+	"\x1fROSTER_ROW_STATUS_NOT_A_STUDENT\x10\x06\x12\x1d\n" +
+	"\x19ROSTER_ROW_STATUS_INVALID\x10\a\x12\x1c\n" +
+	"\x18ROSTER_ROW_STATUS_FAILED\x10\b*\x88\x01\n" +
+	"\x11JoinRequestStatus\x12#\n" +
+	"\x1fJOIN_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14JOIN_REQUEST_PENDING\x10\x01\x12\x19\n" +
+	"\x15JOIN_REQUEST_APPROVED\x10\x02\x12\x19\n" +
+	"\x15JOIN_REQUEST_REJECTED\x10\x03*p\n" +
+	"\tStaffRole\x12\x1a\n" +
+	"\x16STAFF_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10STAFF_ROLE_OWNER\x10\x01\x12\x17\n" +
+	"\x13STAFF_ROLE_CO_TUTOR\x10\x02\x12\x18\n" +
+	"\x14STAFF_ROLE_ASSISTANT\x10\x03*\xdd\x01\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PERMISSION_UPDATE_GROUP\x10\x01\x12\x1b\n" +
+	"\x17PERMISSION_DELETE_GROUP\x10\x02\x12\x1b\n" +
+	"\x17PERMISSION_MANAGE_STAFF\x10\x03\x12\x1d\n" +
+	"\x19PERMISSION_MANAGE_MEMBERS\x10\x04\x12\x1b\n" +
+	"\x17PERMISSION_MANAGE_TASKS\x10\x05\x12 \n" +
+	"\x1cPERMISSION_GRADE_SUBMISSIONS\x10\x06*\xb2\x01\n" +
+	"\x15GroupMessageEventType\x12(\n" +
+	"$GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" GROUP_MESSAGE_EVENT_TYPE_CREATED\x10\x01\x12#\n" +
+	"\x1fGROUP_MESSAGE_EVENT_TYPE_EDITED\x10\x02\x12$\n" +
+	" GROUP_MESSAGE_EVENT_TYPE_DELETED\x10\x032\xdd;\n" +
 	"\rGroupsService\x12[\n" +
 	"\vCreateGroup\x12\x19.group.CreateGroupRequest\x1a\x1a.group.CreateGroupResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/groups\x12U\n" +
@@ -1267,10 +9221,67 @@ const file_group_group_service_proto_rawDesc = "" +
 	"/v1/groups\x12T\n" +
 	"\bGetGroup\x12\x16.group.GetGroupRequest\x1a\x17.group.GetGroupResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/groups/{id}\x12`\n" +
 	"\vUpdateGroup\x12\x19.group.UpdateGroupRequest\x1a\x1a.group.UpdateGroupResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/groups/{id}\x12]\n" +
-	"\vDeleteGroup\x12\x19.group.DeleteGroupRequest\x1a\x1a.group.DeleteGroupResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/groups/{id}\x12z\n" +
+	"\vDeleteGroup\x12\x19.group.DeleteGroupRequest\x1a\x1a.group.DeleteGroupResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/groups/{id}\x12k\n" +
+	"\fArchiveGroup\x12\x1a.group.ArchiveGroupRequest\x1a\x1b.group.ArchiveGroupResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/groups/{id}:archive\x12k\n" +
+	"\fRestoreGroup\x12\x1a.group.RestoreGroupRequest\x1a\x1b.group.RestoreGroupResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/groups/{id}:restore\x12Q\n" +
+	"\n" +
+	"CreateTerm\x12\x18.group.CreateTermRequest\x1a\x13.group.TermResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/terms\x12Q\n" +
+	"\tListTerms\x12\x17.group.ListTermsRequest\x1a\x18.group.ListTermsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/terms\x12R\n" +
+	"\aGetTerm\x12\x15.group.GetTermRequest\x1a\x13.group.TermResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/terms/{term_id}\x12[\n" +
+	"\n" +
+	"UpdateTerm\x12\x18.group.UpdateTermRequest\x1a\x13.group.TermResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/terms/{term_id}\x12^\n" +
+	"\n" +
+	"DeleteTerm\x12\x18.group.DeleteTermRequest\x1a\x19.group.DeleteTermResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/terms/{term_id}\x12n\n" +
+	"\fSetGroupTerm\x12\x1a.group.SetGroupTermRequest\x1a\x1b.group.SetGroupTermResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/groups/{group_id}/term\x12z\n" +
 	"\x10ListGroupMembers\x12\x1e.group.ListGroupMembersRequest\x1a\x1f.group.ListGroupMembersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/groups/{group_id}/members\x12\x84\x01\n" +
 	"\x0fAddGroupMembers\x12\x1d.group.AddGroupMembersRequest\x1a\x1e.group.AddGroupMembersResponse\"2\x82\xd3\xe4\x93\x02,:\vstudent_ids\"\x1d/v1/groups/{group_id}/members\x12\x8a\x01\n" +
-	"\x12RemoveGroupMembers\x12 .group.RemoveGroupMembersRequest\x1a!.group.RemoveGroupMembersResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/groups/{group_id}/members:removeBKZIhttps://github.com/RomanKovalev007/tutors_platform/api/gen/go/group;groupb\x06proto3"
+	"\x12RemoveGroupMembers\x12 .group.RemoveGroupMembersRequest\x1a!.group.RemoveGroupMembersResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/groups/{group_id}/members:remove\x12~\n" +
+	"\x11ListGroupWaitlist\x12\x1f.group.ListGroupWaitlistRequest\x1a .group.ListGroupWaitlistResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/groups/{group_id}/waitlist\x12\x9d\x01\n" +
+	"\x17RemoveFromGroupWaitlist\x12%.group.RemoveFromGroupWaitlistRequest\x1a&.group.RemoveFromGroupWaitlistResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/groups/{group_id}/waitlist/{student_id}\x12\x82\x01\n" +
+	"\x11ImportGroupRoster\x12\x1f.group.ImportGroupRosterRequest\x1a\x1b.group.RosterImportResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/groups/{group_id}/roster-imports\x12\x91\x01\n" +
+	"\x14GetGroupRosterImport\x12\".group.GetGroupRosterImportRequest\x1a\x1b.group.RosterImportResponse\"8\x82\xd3\xe4\x93\x022\x120/v1/groups/{group_id}/roster-imports/{import_id}\x12\xac\x01\n" +
+	"\x1aGetGroupRosterImportReport\x12\".group.GetGroupRosterImportRequest\x1a).group.GetGroupRosterImportReportResponse\"?\x82\xd3\xe4\x93\x029\x127/v1/groups/{group_id}/roster-imports/{import_id}/report\x12\x90\x01\n" +
+	"\x15CreateGroupInvitation\x12#.group.CreateGroupInvitationRequest\x1a$.group.CreateGroupInvitationResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/groups/{group_id}/invitations\x12\x8a\x01\n" +
+	"\x14ListGroupInvitations\x12\".group.ListGroupInvitationsRequest\x1a#.group.ListGroupInvitationsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/groups/{group_id}/invitations\x12\x9d\x01\n" +
+	"\x15RevokeGroupInvitation\x12#.group.RevokeGroupInvitationRequest\x1a$.group.RevokeGroupInvitationResponse\"9\x82\xd3\xe4\x93\x023*1/v1/groups/{group_id}/invitations/{invitation_id}\x12Z\n" +
+	"\tJoinGroup\x12\x17.group.JoinGroupRequest\x1a\x18.group.JoinGroupResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/groups/join\x12\x82\x01\n" +
+	"\x12RequestToJoinGroup\x12 .group.RequestToJoinGroupRequest\x1a\x1a.group.JoinRequestResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/groups/{group_id}/join-requests\x12\x80\x01\n" +
+	"\x10ListJoinRequests\x12\x1e.group.ListJoinRequestsRequest\x1a\x1f.group.ListJoinRequestsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/groups/{group_id}/join-requests\x12\x97\x01\n" +
+	"\x12ApproveJoinRequest\x12 .group.ApproveJoinRequestRequest\x1a\x1a.group.JoinRequestResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/groups/{group_id}/join-requests/{request_id}:approve\x12\x94\x01\n" +
+	"\x11RejectJoinRequest\x12\x1f.group.RejectJoinRequestRequest\x1a\x1a.group.JoinRequestResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/groups/{group_id}/join-requests/{request_id}:reject\x12o\n" +
+	"\rAddGroupStaff\x12\x1b.group.AddGroupStaffRequest\x1a\x19.group.GroupStaffResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/groups/{group_id}/staff\x12r\n" +
+	"\x0eListGroupStaff\x12\x1c.group.ListGroupStaffRequest\x1a\x1d.group.ListGroupStaffResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/groups/{group_id}/staff\x12\x87\x01\n" +
+	"\x14UpdateGroupStaffRole\x12\".group.UpdateGroupStaffRoleRequest\x1a\x19.group.GroupStaffResponse\"0\x82\xd3\xe4\x93\x02*:\x01*2%/v1/groups/{group_id}/staff/{user_id}\x12\x82\x01\n" +
+	"\x10RemoveGroupStaff\x12\x1e.group.RemoveGroupStaffRequest\x1a\x1f.group.RemoveGroupStaffResponse\"-\x82\xd3\xe4\x93\x02'*%/v1/groups/{group_id}/staff/{user_id}\x12P\n" +
+	"\x0fCheckPermission\x12\x1d.group.CheckPermissionRequest\x1a\x1e.group.CheckPermissionResponse\x12M\n" +
+	"\x0eHasSharedGroup\x12\x1c.group.HasSharedGroupRequest\x1a\x1d.group.HasSharedGroupResponse\x12\x95\x01\n" +
+	"\x16TransferGroupOwnership\x12$.group.TransferGroupOwnershipRequest\x1a .group.OwnershipTransferResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/groups/{group_id}/ownership-transfer\x12\x98\x01\n" +
+	"\x19GetGroupOwnershipTransfer\x12'.group.GetGroupOwnershipTransferRequest\x1a .group.OwnershipTransferResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/groups/{group_id}/ownership-transfer\x12\xb3\x01\n" +
+	"\x1cAcceptGroupOwnershipTransfer\x12*.group.AcceptGroupOwnershipTransferRequest\x1a+.group.AcceptGroupOwnershipTransferResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/groups/{group_id}/ownership-transfer:accept\x12\xa9\x01\n" +
+	"\x1cCancelGroupOwnershipTransfer\x12*.group.CancelGroupOwnershipTransferRequest\x1a+.group.CancelGroupOwnershipTransferResponse\"0\x82\xd3\xe4\x93\x02**(/v1/groups/{group_id}/ownership-transfer\x12\x8d\x01\n" +
+	"\x17CreateGroupAnnouncement\x12%.group.CreateGroupAnnouncementRequest\x1a\x1b.group.AnnouncementResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/groups/{group_id}/announcements\x12\x92\x01\n" +
+	"\x16ListGroupAnnouncements\x12$.group.ListGroupAnnouncementsRequest\x1a%.group.ListGroupAnnouncementsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/groups/{group_id}/announcements\x12\x96\x01\n" +
+	"\x14GetGroupAnnouncement\x12\".group.GetGroupAnnouncementRequest\x1a\x1b.group.AnnouncementResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/groups/{group_id}/announcements/{announcement_id}\x12\x9f\x01\n" +
+	"\x17UpdateGroupAnnouncement\x12%.group.UpdateGroupAnnouncementRequest\x1a\x1b.group.AnnouncementResponse\"@\x82\xd3\xe4\x93\x02::\x01*25/v1/groups/{group_id}/announcements/{announcement_id}\x12\xa7\x01\n" +
+	"\x17DeleteGroupAnnouncement\x12%.group.DeleteGroupAnnouncementRequest\x1a&.group.DeleteGroupAnnouncementResponse\"=\x82\xd3\xe4\x93\x027*5/v1/groups/{group_id}/announcements/{announcement_id}\x12\xb5\x01\n" +
+	"\x19MarkGroupAnnouncementRead\x12'.group.MarkGroupAnnouncementReadRequest\x1a(.group.MarkGroupAnnouncementReadResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/v1/groups/{group_id}/announcements/{announcement_id}:read\x12z\n" +
+	"\x10SendGroupMessage\x12\x1e.group.SendGroupMessageRequest\x1a\x1b.group.GroupMessageResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/groups/{group_id}/messages\x12~\n" +
+	"\x11ListGroupMessages\x12\x1f.group.ListGroupMessagesRequest\x1a .group.ListGroupMessagesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/groups/{group_id}/messages\x12\x87\x01\n" +
+	"\x10EditGroupMessage\x12\x1e.group.EditGroupMessageRequest\x1a\x1b.group.GroupMessageResponse\"6\x82\xd3\xe4\x93\x020:\x01*2+/v1/groups/{group_id}/messages/{message_id}\x12\x8e\x01\n" +
+	"\x12DeleteGroupMessage\x12 .group.DeleteGroupMessageRequest\x1a!.group.DeleteGroupMessageResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/groups/{group_id}/messages/{message_id}\x12Z\n" +
+	"\x16SubscribeGroupMessages\x12$.group.SubscribeGroupMessagesRequest\x1a\x18.group.GroupMessageEvent0\x01\x12o\n" +
+	"\x11StartConversation\x12\x1f.group.StartConversationRequest\x1a\x1b.group.ConversationResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/conversations\x12q\n" +
+	"\x11ListConversations\x12\x1f.group.ListConversationsRequest\x1a .group.ListConversationsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/conversations\x12\x8b\x01\n" +
+	"\x11SendDirectMessage\x12\x1f.group.SendDirectMessageRequest\x1a\x1c.group.DirectMessageResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/conversations/{conversation_id}/messages\x12\x8f\x01\n" +
+	"\x12ListDirectMessages\x12 .group.ListDirectMessagesRequest\x1a!.group.ListDirectMessagesResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/conversations/{conversation_id}/messages\x12\x94\x01\n" +
+	"\x14MarkConversationRead\x12\".group.MarkConversationReadRequest\x1a#.group.MarkConversationReadResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/conversations/{conversation_id}:read\x12\x81\x01\n" +
+	"\x14SearchDirectMessages\x12\".group.SearchDirectMessagesRequest\x1a!.group.ListDirectMessagesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/direct-messages:search\x12\x90\x01\n" +
+	"\x12ReportConversation\x12 .group.ReportConversationRequest\x1a!.group.ReportConversationResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/conversations/{conversation_id}:report\x12U\n" +
+	"\tBlockUser\x12\x17.group.BlockUserRequest\x1a\x18.group.BlockUserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/blocks\x12b\n" +
+	"\vUnblockUser\x12\x19.group.UnblockUserRequest\x1a\x1a.group.UnblockUserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/blocks/{user_id}\x12g\n" +
+	"\x10ListBlockedUsers\x12\x1e.group.ListBlockedUsersRequest\x1a\x1f.group.ListBlockedUsersResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/blocksBKZIhttps://github.com/RomanKovalev007/tutors_platform/api/gen/go/group;groupb\x06proto3"
 
 var (
 	file_group_group_service_proto_rawDescOnce sync.Once
@@ -1284,67 +9295,412 @@ func file_group_group_service_proto_rawDescGZIP() []byte {
 	return file_group_group_service_proto_rawDescData
 }
 
-var file_group_group_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_group_group_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_group_group_service_proto_msgTypes = make([]protoimpl.MessageInfo, 126)
 var file_group_group_service_proto_goTypes = []any{
-	(*Error)(nil),                      // 0: group.Error
-	(*Group)(nil),                      // 1: group.Group
-	(*GroupMember)(nil),                // 2: group.GroupMember
-	(*CreateGroupRequest)(nil),         // 3: group.CreateGroupRequest
-	(*CreateGroupResponse)(nil),        // 4: group.CreateGroupResponse
-	(*ListGroupsRequest)(nil),          // 5: group.ListGroupsRequest
-	(*ListGroupsResponse)(nil),         // 6: group.ListGroupsResponse
-	(*GetGroupRequest)(nil),            // 7: group.GetGroupRequest
-	(*GetGroupResponse)(nil),           // 8: group.GetGroupResponse
-	(*UpdateGroupRequest)(nil),         // 9: group.UpdateGroupRequest
-	(*UpdateGroupResponse)(nil),        // 10: group.UpdateGroupResponse
-	(*DeleteGroupRequest)(nil),         // 11: group.DeleteGroupRequest
-	(*DeleteGroupResponse)(nil),        // 12: group.DeleteGroupResponse
-	(*ListGroupMembersRequest)(nil),    // 13: group.ListGroupMembersRequest
-	(*ListGroupMembersResponse)(nil),   // 14: group.ListGroupMembersResponse
-	(*AddGroupMembersRequest)(nil),     // 15: group.AddGroupMembersRequest
-	(*AddGroupMembersResponse)(nil),    // 16: group.AddGroupMembersResponse
-	(*RemoveGroupMembersRequest)(nil),  // 17: group.RemoveGroupMembersRequest
-	(*RemoveGroupMembersResponse)(nil), // 18: group.RemoveGroupMembersResponse
-	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(JoinPolicy)(0),                              // 0: group.JoinPolicy
+	(GroupSortField)(0),                          // 1: group.GroupSortField
+	(SortDirection)(0),                           // 2: group.SortDirection
+	(ArchiveFilter)(0),                           // 3: group.ArchiveFilter
+	(AddMemberStatus)(0),                         // 4: group.AddMemberStatus
+	(RosterImportStatus)(0),                      // 5: group.RosterImportStatus
+	(RosterRowStatus)(0),                         // 6: group.RosterRowStatus
+	(JoinRequestStatus)(0),                       // 7: group.JoinRequestStatus
+	(StaffRole)(0),                               // 8: group.StaffRole
+	(Permission)(0),                              // 9: group.Permission
+	(GroupMessageEventType)(0),                   // 10: group.GroupMessageEventType
+	(*Error)(nil),                                // 11: group.Error
+	(*Group)(nil),                                // 12: group.Group
+	(*Term)(nil),                                 // 13: group.Term
+	(*GroupMember)(nil),                          // 14: group.GroupMember
+	(*CreateGroupRequest)(nil),                   // 15: group.CreateGroupRequest
+	(*CreateGroupResponse)(nil),                  // 16: group.CreateGroupResponse
+	(*ListGroupsRequest)(nil),                    // 17: group.ListGroupsRequest
+	(*ListGroupsResponse)(nil),                   // 18: group.ListGroupsResponse
+	(*GetGroupRequest)(nil),                      // 19: group.GetGroupRequest
+	(*GetGroupResponse)(nil),                     // 20: group.GetGroupResponse
+	(*UpdateGroupRequest)(nil),                   // 21: group.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),                  // 22: group.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),                   // 23: group.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),                  // 24: group.DeleteGroupResponse
+	(*CreateTermRequest)(nil),                    // 25: group.CreateTermRequest
+	(*TermResponse)(nil),                         // 26: group.TermResponse
+	(*ListTermsRequest)(nil),                     // 27: group.ListTermsRequest
+	(*ListTermsResponse)(nil),                    // 28: group.ListTermsResponse
+	(*GetTermRequest)(nil),                       // 29: group.GetTermRequest
+	(*UpdateTermRequest)(nil),                    // 30: group.UpdateTermRequest
+	(*DeleteTermRequest)(nil),                    // 31: group.DeleteTermRequest
+	(*DeleteTermResponse)(nil),                   // 32: group.DeleteTermResponse
+	(*SetGroupTermRequest)(nil),                  // 33: group.SetGroupTermRequest
+	(*SetGroupTermResponse)(nil),                 // 34: group.SetGroupTermResponse
+	(*ArchiveGroupRequest)(nil),                  // 35: group.ArchiveGroupRequest
+	(*ArchiveGroupResponse)(nil),                 // 36: group.ArchiveGroupResponse
+	(*RestoreGroupRequest)(nil),                  // 37: group.RestoreGroupRequest
+	(*RestoreGroupResponse)(nil),                 // 38: group.RestoreGroupResponse
+	(*ListGroupMembersRequest)(nil),              // 39: group.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),             // 40: group.ListGroupMembersResponse
+	(*AddGroupMembersRequest)(nil),               // 41: group.AddGroupMembersRequest
+	(*AddMemberResult)(nil),                      // 42: group.AddMemberResult
+	(*AddGroupMembersResponse)(nil),              // 43: group.AddGroupMembersResponse
+	(*RemoveGroupMembersRequest)(nil),            // 44: group.RemoveGroupMembersRequest
+	(*RemoveGroupMembersResponse)(nil),           // 45: group.RemoveGroupMembersResponse
+	(*WaitlistEntry)(nil),                        // 46: group.WaitlistEntry
+	(*ListGroupWaitlistRequest)(nil),             // 47: group.ListGroupWaitlistRequest
+	(*ListGroupWaitlistResponse)(nil),            // 48: group.ListGroupWaitlistResponse
+	(*RemoveFromGroupWaitlistRequest)(nil),       // 49: group.RemoveFromGroupWaitlistRequest
+	(*RemoveFromGroupWaitlistResponse)(nil),      // 50: group.RemoveFromGroupWaitlistResponse
+	(*RosterRow)(nil),                            // 51: group.RosterRow
+	(*RosterImport)(nil),                         // 52: group.RosterImport
+	(*ImportGroupRosterRequest)(nil),             // 53: group.ImportGroupRosterRequest
+	(*GetGroupRosterImportRequest)(nil),          // 54: group.GetGroupRosterImportRequest
+	(*RosterImportResponse)(nil),                 // 55: group.RosterImportResponse
+	(*GetGroupRosterImportReportResponse)(nil),   // 56: group.GetGroupRosterImportReportResponse
+	(*GroupInvitation)(nil),                      // 57: group.GroupInvitation
+	(*CreateGroupInvitationRequest)(nil),         // 58: group.CreateGroupInvitationRequest
+	(*CreateGroupInvitationResponse)(nil),        // 59: group.CreateGroupInvitationResponse
+	(*ListGroupInvitationsRequest)(nil),          // 60: group.ListGroupInvitationsRequest
+	(*ListGroupInvitationsResponse)(nil),         // 61: group.ListGroupInvitationsResponse
+	(*RevokeGroupInvitationRequest)(nil),         // 62: group.RevokeGroupInvitationRequest
+	(*RevokeGroupInvitationResponse)(nil),        // 63: group.RevokeGroupInvitationResponse
+	(*JoinGroupRequest)(nil),                     // 64: group.JoinGroupRequest
+	(*JoinGroupResponse)(nil),                    // 65: group.JoinGroupResponse
+	(*JoinRequest)(nil),                          // 66: group.JoinRequest
+	(*RequestToJoinGroupRequest)(nil),            // 67: group.RequestToJoinGroupRequest
+	(*JoinRequestResponse)(nil),                  // 68: group.JoinRequestResponse
+	(*ListJoinRequestsRequest)(nil),              // 69: group.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),             // 70: group.ListJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),            // 71: group.ApproveJoinRequestRequest
+	(*RejectJoinRequestRequest)(nil),             // 72: group.RejectJoinRequestRequest
+	(*GroupStaffMember)(nil),                     // 73: group.GroupStaffMember
+	(*AddGroupStaffRequest)(nil),                 // 74: group.AddGroupStaffRequest
+	(*GroupStaffResponse)(nil),                   // 75: group.GroupStaffResponse
+	(*ListGroupStaffRequest)(nil),                // 76: group.ListGroupStaffRequest
+	(*ListGroupStaffResponse)(nil),               // 77: group.ListGroupStaffResponse
+	(*UpdateGroupStaffRoleRequest)(nil),          // 78: group.UpdateGroupStaffRoleRequest
+	(*RemoveGroupStaffRequest)(nil),              // 79: group.RemoveGroupStaffRequest
+	(*RemoveGroupStaffResponse)(nil),             // 80: group.RemoveGroupStaffResponse
+	(*CheckPermissionRequest)(nil),               // 81: group.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),              // 82: group.CheckPermissionResponse
+	(*HasSharedGroupRequest)(nil),                // 83: group.HasSharedGroupRequest
+	(*HasSharedGroupResponse)(nil),               // 84: group.HasSharedGroupResponse
+	(*OwnershipTransfer)(nil),                    // 85: group.OwnershipTransfer
+	(*TransferGroupOwnershipRequest)(nil),        // 86: group.TransferGroupOwnershipRequest
+	(*OwnershipTransferResponse)(nil),            // 87: group.OwnershipTransferResponse
+	(*GetGroupOwnershipTransferRequest)(nil),     // 88: group.GetGroupOwnershipTransferRequest
+	(*AcceptGroupOwnershipTransferRequest)(nil),  // 89: group.AcceptGroupOwnershipTransferRequest
+	(*AcceptGroupOwnershipTransferResponse)(nil), // 90: group.AcceptGroupOwnershipTransferResponse
+	(*CancelGroupOwnershipTransferRequest)(nil),  // 91: group.CancelGroupOwnershipTransferRequest
+	(*CancelGroupOwnershipTransferResponse)(nil), // 92: group.CancelGroupOwnershipTransferResponse
+	(*Announcement)(nil),                         // 93: group.Announcement
+	(*AnnouncementResponse)(nil),                 // 94: group.AnnouncementResponse
+	(*CreateGroupAnnouncementRequest)(nil),       // 95: group.CreateGroupAnnouncementRequest
+	(*ListGroupAnnouncementsRequest)(nil),        // 96: group.ListGroupAnnouncementsRequest
+	(*ListGroupAnnouncementsResponse)(nil),       // 97: group.ListGroupAnnouncementsResponse
+	(*GetGroupAnnouncementRequest)(nil),          // 98: group.GetGroupAnnouncementRequest
+	(*UpdateGroupAnnouncementRequest)(nil),       // 99: group.UpdateGroupAnnouncementRequest
+	(*DeleteGroupAnnouncementRequest)(nil),       // 100: group.DeleteGroupAnnouncementRequest
+	(*DeleteGroupAnnouncementResponse)(nil),      // 101: group.DeleteGroupAnnouncementResponse
+	(*MarkGroupAnnouncementReadRequest)(nil),     // 102: group.MarkGroupAnnouncementReadRequest
+	(*MarkGroupAnnouncementReadResponse)(nil),    // 103: group.MarkGroupAnnouncementReadResponse
+	(*GroupMessage)(nil),                         // 104: group.GroupMessage
+	(*GroupMessageResponse)(nil),                 // 105: group.GroupMessageResponse
+	(*SendGroupMessageRequest)(nil),              // 106: group.SendGroupMessageRequest
+	(*ListGroupMessagesRequest)(nil),             // 107: group.ListGroupMessagesRequest
+	(*ListGroupMessagesResponse)(nil),            // 108: group.ListGroupMessagesResponse
+	(*EditGroupMessageRequest)(nil),              // 109: group.EditGroupMessageRequest
+	(*DeleteGroupMessageRequest)(nil),            // 110: group.DeleteGroupMessageRequest
+	(*DeleteGroupMessageResponse)(nil),           // 111: group.DeleteGroupMessageResponse
+	(*SubscribeGroupMessagesRequest)(nil),        // 112: group.SubscribeGroupMessagesRequest
+	(*GroupMessageEvent)(nil),                    // 113: group.GroupMessageEvent
+	(*Attachment)(nil),                           // 114: group.Attachment
+	(*DirectMessage)(nil),                        // 115: group.DirectMessage
+	(*Conversation)(nil),                         // 116: group.Conversation
+	(*StartConversationRequest)(nil),             // 117: group.StartConversationRequest
+	(*ConversationResponse)(nil),                 // 118: group.ConversationResponse
+	(*ListConversationsRequest)(nil),             // 119: group.ListConversationsRequest
+	(*ListConversationsResponse)(nil),            // 120: group.ListConversationsResponse
+	(*SendDirectMessageRequest)(nil),             // 121: group.SendDirectMessageRequest
+	(*DirectMessageResponse)(nil),                // 122: group.DirectMessageResponse
+	(*ListDirectMessagesRequest)(nil),            // 123: group.ListDirectMessagesRequest
+	(*ListDirectMessagesResponse)(nil),           // 124: group.ListDirectMessagesResponse
+	(*MarkConversationReadRequest)(nil),          // 125: group.MarkConversationReadRequest
+	(*MarkConversationReadResponse)(nil),         // 126: group.MarkConversationReadResponse
+	(*SearchDirectMessagesRequest)(nil),          // 127: group.SearchDirectMessagesRequest
+	(*ReportConversationRequest)(nil),            // 128: group.ReportConversationRequest
+	(*ReportConversationResponse)(nil),           // 129: group.ReportConversationResponse
+	(*BlockedUser)(nil),                          // 130: group.BlockedUser
+	(*BlockUserRequest)(nil),                     // 131: group.BlockUserRequest
+	(*BlockUserResponse)(nil),                    // 132: group.BlockUserResponse
+	(*UnblockUserRequest)(nil),                   // 133: group.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                  // 134: group.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),              // 135: group.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),             // 136: group.ListBlockedUsersResponse
+	(*timestamppb.Timestamp)(nil),                // 137: google.protobuf.Timestamp
 }
 var file_group_group_service_proto_depIdxs = []int32{
-	19, // 0: group.Group.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 1: group.Group.members:type_name -> group.GroupMember
-	19, // 2: group.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: group.CreateGroupResponse.group:type_name -> group.Group
-	0,  // 4: group.CreateGroupResponse.error:type_name -> group.Error
-	1,  // 5: group.ListGroupsResponse.groups:type_name -> group.Group
-	0,  // 6: group.ListGroupsResponse.error:type_name -> group.Error
-	1,  // 7: group.GetGroupResponse.group:type_name -> group.Group
-	0,  // 8: group.GetGroupResponse.error:type_name -> group.Error
-	1,  // 9: group.UpdateGroupResponse.group:type_name -> group.Group
-	0,  // 10: group.UpdateGroupResponse.error:type_name -> group.Error
-	0,  // 11: group.DeleteGroupResponse.error:type_name -> group.Error
-	2,  // 12: group.ListGroupMembersResponse.members:type_name -> group.GroupMember
-	0,  // 13: group.ListGroupMembersResponse.error:type_name -> group.Error
-	0,  // 14: group.AddGroupMembersResponse.error:type_name -> group.Error
-	0,  // 15: group.RemoveGroupMembersResponse.error:type_name -> group.Error
-	3,  // 16: group.GroupsService.CreateGroup:input_type -> group.CreateGroupRequest
-	5,  // 17: group.GroupsService.ListGroups:input_type -> group.ListGroupsRequest
-	7,  // 18: group.GroupsService.GetGroup:input_type -> group.GetGroupRequest
-	9,  // 19: group.GroupsService.UpdateGroup:input_type -> group.UpdateGroupRequest
-	11, // 20: group.GroupsService.DeleteGroup:input_type -> group.DeleteGroupRequest
-	13, // 21: group.GroupsService.ListGroupMembers:input_type -> group.ListGroupMembersRequest
-	15, // 22: group.GroupsService.AddGroupMembers:input_type -> group.AddGroupMembersRequest
-	17, // 23: group.GroupsService.RemoveGroupMembers:input_type -> group.RemoveGroupMembersRequest
-	4,  // 24: group.GroupsService.CreateGroup:output_type -> group.CreateGroupResponse
-	6,  // 25: group.GroupsService.ListGroups:output_type -> group.ListGroupsResponse
-	8,  // 26: group.GroupsService.GetGroup:output_type -> group.GetGroupResponse
-	10, // 27: group.GroupsService.UpdateGroup:output_type -> group.UpdateGroupResponse
-	12, // 28: group.GroupsService.DeleteGroup:output_type -> group.DeleteGroupResponse
-	14, // 29: group.GroupsService.ListGroupMembers:output_type -> group.ListGroupMembersResponse
-	16, // 30: group.GroupsService.AddGroupMembers:output_type -> group.AddGroupMembersResponse
-	18, // 31: group.GroupsService.RemoveGroupMembers:output_type -> group.RemoveGroupMembersResponse
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	137, // 0: group.Group.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 1: group.Group.members:type_name -> group.GroupMember
+	0,   // 2: group.Group.join_policy:type_name -> group.JoinPolicy
+	137, // 3: group.Group.archived_at:type_name -> google.protobuf.Timestamp
+	13,  // 4: group.Group.term:type_name -> group.Term
+	137, // 5: group.Term.starts_at:type_name -> google.protobuf.Timestamp
+	137, // 6: group.Term.ends_at:type_name -> google.protobuf.Timestamp
+	137, // 7: group.Term.created_at:type_name -> google.protobuf.Timestamp
+	137, // 8: group.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
+	0,   // 9: group.CreateGroupRequest.join_policy:type_name -> group.JoinPolicy
+	12,  // 10: group.CreateGroupResponse.group:type_name -> group.Group
+	11,  // 11: group.CreateGroupResponse.error:type_name -> group.Error
+	1,   // 12: group.ListGroupsRequest.sort_by:type_name -> group.GroupSortField
+	2,   // 13: group.ListGroupsRequest.sort_direction:type_name -> group.SortDirection
+	3,   // 14: group.ListGroupsRequest.archive_filter:type_name -> group.ArchiveFilter
+	12,  // 15: group.ListGroupsResponse.groups:type_name -> group.Group
+	11,  // 16: group.ListGroupsResponse.error:type_name -> group.Error
+	12,  // 17: group.GetGroupResponse.group:type_name -> group.Group
+	11,  // 18: group.GetGroupResponse.error:type_name -> group.Error
+	0,   // 19: group.UpdateGroupRequest.join_policy:type_name -> group.JoinPolicy
+	12,  // 20: group.UpdateGroupResponse.group:type_name -> group.Group
+	11,  // 21: group.UpdateGroupResponse.error:type_name -> group.Error
+	11,  // 22: group.DeleteGroupResponse.error:type_name -> group.Error
+	137, // 23: group.CreateTermRequest.starts_at:type_name -> google.protobuf.Timestamp
+	137, // 24: group.CreateTermRequest.ends_at:type_name -> google.protobuf.Timestamp
+	13,  // 25: group.TermResponse.term:type_name -> group.Term
+	11,  // 26: group.TermResponse.error:type_name -> group.Error
+	13,  // 27: group.ListTermsResponse.terms:type_name -> group.Term
+	11,  // 28: group.ListTermsResponse.error:type_name -> group.Error
+	137, // 29: group.UpdateTermRequest.starts_at:type_name -> google.protobuf.Timestamp
+	137, // 30: group.UpdateTermRequest.ends_at:type_name -> google.protobuf.Timestamp
+	11,  // 31: group.DeleteTermResponse.error:type_name -> group.Error
+	12,  // 32: group.SetGroupTermResponse.group:type_name -> group.Group
+	11,  // 33: group.SetGroupTermResponse.error:type_name -> group.Error
+	12,  // 34: group.ArchiveGroupResponse.group:type_name -> group.Group
+	11,  // 35: group.ArchiveGroupResponse.error:type_name -> group.Error
+	12,  // 36: group.RestoreGroupResponse.group:type_name -> group.Group
+	11,  // 37: group.RestoreGroupResponse.error:type_name -> group.Error
+	14,  // 38: group.ListGroupMembersResponse.members:type_name -> group.GroupMember
+	11,  // 39: group.ListGroupMembersResponse.error:type_name -> group.Error
+	4,   // 40: group.AddMemberResult.status:type_name -> group.AddMemberStatus
+	11,  // 41: group.AddGroupMembersResponse.error:type_name -> group.Error
+	42,  // 42: group.AddGroupMembersResponse.results:type_name -> group.AddMemberResult
+	11,  // 43: group.RemoveGroupMembersResponse.error:type_name -> group.Error
+	137, // 44: group.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	46,  // 45: group.ListGroupWaitlistResponse.entries:type_name -> group.WaitlistEntry
+	11,  // 46: group.ListGroupWaitlistResponse.error:type_name -> group.Error
+	11,  // 47: group.RemoveFromGroupWaitlistResponse.error:type_name -> group.Error
+	6,   // 48: group.RosterRow.status:type_name -> group.RosterRowStatus
+	5,   // 49: group.RosterImport.status:type_name -> group.RosterImportStatus
+	51,  // 50: group.RosterImport.rows:type_name -> group.RosterRow
+	137, // 51: group.RosterImport.created_at:type_name -> google.protobuf.Timestamp
+	137, // 52: group.RosterImport.finished_at:type_name -> google.protobuf.Timestamp
+	52,  // 53: group.RosterImportResponse.roster_import:type_name -> group.RosterImport
+	11,  // 54: group.RosterImportResponse.error:type_name -> group.Error
+	11,  // 55: group.GetGroupRosterImportReportResponse.error:type_name -> group.Error
+	137, // 56: group.GroupInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	137, // 57: group.GroupInvitation.created_at:type_name -> google.protobuf.Timestamp
+	137, // 58: group.CreateGroupInvitationRequest.expires_at:type_name -> google.protobuf.Timestamp
+	57,  // 59: group.CreateGroupInvitationResponse.invitation:type_name -> group.GroupInvitation
+	11,  // 60: group.CreateGroupInvitationResponse.error:type_name -> group.Error
+	57,  // 61: group.ListGroupInvitationsResponse.invitations:type_name -> group.GroupInvitation
+	11,  // 62: group.ListGroupInvitationsResponse.error:type_name -> group.Error
+	11,  // 63: group.RevokeGroupInvitationResponse.error:type_name -> group.Error
+	12,  // 64: group.JoinGroupResponse.group:type_name -> group.Group
+	11,  // 65: group.JoinGroupResponse.error:type_name -> group.Error
+	7,   // 66: group.JoinRequest.status:type_name -> group.JoinRequestStatus
+	137, // 67: group.JoinRequest.decided_at:type_name -> google.protobuf.Timestamp
+	137, // 68: group.JoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 69: group.JoinRequestResponse.request:type_name -> group.JoinRequest
+	11,  // 70: group.JoinRequestResponse.error:type_name -> group.Error
+	7,   // 71: group.ListJoinRequestsRequest.status:type_name -> group.JoinRequestStatus
+	66,  // 72: group.ListJoinRequestsResponse.requests:type_name -> group.JoinRequest
+	11,  // 73: group.ListJoinRequestsResponse.error:type_name -> group.Error
+	8,   // 74: group.GroupStaffMember.role:type_name -> group.StaffRole
+	137, // 75: group.GroupStaffMember.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 76: group.AddGroupStaffRequest.role:type_name -> group.StaffRole
+	73,  // 77: group.GroupStaffResponse.member:type_name -> group.GroupStaffMember
+	11,  // 78: group.GroupStaffResponse.error:type_name -> group.Error
+	73,  // 79: group.ListGroupStaffResponse.staff:type_name -> group.GroupStaffMember
+	11,  // 80: group.ListGroupStaffResponse.error:type_name -> group.Error
+	8,   // 81: group.UpdateGroupStaffRoleRequest.role:type_name -> group.StaffRole
+	11,  // 82: group.RemoveGroupStaffResponse.error:type_name -> group.Error
+	9,   // 83: group.CheckPermissionRequest.permission:type_name -> group.Permission
+	8,   // 84: group.CheckPermissionResponse.role:type_name -> group.StaffRole
+	11,  // 85: group.CheckPermissionResponse.error:type_name -> group.Error
+	11,  // 86: group.HasSharedGroupResponse.error:type_name -> group.Error
+	137, // 87: group.OwnershipTransfer.created_at:type_name -> google.protobuf.Timestamp
+	137, // 88: group.OwnershipTransfer.expires_at:type_name -> google.protobuf.Timestamp
+	85,  // 89: group.OwnershipTransferResponse.transfer:type_name -> group.OwnershipTransfer
+	11,  // 90: group.OwnershipTransferResponse.error:type_name -> group.Error
+	12,  // 91: group.AcceptGroupOwnershipTransferResponse.group:type_name -> group.Group
+	11,  // 92: group.AcceptGroupOwnershipTransferResponse.error:type_name -> group.Error
+	11,  // 93: group.CancelGroupOwnershipTransferResponse.error:type_name -> group.Error
+	137, // 94: group.Announcement.publish_at:type_name -> google.protobuf.Timestamp
+	137, // 95: group.Announcement.published_at:type_name -> google.protobuf.Timestamp
+	137, // 96: group.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	137, // 97: group.Announcement.updated_at:type_name -> google.protobuf.Timestamp
+	137, // 98: group.Announcement.read_at:type_name -> google.protobuf.Timestamp
+	93,  // 99: group.AnnouncementResponse.announcement:type_name -> group.Announcement
+	11,  // 100: group.AnnouncementResponse.error:type_name -> group.Error
+	137, // 101: group.CreateGroupAnnouncementRequest.publish_at:type_name -> google.protobuf.Timestamp
+	93,  // 102: group.ListGroupAnnouncementsResponse.announcements:type_name -> group.Announcement
+	11,  // 103: group.ListGroupAnnouncementsResponse.error:type_name -> group.Error
+	137, // 104: group.UpdateGroupAnnouncementRequest.publish_at:type_name -> google.protobuf.Timestamp
+	11,  // 105: group.DeleteGroupAnnouncementResponse.error:type_name -> group.Error
+	11,  // 106: group.MarkGroupAnnouncementReadResponse.error:type_name -> group.Error
+	137, // 107: group.GroupMessage.created_at:type_name -> google.protobuf.Timestamp
+	137, // 108: group.GroupMessage.edited_at:type_name -> google.protobuf.Timestamp
+	137, // 109: group.GroupMessage.deleted_at:type_name -> google.protobuf.Timestamp
+	104, // 110: group.GroupMessageResponse.message:type_name -> group.GroupMessage
+	11,  // 111: group.GroupMessageResponse.error:type_name -> group.Error
+	104, // 112: group.ListGroupMessagesResponse.messages:type_name -> group.GroupMessage
+	11,  // 113: group.ListGroupMessagesResponse.error:type_name -> group.Error
+	11,  // 114: group.DeleteGroupMessageResponse.error:type_name -> group.Error
+	10,  // 115: group.GroupMessageEvent.type:type_name -> group.GroupMessageEventType
+	104, // 116: group.GroupMessageEvent.message:type_name -> group.GroupMessage
+	114, // 117: group.DirectMessage.attachments:type_name -> group.Attachment
+	137, // 118: group.DirectMessage.created_at:type_name -> google.protobuf.Timestamp
+	137, // 119: group.Conversation.created_at:type_name -> google.protobuf.Timestamp
+	137, // 120: group.Conversation.last_message_at:type_name -> google.protobuf.Timestamp
+	115, // 121: group.Conversation.last_message:type_name -> group.DirectMessage
+	116, // 122: group.ConversationResponse.conversation:type_name -> group.Conversation
+	11,  // 123: group.ConversationResponse.error:type_name -> group.Error
+	116, // 124: group.ListConversationsResponse.conversations:type_name -> group.Conversation
+	11,  // 125: group.ListConversationsResponse.error:type_name -> group.Error
+	114, // 126: group.SendDirectMessageRequest.attachments:type_name -> group.Attachment
+	115, // 127: group.DirectMessageResponse.message:type_name -> group.DirectMessage
+	11,  // 128: group.DirectMessageResponse.error:type_name -> group.Error
+	115, // 129: group.ListDirectMessagesResponse.messages:type_name -> group.DirectMessage
+	11,  // 130: group.ListDirectMessagesResponse.error:type_name -> group.Error
+	11,  // 131: group.MarkConversationReadResponse.error:type_name -> group.Error
+	11,  // 132: group.ReportConversationResponse.error:type_name -> group.Error
+	137, // 133: group.BlockedUser.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 134: group.BlockUserResponse.error:type_name -> group.Error
+	11,  // 135: group.UnblockUserResponse.error:type_name -> group.Error
+	130, // 136: group.ListBlockedUsersResponse.users:type_name -> group.BlockedUser
+	11,  // 137: group.ListBlockedUsersResponse.error:type_name -> group.Error
+	15,  // 138: group.GroupsService.CreateGroup:input_type -> group.CreateGroupRequest
+	17,  // 139: group.GroupsService.ListGroups:input_type -> group.ListGroupsRequest
+	19,  // 140: group.GroupsService.GetGroup:input_type -> group.GetGroupRequest
+	21,  // 141: group.GroupsService.UpdateGroup:input_type -> group.UpdateGroupRequest
+	23,  // 142: group.GroupsService.DeleteGroup:input_type -> group.DeleteGroupRequest
+	35,  // 143: group.GroupsService.ArchiveGroup:input_type -> group.ArchiveGroupRequest
+	37,  // 144: group.GroupsService.RestoreGroup:input_type -> group.RestoreGroupRequest
+	25,  // 145: group.GroupsService.CreateTerm:input_type -> group.CreateTermRequest
+	27,  // 146: group.GroupsService.ListTerms:input_type -> group.ListTermsRequest
+	29,  // 147: group.GroupsService.GetTerm:input_type -> group.GetTermRequest
+	30,  // 148: group.GroupsService.UpdateTerm:input_type -> group.UpdateTermRequest
+	31,  // 149: group.GroupsService.DeleteTerm:input_type -> group.DeleteTermRequest
+	33,  // 150: group.GroupsService.SetGroupTerm:input_type -> group.SetGroupTermRequest
+	39,  // 151: group.GroupsService.ListGroupMembers:input_type -> group.ListGroupMembersRequest
+	41,  // 152: group.GroupsService.AddGroupMembers:input_type -> group.AddGroupMembersRequest
+	44,  // 153: group.GroupsService.RemoveGroupMembers:input_type -> group.RemoveGroupMembersRequest
+	47,  // 154: group.GroupsService.ListGroupWaitlist:input_type -> group.ListGroupWaitlistRequest
+	49,  // 155: group.GroupsService.RemoveFromGroupWaitlist:input_type -> group.RemoveFromGroupWaitlistRequest
+	53,  // 156: group.GroupsService.ImportGroupRoster:input_type -> group.ImportGroupRosterRequest
+	54,  // 157: group.GroupsService.GetGroupRosterImport:input_type -> group.GetGroupRosterImportRequest
+	54,  // 158: group.GroupsService.GetGroupRosterImportReport:input_type -> group.GetGroupRosterImportRequest
+	58,  // 159: group.GroupsService.CreateGroupInvitation:input_type -> group.CreateGroupInvitationRequest
+	60,  // 160: group.GroupsService.ListGroupInvitations:input_type -> group.ListGroupInvitationsRequest
+	62,  // 161: group.GroupsService.RevokeGroupInvitation:input_type -> group.RevokeGroupInvitationRequest
+	64,  // 162: group.GroupsService.JoinGroup:input_type -> group.JoinGroupRequest
+	67,  // 163: group.GroupsService.RequestToJoinGroup:input_type -> group.RequestToJoinGroupRequest
+	69,  // 164: group.GroupsService.ListJoinRequests:input_type -> group.ListJoinRequestsRequest
+	71,  // 165: group.GroupsService.ApproveJoinRequest:input_type -> group.ApproveJoinRequestRequest
+	72,  // 166: group.GroupsService.RejectJoinRequest:input_type -> group.RejectJoinRequestRequest
+	74,  // 167: group.GroupsService.AddGroupStaff:input_type -> group.AddGroupStaffRequest
+	76,  // 168: group.GroupsService.ListGroupStaff:input_type -> group.ListGroupStaffRequest
+	78,  // 169: group.GroupsService.UpdateGroupStaffRole:input_type -> group.UpdateGroupStaffRoleRequest
+	79,  // 170: group.GroupsService.RemoveGroupStaff:input_type -> group.RemoveGroupStaffRequest
+	81,  // 171: group.GroupsService.CheckPermission:input_type -> group.CheckPermissionRequest
+	83,  // 172: group.GroupsService.HasSharedGroup:input_type -> group.HasSharedGroupRequest
+	86,  // 173: group.GroupsService.TransferGroupOwnership:input_type -> group.TransferGroupOwnershipRequest
+	88,  // 174: group.GroupsService.GetGroupOwnershipTransfer:input_type -> group.GetGroupOwnershipTransferRequest
+	89,  // 175: group.GroupsService.AcceptGroupOwnershipTransfer:input_type -> group.AcceptGroupOwnershipTransferRequest
+	91,  // 176: group.GroupsService.CancelGroupOwnershipTransfer:input_type -> group.CancelGroupOwnershipTransferRequest
+	95,  // 177: group.GroupsService.CreateGroupAnnouncement:input_type -> group.CreateGroupAnnouncementRequest
+	96,  // 178: group.GroupsService.ListGroupAnnouncements:input_type -> group.ListGroupAnnouncementsRequest
+	98,  // 179: group.GroupsService.GetGroupAnnouncement:input_type -> group.GetGroupAnnouncementRequest
+	99,  // 180: group.GroupsService.UpdateGroupAnnouncement:input_type -> group.UpdateGroupAnnouncementRequest
+	100, // 181: group.GroupsService.DeleteGroupAnnouncement:input_type -> group.DeleteGroupAnnouncementRequest
+	102, // 182: group.GroupsService.MarkGroupAnnouncementRead:input_type -> group.MarkGroupAnnouncementReadRequest
+	106, // 183: group.GroupsService.SendGroupMessage:input_type -> group.SendGroupMessageRequest
+	107, // 184: group.GroupsService.ListGroupMessages:input_type -> group.ListGroupMessagesRequest
+	109, // 185: group.GroupsService.EditGroupMessage:input_type -> group.EditGroupMessageRequest
+	110, // 186: group.GroupsService.DeleteGroupMessage:input_type -> group.DeleteGroupMessageRequest
+	112, // 187: group.GroupsService.SubscribeGroupMessages:input_type -> group.SubscribeGroupMessagesRequest
+	117, // 188: group.GroupsService.StartConversation:input_type -> group.StartConversationRequest
+	119, // 189: group.GroupsService.ListConversations:input_type -> group.ListConversationsRequest
+	121, // 190: group.GroupsService.SendDirectMessage:input_type -> group.SendDirectMessageRequest
+	123, // 191: group.GroupsService.ListDirectMessages:input_type -> group.ListDirectMessagesRequest
+	125, // 192: group.GroupsService.MarkConversationRead:input_type -> group.MarkConversationReadRequest
+	127, // 193: group.GroupsService.SearchDirectMessages:input_type -> group.SearchDirectMessagesRequest
+	128, // 194: group.GroupsService.ReportConversation:input_type -> group.ReportConversationRequest
+	131, // 195: group.GroupsService.BlockUser:input_type -> group.BlockUserRequest
+	133, // 196: group.GroupsService.UnblockUser:input_type -> group.UnblockUserRequest
+	135, // 197: group.GroupsService.ListBlockedUsers:input_type -> group.ListBlockedUsersRequest
+	16,  // 198: group.GroupsService.CreateGroup:output_type -> group.CreateGroupResponse
+	18,  // 199: group.GroupsService.ListGroups:output_type -> group.ListGroupsResponse
+	20,  // 200: group.GroupsService.GetGroup:output_type -> group.GetGroupResponse
+	22,  // 201: group.GroupsService.UpdateGroup:output_type -> group.UpdateGroupResponse
+	24,  // 202: group.GroupsService.DeleteGroup:output_type -> group.DeleteGroupResponse
+	36,  // 203: group.GroupsService.ArchiveGroup:output_type -> group.ArchiveGroupResponse
+	38,  // 204: group.GroupsService.RestoreGroup:output_type -> group.RestoreGroupResponse
+	26,  // 205: group.GroupsService.CreateTerm:output_type -> group.TermResponse
+	28,  // 206: group.GroupsService.ListTerms:output_type -> group.ListTermsResponse
+	26,  // 207: group.GroupsService.GetTerm:output_type -> group.TermResponse
+	26,  // 208: group.GroupsService.UpdateTerm:output_type -> group.TermResponse
+	32,  // 209: group.GroupsService.DeleteTerm:output_type -> group.DeleteTermResponse
+	34,  // 210: group.GroupsService.SetGroupTerm:output_type -> group.SetGroupTermResponse
+	40,  // 211: group.GroupsService.ListGroupMembers:output_type -> group.ListGroupMembersResponse
+	43,  // 212: group.GroupsService.AddGroupMembers:output_type -> group.AddGroupMembersResponse
+	45,  // 213: group.GroupsService.RemoveGroupMembers:output_type -> group.RemoveGroupMembersResponse
+	48,  // 214: group.GroupsService.ListGroupWaitlist:output_type -> group.ListGroupWaitlistResponse
+	50,  // 215: group.GroupsService.RemoveFromGroupWaitlist:output_type -> group.RemoveFromGroupWaitlistResponse
+	55,  // 216: group.GroupsService.ImportGroupRoster:output_type -> group.RosterImportResponse
+	55,  // 217: group.GroupsService.GetGroupRosterImport:output_type -> group.RosterImportResponse
+	56,  // 218: group.GroupsService.GetGroupRosterImportReport:output_type -> group.GetGroupRosterImportReportResponse
+	59,  // 219: group.GroupsService.CreateGroupInvitation:output_type -> group.CreateGroupInvitationResponse
+	61,  // 220: group.GroupsService.ListGroupInvitations:output_type -> group.ListGroupInvitationsResponse
+	63,  // 221: group.GroupsService.RevokeGroupInvitation:output_type -> group.RevokeGroupInvitationResponse
+	65,  // 222: group.GroupsService.JoinGroup:output_type -> group.JoinGroupResponse
+	68,  // 223: group.GroupsService.RequestToJoinGroup:output_type -> group.JoinRequestResponse
+	70,  // 224: group.GroupsService.ListJoinRequests:output_type -> group.ListJoinRequestsResponse
+	68,  // 225: group.GroupsService.ApproveJoinRequest:output_type -> group.JoinRequestResponse
+	68,  // 226: group.GroupsService.RejectJoinRequest:output_type -> group.JoinRequestResponse
+	75,  // 227: group.GroupsService.AddGroupStaff:output_type -> group.GroupStaffResponse
+	77,  // 228: group.GroupsService.ListGroupStaff:output_type -> group.ListGroupStaffResponse
+	75,  // 229: group.GroupsService.UpdateGroupStaffRole:output_type -> group.GroupStaffResponse
+	80,  // 230: group.GroupsService.RemoveGroupStaff:output_type -> group.RemoveGroupStaffResponse
+	82,  // 231: group.GroupsService.CheckPermission:output_type -> group.CheckPermissionResponse
+	84,  // 232: group.GroupsService.HasSharedGroup:output_type -> group.HasSharedGroupResponse
+	87,  // 233: group.GroupsService.TransferGroupOwnership:output_type -> group.OwnershipTransferResponse
+	87,  // 234: group.GroupsService.GetGroupOwnershipTransfer:output_type -> group.OwnershipTransferResponse
+	90,  // 235: group.GroupsService.AcceptGroupOwnershipTransfer:output_type -> group.AcceptGroupOwnershipTransferResponse
+	92,  // 236: group.GroupsService.CancelGroupOwnershipTransfer:output_type -> group.CancelGroupOwnershipTransferResponse
+	94,  // 237: group.GroupsService.CreateGroupAnnouncement:output_type -> group.AnnouncementResponse
+	97,  // 238: group.GroupsService.ListGroupAnnouncements:output_type -> group.ListGroupAnnouncementsResponse
+	94,  // 239: group.GroupsService.GetGroupAnnouncement:output_type -> group.AnnouncementResponse
+	94,  // 240: group.GroupsService.UpdateGroupAnnouncement:output_type -> group.AnnouncementResponse
+	101, // 241: group.GroupsService.DeleteGroupAnnouncement:output_type -> group.DeleteGroupAnnouncementResponse
+	103, // 242: group.GroupsService.MarkGroupAnnouncementRead:output_type -> group.MarkGroupAnnouncementReadResponse
+	105, // 243: group.GroupsService.SendGroupMessage:output_type -> group.GroupMessageResponse
+	108, // 244: group.GroupsService.ListGroupMessages:output_type -> group.ListGroupMessagesResponse
+	105, // 245: group.GroupsService.EditGroupMessage:output_type -> group.GroupMessageResponse
+	111, // 246: group.GroupsService.DeleteGroupMessage:output_type -> group.DeleteGroupMessageResponse
+	113, // 247: group.GroupsService.SubscribeGroupMessages:output_type -> group.GroupMessageEvent
+	118, // 248: group.GroupsService.StartConversation:output_type -> group.ConversationResponse
+	120, // 249: group.GroupsService.ListConversations:output_type -> group.ListConversationsResponse
+	122, // 250: group.GroupsService.SendDirectMessage:output_type -> group.DirectMessageResponse
+	124, // 251: group.GroupsService.ListDirectMessages:output_type -> group.ListDirectMessagesResponse
+	126, // 252: group.GroupsService.MarkConversationRead:output_type -> group.MarkConversationReadResponse
+	124, // 253: group.GroupsService.SearchDirectMessages:output_type -> group.ListDirectMessagesResponse
+	129, // 254: group.GroupsService.ReportConversation:output_type -> group.ReportConversationResponse
+	132, // 255: group.GroupsService.BlockUser:output_type -> group.BlockUserResponse
+	134, // 256: group.GroupsService.UnblockUser:output_type -> group.UnblockUserResponse
+	136, // 257: group.GroupsService.ListBlockedUsers:output_type -> group.ListBlockedUsersResponse
+	198, // [198:258] is the sub-list for method output_type
+	138, // [138:198] is the sub-list for method input_type
+	138, // [138:138] is the sub-list for extension type_name
+	138, // [138:138] is the sub-list for extension extendee
+	0,   // [0:138] is the sub-list for field type_name
 }
 
 func init() { file_group_group_service_proto_init() }
@@ -1352,35 +9708,98 @@ func file_group_group_service_proto_init() {
 	if File_group_group_service_proto != nil {
 		return
 	}
-	file_group_group_service_proto_msgTypes[4].OneofWrappers = []any{
+	file_group_group_service_proto_msgTypes[5].OneofWrappers = []any{
 		(*CreateGroupResponse_Group)(nil),
 		(*CreateGroupResponse_Error)(nil),
 	}
-	file_group_group_service_proto_msgTypes[5].OneofWrappers = []any{
+	file_group_group_service_proto_msgTypes[6].OneofWrappers = []any{
 		(*ListGroupsRequest_TutorId)(nil),
 		(*ListGroupsRequest_StudentId)(nil),
 	}
-	file_group_group_service_proto_msgTypes[8].OneofWrappers = []any{
+	file_group_group_service_proto_msgTypes[9].OneofWrappers = []any{
 		(*GetGroupResponse_Group)(nil),
 		(*GetGroupResponse_Error)(nil),
 	}
-	file_group_group_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_group_group_service_proto_msgTypes[10].OneofWrappers = []any{
+	file_group_group_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_group_group_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*UpdateGroupResponse_Group)(nil),
 		(*UpdateGroupResponse_Error)(nil),
 	}
+	file_group_group_service_proto_msgTypes[15].OneofWrappers = []any{
+		(*TermResponse_Term)(nil),
+		(*TermResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_group_group_service_proto_msgTypes[23].OneofWrappers = []any{
+		(*SetGroupTermResponse_Group)(nil),
+		(*SetGroupTermResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[25].OneofWrappers = []any{
+		(*ArchiveGroupResponse_Group)(nil),
+		(*ArchiveGroupResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[27].OneofWrappers = []any{
+		(*RestoreGroupResponse_Group)(nil),
+		(*RestoreGroupResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[44].OneofWrappers = []any{
+		(*RosterImportResponse_RosterImport)(nil),
+		(*RosterImportResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[48].OneofWrappers = []any{
+		(*CreateGroupInvitationResponse_Invitation)(nil),
+		(*CreateGroupInvitationResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[54].OneofWrappers = []any{
+		(*JoinGroupResponse_Group)(nil),
+		(*JoinGroupResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[57].OneofWrappers = []any{
+		(*JoinRequestResponse_Request)(nil),
+		(*JoinRequestResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[64].OneofWrappers = []any{
+		(*GroupStaffResponse_Member)(nil),
+		(*GroupStaffResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[76].OneofWrappers = []any{
+		(*OwnershipTransferResponse_Transfer)(nil),
+		(*OwnershipTransferResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[79].OneofWrappers = []any{
+		(*AcceptGroupOwnershipTransferResponse_Group)(nil),
+		(*AcceptGroupOwnershipTransferResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[83].OneofWrappers = []any{
+		(*AnnouncementResponse_Announcement)(nil),
+		(*AnnouncementResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_group_group_service_proto_msgTypes[94].OneofWrappers = []any{
+		(*GroupMessageResponse_Message)(nil),
+		(*GroupMessageResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[107].OneofWrappers = []any{
+		(*ConversationResponse_Conversation)(nil),
+		(*ConversationResponse_Error)(nil),
+	}
+	file_group_group_service_proto_msgTypes[111].OneofWrappers = []any{
+		(*DirectMessageResponse_Message)(nil),
+		(*DirectMessageResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_group_group_service_proto_rawDesc), len(file_group_group_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      11,
+			NumMessages:   126,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_group_group_service_proto_goTypes,
 		DependencyIndexes: file_group_group_service_proto_depIdxs,
+		EnumInfos:         file_group_group_service_proto_enumTypes,
 		MessageInfos:      file_group_group_service_proto_msgTypes,
 	}.Build()
 	File_group_group_service_proto = out.File
@@ -19,14 +19,66 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GroupsService_CreateGroup_FullMethodName        = "/group.GroupsService/CreateGroup"
-	GroupsService_ListGroups_FullMethodName         = "/group.GroupsService/ListGroups"
-	GroupsService_GetGroup_FullMethodName           = "/group.GroupsService/GetGroup"
-	GroupsService_UpdateGroup_FullMethodName        = "/group.GroupsService/UpdateGroup"
-	GroupsService_DeleteGroup_FullMethodName        = "/group.GroupsService/DeleteGroup"
-	GroupsService_ListGroupMembers_FullMethodName   = "/group.GroupsService/ListGroupMembers"
-	GroupsService_AddGroupMembers_FullMethodName    = "/group.GroupsService/AddGroupMembers"
-	GroupsService_RemoveGroupMembers_FullMethodName = "/group.GroupsService/RemoveGroupMembers"
+	GroupsService_CreateGroup_FullMethodName                  = "/group.GroupsService/CreateGroup"
+	GroupsService_ListGroups_FullMethodName                   = "/group.GroupsService/ListGroups"
+	GroupsService_GetGroup_FullMethodName                     = "/group.GroupsService/GetGroup"
+	GroupsService_UpdateGroup_FullMethodName                  = "/group.GroupsService/UpdateGroup"
+	GroupsService_DeleteGroup_FullMethodName                  = "/group.GroupsService/DeleteGroup"
+	GroupsService_ArchiveGroup_FullMethodName                 = "/group.GroupsService/ArchiveGroup"
+	GroupsService_RestoreGroup_FullMethodName                 = "/group.GroupsService/RestoreGroup"
+	GroupsService_CreateTerm_FullMethodName                   = "/group.GroupsService/CreateTerm"
+	GroupsService_ListTerms_FullMethodName                    = "/group.GroupsService/ListTerms"
+	GroupsService_GetTerm_FullMethodName                      = "/group.GroupsService/GetTerm"
+	GroupsService_UpdateTerm_FullMethodName                   = "/group.GroupsService/UpdateTerm"
+	GroupsService_DeleteTerm_FullMethodName                   = "/group.GroupsService/DeleteTerm"
+	GroupsService_SetGroupTerm_FullMethodName                 = "/group.GroupsService/SetGroupTerm"
+	GroupsService_ListGroupMembers_FullMethodName             = "/group.GroupsService/ListGroupMembers"
+	GroupsService_AddGroupMembers_FullMethodName              = "/group.GroupsService/AddGroupMembers"
+	GroupsService_RemoveGroupMembers_FullMethodName           = "/group.GroupsService/RemoveGroupMembers"
+	GroupsService_ListGroupWaitlist_FullMethodName            = "/group.GroupsService/ListGroupWaitlist"
+	GroupsService_RemoveFromGroupWaitlist_FullMethodName      = "/group.GroupsService/RemoveFromGroupWaitlist"
+	GroupsService_ImportGroupRoster_FullMethodName            = "/group.GroupsService/ImportGroupRoster"
+	GroupsService_GetGroupRosterImport_FullMethodName         = "/group.GroupsService/GetGroupRosterImport"
+	GroupsService_GetGroupRosterImportReport_FullMethodName   = "/group.GroupsService/GetGroupRosterImportReport"
+	GroupsService_CreateGroupInvitation_FullMethodName        = "/group.GroupsService/CreateGroupInvitation"
+	GroupsService_ListGroupInvitations_FullMethodName         = "/group.GroupsService/ListGroupInvitations"
+	GroupsService_RevokeGroupInvitation_FullMethodName        = "/group.GroupsService/RevokeGroupInvitation"
+	GroupsService_JoinGroup_FullMethodName                    = "/group.GroupsService/JoinGroup"
+	GroupsService_RequestToJoinGroup_FullMethodName           = "/group.GroupsService/RequestToJoinGroup"
+	GroupsService_ListJoinRequests_FullMethodName             = "/group.GroupsService/ListJoinRequests"
+	GroupsService_ApproveJoinRequest_FullMethodName           = "/group.GroupsService/ApproveJoinRequest"
+	GroupsService_RejectJoinRequest_FullMethodName            = "/group.GroupsService/RejectJoinRequest"
+	GroupsService_AddGroupStaff_FullMethodName                = "/group.GroupsService/AddGroupStaff"
+	GroupsService_ListGroupStaff_FullMethodName               = "/group.GroupsService/ListGroupStaff"
+	GroupsService_UpdateGroupStaffRole_FullMethodName         = "/group.GroupsService/UpdateGroupStaffRole"
+	GroupsService_RemoveGroupStaff_FullMethodName             = "/group.GroupsService/RemoveGroupStaff"
+	GroupsService_CheckPermission_FullMethodName              = "/group.GroupsService/CheckPermission"
+	GroupsService_HasSharedGroup_FullMethodName               = "/group.GroupsService/HasSharedGroup"
+	GroupsService_TransferGroupOwnership_FullMethodName       = "/group.GroupsService/TransferGroupOwnership"
+	GroupsService_GetGroupOwnershipTransfer_FullMethodName    = "/group.GroupsService/GetGroupOwnershipTransfer"
+	GroupsService_AcceptGroupOwnershipTransfer_FullMethodName = "/group.GroupsService/AcceptGroupOwnershipTransfer"
+	GroupsService_CancelGroupOwnershipTransfer_FullMethodName = "/group.GroupsService/CancelGroupOwnershipTransfer"
+	GroupsService_CreateGroupAnnouncement_FullMethodName      = "/group.GroupsService/CreateGroupAnnouncement"
+	GroupsService_ListGroupAnnouncements_FullMethodName       = "/group.GroupsService/ListGroupAnnouncements"
+	GroupsService_GetGroupAnnouncement_FullMethodName         = "/group.GroupsService/GetGroupAnnouncement"
+	GroupsService_UpdateGroupAnnouncement_FullMethodName      = "/group.GroupsService/UpdateGroupAnnouncement"
+	GroupsService_DeleteGroupAnnouncement_FullMethodName      = "/group.GroupsService/DeleteGroupAnnouncement"
+	GroupsService_MarkGroupAnnouncementRead_FullMethodName    = "/group.GroupsService/MarkGroupAnnouncementRead"
+	GroupsService_SendGroupMessage_FullMethodName             = "/group.GroupsService/SendGroupMessage"
+	GroupsService_ListGroupMessages_FullMethodName            = "/group.GroupsService/ListGroupMessages"
+	GroupsService_EditGroupMessage_FullMethodName             = "/group.GroupsService/EditGroupMessage"
+	GroupsService_DeleteGroupMessage_FullMethodName           = "/group.GroupsService/DeleteGroupMessage"
+	GroupsService_SubscribeGroupMessages_FullMethodName       = "/group.GroupsService/SubscribeGroupMessages"
+	GroupsService_StartConversation_FullMethodName            = "/group.GroupsService/StartConversation"
+	GroupsService_ListConversations_FullMethodName            = "/group.GroupsService/ListConversations"
+	GroupsService_SendDirectMessage_FullMethodName            = "/group.GroupsService/SendDirectMessage"
+	GroupsService_ListDirectMessages_FullMethodName           = "/group.GroupsService/ListDirectMessages"
+	GroupsService_MarkConversationRead_FullMethodName         = "/group.GroupsService/MarkConversationRead"
+	GroupsService_SearchDirectMessages_FullMethodName         = "/group.GroupsService/SearchDirectMessages"
+	GroupsService_ReportConversation_FullMethodName           = "/group.GroupsService/ReportConversation"
+	GroupsService_BlockUser_FullMethodName                    = "/group.GroupsService/BlockUser"
+	GroupsService_UnblockUser_FullMethodName                  = "/group.GroupsService/UnblockUser"
+	GroupsService_ListBlockedUsers_FullMethodName             = "/group.GroupsService/ListBlockedUsers"
 )
 
 // GroupsServiceClient is the client API for GroupsService service.
@@ -37,10 +89,81 @@ type GroupsServiceClient interface {
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+	// Окончательное удаление - только архивной группы после срока хранения
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	// Архивная группа доступна только для чтения и скрыта из списков
+	ArchiveGroup(ctx context.Context, in *ArchiveGroupRequest, opts ...grpc.CallOption) (*ArchiveGroupResponse, error)
+	RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...grpc.CallOption) (*RestoreGroupResponse, error)
+	// Учебные периоды репетитора: группы периода архивируются после его окончания
+	CreateTerm(ctx context.Context, in *CreateTermRequest, opts ...grpc.CallOption) (*TermResponse, error)
+	ListTerms(ctx context.Context, in *ListTermsRequest, opts ...grpc.CallOption) (*ListTermsResponse, error)
+	GetTerm(ctx context.Context, in *GetTermRequest, opts ...grpc.CallOption) (*TermResponse, error)
+	UpdateTerm(ctx context.Context, in *UpdateTermRequest, opts ...grpc.CallOption) (*TermResponse, error)
+	DeleteTerm(ctx context.Context, in *DeleteTermRequest, opts ...grpc.CallOption) (*DeleteTermResponse, error)
+	// Пустой term_id отвязывает группу от периода
+	SetGroupTerm(ctx context.Context, in *SetGroupTermRequest, opts ...grpc.CallOption) (*SetGroupTermResponse, error)
 	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
 	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
 	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+	// Очередь заполненной группы
+	ListGroupWaitlist(ctx context.Context, in *ListGroupWaitlistRequest, opts ...grpc.CallOption) (*ListGroupWaitlistResponse, error)
+	RemoveFromGroupWaitlist(ctx context.Context, in *RemoveFromGroupWaitlistRequest, opts ...grpc.CallOption) (*RemoveFromGroupWaitlistResponse, error)
+	// Импорт списка учеников из CSV, обрабатывается в фоне
+	ImportGroupRoster(ctx context.Context, in *ImportGroupRosterRequest, opts ...grpc.CallOption) (*RosterImportResponse, error)
+	GetGroupRosterImport(ctx context.Context, in *GetGroupRosterImportRequest, opts ...grpc.CallOption) (*RosterImportResponse, error)
+	GetGroupRosterImportReport(ctx context.Context, in *GetGroupRosterImportRequest, opts ...grpc.CallOption) (*GetGroupRosterImportReportResponse, error)
+	// Приглашения: код или ссылка с ограничением по сроку и числу использований
+	CreateGroupInvitation(ctx context.Context, in *CreateGroupInvitationRequest, opts ...grpc.CallOption) (*CreateGroupInvitationResponse, error)
+	ListGroupInvitations(ctx context.Context, in *ListGroupInvitationsRequest, opts ...grpc.CallOption) (*ListGroupInvitationsResponse, error)
+	RevokeGroupInvitation(ctx context.Context, in *RevokeGroupInvitationRequest, opts ...grpc.CallOption) (*RevokeGroupInvitationResponse, error)
+	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error)
+	// Заявки на вступление: в открытую группу ученик попадает сразу,
+	// в группу с заявками - после одобрения репетитором
+	RequestToJoinGroup(ctx context.Context, in *RequestToJoinGroupRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error)
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error)
+	RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error)
+	// Персонал группы: владелец, соведущие и ассистенты
+	AddGroupStaff(ctx context.Context, in *AddGroupStaffRequest, opts ...grpc.CallOption) (*GroupStaffResponse, error)
+	ListGroupStaff(ctx context.Context, in *ListGroupStaffRequest, opts ...grpc.CallOption) (*ListGroupStaffResponse, error)
+	UpdateGroupStaffRole(ctx context.Context, in *UpdateGroupStaffRoleRequest, opts ...grpc.CallOption) (*GroupStaffResponse, error)
+	RemoveGroupStaff(ctx context.Context, in *RemoveGroupStaffRequest, opts ...grpc.CallOption) (*RemoveGroupStaffResponse, error)
+	// Внутренняя проверка прав для других сервисов, через gateway не публикуется
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// Внутренняя проверка для отзывов: учился ли ученик у репетитора в какой-либо группе
+	HasSharedGroup(ctx context.Context, in *HasSharedGroupRequest, opts ...grpc.CallOption) (*HasSharedGroupResponse, error)
+	// Передача владения: владелец предлагает группу репетитору, тот принимает или отказывается
+	TransferGroupOwnership(ctx context.Context, in *TransferGroupOwnershipRequest, opts ...grpc.CallOption) (*OwnershipTransferResponse, error)
+	GetGroupOwnershipTransfer(ctx context.Context, in *GetGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*OwnershipTransferResponse, error)
+	AcceptGroupOwnershipTransfer(ctx context.Context, in *AcceptGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptGroupOwnershipTransferResponse, error)
+	CancelGroupOwnershipTransfer(ctx context.Context, in *CancelGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*CancelGroupOwnershipTransferResponse, error)
+	// Объявления группы: публикует персонал, читают участники
+	CreateGroupAnnouncement(ctx context.Context, in *CreateGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error)
+	ListGroupAnnouncements(ctx context.Context, in *ListGroupAnnouncementsRequest, opts ...grpc.CallOption) (*ListGroupAnnouncementsResponse, error)
+	GetGroupAnnouncement(ctx context.Context, in *GetGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error)
+	UpdateGroupAnnouncement(ctx context.Context, in *UpdateGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error)
+	DeleteGroupAnnouncement(ctx context.Context, in *DeleteGroupAnnouncementRequest, opts ...grpc.CallOption) (*DeleteGroupAnnouncementResponse, error)
+	MarkGroupAnnouncementRead(ctx context.Context, in *MarkGroupAnnouncementReadRequest, opts ...grpc.CallOption) (*MarkGroupAnnouncementReadResponse, error)
+	// Чат группы: пишут и читают участники и персонал
+	SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, opts ...grpc.CallOption) (*GroupMessageResponse, error)
+	ListGroupMessages(ctx context.Context, in *ListGroupMessagesRequest, opts ...grpc.CallOption) (*ListGroupMessagesResponse, error)
+	EditGroupMessage(ctx context.Context, in *EditGroupMessageRequest, opts ...grpc.CallOption) (*GroupMessageResponse, error)
+	DeleteGroupMessage(ctx context.Context, in *DeleteGroupMessageRequest, opts ...grpc.CallOption) (*DeleteGroupMessageResponse, error)
+	// Поток событий чата. Браузеры получают его через SSE в api-gateway:
+	// GET /v1/groups/{group_id}/messages:stream. Поток завершается, если пользователь
+	// потерял доступ к чату или группа архивирована.
+	SubscribeGroupMessages(ctx context.Context, in *SubscribeGroupMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GroupMessageEvent], error)
+	// Личная переписка персонала группы с учениками
+	StartConversation(ctx context.Context, in *StartConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*DirectMessageResponse, error)
+	ListDirectMessages(ctx context.Context, in *ListDirectMessagesRequest, opts ...grpc.CallOption) (*ListDirectMessagesResponse, error)
+	MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadResponse, error)
+	SearchDirectMessages(ctx context.Context, in *SearchDirectMessagesRequest, opts ...grpc.CallOption) (*ListDirectMessagesResponse, error)
+	ReportConversation(ctx context.Context, in *ReportConversationRequest, opts ...grpc.CallOption) (*ReportConversationResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
 }
 
 type groupsServiceClient struct {
@@ -101,6 +224,86 @@ func (c *groupsServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRe
 	return out, nil
 }
 
+func (c *groupsServiceClient) ArchiveGroup(ctx context.Context, in *ArchiveGroupRequest, opts ...grpc.CallOption) (*ArchiveGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ArchiveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...grpc.CallOption) (*RestoreGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RestoreGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CreateTerm(ctx context.Context, in *CreateTermRequest, opts ...grpc.CallOption) (*TermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CreateTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListTerms(ctx context.Context, in *ListTermsRequest, opts ...grpc.CallOption) (*ListTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTermsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) GetTerm(ctx context.Context, in *GetTermRequest, opts ...grpc.CallOption) (*TermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermResponse)
+	err := c.cc.Invoke(ctx, GroupsService_GetTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) UpdateTerm(ctx context.Context, in *UpdateTermRequest, opts ...grpc.CallOption) (*TermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermResponse)
+	err := c.cc.Invoke(ctx, GroupsService_UpdateTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) DeleteTerm(ctx context.Context, in *DeleteTermRequest, opts ...grpc.CallOption) (*DeleteTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTermResponse)
+	err := c.cc.Invoke(ctx, GroupsService_DeleteTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SetGroupTerm(ctx context.Context, in *SetGroupTermRequest, opts ...grpc.CallOption) (*SetGroupTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupTermResponse)
+	err := c.cc.Invoke(ctx, GroupsService_SetGroupTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupsServiceClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGroupMembersResponse)
@@ -111,233 +314,1838 @@ func (c *groupsServiceClient) ListGroupMembers(ctx context.Context, in *ListGrou
 	return out, nil
 }
 
-func (c *groupsServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AddGroupMembersResponse)
-	err := c.cc.Invoke(ctx, GroupsService_AddGroupMembers_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func (c *groupsServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupMembersResponse)
+	err := c.cc.Invoke(ctx, GroupsService_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMembersResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupWaitlist(ctx context.Context, in *ListGroupWaitlistRequest, opts ...grpc.CallOption) (*ListGroupWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupWaitlistResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RemoveFromGroupWaitlist(ctx context.Context, in *RemoveFromGroupWaitlistRequest, opts ...grpc.CallOption) (*RemoveFromGroupWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromGroupWaitlistResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RemoveFromGroupWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ImportGroupRoster(ctx context.Context, in *ImportGroupRosterRequest, opts ...grpc.CallOption) (*RosterImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RosterImportResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ImportGroupRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) GetGroupRosterImport(ctx context.Context, in *GetGroupRosterImportRequest, opts ...grpc.CallOption) (*RosterImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RosterImportResponse)
+	err := c.cc.Invoke(ctx, GroupsService_GetGroupRosterImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) GetGroupRosterImportReport(ctx context.Context, in *GetGroupRosterImportRequest, opts ...grpc.CallOption) (*GetGroupRosterImportReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupRosterImportReportResponse)
+	err := c.cc.Invoke(ctx, GroupsService_GetGroupRosterImportReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CreateGroupInvitation(ctx context.Context, in *CreateGroupInvitationRequest, opts ...grpc.CallOption) (*CreateGroupInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupInvitationResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CreateGroupInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupInvitations(ctx context.Context, in *ListGroupInvitationsRequest, opts ...grpc.CallOption) (*ListGroupInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupInvitationsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RevokeGroupInvitation(ctx context.Context, in *RevokeGroupInvitationRequest, opts ...grpc.CallOption) (*RevokeGroupInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeGroupInvitationResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RevokeGroupInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_JoinGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RequestToJoinGroup(ctx context.Context, in *RequestToJoinGroupRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RequestToJoinGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RejectJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) AddGroupStaff(ctx context.Context, in *AddGroupStaffRequest, opts ...grpc.CallOption) (*GroupStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupStaffResponse)
+	err := c.cc.Invoke(ctx, GroupsService_AddGroupStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupStaff(ctx context.Context, in *ListGroupStaffRequest, opts ...grpc.CallOption) (*ListGroupStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupStaffResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) UpdateGroupStaffRole(ctx context.Context, in *UpdateGroupStaffRoleRequest, opts ...grpc.CallOption) (*GroupStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupStaffResponse)
+	err := c.cc.Invoke(ctx, GroupsService_UpdateGroupStaffRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RemoveGroupStaff(ctx context.Context, in *RemoveGroupStaffRequest, opts ...grpc.CallOption) (*RemoveGroupStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupStaffResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RemoveGroupStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) HasSharedGroup(ctx context.Context, in *HasSharedGroupRequest, opts ...grpc.CallOption) (*HasSharedGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasSharedGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_HasSharedGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) TransferGroupOwnership(ctx context.Context, in *TransferGroupOwnershipRequest, opts ...grpc.CallOption) (*OwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, GroupsService_TransferGroupOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) GetGroupOwnershipTransfer(ctx context.Context, in *GetGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*OwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, GroupsService_GetGroupOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) AcceptGroupOwnershipTransfer(ctx context.Context, in *AcceptGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptGroupOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptGroupOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, GroupsService_AcceptGroupOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CancelGroupOwnershipTransfer(ctx context.Context, in *CancelGroupOwnershipTransferRequest, opts ...grpc.CallOption) (*CancelGroupOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGroupOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CancelGroupOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CreateGroupAnnouncement(ctx context.Context, in *CreateGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnouncementResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CreateGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupAnnouncements(ctx context.Context, in *ListGroupAnnouncementsRequest, opts ...grpc.CallOption) (*ListGroupAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) GetGroupAnnouncement(ctx context.Context, in *GetGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnouncementResponse)
+	err := c.cc.Invoke(ctx, GroupsService_GetGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) UpdateGroupAnnouncement(ctx context.Context, in *UpdateGroupAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnouncementResponse)
+	err := c.cc.Invoke(ctx, GroupsService_UpdateGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) DeleteGroupAnnouncement(ctx context.Context, in *DeleteGroupAnnouncementRequest, opts ...grpc.CallOption) (*DeleteGroupAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupAnnouncementResponse)
+	err := c.cc.Invoke(ctx, GroupsService_DeleteGroupAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) MarkGroupAnnouncementRead(ctx context.Context, in *MarkGroupAnnouncementReadRequest, opts ...grpc.CallOption) (*MarkGroupAnnouncementReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkGroupAnnouncementReadResponse)
+	err := c.cc.Invoke(ctx, GroupsService_MarkGroupAnnouncementRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SendGroupMessage(ctx context.Context, in *SendGroupMessageRequest, opts ...grpc.CallOption) (*GroupMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMessageResponse)
+	err := c.cc.Invoke(ctx, GroupsService_SendGroupMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupMessages(ctx context.Context, in *ListGroupMessagesRequest, opts ...grpc.CallOption) (*ListGroupMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupMessagesResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) EditGroupMessage(ctx context.Context, in *EditGroupMessageRequest, opts ...grpc.CallOption) (*GroupMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMessageResponse)
+	err := c.cc.Invoke(ctx, GroupsService_EditGroupMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) DeleteGroupMessage(ctx context.Context, in *DeleteGroupMessageRequest, opts ...grpc.CallOption) (*DeleteGroupMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupMessageResponse)
+	err := c.cc.Invoke(ctx, GroupsService_DeleteGroupMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SubscribeGroupMessages(ctx context.Context, in *SubscribeGroupMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GroupMessageEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroupsService_ServiceDesc.Streams[0], GroupsService_SubscribeGroupMessages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeGroupMessagesRequest, GroupMessageEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupsService_SubscribeGroupMessagesClient = grpc.ServerStreamingClient[GroupMessageEvent]
+
+func (c *groupsServiceClient) StartConversation(ctx context.Context, in *StartConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, GroupsService_StartConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*DirectMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DirectMessageResponse)
+	err := c.cc.Invoke(ctx, GroupsService_SendDirectMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListDirectMessages(ctx context.Context, in *ListDirectMessagesRequest, opts ...grpc.CallOption) (*ListDirectMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectMessagesResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListDirectMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationReadResponse)
+	err := c.cc.Invoke(ctx, GroupsService_MarkConversationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SearchDirectMessages(ctx context.Context, in *SearchDirectMessagesRequest, opts ...grpc.CallOption) (*ListDirectMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectMessagesResponse)
+	err := c.cc.Invoke(ctx, GroupsService_SearchDirectMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ReportConversation(ctx context.Context, in *ReportConversationRequest, opts ...grpc.CallOption) (*ReportConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportConversationResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ReportConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, GroupsService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, GroupsService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupsServiceServer is the server API for GroupsService service.
+// All implementations must embed UnimplementedGroupsServiceServer
+// for forward compatibility.
+type GroupsServiceServer interface {
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	// Окончательное удаление - только архивной группы после срока хранения
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	// Архивная группа доступна только для чтения и скрыта из списков
+	ArchiveGroup(context.Context, *ArchiveGroupRequest) (*ArchiveGroupResponse, error)
+	RestoreGroup(context.Context, *RestoreGroupRequest) (*RestoreGroupResponse, error)
+	// Учебные периоды репетитора: группы периода архивируются после его окончания
+	CreateTerm(context.Context, *CreateTermRequest) (*TermResponse, error)
+	ListTerms(context.Context, *ListTermsRequest) (*ListTermsResponse, error)
+	GetTerm(context.Context, *GetTermRequest) (*TermResponse, error)
+	UpdateTerm(context.Context, *UpdateTermRequest) (*TermResponse, error)
+	DeleteTerm(context.Context, *DeleteTermRequest) (*DeleteTermResponse, error)
+	// Пустой term_id отвязывает группу от периода
+	SetGroupTerm(context.Context, *SetGroupTermRequest) (*SetGroupTermResponse, error)
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
+	// Очередь заполненной группы
+	ListGroupWaitlist(context.Context, *ListGroupWaitlistRequest) (*ListGroupWaitlistResponse, error)
+	RemoveFromGroupWaitlist(context.Context, *RemoveFromGroupWaitlistRequest) (*RemoveFromGroupWaitlistResponse, error)
+	// Импорт списка учеников из CSV, обрабатывается в фоне
+	ImportGroupRoster(context.Context, *ImportGroupRosterRequest) (*RosterImportResponse, error)
+	GetGroupRosterImport(context.Context, *GetGroupRosterImportRequest) (*RosterImportResponse, error)
+	GetGroupRosterImportReport(context.Context, *GetGroupRosterImportRequest) (*GetGroupRosterImportReportResponse, error)
+	// Приглашения: код или ссылка с ограничением по сроку и числу использований
+	CreateGroupInvitation(context.Context, *CreateGroupInvitationRequest) (*CreateGroupInvitationResponse, error)
+	ListGroupInvitations(context.Context, *ListGroupInvitationsRequest) (*ListGroupInvitationsResponse, error)
+	RevokeGroupInvitation(context.Context, *RevokeGroupInvitationRequest) (*RevokeGroupInvitationResponse, error)
+	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error)
+	// Заявки на вступление: в открытую группу ученик попадает сразу,
+	// в группу с заявками - после одобрения репетитором
+	RequestToJoinGroup(context.Context, *RequestToJoinGroupRequest) (*JoinRequestResponse, error)
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*JoinRequestResponse, error)
+	RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*JoinRequestResponse, error)
+	// Персонал группы: владелец, соведущие и ассистенты
+	AddGroupStaff(context.Context, *AddGroupStaffRequest) (*GroupStaffResponse, error)
+	ListGroupStaff(context.Context, *ListGroupStaffRequest) (*ListGroupStaffResponse, error)
+	UpdateGroupStaffRole(context.Context, *UpdateGroupStaffRoleRequest) (*GroupStaffResponse, error)
+	RemoveGroupStaff(context.Context, *RemoveGroupStaffRequest) (*RemoveGroupStaffResponse, error)
+	// Внутренняя проверка прав для других сервисов, через gateway не публикуется
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// Внутренняя проверка для отзывов: учился ли ученик у репетитора в какой-либо группе
+	HasSharedGroup(context.Context, *HasSharedGroupRequest) (*HasSharedGroupResponse, error)
+	// Передача владения: владелец предлагает группу репетитору, тот принимает или отказывается
+	TransferGroupOwnership(context.Context, *TransferGroupOwnershipRequest) (*OwnershipTransferResponse, error)
+	GetGroupOwnershipTransfer(context.Context, *GetGroupOwnershipTransferRequest) (*OwnershipTransferResponse, error)
+	AcceptGroupOwnershipTransfer(context.Context, *AcceptGroupOwnershipTransferRequest) (*AcceptGroupOwnershipTransferResponse, error)
+	CancelGroupOwnershipTransfer(context.Context, *CancelGroupOwnershipTransferRequest) (*CancelGroupOwnershipTransferResponse, error)
+	// Объявления группы: публикует персонал, читают участники
+	CreateGroupAnnouncement(context.Context, *CreateGroupAnnouncementRequest) (*AnnouncementResponse, error)
+	ListGroupAnnouncements(context.Context, *ListGroupAnnouncementsRequest) (*ListGroupAnnouncementsResponse, error)
+	GetGroupAnnouncement(context.Context, *GetGroupAnnouncementRequest) (*AnnouncementResponse, error)
+	UpdateGroupAnnouncement(context.Context, *UpdateGroupAnnouncementRequest) (*AnnouncementResponse, error)
+	DeleteGroupAnnouncement(context.Context, *DeleteGroupAnnouncementRequest) (*DeleteGroupAnnouncementResponse, error)
+	MarkGroupAnnouncementRead(context.Context, *MarkGroupAnnouncementReadRequest) (*MarkGroupAnnouncementReadResponse, error)
+	// Чат группы: пишут и читают участники и персонал
+	SendGroupMessage(context.Context, *SendGroupMessageRequest) (*GroupMessageResponse, error)
+	ListGroupMessages(context.Context, *ListGroupMessagesRequest) (*ListGroupMessagesResponse, error)
+	EditGroupMessage(context.Context, *EditGroupMessageRequest) (*GroupMessageResponse, error)
+	DeleteGroupMessage(context.Context, *DeleteGroupMessageRequest) (*DeleteGroupMessageResponse, error)
+	// Поток событий чата. Браузеры получают его через SSE в api-gateway:
+	// GET /v1/groups/{group_id}/messages:stream. Поток завершается, если пользователь
+	// потерял доступ к чату или группа архивирована.
+	SubscribeGroupMessages(*SubscribeGroupMessagesRequest, grpc.ServerStreamingServer[GroupMessageEvent]) error
+	// Личная переписка персонала группы с учениками
+	StartConversation(context.Context, *StartConversationRequest) (*ConversationResponse, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	SendDirectMessage(context.Context, *SendDirectMessageRequest) (*DirectMessageResponse, error)
+	ListDirectMessages(context.Context, *ListDirectMessagesRequest) (*ListDirectMessagesResponse, error)
+	MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadResponse, error)
+	SearchDirectMessages(context.Context, *SearchDirectMessagesRequest) (*ListDirectMessagesResponse, error)
+	ReportConversation(context.Context, *ReportConversationRequest) (*ReportConversationResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	mustEmbedUnimplementedGroupsServiceServer()
+}
+
+// UnimplementedGroupsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupsServiceServer struct{}
+
+func (UnimplementedGroupsServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) ArchiveGroup(context.Context, *ArchiveGroupRequest) (*ArchiveGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) RestoreGroup(context.Context, *RestoreGroupRequest) (*RestoreGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) CreateTerm(context.Context, *CreateTermRequest) (*TermResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTerm not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListTerms(context.Context, *ListTermsRequest) (*ListTermsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTerms not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetTerm(context.Context, *GetTermRequest) (*TermResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTerm not implemented")
+}
+func (UnimplementedGroupsServiceServer) UpdateTerm(context.Context, *UpdateTermRequest) (*TermResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTerm not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteTerm(context.Context, *DeleteTermRequest) (*DeleteTermResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTerm not implemented")
+}
+func (UnimplementedGroupsServiceServer) SetGroupTerm(context.Context, *SetGroupTermRequest) (*SetGroupTermResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupTerm not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedGroupsServiceServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedGroupsServiceServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupWaitlist(context.Context, *ListGroupWaitlistRequest) (*ListGroupWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupWaitlist not implemented")
+}
+func (UnimplementedGroupsServiceServer) RemoveFromGroupWaitlist(context.Context, *RemoveFromGroupWaitlistRequest) (*RemoveFromGroupWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFromGroupWaitlist not implemented")
+}
+func (UnimplementedGroupsServiceServer) ImportGroupRoster(context.Context, *ImportGroupRosterRequest) (*RosterImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportGroupRoster not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetGroupRosterImport(context.Context, *GetGroupRosterImportRequest) (*RosterImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupRosterImport not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetGroupRosterImportReport(context.Context, *GetGroupRosterImportRequest) (*GetGroupRosterImportReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupRosterImportReport not implemented")
+}
+func (UnimplementedGroupsServiceServer) CreateGroupInvitation(context.Context, *CreateGroupInvitationRequest) (*CreateGroupInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroupInvitation not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupInvitations(context.Context, *ListGroupInvitationsRequest) (*ListGroupInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupInvitations not implemented")
+}
+func (UnimplementedGroupsServiceServer) RevokeGroupInvitation(context.Context, *RevokeGroupInvitationRequest) (*RevokeGroupInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeGroupInvitation not implemented")
+}
+func (UnimplementedGroupsServiceServer) JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) RequestToJoinGroup(context.Context, *RequestToJoinGroupRequest) (*JoinRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestToJoinGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedGroupsServiceServer) ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*JoinRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedGroupsServiceServer) RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*JoinRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedGroupsServiceServer) AddGroupStaff(context.Context, *AddGroupStaffRequest) (*GroupStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddGroupStaff not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupStaff(context.Context, *ListGroupStaffRequest) (*ListGroupStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupStaff not implemented")
+}
+func (UnimplementedGroupsServiceServer) UpdateGroupStaffRole(context.Context, *UpdateGroupStaffRoleRequest) (*GroupStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGroupStaffRole not implemented")
+}
+func (UnimplementedGroupsServiceServer) RemoveGroupStaff(context.Context, *RemoveGroupStaffRequest) (*RemoveGroupStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveGroupStaff not implemented")
+}
+func (UnimplementedGroupsServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedGroupsServiceServer) HasSharedGroup(context.Context, *HasSharedGroupRequest) (*HasSharedGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HasSharedGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) TransferGroupOwnership(context.Context, *TransferGroupOwnershipRequest) (*OwnershipTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferGroupOwnership not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetGroupOwnershipTransfer(context.Context, *GetGroupOwnershipTransferRequest) (*OwnershipTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupOwnershipTransfer not implemented")
+}
+func (UnimplementedGroupsServiceServer) AcceptGroupOwnershipTransfer(context.Context, *AcceptGroupOwnershipTransferRequest) (*AcceptGroupOwnershipTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptGroupOwnershipTransfer not implemented")
+}
+func (UnimplementedGroupsServiceServer) CancelGroupOwnershipTransfer(context.Context, *CancelGroupOwnershipTransferRequest) (*CancelGroupOwnershipTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelGroupOwnershipTransfer not implemented")
+}
+func (UnimplementedGroupsServiceServer) CreateGroupAnnouncement(context.Context, *CreateGroupAnnouncementRequest) (*AnnouncementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupAnnouncements(context.Context, *ListGroupAnnouncementsRequest) (*ListGroupAnnouncementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupAnnouncements not implemented")
+}
+func (UnimplementedGroupsServiceServer) GetGroupAnnouncement(context.Context, *GetGroupAnnouncementRequest) (*AnnouncementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupsServiceServer) UpdateGroupAnnouncement(context.Context, *UpdateGroupAnnouncementRequest) (*AnnouncementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteGroupAnnouncement(context.Context, *DeleteGroupAnnouncementRequest) (*DeleteGroupAnnouncementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGroupAnnouncement not implemented")
+}
+func (UnimplementedGroupsServiceServer) MarkGroupAnnouncementRead(context.Context, *MarkGroupAnnouncementReadRequest) (*MarkGroupAnnouncementReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkGroupAnnouncementRead not implemented")
+}
+func (UnimplementedGroupsServiceServer) SendGroupMessage(context.Context, *SendGroupMessageRequest) (*GroupMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendGroupMessage not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupMessages(context.Context, *ListGroupMessagesRequest) (*ListGroupMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupMessages not implemented")
+}
+func (UnimplementedGroupsServiceServer) EditGroupMessage(context.Context, *EditGroupMessageRequest) (*GroupMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EditGroupMessage not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteGroupMessage(context.Context, *DeleteGroupMessageRequest) (*DeleteGroupMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGroupMessage not implemented")
+}
+func (UnimplementedGroupsServiceServer) SubscribeGroupMessages(*SubscribeGroupMessagesRequest, grpc.ServerStreamingServer[GroupMessageEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeGroupMessages not implemented")
+}
+func (UnimplementedGroupsServiceServer) StartConversation(context.Context, *StartConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartConversation not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedGroupsServiceServer) SendDirectMessage(context.Context, *SendDirectMessageRequest) (*DirectMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendDirectMessage not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListDirectMessages(context.Context, *ListDirectMessagesRequest) (*ListDirectMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDirectMessages not implemented")
+}
+func (UnimplementedGroupsServiceServer) MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkConversationRead not implemented")
+}
+func (UnimplementedGroupsServiceServer) SearchDirectMessages(context.Context, *SearchDirectMessagesRequest) (*ListDirectMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchDirectMessages not implemented")
+}
+func (UnimplementedGroupsServiceServer) ReportConversation(context.Context, *ReportConversationRequest) (*ReportConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportConversation not implemented")
+}
+func (UnimplementedGroupsServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedGroupsServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedGroupsServiceServer) mustEmbedUnimplementedGroupsServiceServer() {}
+func (UnimplementedGroupsServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGroupsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupsServiceServer will
+// result in compilation errors.
+type UnsafeGroupsServiceServer interface {
+	mustEmbedUnimplementedGroupsServiceServer()
+}
+
+func RegisterGroupsServiceServer(s grpc.ServiceRegistrar, srv GroupsServiceServer) {
+	// If the following call panics, it indicates UnimplementedGroupsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupsService_ServiceDesc, srv)
+}
+
+func _GroupsService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ArchiveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ArchiveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ArchiveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ArchiveGroup(ctx, req.(*ArchiveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RestoreGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RestoreGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RestoreGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RestoreGroup(ctx, req.(*RestoreGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CreateTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CreateTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CreateTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CreateTerm(ctx, req.(*CreateTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListTerms(ctx, req.(*ListTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetTerm(ctx, req.(*GetTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_UpdateTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).UpdateTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_UpdateTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).UpdateTerm(ctx, req.(*UpdateTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_DeleteTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteTerm(ctx, req.(*DeleteTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_SetGroupTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).SetGroupTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_SetGroupTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).SetGroupTerm(ctx, req.(*SetGroupTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupWaitlist(ctx, req.(*ListGroupWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RemoveFromGroupWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromGroupWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RemoveFromGroupWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RemoveFromGroupWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RemoveFromGroupWaitlist(ctx, req.(*RemoveFromGroupWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ImportGroupRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGroupRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ImportGroupRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ImportGroupRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ImportGroupRoster(ctx, req.(*ImportGroupRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetGroupRosterImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRosterImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetGroupRosterImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetGroupRosterImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetGroupRosterImport(ctx, req.(*GetGroupRosterImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetGroupRosterImportReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRosterImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetGroupRosterImportReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetGroupRosterImportReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetGroupRosterImportReport(ctx, req.(*GetGroupRosterImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CreateGroupInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CreateGroupInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CreateGroupInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CreateGroupInvitation(ctx, req.(*CreateGroupInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupInvitations(ctx, req.(*ListGroupInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RevokeGroupInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RevokeGroupInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RevokeGroupInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RevokeGroupInvitation(ctx, req.(*RevokeGroupInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).JoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_JoinGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).JoinGroup(ctx, req.(*JoinGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RequestToJoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestToJoinGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RequestToJoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RequestToJoinGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RequestToJoinGroup(ctx, req.(*RequestToJoinGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ApproveJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ApproveJoinRequest(ctx, req.(*ApproveJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RejectJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RejectJoinRequest(ctx, req.(*RejectJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_AddGroupStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).AddGroupStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_AddGroupStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).AddGroupStaff(ctx, req.(*AddGroupStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupStaff(ctx, req.(*ListGroupStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_UpdateGroupStaffRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupStaffRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).UpdateGroupStaffRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_UpdateGroupStaffRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).UpdateGroupStaffRole(ctx, req.(*UpdateGroupStaffRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RemoveGroupStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RemoveGroupStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RemoveGroupStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RemoveGroupStaff(ctx, req.(*RemoveGroupStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_HasSharedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasSharedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).HasSharedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_HasSharedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).HasSharedGroup(ctx, req.(*HasSharedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_TransferGroupOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGroupOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).TransferGroupOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_TransferGroupOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).TransferGroupOwnership(ctx, req.(*TransferGroupOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetGroupOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetGroupOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetGroupOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetGroupOwnershipTransfer(ctx, req.(*GetGroupOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_AcceptGroupOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGroupOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).AcceptGroupOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_AcceptGroupOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).AcceptGroupOwnershipTransfer(ctx, req.(*AcceptGroupOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CancelGroupOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGroupOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CancelGroupOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CancelGroupOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CancelGroupOwnershipTransfer(ctx, req.(*CancelGroupOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CreateGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CreateGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CreateGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CreateGroupAnnouncement(ctx, req.(*CreateGroupAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupAnnouncements(ctx, req.(*ListGroupAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_GetGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).GetGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_GetGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).GetGroupAnnouncement(ctx, req.(*GetGroupAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_UpdateGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).UpdateGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_UpdateGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).UpdateGroupAnnouncement(ctx, req.(*UpdateGroupAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_DeleteGroupAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteGroupAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteGroupAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteGroupAnnouncement(ctx, req.(*DeleteGroupAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_MarkGroupAnnouncementRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkGroupAnnouncementReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).MarkGroupAnnouncementRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_MarkGroupAnnouncementRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).MarkGroupAnnouncementRead(ctx, req.(*MarkGroupAnnouncementReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_SendGroupMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).SendGroupMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_SendGroupMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).SendGroupMessage(ctx, req.(*SendGroupMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupMessages(ctx, req.(*ListGroupMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_EditGroupMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGroupMessageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).EditGroupMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_EditGroupMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).EditGroupMessage(ctx, req.(*EditGroupMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *groupsServiceClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RemoveGroupMembersResponse)
-	err := c.cc.Invoke(ctx, GroupsService_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _GroupsService_DeleteGroupMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupMessageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteGroupMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteGroupMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteGroupMessage(ctx, req.(*DeleteGroupMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GroupsServiceServer is the server API for GroupsService service.
-// All implementations must embed UnimplementedGroupsServiceServer
-// for forward compatibility.
-type GroupsServiceServer interface {
-	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
-	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
-	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
-	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
-	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
-	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
-	mustEmbedUnimplementedGroupsServiceServer()
+func _GroupsService_SubscribeGroupMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeGroupMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroupsServiceServer).SubscribeGroupMessages(m, &grpc.GenericServerStream[SubscribeGroupMessagesRequest, GroupMessageEvent]{ServerStream: stream})
 }
 
-// UnimplementedGroupsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGroupsServiceServer struct{}
-
-func (UnimplementedGroupsServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateGroup not implemented")
-}
-func (UnimplementedGroupsServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListGroups not implemented")
-}
-func (UnimplementedGroupsServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetGroup not implemented")
-}
-func (UnimplementedGroupsServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateGroup not implemented")
-}
-func (UnimplementedGroupsServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteGroup not implemented")
-}
-func (UnimplementedGroupsServiceServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListGroupMembers not implemented")
-}
-func (UnimplementedGroupsServiceServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddGroupMembers not implemented")
-}
-func (UnimplementedGroupsServiceServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveGroupMembers not implemented")
-}
-func (UnimplementedGroupsServiceServer) mustEmbedUnimplementedGroupsServiceServer() {}
-func (UnimplementedGroupsServiceServer) testEmbeddedByValue()                       {}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupsService_SubscribeGroupMessagesServer = grpc.ServerStreamingServer[GroupMessageEvent]
 
-// UnsafeGroupsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GroupsServiceServer will
-// result in compilation errors.
-type UnsafeGroupsServiceServer interface {
-	mustEmbedUnimplementedGroupsServiceServer()
+func _GroupsService_StartConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).StartConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_StartConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).StartConversation(ctx, req.(*StartConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGroupsServiceServer(s grpc.ServiceRegistrar, srv GroupsServiceServer) {
-	// If the following call panics, it indicates UnimplementedGroupsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _GroupsService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GroupsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _GroupsService_SendDirectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDirectMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).CreateGroup(ctx, in)
+		return srv.(GroupsServiceServer).SendDirectMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_CreateGroup_FullMethodName,
+		FullMethod: GroupsService_SendDirectMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+		return srv.(GroupsServiceServer).SendDirectMessage(ctx, req.(*SendDirectMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _GroupsService_ListDirectMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).ListGroups(ctx, in)
+		return srv.(GroupsServiceServer).ListDirectMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_ListGroups_FullMethodName,
+		FullMethod: GroupsService_ListDirectMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+		return srv.(GroupsServiceServer).ListDirectMessages(ctx, req.(*ListDirectMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _GroupsService_MarkConversationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).GetGroup(ctx, in)
+		return srv.(GroupsServiceServer).MarkConversationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_GetGroup_FullMethodName,
+		FullMethod: GroupsService_MarkConversationRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+		return srv.(GroupsServiceServer).MarkConversationRead(ctx, req.(*MarkConversationReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupRequest)
+func _GroupsService_SearchDirectMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDirectMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).UpdateGroup(ctx, in)
+		return srv.(GroupsServiceServer).SearchDirectMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_UpdateGroup_FullMethodName,
+		FullMethod: GroupsService_SearchDirectMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+		return srv.(GroupsServiceServer).SearchDirectMessages(ctx, req.(*SearchDirectMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupRequest)
+func _GroupsService_ReportConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).DeleteGroup(ctx, in)
+		return srv.(GroupsServiceServer).ReportConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_DeleteGroup_FullMethodName,
+		FullMethod: GroupsService_ReportConversation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+		return srv.(GroupsServiceServer).ReportConversation(ctx, req.(*ReportConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupMembersRequest)
+func _GroupsService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).ListGroupMembers(ctx, in)
+		return srv.(GroupsServiceServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_ListGroupMembers_FullMethodName,
+		FullMethod: GroupsService_BlockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+		return srv.(GroupsServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddGroupMembersRequest)
+func _GroupsService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).AddGroupMembers(ctx, in)
+		return srv.(GroupsServiceServer).UnblockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_AddGroupMembers_FullMethodName,
+		FullMethod: GroupsService_UnblockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+		return srv.(GroupsServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupsService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveGroupMembersRequest)
+func _GroupsService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServiceServer).RemoveGroupMembers(ctx, in)
+		return srv.(GroupsServiceServer).ListBlockedUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupsService_RemoveGroupMembers_FullMethodName,
+		FullMethod: GroupsService_ListBlockedUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServiceServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+		return srv.(GroupsServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -369,6 +2177,38 @@ var GroupsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGroup",
 			Handler:    _GroupsService_DeleteGroup_Handler,
 		},
+		{
+			MethodName: "ArchiveGroup",
+			Handler:    _GroupsService_ArchiveGroup_Handler,
+		},
+		{
+			MethodName: "RestoreGroup",
+			Handler:    _GroupsService_RestoreGroup_Handler,
+		},
+		{
+			MethodName: "CreateTerm",
+			Handler:    _GroupsService_CreateTerm_Handler,
+		},
+		{
+			MethodName: "ListTerms",
+			Handler:    _GroupsService_ListTerms_Handler,
+		},
+		{
+			MethodName: "GetTerm",
+			Handler:    _GroupsService_GetTerm_Handler,
+		},
+		{
+			MethodName: "UpdateTerm",
+			Handler:    _GroupsService_UpdateTerm_Handler,
+		},
+		{
+			MethodName: "DeleteTerm",
+			Handler:    _GroupsService_DeleteTerm_Handler,
+		},
+		{
+			MethodName: "SetGroupTerm",
+			Handler:    _GroupsService_SetGroupTerm_Handler,
+		},
 		{
 			MethodName: "ListGroupMembers",
 			Handler:    _GroupsService_ListGroupMembers_Handler,
@@ -381,7 +2221,185 @@ var GroupsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveGroupMembers",
 			Handler:    _GroupsService_RemoveGroupMembers_Handler,
 		},
+		{
+			MethodName: "ListGroupWaitlist",
+			Handler:    _GroupsService_ListGroupWaitlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromGroupWaitlist",
+			Handler:    _GroupsService_RemoveFromGroupWaitlist_Handler,
+		},
+		{
+			MethodName: "ImportGroupRoster",
+			Handler:    _GroupsService_ImportGroupRoster_Handler,
+		},
+		{
+			MethodName: "GetGroupRosterImport",
+			Handler:    _GroupsService_GetGroupRosterImport_Handler,
+		},
+		{
+			MethodName: "GetGroupRosterImportReport",
+			Handler:    _GroupsService_GetGroupRosterImportReport_Handler,
+		},
+		{
+			MethodName: "CreateGroupInvitation",
+			Handler:    _GroupsService_CreateGroupInvitation_Handler,
+		},
+		{
+			MethodName: "ListGroupInvitations",
+			Handler:    _GroupsService_ListGroupInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInvitation",
+			Handler:    _GroupsService_RevokeGroupInvitation_Handler,
+		},
+		{
+			MethodName: "JoinGroup",
+			Handler:    _GroupsService_JoinGroup_Handler,
+		},
+		{
+			MethodName: "RequestToJoinGroup",
+			Handler:    _GroupsService_RequestToJoinGroup_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _GroupsService_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _GroupsService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _GroupsService_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "AddGroupStaff",
+			Handler:    _GroupsService_AddGroupStaff_Handler,
+		},
+		{
+			MethodName: "ListGroupStaff",
+			Handler:    _GroupsService_ListGroupStaff_Handler,
+		},
+		{
+			MethodName: "UpdateGroupStaffRole",
+			Handler:    _GroupsService_UpdateGroupStaffRole_Handler,
+		},
+		{
+			MethodName: "RemoveGroupStaff",
+			Handler:    _GroupsService_RemoveGroupStaff_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _GroupsService_CheckPermission_Handler,
+		},
+		{
+			MethodName: "HasSharedGroup",
+			Handler:    _GroupsService_HasSharedGroup_Handler,
+		},
+		{
+			MethodName: "TransferGroupOwnership",
+			Handler:    _GroupsService_TransferGroupOwnership_Handler,
+		},
+		{
+			MethodName: "GetGroupOwnershipTransfer",
+			Handler:    _GroupsService_GetGroupOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptGroupOwnershipTransfer",
+			Handler:    _GroupsService_AcceptGroupOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CancelGroupOwnershipTransfer",
+			Handler:    _GroupsService_CancelGroupOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CreateGroupAnnouncement",
+			Handler:    _GroupsService_CreateGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListGroupAnnouncements",
+			Handler:    _GroupsService_ListGroupAnnouncements_Handler,
+		},
+		{
+			MethodName: "GetGroupAnnouncement",
+			Handler:    _GroupsService_GetGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAnnouncement",
+			Handler:    _GroupsService_UpdateGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeleteGroupAnnouncement",
+			Handler:    _GroupsService_DeleteGroupAnnouncement_Handler,
+		},
+		{
+			MethodName: "MarkGroupAnnouncementRead",
+			Handler:    _GroupsService_MarkGroupAnnouncementRead_Handler,
+		},
+		{
+			MethodName: "SendGroupMessage",
+			Handler:    _GroupsService_SendGroupMessage_Handler,
+		},
+		{
+			MethodName: "ListGroupMessages",
+			Handler:    _GroupsService_ListGroupMessages_Handler,
+		},
+		{
+			MethodName: "EditGroupMessage",
+			Handler:    _GroupsService_EditGroupMessage_Handler,
+		},
+		{
+			MethodName: "DeleteGroupMessage",
+			Handler:    _GroupsService_DeleteGroupMessage_Handler,
+		},
+		{
+			MethodName: "StartConversation",
+			Handler:    _GroupsService_StartConversation_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _GroupsService_ListConversations_Handler,
+		},
+		{
+			MethodName: "SendDirectMessage",
+			Handler:    _GroupsService_SendDirectMessage_Handler,
+		},
+		{
+			MethodName: "ListDirectMessages",
+			Handler:    _GroupsService_ListDirectMessages_Handler,
+		},
+		{
+			MethodName: "MarkConversationRead",
+			Handler:    _GroupsService_MarkConversationRead_Handler,
+		},
+		{
+			MethodName: "SearchDirectMessages",
+			Handler:    _GroupsService_SearchDirectMessages_Handler,
+		},
+		{
+			MethodName: "ReportConversation",
+			Handler:    _GroupsService_ReportConversation_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _GroupsService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _GroupsService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _GroupsService_ListBlockedUsers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeGroupMessages",
+			Handler:       _GroupsService_SubscribeGroupMessages_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "group/group_service.proto",
 }
@@ -121,16 +121,18 @@ func (SubmittedTaskStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type AssignedTask struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	TutorId       string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	MaxScore      int32                  `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	Status        AssignedTaskStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=task.AssignedTaskStatus" json:"status,omitempty"`
-	Deadline      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	GroupId     string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	TutorId     string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	MaxScore    int32                  `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Status      AssignedTaskStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=task.AssignedTaskStatus" json:"status,omitempty"`
+	Deadline    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// дедлайн в часовом поясе запрашивающего пользователя, RFC 3339
+	DeadlineLocal string `protobuf:"bytes,10,opt,name=deadline_local,json=deadlineLocal,proto3" json:"deadline_local,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -228,14 +230,23 @@ func (x *AssignedTask) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AssignedTask) GetDeadlineLocal() string {
+	if x != nil {
+		return x.DeadlineLocal
+	}
+	return ""
+}
+
 type AssignedTaskShort struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	TutorId       string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Status        AssignedTaskStatus     `protobuf:"varint,6,opt,name=status,proto3,enum=task.AssignedTaskStatus" json:"status,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TaskId   string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	GroupId  string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	TutorId  string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Title    string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status   AssignedTaskStatus     `protobuf:"varint,6,opt,name=status,proto3,enum=task.AssignedTaskStatus" json:"status,omitempty"`
+	// дедлайн в часовом поясе запрашивающего пользователя, RFC 3339
+	DeadlineLocal string `protobuf:"bytes,7,opt,name=deadline_local,json=deadlineLocal,proto3" json:"deadline_local,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -312,6 +323,13 @@ func (x *AssignedTaskShort) GetStatus() AssignedTaskStatus {
 	return AssignedTaskStatus_ACTIVE
 }
 
+func (x *AssignedTaskShort) GetDeadlineLocal() string {
+	if x != nil {
+		return x.DeadlineLocal
+	}
+	return ""
+}
+
 type SubmittedTask struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
@@ -1953,11 +1971,191 @@ func (x *GetTaskSubmissionsResponse) GetLimit() int32 {
 	return 0
 }
 
+type StudentTaskProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *AssignedTaskShort     `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Submission    *SubmittedTaskShort    `protobuf:"bytes,2,opt,name=submission,proto3,oneof" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentTaskProgress) Reset() {
+	*x = StudentTaskProgress{}
+	mi := &file_task_task_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentTaskProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentTaskProgress) ProtoMessage() {}
+
+func (x *StudentTaskProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentTaskProgress.ProtoReflect.Descriptor instead.
+func (*StudentTaskProgress) Descriptor() ([]byte, []int) {
+	return file_task_task_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StudentTaskProgress) GetTask() *AssignedTaskShort {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *StudentTaskProgress) GetSubmission() *SubmittedTaskShort {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type GetStudentTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentTasksRequest) Reset() {
+	*x = GetStudentTasksRequest{}
+	mi := &file_task_task_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentTasksRequest) ProtoMessage() {}
+
+func (x *GetStudentTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStudentTasksRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetStudentTasksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetStudentTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetStudentTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*StudentTaskProgress `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentTasksResponse) Reset() {
+	*x = GetStudentTasksResponse{}
+	mi := &file_task_task_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentTasksResponse) ProtoMessage() {}
+
+func (x *GetStudentTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStudentTasksResponse) GetTasks() []*StudentTaskProgress {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetStudentTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetStudentTasksResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetStudentTasksResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 var File_task_task_service_proto protoreflect.FileDescriptor
 
 const file_task_task_service_proto_rawDesc = "" +
 	"\n" +
-	"\x17task/task_service.proto\x12\x04task\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\"\xec\x02\n" +
+	"\x17task/task_service.proto\x12\x04task\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\"\x93\x03\n" +
 	"\fAssignedTask\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x19\n" +
@@ -1968,15 +2166,18 @@ const file_task_task_service_proto_rawDesc = "" +
 	"\x06status\x18\a \x01(\x0e2\x18.task.AssignedTaskStatusR\x06status\x126\n" +
 	"\bdeadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
-	"\f_description\"\xe2\x01\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0edeadline_local\x18\n" +
+	" \x01(\tR\rdeadlineLocalB\x0e\n" +
+	"\f_description\"\x89\x02\n" +
 	"\x11AssignedTaskShort\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x19\n" +
 	"\btutor_id\x18\x03 \x01(\tR\atutorId\x12\x14\n" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x126\n" +
 	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x120\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x18.task.AssignedTaskStatusR\x06status\"\xe4\x03\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.task.AssignedTaskStatusR\x06status\x12%\n" +
+	"\x0edeadline_local\x18\a \x01(\tR\rdeadlineLocal\"\xe4\x03\n" +
 	"\rSubmittedTask\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1d\n" +
@@ -2118,6 +2319,22 @@ const file_task_task_service_proto_rawDesc = "" +
 	"\vsubmissions\x18\x01 \x03(\v2\x18.task.SubmittedTaskShortR\vsubmissions\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x90\x01\n" +
+	"\x13StudentTaskProgress\x12+\n" +
+	"\x04task\x18\x01 \x01(\v2\x17.task.AssignedTaskShortR\x04task\x12=\n" +
+	"\n" +
+	"submission\x18\x02 \x01(\v2\x18.task.SubmittedTaskShortH\x00R\n" +
+	"submission\x88\x01\x01B\r\n" +
+	"\v_submission\"e\n" +
+	"\x16GetStudentTasksRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x8e\x01\n" +
+	"\x17GetStudentTasksResponse\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x19.task.StudentTaskProgressR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit*-\n" +
 	"\x12AssignedTaskStatus\x12\n" +
 	"\n" +
@@ -2126,7 +2343,7 @@ const file_task_task_service_proto_rawDesc = "" +
 	"\x13SubmittedTaskStatus\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\f\n" +
-	"\bVERIFIED\x10\x022\xcd\v\n" +
+	"\bVERIFIED\x10\x022\xc6\f\n" +
 	"\vTaskService\x12U\n" +
 	"\n" +
 	"CreateTask\x12\x17.task.CreateTaskRequest\x1a\x18.task.CreateTaskResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/tasks\x12_\n" +
@@ -2144,7 +2361,8 @@ const file_task_task_service_proto_rawDesc = "" +
 	"\n" +
 	"ResetGrade\x12\x17.task.ResetGradeRequest\x1a\x18.task.ResetGradeResponse\"3\x82\xd3\xe4\x93\x02-\"+/v1/submissions/{submission_id}/reset-grade\x12q\n" +
 	"\rGetSubmission\x12\x1a.task.GetSubmissionRequest\x1a\x1b.task.GetSubmissionResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/submissions/{submission_id}\x12\x80\x01\n" +
-	"\x12GetTaskSubmissions\x12\x1f.task.GetTaskSubmissionsRequest\x1a .task.GetTaskSubmissionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/tasks/{task_id}/submissionsBAZ?github.com/RomanKovalev007/tutors_platform/api/gen/go/task;taskb\x06proto3"
+	"\x12GetTaskSubmissions\x12\x1f.task.GetTaskSubmissionsRequest\x1a .task.GetTaskSubmissionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/tasks/{task_id}/submissions\x12w\n" +
+	"\x0fGetStudentTasks\x12\x1c.task.GetStudentTasksRequest\x1a\x1d.task.GetStudentTasksResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/students/{student_id}/tasksBAZ?github.com/RomanKovalev007/tutors_platform/api/gen/go/task;taskb\x06proto3"
 
 var (
 	file_task_task_service_proto_rawDescOnce sync.Once
@@ -2159,7 +2377,7 @@ func file_task_task_service_proto_rawDescGZIP() []byte {
 }
 
 var file_task_task_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_task_task_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_task_task_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_task_task_service_proto_goTypes = []any{
 	(AssignedTaskStatus)(0),             // 0: task.AssignedTaskStatus
 	(SubmittedTaskStatus)(0),            // 1: task.SubmittedTaskStatus
@@ -2193,25 +2411,28 @@ var file_task_task_service_proto_goTypes = []any{
 	(*GetSubmissionResponse)(nil),       // 29: task.GetSubmissionResponse
 	(*GetTaskSubmissionsRequest)(nil),   // 30: task.GetTaskSubmissionsRequest
 	(*GetTaskSubmissionsResponse)(nil),  // 31: task.GetTaskSubmissionsResponse
-	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),         // 33: google.protobuf.Duration
+	(*StudentTaskProgress)(nil),         // 32: task.StudentTaskProgress
+	(*GetStudentTasksRequest)(nil),      // 33: task.GetStudentTasksRequest
+	(*GetStudentTasksResponse)(nil),     // 34: task.GetStudentTasksResponse
+	(*timestamppb.Timestamp)(nil),       // 35: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 36: google.protobuf.Duration
 }
 var file_task_task_service_proto_depIdxs = []int32{
 	0,  // 0: task.AssignedTask.status:type_name -> task.AssignedTaskStatus
-	32, // 1: task.AssignedTask.deadline:type_name -> google.protobuf.Timestamp
-	32, // 2: task.AssignedTask.created_at:type_name -> google.protobuf.Timestamp
-	32, // 3: task.AssignedTaskShort.deadline:type_name -> google.protobuf.Timestamp
+	35, // 1: task.AssignedTask.deadline:type_name -> google.protobuf.Timestamp
+	35, // 2: task.AssignedTask.created_at:type_name -> google.protobuf.Timestamp
+	35, // 3: task.AssignedTaskShort.deadline:type_name -> google.protobuf.Timestamp
 	0,  // 4: task.AssignedTaskShort.status:type_name -> task.AssignedTaskStatus
 	1,  // 5: task.SubmittedTask.status:type_name -> task.SubmittedTaskStatus
-	32, // 6: task.SubmittedTask.created_at:type_name -> google.protobuf.Timestamp
-	32, // 7: task.SubmittedTask.updated_at:type_name -> google.protobuf.Timestamp
-	33, // 8: task.SubmittedTask.overdue_by:type_name -> google.protobuf.Duration
+	35, // 6: task.SubmittedTask.created_at:type_name -> google.protobuf.Timestamp
+	35, // 7: task.SubmittedTask.updated_at:type_name -> google.protobuf.Timestamp
+	36, // 8: task.SubmittedTask.overdue_by:type_name -> google.protobuf.Duration
 	1,  // 9: task.SubmittedTaskShort.status:type_name -> task.SubmittedTaskStatus
-	32, // 10: task.SubmittedTaskShort.created_at:type_name -> google.protobuf.Timestamp
-	33, // 11: task.SubmittedTaskShort.overdue_by:type_name -> google.protobuf.Duration
-	32, // 12: task.CreateTaskRequest.deadline:type_name -> google.protobuf.Timestamp
+	35, // 10: task.SubmittedTaskShort.created_at:type_name -> google.protobuf.Timestamp
+	36, // 11: task.SubmittedTaskShort.overdue_by:type_name -> google.protobuf.Duration
+	35, // 12: task.CreateTaskRequest.deadline:type_name -> google.protobuf.Timestamp
 	2,  // 13: task.CreateTaskResponse.task:type_name -> task.AssignedTask
-	32, // 14: task.UpdateTaskRequest.deadline:type_name -> google.protobuf.Timestamp
+	35, // 14: task.UpdateTaskRequest.deadline:type_name -> google.protobuf.Timestamp
 	2,  // 15: task.UpdateTaskResponse.task:type_name -> task.AssignedTask
 	2,  // 16: task.GetTaskResponse.task:type_name -> task.AssignedTask
 	3,  // 17: task.GetGroupTasksResponse.tasks:type_name -> task.AssignedTaskShort
@@ -2221,37 +2442,42 @@ var file_task_task_service_proto_depIdxs = []int32{
 	4,  // 21: task.GradeSubmissionResponse.submission:type_name -> task.SubmittedTask
 	4,  // 22: task.GetSubmissionResponse.submission:type_name -> task.SubmittedTask
 	5,  // 23: task.GetTaskSubmissionsResponse.submissions:type_name -> task.SubmittedTaskShort
-	6,  // 24: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
-	8,  // 25: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
-	10, // 26: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
-	12, // 27: task.TaskService.GetTask:input_type -> task.GetTaskRequest
-	14, // 28: task.TaskService.GetGroupTasks:input_type -> task.GetGroupTasksRequest
-	16, // 29: task.TaskService.GetCreatedByMeTasks:input_type -> task.GetCreatedByMeTasksRequest
-	18, // 30: task.TaskService.CreateSubmission:input_type -> task.CreateSubmissionRequest
-	20, // 31: task.TaskService.UpdateSubmission:input_type -> task.UpdateSubmissionRequest
-	22, // 32: task.TaskService.DeleteSubmission:input_type -> task.DeleteSubmissionRequest
-	24, // 33: task.TaskService.GradeSubmission:input_type -> task.GradeSubmissionRequest
-	26, // 34: task.TaskService.ResetGrade:input_type -> task.ResetGradeRequest
-	28, // 35: task.TaskService.GetSubmission:input_type -> task.GetSubmissionRequest
-	30, // 36: task.TaskService.GetTaskSubmissions:input_type -> task.GetTaskSubmissionsRequest
-	7,  // 37: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
-	9,  // 38: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
-	11, // 39: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
-	13, // 40: task.TaskService.GetTask:output_type -> task.GetTaskResponse
-	15, // 41: task.TaskService.GetGroupTasks:output_type -> task.GetGroupTasksResponse
-	17, // 42: task.TaskService.GetCreatedByMeTasks:output_type -> task.GetCreatedByMeTasksResponse
-	19, // 43: task.TaskService.CreateSubmission:output_type -> task.CreateSubmissionResponse
-	21, // 44: task.TaskService.UpdateSubmission:output_type -> task.UpdateSubmissionResponse
-	23, // 45: task.TaskService.DeleteSubmission:output_type -> task.DeleteSubmissionResponse
-	25, // 46: task.TaskService.GradeSubmission:output_type -> task.GradeSubmissionResponse
-	27, // 47: task.TaskService.ResetGrade:output_type -> task.ResetGradeResponse
-	29, // 48: task.TaskService.GetSubmission:output_type -> task.GetSubmissionResponse
-	31, // 49: task.TaskService.GetTaskSubmissions:output_type -> task.GetTaskSubmissionsResponse
-	37, // [37:50] is the sub-list for method output_type
-	24, // [24:37] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	3,  // 24: task.StudentTaskProgress.task:type_name -> task.AssignedTaskShort
+	5,  // 25: task.StudentTaskProgress.submission:type_name -> task.SubmittedTaskShort
+	32, // 26: task.GetStudentTasksResponse.tasks:type_name -> task.StudentTaskProgress
+	6,  // 27: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
+	8,  // 28: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
+	10, // 29: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
+	12, // 30: task.TaskService.GetTask:input_type -> task.GetTaskRequest
+	14, // 31: task.TaskService.GetGroupTasks:input_type -> task.GetGroupTasksRequest
+	16, // 32: task.TaskService.GetCreatedByMeTasks:input_type -> task.GetCreatedByMeTasksRequest
+	18, // 33: task.TaskService.CreateSubmission:input_type -> task.CreateSubmissionRequest
+	20, // 34: task.TaskService.UpdateSubmission:input_type -> task.UpdateSubmissionRequest
+	22, // 35: task.TaskService.DeleteSubmission:input_type -> task.DeleteSubmissionRequest
+	24, // 36: task.TaskService.GradeSubmission:input_type -> task.GradeSubmissionRequest
+	26, // 37: task.TaskService.ResetGrade:input_type -> task.ResetGradeRequest
+	28, // 38: task.TaskService.GetSubmission:input_type -> task.GetSubmissionRequest
+	30, // 39: task.TaskService.GetTaskSubmissions:input_type -> task.GetTaskSubmissionsRequest
+	33, // 40: task.TaskService.GetStudentTasks:input_type -> task.GetStudentTasksRequest
+	7,  // 41: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
+	9,  // 42: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
+	11, // 43: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
+	13, // 44: task.TaskService.GetTask:output_type -> task.GetTaskResponse
+	15, // 45: task.TaskService.GetGroupTasks:output_type -> task.GetGroupTasksResponse
+	17, // 46: task.TaskService.GetCreatedByMeTasks:output_type -> task.GetCreatedByMeTasksResponse
+	19, // 47: task.TaskService.CreateSubmission:output_type -> task.CreateSubmissionResponse
+	21, // 48: task.TaskService.UpdateSubmission:output_type -> task.UpdateSubmissionResponse
+	23, // 49: task.TaskService.DeleteSubmission:output_type -> task.DeleteSubmissionResponse
+	25, // 50: task.TaskService.GradeSubmission:output_type -> task.GradeSubmissionResponse
+	27, // 51: task.TaskService.ResetGrade:output_type -> task.ResetGradeResponse
+	29, // 52: task.TaskService.GetSubmission:output_type -> task.GetSubmissionResponse
+	31, // 53: task.TaskService.GetTaskSubmissions:output_type -> task.GetTaskSubmissionsResponse
+	34, // 54: task.TaskService.GetStudentTasks:output_type -> task.GetStudentTasksResponse
+	41, // [41:55] is the sub-list for method output_type
+	27, // [27:41] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_task_task_service_proto_init() }
@@ -2266,13 +2492,14 @@ func file_task_task_service_proto_init() {
 	file_task_task_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_task_task_service_proto_msgTypes[18].OneofWrappers = []any{}
 	file_task_task_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_task_task_service_proto_msgTypes[30].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_task_service_proto_rawDesc), len(file_task_task_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   30,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,6 +32,7 @@ const (
 	TaskService_ResetGrade_FullMethodName          = "/task.TaskService/ResetGrade"
 	TaskService_GetSubmission_FullMethodName       = "/task.TaskService/GetSubmission"
 	TaskService_GetTaskSubmissions_FullMethodName  = "/task.TaskService/GetTaskSubmissions"
+	TaskService_GetStudentTasks_FullMethodName     = "/task.TaskService/GetStudentTasks"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -51,6 +52,8 @@ type TaskServiceClient interface {
 	ResetGrade(ctx context.Context, in *ResetGradeRequest, opts ...grpc.CallOption) (*ResetGradeResponse, error)
 	GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*GetSubmissionResponse, error)
 	GetTaskSubmissions(ctx context.Context, in *GetTaskSubmissionsRequest, opts ...grpc.CallOption) (*GetTaskSubmissionsResponse, error)
+	// задания и оценки ученика, доступно ученику и его опекунам
+	GetStudentTasks(ctx context.Context, in *GetStudentTasksRequest, opts ...grpc.CallOption) (*GetStudentTasksResponse, error)
 }
 
 type taskServiceClient struct {
@@ -191,6 +194,16 @@ func (c *taskServiceClient) GetTaskSubmissions(ctx context.Context, in *GetTaskS
 	return out, nil
 }
 
+func (c *taskServiceClient) GetStudentTasks(ctx context.Context, in *GetStudentTasksRequest, opts ...grpc.CallOption) (*GetStudentTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetStudentTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -208,6 +221,8 @@ type TaskServiceServer interface {
 	ResetGrade(context.Context, *ResetGradeRequest) (*ResetGradeResponse, error)
 	GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error)
 	GetTaskSubmissions(context.Context, *GetTaskSubmissionsRequest) (*GetTaskSubmissionsResponse, error)
+	// задания и оценки ученика, доступно ученику и его опекунам
+	GetStudentTasks(context.Context, *GetStudentTasksRequest) (*GetStudentTasksResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -257,6 +272,9 @@ func (UnimplementedTaskServiceServer) GetSubmission(context.Context, *GetSubmiss
 func (UnimplementedTaskServiceServer) GetTaskSubmissions(context.Context, *GetTaskSubmissionsRequest) (*GetTaskSubmissionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTaskSubmissions not implemented")
 }
+func (UnimplementedTaskServiceServer) GetStudentTasks(context.Context, *GetStudentTasksRequest) (*GetStudentTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStudentTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -512,6 +530,24 @@ func _TaskService_GetTaskSubmissions_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_GetStudentTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetStudentTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetStudentTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetStudentTasks(ctx, req.(*GetStudentTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -571,6 +607,10 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskSubmissions",
 			Handler:    _TaskService_GetTaskSubmissions_Handler,
 		},
+		{
+			MethodName: "GetStudentTasks",
+			Handler:    _TaskService_GetStudentTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "task/task_service.proto",
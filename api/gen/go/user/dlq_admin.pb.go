@@ -0,0 +1,760 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: user/dlq_admin.proto
+
+package user
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DLQMessageStatus int32
+
+const (
+	DLQMessageStatus_DLQ_MESSAGE_STATUS_UNSPECIFIED DLQMessageStatus = 0
+	DLQMessageStatus_DLQ_PENDING                    DLQMessageStatus = 1
+	DLQMessageStatus_DLQ_REPLAYED                   DLQMessageStatus = 2
+	DLQMessageStatus_DLQ_DISCARDED                  DLQMessageStatus = 3
+)
+
+// Enum value maps for DLQMessageStatus.
+var (
+	DLQMessageStatus_name = map[int32]string{
+		0: "DLQ_MESSAGE_STATUS_UNSPECIFIED",
+		1: "DLQ_PENDING",
+		2: "DLQ_REPLAYED",
+		3: "DLQ_DISCARDED",
+	}
+	DLQMessageStatus_value = map[string]int32{
+		"DLQ_MESSAGE_STATUS_UNSPECIFIED": 0,
+		"DLQ_PENDING":                    1,
+		"DLQ_REPLAYED":                   2,
+		"DLQ_DISCARDED":                  3,
+	}
+)
+
+func (x DLQMessageStatus) Enum() *DLQMessageStatus {
+	p := new(DLQMessageStatus)
+	*p = x
+	return p
+}
+
+func (x DLQMessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DLQMessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_dlq_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (DLQMessageStatus) Type() protoreflect.EnumType {
+	return &file_user_dlq_admin_proto_enumTypes[0]
+}
+
+func (x DLQMessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DLQMessageStatus.Descriptor instead.
+func (DLQMessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type DLQMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition     int32                  `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	OriginalTopic string                 `protobuf:"bytes,6,opt,name=original_topic,json=originalTopic,proto3" json:"original_topic,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	RetryCount    int32                  `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	Status        DLQMessageStatus       `protobuf:"varint,9,opt,name=status,proto3,enum=user.DLQMessageStatus" json:"status,omitempty"`
+	// заполняется только в GetDLQMessage
+	Payload       []byte                 `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQMessage) Reset() {
+	*x = DLQMessage{}
+	mi := &file_user_dlq_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQMessage) ProtoMessage() {}
+
+func (x *DLQMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQMessage.ProtoReflect.Descriptor instead.
+func (*DLQMessage) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DLQMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *DLQMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DLQMessage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetOriginalTopic() string {
+	if x != nil {
+		return x.OriginalTopic
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *DLQMessage) GetStatus() DLQMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DLQMessageStatus_DLQ_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *DLQMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DLQMessage) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *DLQMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DLQMessage) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListDLQMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UNSPECIFIED - все статусы
+	Status        DLQMessageStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user.DLQMessageStatus" json:"status,omitempty"`
+	Limit         int32            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32            `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQMessagesRequest) Reset() {
+	*x = ListDLQMessagesRequest{}
+	mi := &file_user_dlq_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQMessagesRequest) ProtoMessage() {}
+
+func (x *ListDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDLQMessagesRequest) GetStatus() DLQMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DLQMessageStatus_DLQ_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ListDLQMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDLQMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*DLQMessage          `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQMessagesResponse) Reset() {
+	*x = ListDLQMessagesResponse{}
+	mi := &file_user_dlq_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQMessagesResponse) ProtoMessage() {}
+
+func (x *ListDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDLQMessagesResponse) GetMessages() []*DLQMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListDLQMessagesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetDLQMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQMessageRequest) Reset() {
+	*x = GetDLQMessageRequest{}
+	mi := &file_user_dlq_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQMessageRequest) ProtoMessage() {}
+
+func (x *GetDLQMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDLQMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DLQMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *DLQMessage            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQMessageResponse) Reset() {
+	*x = DLQMessageResponse{}
+	mi := &file_user_dlq_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQMessageResponse) ProtoMessage() {}
+
+func (x *DLQMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQMessageResponse.ProtoReflect.Descriptor instead.
+func (*DLQMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DLQMessageResponse) GetMessage() *DLQMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ReplayDLQMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// все сообщения в статусе PENDING, ids игнорируются
+	All           bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQMessagesRequest) Reset() {
+	*x = ReplayDLQMessagesRequest{}
+	mi := &file_user_dlq_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQMessagesRequest) ProtoMessage() {}
+
+func (x *ReplayDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayDLQMessagesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReplayDLQMessagesRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ReplayDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      int32                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQMessagesResponse) Reset() {
+	*x = ReplayDLQMessagesResponse{}
+	mi := &file_user_dlq_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQMessagesResponse) ProtoMessage() {}
+
+func (x *ReplayDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplayDLQMessagesResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+type DiscardDLQMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	All   bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// обязательная причина для аудита
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQMessagesRequest) Reset() {
+	*x = DiscardDLQMessagesRequest{}
+	mi := &file_user_dlq_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQMessagesRequest) ProtoMessage() {}
+
+func (x *DiscardDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiscardDLQMessagesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DiscardDLQMessagesRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *DiscardDLQMessagesRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DiscardDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discarded     int32                  `protobuf:"varint,1,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQMessagesResponse) Reset() {
+	*x = DiscardDLQMessagesResponse{}
+	mi := &file_user_dlq_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQMessagesResponse) ProtoMessage() {}
+
+func (x *DiscardDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_dlq_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_user_dlq_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiscardDLQMessagesResponse) GetDiscarded() int32 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+var File_user_dlq_admin_proto protoreflect.FileDescriptor
+
+const file_user_dlq_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14user/dlq_admin.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x03\n" +
+	"\n" +
+	"DLQMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tpartition\x18\x03 \x01(\x05R\tpartition\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12%\n" +
+	"\x0eoriginal_topic\x18\x06 \x01(\tR\roriginalTopic\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1f\n" +
+	"\vretry_count\x18\b \x01(\x05R\n" +
+	"retryCount\x12.\n" +
+	"\x06status\x18\t \x01(\x0e2\x16.user.DLQMessageStatusR\x06status\x12\x18\n" +
+	"\apayload\x18\n" +
+	" \x01(\fR\apayload\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x12\x1f\n" +
+	"\vresolved_by\x18\f \x01(\tR\n" +
+	"resolvedBy\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"v\n" +
+	"\x16ListDLQMessagesRequest\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.user.DLQMessageStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"]\n" +
+	"\x17ListDLQMessagesResponse\x12,\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.user.DLQMessageR\bmessages\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"&\n" +
+	"\x14GetDLQMessageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x12DLQMessageResponse\x12*\n" +
+	"\amessage\x18\x01 \x01(\v2\x10.user.DLQMessageR\amessage\">\n" +
+	"\x18ReplayDLQMessagesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"7\n" +
+	"\x19ReplayDLQMessagesResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed\"S\n" +
+	"\x19DiscardDLQMessagesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\":\n" +
+	"\x1aDiscardDLQMessagesResponse\x12\x1c\n" +
+	"\tdiscarded\x18\x01 \x01(\x05R\tdiscarded*l\n" +
+	"\x10DLQMessageStatus\x12\"\n" +
+	"\x1eDLQ_MESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vDLQ_PENDING\x10\x01\x12\x10\n" +
+	"\fDLQ_REPLAYED\x10\x02\x12\x11\n" +
+	"\rDLQ_DISCARDED\x10\x032\xd7\x02\n" +
+	"\x0fDLQAdminService\x12N\n" +
+	"\x0fListDLQMessages\x12\x1c.user.ListDLQMessagesRequest\x1a\x1d.user.ListDLQMessagesResponse\x12E\n" +
+	"\rGetDLQMessage\x12\x1a.user.GetDLQMessageRequest\x1a\x18.user.DLQMessageResponse\x12T\n" +
+	"\x11ReplayDLQMessages\x12\x1e.user.ReplayDLQMessagesRequest\x1a\x1f.user.ReplayDLQMessagesResponse\x12W\n" +
+	"\x12DiscardDLQMessages\x12\x1f.user.DiscardDLQMessagesRequest\x1a .user.DiscardDLQMessagesResponseBAZ?github.com/RomanKovalev007/tutors_platform/api/gen/go/user;userb\x06proto3"
+
+var (
+	file_user_dlq_admin_proto_rawDescOnce sync.Once
+	file_user_dlq_admin_proto_rawDescData []byte
+)
+
+func file_user_dlq_admin_proto_rawDescGZIP() []byte {
+	file_user_dlq_admin_proto_rawDescOnce.Do(func() {
+		file_user_dlq_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_dlq_admin_proto_rawDesc), len(file_user_dlq_admin_proto_rawDesc)))
+	})
+	return file_user_dlq_admin_proto_rawDescData
+}
+
+var file_user_dlq_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_dlq_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_user_dlq_admin_proto_goTypes = []any{
+	(DLQMessageStatus)(0),              // 0: user.DLQMessageStatus
+	(*DLQMessage)(nil),                 // 1: user.DLQMessage
+	(*ListDLQMessagesRequest)(nil),     // 2: user.ListDLQMessagesRequest
+	(*ListDLQMessagesResponse)(nil),    // 3: user.ListDLQMessagesResponse
+	(*GetDLQMessageRequest)(nil),       // 4: user.GetDLQMessageRequest
+	(*DLQMessageResponse)(nil),         // 5: user.DLQMessageResponse
+	(*ReplayDLQMessagesRequest)(nil),   // 6: user.ReplayDLQMessagesRequest
+	(*ReplayDLQMessagesResponse)(nil),  // 7: user.ReplayDLQMessagesResponse
+	(*DiscardDLQMessagesRequest)(nil),  // 8: user.DiscardDLQMessagesRequest
+	(*DiscardDLQMessagesResponse)(nil), // 9: user.DiscardDLQMessagesResponse
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+}
+var file_user_dlq_admin_proto_depIdxs = []int32{
+	0,  // 0: user.DLQMessage.status:type_name -> user.DLQMessageStatus
+	10, // 1: user.DLQMessage.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: user.DLQMessage.resolved_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: user.ListDLQMessagesRequest.status:type_name -> user.DLQMessageStatus
+	1,  // 4: user.ListDLQMessagesResponse.messages:type_name -> user.DLQMessage
+	1,  // 5: user.DLQMessageResponse.message:type_name -> user.DLQMessage
+	2,  // 6: user.DLQAdminService.ListDLQMessages:input_type -> user.ListDLQMessagesRequest
+	4,  // 7: user.DLQAdminService.GetDLQMessage:input_type -> user.GetDLQMessageRequest
+	6,  // 8: user.DLQAdminService.ReplayDLQMessages:input_type -> user.ReplayDLQMessagesRequest
+	8,  // 9: user.DLQAdminService.DiscardDLQMessages:input_type -> user.DiscardDLQMessagesRequest
+	3,  // 10: user.DLQAdminService.ListDLQMessages:output_type -> user.ListDLQMessagesResponse
+	5,  // 11: user.DLQAdminService.GetDLQMessage:output_type -> user.DLQMessageResponse
+	7,  // 12: user.DLQAdminService.ReplayDLQMessages:output_type -> user.ReplayDLQMessagesResponse
+	9,  // 13: user.DLQAdminService.DiscardDLQMessages:output_type -> user.DiscardDLQMessagesResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_user_dlq_admin_proto_init() }
+func file_user_dlq_admin_proto_init() {
+	if File_user_dlq_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_dlq_admin_proto_rawDesc), len(file_user_dlq_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_dlq_admin_proto_goTypes,
+		DependencyIndexes: file_user_dlq_admin_proto_depIdxs,
+		EnumInfos:         file_user_dlq_admin_proto_enumTypes,
+		MessageInfos:      file_user_dlq_admin_proto_msgTypes,
+	}.Build()
+	File_user_dlq_admin_proto = out.File
+	file_user_dlq_admin_proto_goTypes = nil
+	file_user_dlq_admin_proto_depIdxs = nil
+}
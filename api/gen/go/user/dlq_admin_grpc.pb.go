@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: user/dlq_admin.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DLQAdminService_ListDLQMessages_FullMethodName    = "/user.DLQAdminService/ListDLQMessages"
+	DLQAdminService_GetDLQMessage_FullMethodName      = "/user.DLQAdminService/GetDLQMessage"
+	DLQAdminService_ReplayDLQMessages_FullMethodName  = "/user.DLQAdminService/ReplayDLQMessages"
+	DLQAdminService_DiscardDLQMessages_FullMethodName = "/user.DLQAdminService/DiscardDLQMessages"
+)
+
+// DLQAdminServiceClient is the client API for DLQAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Администрирование DLQ событий Kafka. Только внутренний доступ, через gateway не публикуется.
+// Вызывающий передает свой id в метаданных x-user-id, он должен входить в ADMIN_USER_IDS.
+type DLQAdminServiceClient interface {
+	ListDLQMessages(ctx context.Context, in *ListDLQMessagesRequest, opts ...grpc.CallOption) (*ListDLQMessagesResponse, error)
+	GetDLQMessage(ctx context.Context, in *GetDLQMessageRequest, opts ...grpc.CallOption) (*DLQMessageResponse, error)
+	// повторная отправка в исходный топик
+	ReplayDLQMessages(ctx context.Context, in *ReplayDLQMessagesRequest, opts ...grpc.CallOption) (*ReplayDLQMessagesResponse, error)
+	DiscardDLQMessages(ctx context.Context, in *DiscardDLQMessagesRequest, opts ...grpc.CallOption) (*DiscardDLQMessagesResponse, error)
+}
+
+type dLQAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDLQAdminServiceClient(cc grpc.ClientConnInterface) DLQAdminServiceClient {
+	return &dLQAdminServiceClient{cc}
+}
+
+func (c *dLQAdminServiceClient) ListDLQMessages(ctx context.Context, in *ListDLQMessagesRequest, opts ...grpc.CallOption) (*ListDLQMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDLQMessagesResponse)
+	err := c.cc.Invoke(ctx, DLQAdminService_ListDLQMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dLQAdminServiceClient) GetDLQMessage(ctx context.Context, in *GetDLQMessageRequest, opts ...grpc.CallOption) (*DLQMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQMessageResponse)
+	err := c.cc.Invoke(ctx, DLQAdminService_GetDLQMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dLQAdminServiceClient) ReplayDLQMessages(ctx context.Context, in *ReplayDLQMessagesRequest, opts ...grpc.CallOption) (*ReplayDLQMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDLQMessagesResponse)
+	err := c.cc.Invoke(ctx, DLQAdminService_ReplayDLQMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dLQAdminServiceClient) DiscardDLQMessages(ctx context.Context, in *DiscardDLQMessagesRequest, opts ...grpc.CallOption) (*DiscardDLQMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDLQMessagesResponse)
+	err := c.cc.Invoke(ctx, DLQAdminService_DiscardDLQMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DLQAdminServiceServer is the server API for DLQAdminService service.
+// All implementations must embed UnimplementedDLQAdminServiceServer
+// for forward compatibility.
+//
+// Администрирование DLQ событий Kafka. Только внутренний доступ, через gateway не публикуется.
+// Вызывающий передает свой id в метаданных x-user-id, он должен входить в ADMIN_USER_IDS.
+type DLQAdminServiceServer interface {
+	ListDLQMessages(context.Context, *ListDLQMessagesRequest) (*ListDLQMessagesResponse, error)
+	GetDLQMessage(context.Context, *GetDLQMessageRequest) (*DLQMessageResponse, error)
+	// повторная отправка в исходный топик
+	ReplayDLQMessages(context.Context, *ReplayDLQMessagesRequest) (*ReplayDLQMessagesResponse, error)
+	DiscardDLQMessages(context.Context, *DiscardDLQMessagesRequest) (*DiscardDLQMessagesResponse, error)
+	mustEmbedUnimplementedDLQAdminServiceServer()
+}
+
+// UnimplementedDLQAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDLQAdminServiceServer struct{}
+
+func (UnimplementedDLQAdminServiceServer) ListDLQMessages(context.Context, *ListDLQMessagesRequest) (*ListDLQMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDLQMessages not implemented")
+}
+func (UnimplementedDLQAdminServiceServer) GetDLQMessage(context.Context, *GetDLQMessageRequest) (*DLQMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDLQMessage not implemented")
+}
+func (UnimplementedDLQAdminServiceServer) ReplayDLQMessages(context.Context, *ReplayDLQMessagesRequest) (*ReplayDLQMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDLQMessages not implemented")
+}
+func (UnimplementedDLQAdminServiceServer) DiscardDLQMessages(context.Context, *DiscardDLQMessagesRequest) (*DiscardDLQMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardDLQMessages not implemented")
+}
+func (UnimplementedDLQAdminServiceServer) mustEmbedUnimplementedDLQAdminServiceServer() {}
+func (UnimplementedDLQAdminServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeDLQAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DLQAdminServiceServer will
+// result in compilation errors.
+type UnsafeDLQAdminServiceServer interface {
+	mustEmbedUnimplementedDLQAdminServiceServer()
+}
+
+func RegisterDLQAdminServiceServer(s grpc.ServiceRegistrar, srv DLQAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedDLQAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DLQAdminService_ServiceDesc, srv)
+}
+
+func _DLQAdminService_ListDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DLQAdminServiceServer).ListDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DLQAdminService_ListDLQMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DLQAdminServiceServer).ListDLQMessages(ctx, req.(*ListDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DLQAdminService_GetDLQMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DLQAdminServiceServer).GetDLQMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DLQAdminService_GetDLQMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DLQAdminServiceServer).GetDLQMessage(ctx, req.(*GetDLQMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DLQAdminService_ReplayDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DLQAdminServiceServer).ReplayDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DLQAdminService_ReplayDLQMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DLQAdminServiceServer).ReplayDLQMessages(ctx, req.(*ReplayDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DLQAdminService_DiscardDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DLQAdminServiceServer).DiscardDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DLQAdminService_DiscardDLQMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DLQAdminServiceServer).DiscardDLQMessages(ctx, req.(*DiscardDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DLQAdminService_ServiceDesc is the grpc.ServiceDesc for DLQAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DLQAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.DLQAdminService",
+	HandlerType: (*DLQAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDLQMessages",
+			Handler:    _DLQAdminService_ListDLQMessages_Handler,
+		},
+		{
+			MethodName: "GetDLQMessage",
+			Handler:    _DLQAdminService_GetDLQMessage_Handler,
+		},
+		{
+			MethodName: "ReplayDLQMessages",
+			Handler:    _DLQAdminService_ReplayDLQMessages_Handler,
+		},
+		{
+			MethodName: "DiscardDLQMessages",
+			Handler:    _DLQAdminService_DiscardDLQMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/dlq_admin.proto",
+}
@@ -23,6 +23,261 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UserRoleFilter int32
+
+const (
+	UserRoleFilter_USER_ROLE_ANY     UserRoleFilter = 0
+	UserRoleFilter_USER_ROLE_TUTOR   UserRoleFilter = 1
+	UserRoleFilter_USER_ROLE_STUDENT UserRoleFilter = 2
+)
+
+// Enum value maps for UserRoleFilter.
+var (
+	UserRoleFilter_name = map[int32]string{
+		0: "USER_ROLE_ANY",
+		1: "USER_ROLE_TUTOR",
+		2: "USER_ROLE_STUDENT",
+	}
+	UserRoleFilter_value = map[string]int32{
+		"USER_ROLE_ANY":     0,
+		"USER_ROLE_TUTOR":   1,
+		"USER_ROLE_STUDENT": 2,
+	}
+)
+
+func (x UserRoleFilter) Enum() *UserRoleFilter {
+	p := new(UserRoleFilter)
+	*p = x
+	return p
+}
+
+func (x UserRoleFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRoleFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_user_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UserRoleFilter) Type() protoreflect.EnumType {
+	return &file_user_user_service_proto_enumTypes[0]
+}
+
+func (x UserRoleFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRoleFilter.Descriptor instead.
+func (UserRoleFilter) EnumDescriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ProfileSortField int32
+
+const (
+	ProfileSortField_PROFILE_SORT_CREATED_AT ProfileSortField = 0
+	ProfileSortField_PROFILE_SORT_EMAIL      ProfileSortField = 1
+	ProfileSortField_PROFILE_SORT_NAME       ProfileSortField = 2 // фамилия, затем имя
+)
+
+// Enum value maps for ProfileSortField.
+var (
+	ProfileSortField_name = map[int32]string{
+		0: "PROFILE_SORT_CREATED_AT",
+		1: "PROFILE_SORT_EMAIL",
+		2: "PROFILE_SORT_NAME",
+	}
+	ProfileSortField_value = map[string]int32{
+		"PROFILE_SORT_CREATED_AT": 0,
+		"PROFILE_SORT_EMAIL":      1,
+		"PROFILE_SORT_NAME":       2,
+	}
+)
+
+func (x ProfileSortField) Enum() *ProfileSortField {
+	p := new(ProfileSortField)
+	*p = x
+	return p
+}
+
+func (x ProfileSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ProfileSortField) Type() protoreflect.EnumType {
+	return &file_user_user_service_proto_enumTypes[1]
+}
+
+func (x ProfileSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileSortField.Descriptor instead.
+func (ProfileSortField) EnumDescriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{1}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // created_at по убыванию, остальные поля по возрастанию
+	SortDirection_SORT_ASC                   SortDirection = 1
+	SortDirection_SORT_DESC                  SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_ASC",
+		2: "SORT_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_ASC":                   1,
+		"SORT_DESC":                  2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_user_user_service_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{2}
+}
+
+// родители / опекуны
+type GuardianLinkStatus int32
+
+const (
+	GuardianLinkStatus_GUARDIAN_LINK_STATUS_UNSPECIFIED GuardianLinkStatus = 0
+	GuardianLinkStatus_GUARDIAN_LINK_PENDING            GuardianLinkStatus = 1
+	GuardianLinkStatus_GUARDIAN_LINK_ACCEPTED           GuardianLinkStatus = 2
+	GuardianLinkStatus_GUARDIAN_LINK_REVOKED            GuardianLinkStatus = 3
+)
+
+// Enum value maps for GuardianLinkStatus.
+var (
+	GuardianLinkStatus_name = map[int32]string{
+		0: "GUARDIAN_LINK_STATUS_UNSPECIFIED",
+		1: "GUARDIAN_LINK_PENDING",
+		2: "GUARDIAN_LINK_ACCEPTED",
+		3: "GUARDIAN_LINK_REVOKED",
+	}
+	GuardianLinkStatus_value = map[string]int32{
+		"GUARDIAN_LINK_STATUS_UNSPECIFIED": 0,
+		"GUARDIAN_LINK_PENDING":            1,
+		"GUARDIAN_LINK_ACCEPTED":           2,
+		"GUARDIAN_LINK_REVOKED":            3,
+	}
+)
+
+func (x GuardianLinkStatus) Enum() *GuardianLinkStatus {
+	p := new(GuardianLinkStatus)
+	*p = x
+	return p
+}
+
+func (x GuardianLinkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GuardianLinkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (GuardianLinkStatus) Type() protoreflect.EnumType {
+	return &file_user_user_service_proto_enumTypes[3]
+}
+
+func (x GuardianLinkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GuardianLinkStatus.Descriptor instead.
+func (GuardianLinkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{3}
+}
+
+type TutorVerificationStatus int32
+
+const (
+	TutorVerificationStatus_TUTOR_VERIFICATION_STATUS_UNSPECIFIED TutorVerificationStatus = 0
+	TutorVerificationStatus_TUTOR_UNVERIFIED                      TutorVerificationStatus = 1
+	TutorVerificationStatus_TUTOR_VERIFICATION_PENDING            TutorVerificationStatus = 2
+	TutorVerificationStatus_TUTOR_VERIFIED                        TutorVerificationStatus = 3
+	TutorVerificationStatus_TUTOR_REJECTED                        TutorVerificationStatus = 4
+)
+
+// Enum value maps for TutorVerificationStatus.
+var (
+	TutorVerificationStatus_name = map[int32]string{
+		0: "TUTOR_VERIFICATION_STATUS_UNSPECIFIED",
+		1: "TUTOR_UNVERIFIED",
+		2: "TUTOR_VERIFICATION_PENDING",
+		3: "TUTOR_VERIFIED",
+		4: "TUTOR_REJECTED",
+	}
+	TutorVerificationStatus_value = map[string]int32{
+		"TUTOR_VERIFICATION_STATUS_UNSPECIFIED": 0,
+		"TUTOR_UNVERIFIED":                      1,
+		"TUTOR_VERIFICATION_PENDING":            2,
+		"TUTOR_VERIFIED":                        3,
+		"TUTOR_REJECTED":                        4,
+	}
+)
+
+func (x TutorVerificationStatus) Enum() *TutorVerificationStatus {
+	p := new(TutorVerificationStatus)
+	*p = x
+	return p
+}
+
+func (x TutorVerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TutorVerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_user_service_proto_enumTypes[4].Descriptor()
+}
+
+func (TutorVerificationStatus) Type() protoreflect.EnumType {
+	return &file_user_user_service_proto_enumTypes[4]
+}
+
+func (x TutorVerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TutorVerificationStatus.Descriptor instead.
+func (TutorVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{4}
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -112,13 +367,18 @@ func (x *Error) GetMessage() string {
 }
 
 type UserProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	Telegram      string                 `protobuf:"bytes,5,opt,name=telegram,proto3" json:"telegram,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Surname   string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	Telegram  string                 `protobuf:"bytes,5,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// IANA, например Europe/Moscow
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// BCP 47, например ru или en-US
+	Locale        string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	AvatarUrl     string `protobuf:"bytes,9,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,15 +455,39 @@ func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserProfile) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
 type TutorProfile struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Specialization  string                 `protobuf:"bytes,2,opt,name=specialization,proto3" json:"specialization,omitempty"`
-	ExperienceYears int32                  `protobuf:"varint,3,opt,name=experience_years,json=experienceYears,proto3" json:"experience_years,omitempty"`
-	Bio             string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	UserId             string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Specialization     string                  `protobuf:"bytes,2,opt,name=specialization,proto3" json:"specialization,omitempty"`
+	ExperienceYears    int32                   `protobuf:"varint,3,opt,name=experience_years,json=experienceYears,proto3" json:"experience_years,omitempty"`
+	Bio                string                  `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	CreatedAt          *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AverageRating      float64                 `protobuf:"fixed64,6,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // средняя оценка по видимым отзывам
+	ReviewsCount       int32                   `protobuf:"varint,7,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`
+	VerificationStatus TutorVerificationStatus `protobuf:"varint,8,opt,name=verification_status,json=verificationStatus,proto3,enum=user.TutorVerificationStatus" json:"verification_status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *TutorProfile) Reset() {
@@ -271,6 +555,27 @@ func (x *TutorProfile) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TutorProfile) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *TutorProfile) GetReviewsCount() int32 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *TutorProfile) GetVerificationStatus() TutorVerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return TutorVerificationStatus_TUTOR_VERIFICATION_STATUS_UNSPECIFIED
+}
+
 type StudentProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -347,6 +652,8 @@ type CreateUserProfileRequest struct {
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
 	Telegram      string                 `protobuf:"bytes,5,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -416,6 +723,20 @@ func (x *CreateUserProfileRequest) GetTelegram() string {
 	return ""
 }
 
+func (x *CreateUserProfileRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateUserProfileRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type GetUserProfileByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -505,11 +826,16 @@ func (x *GetUserProfileByEmailRequest) GetEmail() string {
 }
 
 type UpdateUserProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
-	Telegram      string                 `protobuf:"bytes,4,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Surname  string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	Telegram string                 `protobuf:"bytes,4,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	// пустое значение оставляет текущую настройку
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Locale   string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	// http(s) ссылка на изображение, пустое значение оставляет текущее
+	AvatarUrl     string `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -572,6 +898,27 @@ func (x *UpdateUserProfileRequest) GetTelegram() string {
 	return ""
 }
 
+func (x *UpdateUserProfileRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
 type DeleteUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -661,10 +1008,21 @@ func (x *UserProfileResponse) GetProfile() *UserProfile {
 }
 
 type ListUserProfilesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Offset int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"` // игнорируется, если передан page_token
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// профили не хранят активность аккаунта, фильтр по статусу - auth ListUsers;
+	// true отклоняется с INVALID_ARGUMENT
+	//
+	// Deprecated: Marked as deprecated in user/user_service.proto.
 	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Role          UserRoleFilter         `protobuf:"varint,4,opt,name=role,proto3,enum=user.UserRoleFilter" json:"role,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`                              // префикс email, имени или фамилии
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // включительно
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // не включительно
+	SortBy        ProfileSortField       `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=user.ProfileSortField" json:"sort_by,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,9,opt,name=sort_direction,json=sortDirection,proto3,enum=user.SortDirection" json:"sort_direction,omitempty"`
+	PageToken     string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -713,6 +1071,7 @@ func (x *ListUserProfilesRequest) GetLimit() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in user/user_service.proto.
 func (x *ListUserProfilesRequest) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
@@ -720,11 +1079,62 @@ func (x *ListUserProfilesRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *ListUserProfilesRequest) GetRole() UserRoleFilter {
+	if x != nil {
+		return x.Role
+	}
+	return UserRoleFilter_USER_ROLE_ANY
+}
+
+func (x *ListUserProfilesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListUserProfilesRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListUserProfilesRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListUserProfilesRequest) GetSortBy() ProfileSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ProfileSortField_PROFILE_SORT_CREATED_AT
+}
+
+func (x *ListUserProfilesRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListUserProfilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListUserProfilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                                       // число профилей по фильтру без учета пагинации
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // пустой на последней странице
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -780,6 +1190,20 @@ func (x *ListUserProfilesResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUserProfilesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUserProfilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // доп методы юзер профилей
 type UserTypes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1636,64 +2060,2078 @@ func (x *GetCompliteUserProfileResponse) GetStudentProfile() *StudentProfile {
 	return nil
 }
 
-var File_user_user_service_proto protoreflect.FileDescriptor
+// отзывы о репетиторах
+type TutorReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	TutorReply    string                 `protobuf:"bytes,6,opt,name=tutor_reply,json=tutorReply,proto3" json:"tutor_reply,omitempty"`
+	RepliedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=replied_at,json=repliedAt,proto3" json:"replied_at,omitempty"`
+	IsHidden      bool                   `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	HiddenReason  string                 `protobuf:"bytes,9,opt,name=hidden_reason,json=hiddenReason,proto3" json:"hidden_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_user_service_proto_rawDesc = "" +
-	"\n" +
-	"\x17user/user_service.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x0f\n" +
-	"\rEmptyResponse\"5\n" +
-	"\x05Error\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xc1\x01\n" +
-	"\vUserProfile\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
-	"\asurname\x18\x04 \x01(\tR\asurname\x12\x1a\n" +
-	"\btelegram\x18\x05 \x01(\tR\btelegram\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc7\x01\n" +
-	"\fTutorProfile\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
-	"\x0especialization\x18\x02 \x01(\tR\x0especialization\x12)\n" +
-	"\x10experience_years\x18\x03 \x01(\x05R\x0fexperienceYears\x12\x10\n" +
-	"\x03bio\x18\x04 \x01(\tR\x03bio\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x97\x01\n" +
-	"\x0eStudentProfile\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
-	"\vgrade_level\x18\x02 \x01(\tR\n" +
-	"gradeLevel\x12\x10\n" +
-	"\x03bio\x18\x03 \x01(\tR\x03bio\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x93\x01\n" +
-	"\x18CreateUserProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+func (x *TutorReview) Reset() {
+	*x = TutorReview{}
+	mi := &file_user_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorReview) ProtoMessage() {}
+
+func (x *TutorReview) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorReview.ProtoReflect.Descriptor instead.
+func (*TutorReview) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TutorReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TutorReview) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *TutorReview) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *TutorReview) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TutorReview) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TutorReview) GetTutorReply() string {
+	if x != nil {
+		return x.TutorReply
+	}
+	return ""
+}
+
+func (x *TutorReview) GetRepliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RepliedAt
+	}
+	return nil
+}
+
+func (x *TutorReview) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *TutorReview) GetHiddenReason() string {
+	if x != nil {
+		return x.HiddenReason
+	}
+	return ""
+}
+
+func (x *TutorReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TutorReview) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTutorReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // от 1 до 5
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTutorReviewRequest) Reset() {
+	*x = CreateTutorReviewRequest{}
+	mi := &file_user_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTutorReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTutorReviewRequest) ProtoMessage() {}
+
+func (x *CreateTutorReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTutorReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateTutorReviewRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateTutorReviewRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateTutorReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateTutorReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateTutorReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTutorReviewRequest) Reset() {
+	*x = UpdateTutorReviewRequest{}
+	mi := &file_user_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTutorReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTutorReviewRequest) ProtoMessage() {}
+
+func (x *UpdateTutorReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTutorReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTutorReviewRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateTutorReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *UpdateTutorReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateTutorReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteTutorReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTutorReviewRequest) Reset() {
+	*x = DeleteTutorReviewRequest{}
+	mi := &file_user_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTutorReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTutorReviewRequest) ProtoMessage() {}
+
+func (x *DeleteTutorReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTutorReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTutorReviewRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteTutorReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type ReplyToTutorReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reply         string                 `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToTutorReviewRequest) Reset() {
+	*x = ReplyToTutorReviewRequest{}
+	mi := &file_user_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToTutorReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToTutorReviewRequest) ProtoMessage() {}
+
+func (x *ReplyToTutorReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToTutorReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToTutorReviewRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReplyToTutorReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ReplyToTutorReviewRequest) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+type ModerateTutorReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateTutorReviewRequest) Reset() {
+	*x = ModerateTutorReviewRequest{}
+	mi := &file_user_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateTutorReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateTutorReviewRequest) ProtoMessage() {}
+
+func (x *ModerateTutorReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateTutorReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateTutorReviewRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ModerateTutorReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ModerateTutorReviewRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *ModerateTutorReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TutorReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *TutorReview           `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorReviewResponse) Reset() {
+	*x = TutorReviewResponse{}
+	mi := &file_user_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorReviewResponse) ProtoMessage() {}
+
+func (x *TutorReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorReviewResponse.ProtoReflect.Descriptor instead.
+func (*TutorReviewResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TutorReviewResponse) GetReview() *TutorReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListTutorReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorReviewsRequest) Reset() {
+	*x = ListTutorReviewsRequest{}
+	mi := &file_user_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorReviewsRequest) ProtoMessage() {}
+
+func (x *ListTutorReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListTutorReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListTutorReviewsRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListTutorReviewsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTutorReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTutorReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*TutorReview         `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewsCount  int32                  `protobuf:"varint,3,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorReviewsResponse) Reset() {
+	*x = ListTutorReviewsResponse{}
+	mi := &file_user_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorReviewsResponse) ProtoMessage() {}
+
+func (x *ListTutorReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListTutorReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTutorReviewsResponse) GetReviews() []*TutorReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListTutorReviewsResponse) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *ListTutorReviewsResponse) GetReviewsCount() int32 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *ListTutorReviewsResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTutorReviewsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GuardianLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GuardianId    string                 `protobuf:"bytes,3,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	Status        GuardianLinkStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=user.GuardianLinkStatus" json:"status,omitempty"`
+	InvitedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	ConsentedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=consented_at,json=consentedAt,proto3" json:"consented_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianLink) Reset() {
+	*x = GuardianLink{}
+	mi := &file_user_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianLink) ProtoMessage() {}
+
+func (x *GuardianLink) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianLink.ProtoReflect.Descriptor instead.
+func (*GuardianLink) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GuardianLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetStatus() GuardianLinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GuardianLinkStatus_GUARDIAN_LINK_STATUS_UNSPECIFIED
+}
+
+func (x *GuardianLink) GetInvitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return nil
+}
+
+func (x *GuardianLink) GetConsentedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConsentedAt
+	}
+	return nil
+}
+
+func (x *GuardianLink) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type InviteGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianEmail string                 `protobuf:"bytes,1,opt,name=guardian_email,json=guardianEmail,proto3" json:"guardian_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGuardianRequest) Reset() {
+	*x = InviteGuardianRequest{}
+	mi := &file_user_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGuardianRequest) ProtoMessage() {}
+
+func (x *InviteGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGuardianRequest.ProtoReflect.Descriptor instead.
+func (*InviteGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InviteGuardianRequest) GetGuardianEmail() string {
+	if x != nil {
+		return x.GuardianEmail
+	}
+	return ""
+}
+
+type AcceptGuardianInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Consent       bool                   `protobuf:"varint,2,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGuardianInvitationRequest) Reset() {
+	*x = AcceptGuardianInvitationRequest{}
+	mi := &file_user_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGuardianInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuardianInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptGuardianInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuardianInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptGuardianInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AcceptGuardianInvitationRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *AcceptGuardianInvitationRequest) GetConsent() bool {
+	if x != nil {
+		return x.Consent
+	}
+	return false
+}
+
+type RevokeGuardianLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGuardianLinkRequest) Reset() {
+	*x = RevokeGuardianLinkRequest{}
+	mi := &file_user_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGuardianLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGuardianLinkRequest) ProtoMessage() {}
+
+func (x *RevokeGuardianLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGuardianLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGuardianLinkRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeGuardianLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type GuardianLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *GuardianLink          `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianLinkResponse) Reset() {
+	*x = GuardianLinkResponse{}
+	mi := &file_user_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianLinkResponse) ProtoMessage() {}
+
+func (x *GuardianLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianLinkResponse.ProtoReflect.Descriptor instead.
+func (*GuardianLinkResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GuardianLinkResponse) GetLink() *GuardianLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type ListGuardianLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardianLinksRequest) Reset() {
+	*x = ListGuardianLinksRequest{}
+	mi := &file_user_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardianLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardianLinksRequest) ProtoMessage() {}
+
+func (x *ListGuardianLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardianLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListGuardianLinksRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+type ListGuardianLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*GuardianLink        `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardianLinksResponse) Reset() {
+	*x = ListGuardianLinksResponse{}
+	mi := &file_user_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardianLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardianLinksResponse) ProtoMessage() {}
+
+func (x *ListGuardianLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardianLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListGuardianLinksResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListGuardianLinksResponse) GetLinks() []*GuardianLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type CheckGuardianAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckGuardianAccessRequest) Reset() {
+	*x = CheckGuardianAccessRequest{}
+	mi := &file_user_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGuardianAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGuardianAccessRequest) ProtoMessage() {}
+
+func (x *CheckGuardianAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGuardianAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckGuardianAccessRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CheckGuardianAccessRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *CheckGuardianAccessRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type CheckGuardianAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasAccess     bool                   `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckGuardianAccessResponse) Reset() {
+	*x = CheckGuardianAccessResponse{}
+	mi := &file_user_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGuardianAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGuardianAccessResponse) ProtoMessage() {}
+
+func (x *CheckGuardianAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGuardianAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckGuardianAccessResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CheckGuardianAccessResponse) GetHasAccess() bool {
+	if x != nil {
+		return x.HasAccess
+	}
+	return false
+}
+
+type GetUserPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPreferencesRequest) Reset() {
+	*x = GetUserPreferencesRequest{}
+	mi := &file_user_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPreferencesRequest) ProtoMessage() {}
+
+func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUserPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPreferencesResponse) Reset() {
+	*x = GetUserPreferencesResponse{}
+	mi := &file_user_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPreferencesResponse) ProtoMessage() {}
+
+func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserPreferencesResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetUserPreferencesResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ResolveUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Emails        []string               `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"` // без учета регистра
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUsersRequest) Reset() {
+	*x = ResolveUsersRequest{}
+	mi := &file_user_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsersRequest) ProtoMessage() {}
+
+func (x *ResolveUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUsersRequest.ProtoReflect.Descriptor instead.
+func (*ResolveUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResolveUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ResolveUsersRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+type ResolvedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Types         *UserTypes             `protobuf:"bytes,2,opt,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedUser) Reset() {
+	*x = ResolvedUser{}
+	mi := &file_user_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedUser) ProtoMessage() {}
+
+func (x *ResolvedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedUser.ProtoReflect.Descriptor instead.
+func (*ResolvedUser) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResolvedUser) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ResolvedUser) GetTypes() *UserTypes {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ResolveUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// только найденные профили, порядок не гарантируется
+	Users         []*ResolvedUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUsersResponse) Reset() {
+	*x = ResolveUsersResponse{}
+	mi := &file_user_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsersResponse) ProtoMessage() {}
+
+func (x *ResolveUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUsersResponse.ProtoReflect.Descriptor instead.
+func (*ResolveUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResolveUsersResponse) GetUsers() []*ResolvedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ReconcileProfilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// только отчет, без исправлений
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileProfilesRequest) Reset() {
+	*x = ReconcileProfilesRequest{}
+	mi := &file_user_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileProfilesRequest) ProtoMessage() {}
+
+func (x *ReconcileProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReconcileProfilesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DriftRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// missing, orphaned или email_mismatch
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthEmail     string `protobuf:"bytes,3,opt,name=auth_email,json=authEmail,proto3" json:"auth_email,omitempty"`
+	ProfileEmail  string `protobuf:"bytes,4,opt,name=profile_email,json=profileEmail,proto3" json:"profile_email,omitempty"`
+	Repaired      bool   `protobuf:"varint,5,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftRecord) Reset() {
+	*x = DriftRecord{}
+	mi := &file_user_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftRecord) ProtoMessage() {}
+
+func (x *DriftRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftRecord.ProtoReflect.Descriptor instead.
+func (*DriftRecord) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DriftRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DriftRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DriftRecord) GetAuthEmail() string {
+	if x != nil {
+		return x.AuthEmail
+	}
+	return ""
+}
+
+func (x *DriftRecord) GetProfileEmail() string {
+	if x != nil {
+		return x.ProfileEmail
+	}
+	return ""
+}
+
+func (x *DriftRecord) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *DriftRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconcileProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	AuthUsers     int32                  `protobuf:"varint,4,opt,name=auth_users,json=authUsers,proto3" json:"auth_users,omitempty"`
+	Profiles      int32                  `protobuf:"varint,5,opt,name=profiles,proto3" json:"profiles,omitempty"`
+	Missing       int32                  `protobuf:"varint,6,opt,name=missing,proto3" json:"missing,omitempty"`
+	Orphaned      int32                  `protobuf:"varint,7,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	EmailMismatch int32                  `protobuf:"varint,8,opt,name=email_mismatch,json=emailMismatch,proto3" json:"email_mismatch,omitempty"`
+	Records       []*DriftRecord         `protobuf:"bytes,9,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileProfilesResponse) Reset() {
+	*x = ReconcileProfilesResponse{}
+	mi := &file_user_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileProfilesResponse) ProtoMessage() {}
+
+func (x *ReconcileProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReconcileProfilesResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ReconcileProfilesResponse) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ReconcileProfilesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReconcileProfilesResponse) GetAuthUsers() int32 {
+	if x != nil {
+		return x.AuthUsers
+	}
+	return 0
+}
+
+func (x *ReconcileProfilesResponse) GetProfiles() int32 {
+	if x != nil {
+		return x.Profiles
+	}
+	return 0
+}
+
+func (x *ReconcileProfilesResponse) GetMissing() int32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *ReconcileProfilesResponse) GetOrphaned() int32 {
+	if x != nil {
+		return x.Orphaned
+	}
+	return 0
+}
+
+func (x *ReconcileProfilesResponse) GetEmailMismatch() int32 {
+	if x != nil {
+		return x.EmailMismatch
+	}
+	return 0
+}
+
+func (x *ReconcileProfilesResponse) GetRecords() []*DriftRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// документ загружается через сервис загрузок, здесь хранится только ссылка на него
+type VerificationDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	UploadedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationDocument) Reset() {
+	*x = VerificationDocument{}
+	mi := &file_user_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationDocument) ProtoMessage() {}
+
+func (x *VerificationDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationDocument.ProtoReflect.Descriptor instead.
+func (*VerificationDocument) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VerificationDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VerificationDocument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VerificationDocument) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *VerificationDocument) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+type TutorVerification struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TutorId       string                  `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Status        TutorVerificationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=user.TutorVerificationStatus" json:"status,omitempty"`
+	Reason        string                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // причина отказа
+	Documents     []*VerificationDocument `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewedBy    string                  `protobuf:"bytes,7,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorVerification) Reset() {
+	*x = TutorVerification{}
+	mi := &file_user_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorVerification) ProtoMessage() {}
+
+func (x *TutorVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorVerification.ProtoReflect.Descriptor instead.
+func (*TutorVerification) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TutorVerification) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *TutorVerification) GetStatus() TutorVerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TutorVerificationStatus_TUTOR_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *TutorVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TutorVerification) GetDocuments() []*VerificationDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *TutorVerification) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *TutorVerification) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *TutorVerification) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+type SubmitTutorVerificationRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Documents     []*VerificationDocument `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTutorVerificationRequest) Reset() {
+	*x = SubmitTutorVerificationRequest{}
+	mi := &file_user_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTutorVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTutorVerificationRequest) ProtoMessage() {}
+
+func (x *SubmitTutorVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTutorVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTutorVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SubmitTutorVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitTutorVerificationRequest) GetDocuments() []*VerificationDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type GetTutorVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTutorVerificationRequest) Reset() {
+	*x = GetTutorVerificationRequest{}
+	mi := &file_user_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTutorVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTutorVerificationRequest) ProtoMessage() {}
+
+func (x *GetTutorVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTutorVerificationRequest.ProtoReflect.Descriptor instead.
+func (*GetTutorVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTutorVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReviewTutorVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // обязательна при отказе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTutorVerificationRequest) Reset() {
+	*x = ReviewTutorVerificationRequest{}
+	mi := &file_user_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTutorVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTutorVerificationRequest) ProtoMessage() {}
+
+func (x *ReviewTutorVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTutorVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewTutorVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReviewTutorVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewTutorVerificationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewTutorVerificationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListTutorVerificationsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        TutorVerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user.TutorVerificationStatus" json:"status,omitempty"` // по умолчанию ожидающие проверки
+	Limit         int32                   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorVerificationsRequest) Reset() {
+	*x = ListTutorVerificationsRequest{}
+	mi := &file_user_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorVerificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorVerificationsRequest) ProtoMessage() {}
+
+func (x *ListTutorVerificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorVerificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTutorVerificationsRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListTutorVerificationsRequest) GetStatus() TutorVerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TutorVerificationStatus_TUTOR_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListTutorVerificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTutorVerificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TutorVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *TutorVerification     `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorVerificationResponse) Reset() {
+	*x = TutorVerificationResponse{}
+	mi := &file_user_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorVerificationResponse) ProtoMessage() {}
+
+func (x *TutorVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorVerificationResponse.ProtoReflect.Descriptor instead.
+func (*TutorVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TutorVerificationResponse) GetVerification() *TutorVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+type ListTutorVerificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verifications []*TutorVerification   `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorVerificationsResponse) Reset() {
+	*x = ListTutorVerificationsResponse{}
+	mi := &file_user_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorVerificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorVerificationsResponse) ProtoMessage() {}
+
+func (x *ListTutorVerificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorVerificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTutorVerificationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListTutorVerificationsResponse) GetVerifications() []*TutorVerification {
+	if x != nil {
+		return x.Verifications
+	}
+	return nil
+}
+
+func (x *ListTutorVerificationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_user_user_service_proto protoreflect.FileDescriptor
+
+const file_user_user_service_proto_rawDesc = "" +
+	"\n" +
+	"\x17user/user_service.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x0f\n" +
+	"\rEmptyResponse\"5\n" +
+	"\x05Error\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x94\x02\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x04 \x01(\tR\asurname\x12\x1a\n" +
+	"\btelegram\x18\x05 \x01(\tR\btelegram\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\t \x01(\tR\tavatarUrl\"\xe3\x02\n" +
+	"\fTutorProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0especialization\x18\x02 \x01(\tR\x0especialization\x12)\n" +
+	"\x10experience_years\x18\x03 \x01(\x05R\x0fexperienceYears\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0eaverage_rating\x18\x06 \x01(\x01R\raverageRating\x12#\n" +
+	"\rreviews_count\x18\a \x01(\x05R\freviewsCount\x12N\n" +
+	"\x13verification_status\x18\b \x01(\x0e2\x1d.user.TutorVerificationStatusR\x12verificationStatus\"\x97\x01\n" +
+	"\x0eStudentProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vgrade_level\x18\x02 \x01(\tR\n" +
+	"gradeLevel\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc7\x01\n" +
+	"\x18CreateUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
 	"\asurname\x18\x04 \x01(\tR\asurname\x12\x1a\n" +
-	"\btelegram\x18\x05 \x01(\tR\btelegram\"4\n" +
+	"\btelegram\x18\x05 \x01(\tR\btelegram\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\"4\n" +
 	"\x19GetUserProfileByIDRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
 	"\x1cGetUserProfileByEmailRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"}\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\xd0\x01\n" +
 	"\x18UpdateUserProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\asurname\x18\x03 \x01(\tR\asurname\x12\x1a\n" +
-	"\btelegram\x18\x04 \x01(\tR\btelegram\"3\n" +
+	"\btelegram\x18\x04 \x01(\tR\btelegram\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\a \x01(\tR\tavatarUrl\"3\n" +
 	"\x18DeleteUserProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"B\n" +
 	"\x13UserProfileResponse\x12+\n" +
-	"\aprofile\x18\x01 \x01(\v2\x11.user.UserProfileR\aprofile\"d\n" +
+	"\aprofile\x18\x01 \x01(\v2\x11.user.UserProfileR\aprofile\"\xb0\x03\n" +
 	"\x17ListUserProfilesRequest\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1b\n" +
-	"\tis_active\x18\x03 \x01(\bR\bisActive\"q\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\tis_active\x18\x03 \x01(\bB\x02\x18\x01R\bisActive\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.user.UserRoleFilterR\x04role\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12/\n" +
+	"\asort_by\x18\b \x01(\x0e2\x16.user.ProfileSortFieldR\x06sortBy\x12:\n" +
+	"\x0esort_direction\x18\t \x01(\x0e2\x13.user.SortDirectionR\rsortDirection\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\"\xaf\x01\n" +
 	"\x18ListUserProfilesResponse\x12'\n" +
 	"\x05users\x18\x01 \x03(\v2\x11.user.UserProfileR\x05users\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"E\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"E\n" +
 	"\tUserTypes\x12\x19\n" +
 	"\bis_tutor\x18\x01 \x01(\bR\aisTutor\x12\x1d\n" +
 	"\n" +
@@ -1743,7 +4181,179 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\x1eGetCompliteUserProfileResponse\x124\n" +
 	"\fuser_profile\x18\x01 \x01(\v2\x11.user.UserProfileR\vuserProfile\x127\n" +
 	"\rtutor_profile\x18\x02 \x01(\v2\x12.user.TutorProfileR\ftutorProfile\x12=\n" +
-	"\x0fstudent_profile\x18\x03 \x01(\v2\x14.user.StudentProfileR\x0estudentProfile2\xce\x0e\n" +
+	"\x0fstudent_profile\x18\x03 \x01(\v2\x14.user.StudentProfileR\x0estudentProfile\"\x97\x03\n" +
+	"\vTutorReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1f\n" +
+	"\vtutor_reply\x18\x06 \x01(\tR\n" +
+	"tutorReply\x129\n" +
+	"\n" +
+	"replied_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trepliedAt\x12\x1b\n" +
+	"\tis_hidden\x18\b \x01(\bR\bisHidden\x12#\n" +
+	"\rhidden_reason\x18\t \x01(\tR\fhiddenReason\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"a\n" +
+	"\x18CreateTutorReviewRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"c\n" +
+	"\x18UpdateTutorReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"7\n" +
+	"\x18DeleteTutorReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"N\n" +
+	"\x19ReplyToTutorReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x14\n" +
+	"\x05reply\x18\x02 \x01(\tR\x05reply\"i\n" +
+	"\x1aModerateTutorReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06hidden\x18\x02 \x01(\bR\x06hidden\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"@\n" +
+	"\x13TutorReviewResponse\x12)\n" +
+	"\x06review\x18\x01 \x01(\v2\x11.user.TutorReviewR\x06review\"b\n" +
+	"\x17ListTutorReviewsRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xc1\x01\n" +
+	"\x18ListTutorReviewsResponse\x12+\n" +
+	"\areviews\x18\x01 \x03(\v2\x11.user.TutorReviewR\areviews\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12#\n" +
+	"\rreviews_count\x18\x03 \x01(\x05R\freviewsCount\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xc5\x02\n" +
+	"\fGuardianLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1f\n" +
+	"\vguardian_id\x18\x03 \x01(\tR\n" +
+	"guardianId\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.user.GuardianLinkStatusR\x06status\x129\n" +
+	"\n" +
+	"invited_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tinvitedAt\x12=\n" +
+	"\fconsented_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vconsentedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\">\n" +
+	"\x15InviteGuardianRequest\x12%\n" +
+	"\x0eguardian_email\x18\x01 \x01(\tR\rguardianEmail\"T\n" +
+	"\x1fAcceptGuardianInvitationRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x18\n" +
+	"\aconsent\x18\x02 \x01(\bR\aconsent\"4\n" +
+	"\x19RevokeGuardianLinkRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\">\n" +
+	"\x14GuardianLinkResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.user.GuardianLinkR\x04link\"\x1a\n" +
+	"\x18ListGuardianLinksRequest\"E\n" +
+	"\x19ListGuardianLinksResponse\x12(\n" +
+	"\x05links\x18\x01 \x03(\v2\x12.user.GuardianLinkR\x05links\"\\\n" +
+	"\x1aCheckGuardianAccessRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"<\n" +
+	"\x1bCheckGuardianAccessResponse\x12\x1d\n" +
+	"\n" +
+	"has_access\x18\x01 \x01(\bR\thasAccess\"4\n" +
+	"\x19GetUserPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x1aGetUserPreferencesResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"H\n" +
+	"\x13ResolveUsersRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x16\n" +
+	"\x06emails\x18\x02 \x03(\tR\x06emails\"b\n" +
+	"\fResolvedUser\x12+\n" +
+	"\aprofile\x18\x01 \x01(\v2\x11.user.UserProfileR\aprofile\x12%\n" +
+	"\x05types\x18\x02 \x01(\v2\x0f.user.UserTypesR\x05types\"@\n" +
+	"\x14ResolveUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.user.ResolvedUserR\x05users\"3\n" +
+	"\x18ReconcileProfilesRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\xb0\x01\n" +
+	"\vDriftRecord\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"auth_email\x18\x03 \x01(\tR\tauthEmail\x12#\n" +
+	"\rprofile_email\x18\x04 \x01(\tR\fprofileEmail\x12\x1a\n" +
+	"\brepaired\x18\x05 \x01(\bR\brepaired\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xf1\x02\n" +
+	"\x19ReconcileProfilesResponse\x129\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"auth_users\x18\x04 \x01(\x05R\tauthUsers\x12\x1a\n" +
+	"\bprofiles\x18\x05 \x01(\x05R\bprofiles\x12\x18\n" +
+	"\amissing\x18\x06 \x01(\x05R\amissing\x12\x1a\n" +
+	"\borphaned\x18\a \x01(\x05R\borphaned\x12%\n" +
+	"\x0eemail_mismatch\x18\b \x01(\x05R\remailMismatch\x12+\n" +
+	"\arecords\x18\t \x03(\v2\x11.user.DriftRecordR\arecords\"\x89\x01\n" +
+	"\x14VerificationDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12;\n" +
+	"\vuploaded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"\xd4\x02\n" +
+	"\x11TutorVerification\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.user.TutorVerificationStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x128\n" +
+	"\tdocuments\x18\x04 \x03(\v2\x1a.user.VerificationDocumentR\tdocuments\x12=\n" +
+	"\fsubmitted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12;\n" +
+	"\vreviewed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vreviewed_by\x18\a \x01(\tR\n" +
+	"reviewedBy\"s\n" +
+	"\x1eSubmitTutorVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x128\n" +
+	"\tdocuments\x18\x02 \x03(\v2\x1a.user.VerificationDocumentR\tdocuments\"6\n" +
+	"\x1bGetTutorVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"k\n" +
+	"\x1eReviewTutorVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x1dListTutorVerificationsRequest\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.user.TutorVerificationStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"X\n" +
+	"\x19TutorVerificationResponse\x12;\n" +
+	"\fverification\x18\x01 \x01(\v2\x17.user.TutorVerificationR\fverification\"u\n" +
+	"\x1eListTutorVerificationsResponse\x12=\n" +
+	"\rverifications\x18\x01 \x03(\v2\x17.user.TutorVerificationR\rverifications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total*O\n" +
+	"\x0eUserRoleFilter\x12\x11\n" +
+	"\rUSER_ROLE_ANY\x10\x00\x12\x13\n" +
+	"\x0fUSER_ROLE_TUTOR\x10\x01\x12\x15\n" +
+	"\x11USER_ROLE_STUDENT\x10\x02*^\n" +
+	"\x10ProfileSortField\x12\x1b\n" +
+	"\x17PROFILE_SORT_CREATED_AT\x10\x00\x12\x16\n" +
+	"\x12PROFILE_SORT_EMAIL\x10\x01\x12\x15\n" +
+	"\x11PROFILE_SORT_NAME\x10\x02*L\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSORT_ASC\x10\x01\x12\r\n" +
+	"\tSORT_DESC\x10\x02*\x8c\x01\n" +
+	"\x12GuardianLinkStatus\x12$\n" +
+	" GUARDIAN_LINK_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15GUARDIAN_LINK_PENDING\x10\x01\x12\x1a\n" +
+	"\x16GUARDIAN_LINK_ACCEPTED\x10\x02\x12\x19\n" +
+	"\x15GUARDIAN_LINK_REVOKED\x10\x03*\xa2\x01\n" +
+	"\x17TutorVerificationStatus\x12)\n" +
+	"%TUTOR_VERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TUTOR_UNVERIFIED\x10\x01\x12\x1e\n" +
+	"\x1aTUTOR_VERIFICATION_PENDING\x10\x02\x12\x12\n" +
+	"\x0eTUTOR_VERIFIED\x10\x03\x12\x12\n" +
+	"\x0eTUTOR_REJECTED\x10\x042\x96\x1f\n" +
 	"\vUserService\x12d\n" +
 	"\x11CreateUserProfile\x12\x1e.user.CreateUserProfileRequest\x1a\x19.user.UserProfileResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12m\n" +
 	"\x12GetUserProfileByID\x12\x1f.user.GetUserProfileByIDRequest\x1a\x19.user.UserProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/users/{user_id}\x12w\n" +
@@ -1760,9 +4370,27 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\x14CreateStudentProfile\x12!.user.CreateStudentProfileRequest\x1a\x1c.user.StudentProfileResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/students\x12q\n" +
 	"\x11GetStudentProfile\x12\x1e.user.GetStudentProfileRequest\x1a\x1c.user.StudentProfileResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/students/{user_id}\x12z\n" +
 	"\x14UpdateStudentProfile\x12!.user.UpdateStudentProfileRequest\x1a\x1c.user.StudentProfileResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/students/{user_id}\x12n\n" +
-	"\x14DeleteStudentProfile\x12!.user.DeleteStudentProfileRequest\x1a\x13.user.EmptyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/students/{user_id}\x12H\n" +
+	"\x14DeleteStudentProfile\x12!.user.DeleteStudentProfileRequest\x1a\x13.user.EmptyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/students/{user_id}\x12\x8e\x01\n" +
+	"\x17SubmitTutorVerification\x12$.user.SubmitTutorVerificationRequest\x1a\x1f.user.TutorVerificationResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/tutors/{user_id}/verification\x12\x85\x01\n" +
+	"\x14GetTutorVerification\x12!.user.GetTutorVerificationRequest\x1a\x1f.user.TutorVerificationResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/tutors/{user_id}/verification\x12\x95\x01\n" +
+	"\x17ReviewTutorVerification\x12$.user.ReviewTutorVerificationRequest\x1a\x1f.user.TutorVerificationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/tutors/{user_id}/verification/review\x12\x84\x01\n" +
+	"\x16ListTutorVerifications\x12#.user.ListTutorVerificationsRequest\x1a$.user.ListTutorVerificationsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/tutor-verifications\x12H\n" +
 	"\rValidateTutor\x12\x1a.user.ValidateTutorRequest\x1a\x1b.user.ValidateTutorResponse\x12\x85\x01\n" +
-	"\x16GetCompliteUserProfile\x12#.user.GetCompliteUserProfileRequest\x1a$.user.GetCompliteUserProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/users/{user_id}/fullBAZ?github.com/RomanKovalev007/tutors_platform/api/gen/go/user;userb\x06proto3"
+	"\x16GetCompliteUserProfile\x12#.user.GetCompliteUserProfileRequest\x1a$.user.GetCompliteUserProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/users/{user_id}/full\x12x\n" +
+	"\x11CreateTutorReview\x12\x1e.user.CreateTutorReviewRequest\x1a\x19.user.TutorReviewResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tutors/{tutor_id}/reviews\x12x\n" +
+	"\x10ListTutorReviews\x12\x1d.user.ListTutorReviewsRequest\x1a\x1e.user.ListTutorReviewsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tutors/{tutor_id}/reviews\x12r\n" +
+	"\x11UpdateTutorReview\x12\x1e.user.UpdateTutorReviewRequest\x1a\x19.user.TutorReviewResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/reviews/{review_id}\x12i\n" +
+	"\x11DeleteTutorReview\x12\x1e.user.DeleteTutorReviewRequest\x1a\x13.user.EmptyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/reviews/{review_id}\x12z\n" +
+	"\x12ReplyToTutorReview\x12\x1f.user.ReplyToTutorReviewRequest\x1a\x19.user.TutorReviewResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/reviews/{review_id}/reply\x12\x7f\n" +
+	"\x13ModerateTutorReview\x12 .user.ModerateTutorReviewRequest\x1a\x19.user.TutorReviewResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/reviews/{review_id}/moderate\x12o\n" +
+	"\x0eInviteGuardian\x12\x1b.user.InviteGuardianRequest\x1a\x1a.user.GuardianLinkResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/guardians/invitations\x12\x94\x01\n" +
+	"\x18AcceptGuardianInvitation\x12%.user.AcceptGuardianInvitationRequest\x1a\x1a.user.GuardianLinkResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/guardians/invitations/{link_id}/accept\x12q\n" +
+	"\x12RevokeGuardianLink\x12\x1f.user.RevokeGuardianLinkRequest\x1a\x13.user.EmptyResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/guardians/links/{link_id}\x12q\n" +
+	"\x11ListGuardianLinks\x12\x1e.user.ListGuardianLinksRequest\x1a\x1f.user.ListGuardianLinksResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/guardians/links\x12Z\n" +
+	"\x13CheckGuardianAccess\x12 .user.CheckGuardianAccessRequest\x1a!.user.CheckGuardianAccessResponse\x12W\n" +
+	"\x12GetUserPreferences\x12\x1f.user.GetUserPreferencesRequest\x1a .user.GetUserPreferencesResponse\x12T\n" +
+	"\x11ReconcileProfiles\x12\x1e.user.ReconcileProfilesRequest\x1a\x1f.user.ReconcileProfilesResponse\x12E\n" +
+	"\fResolveUsers\x12\x19.user.ResolveUsersRequest\x1a\x1a.user.ResolveUsersResponseBAZ?github.com/RomanKovalev007/tutors_platform/api/gen/go/user;userb\x06proto3"
 
 var (
 	file_user_user_service_proto_rawDescOnce sync.Once
@@ -1776,91 +4404,199 @@ func file_user_user_service_proto_rawDescGZIP() []byte {
 	return file_user_user_service_proto_rawDescData
 }
 
-var file_user_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_user_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_user_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_user_user_service_proto_goTypes = []any{
-	(*EmptyResponse)(nil),                  // 0: user.EmptyResponse
-	(*Error)(nil),                          // 1: user.Error
-	(*UserProfile)(nil),                    // 2: user.UserProfile
-	(*TutorProfile)(nil),                   // 3: user.TutorProfile
-	(*StudentProfile)(nil),                 // 4: user.StudentProfile
-	(*CreateUserProfileRequest)(nil),       // 5: user.CreateUserProfileRequest
-	(*GetUserProfileByIDRequest)(nil),      // 6: user.GetUserProfileByIDRequest
-	(*GetUserProfileByEmailRequest)(nil),   // 7: user.GetUserProfileByEmailRequest
-	(*UpdateUserProfileRequest)(nil),       // 8: user.UpdateUserProfileRequest
-	(*DeleteUserProfileRequest)(nil),       // 9: user.DeleteUserProfileRequest
-	(*UserProfileResponse)(nil),            // 10: user.UserProfileResponse
-	(*ListUserProfilesRequest)(nil),        // 11: user.ListUserProfilesRequest
-	(*ListUserProfilesResponse)(nil),       // 12: user.ListUserProfilesResponse
-	(*UserTypes)(nil),                      // 13: user.UserTypes
-	(*GetUserTypesRequest)(nil),            // 14: user.GetUserTypesRequest
-	(*UserTypesResponse)(nil),              // 15: user.UserTypesResponse
-	(*CreateTutorProfileRequest)(nil),      // 16: user.CreateTutorProfileRequest
-	(*GetTutorProfileRequest)(nil),         // 17: user.GetTutorProfileRequest
-	(*UpdateTutorProfileRequest)(nil),      // 18: user.UpdateTutorProfileRequest
-	(*DeleteTutorProfileRequest)(nil),      // 19: user.DeleteTutorProfileRequest
-	(*TutorProfileResponse)(nil),           // 20: user.TutorProfileResponse
-	(*CreateStudentProfileRequest)(nil),    // 21: user.CreateStudentProfileRequest
-	(*GetStudentProfileRequest)(nil),       // 22: user.GetStudentProfileRequest
-	(*UpdateStudentProfileRequest)(nil),    // 23: user.UpdateStudentProfileRequest
-	(*DeleteStudentProfileRequest)(nil),    // 24: user.DeleteStudentProfileRequest
-	(*StudentProfileResponse)(nil),         // 25: user.StudentProfileResponse
-	(*ValidateTutorRequest)(nil),           // 26: user.ValidateTutorRequest
-	(*ValidateTutorResponse)(nil),          // 27: user.ValidateTutorResponse
-	(*GetCompliteUserProfileRequest)(nil),  // 28: user.GetCompliteUserProfileRequest
-	(*GetCompliteUserProfileResponse)(nil), // 29: user.GetCompliteUserProfileResponse
-	(*timestamppb.Timestamp)(nil),          // 30: google.protobuf.Timestamp
+	(UserRoleFilter)(0),                     // 0: user.UserRoleFilter
+	(ProfileSortField)(0),                   // 1: user.ProfileSortField
+	(SortDirection)(0),                      // 2: user.SortDirection
+	(GuardianLinkStatus)(0),                 // 3: user.GuardianLinkStatus
+	(TutorVerificationStatus)(0),            // 4: user.TutorVerificationStatus
+	(*EmptyResponse)(nil),                   // 5: user.EmptyResponse
+	(*Error)(nil),                           // 6: user.Error
+	(*UserProfile)(nil),                     // 7: user.UserProfile
+	(*TutorProfile)(nil),                    // 8: user.TutorProfile
+	(*StudentProfile)(nil),                  // 9: user.StudentProfile
+	(*CreateUserProfileRequest)(nil),        // 10: user.CreateUserProfileRequest
+	(*GetUserProfileByIDRequest)(nil),       // 11: user.GetUserProfileByIDRequest
+	(*GetUserProfileByEmailRequest)(nil),    // 12: user.GetUserProfileByEmailRequest
+	(*UpdateUserProfileRequest)(nil),        // 13: user.UpdateUserProfileRequest
+	(*DeleteUserProfileRequest)(nil),        // 14: user.DeleteUserProfileRequest
+	(*UserProfileResponse)(nil),             // 15: user.UserProfileResponse
+	(*ListUserProfilesRequest)(nil),         // 16: user.ListUserProfilesRequest
+	(*ListUserProfilesResponse)(nil),        // 17: user.ListUserProfilesResponse
+	(*UserTypes)(nil),                       // 18: user.UserTypes
+	(*GetUserTypesRequest)(nil),             // 19: user.GetUserTypesRequest
+	(*UserTypesResponse)(nil),               // 20: user.UserTypesResponse
+	(*CreateTutorProfileRequest)(nil),       // 21: user.CreateTutorProfileRequest
+	(*GetTutorProfileRequest)(nil),          // 22: user.GetTutorProfileRequest
+	(*UpdateTutorProfileRequest)(nil),       // 23: user.UpdateTutorProfileRequest
+	(*DeleteTutorProfileRequest)(nil),       // 24: user.DeleteTutorProfileRequest
+	(*TutorProfileResponse)(nil),            // 25: user.TutorProfileResponse
+	(*CreateStudentProfileRequest)(nil),     // 26: user.CreateStudentProfileRequest
+	(*GetStudentProfileRequest)(nil),        // 27: user.GetStudentProfileRequest
+	(*UpdateStudentProfileRequest)(nil),     // 28: user.UpdateStudentProfileRequest
+	(*DeleteStudentProfileRequest)(nil),     // 29: user.DeleteStudentProfileRequest
+	(*StudentProfileResponse)(nil),          // 30: user.StudentProfileResponse
+	(*ValidateTutorRequest)(nil),            // 31: user.ValidateTutorRequest
+	(*ValidateTutorResponse)(nil),           // 32: user.ValidateTutorResponse
+	(*GetCompliteUserProfileRequest)(nil),   // 33: user.GetCompliteUserProfileRequest
+	(*GetCompliteUserProfileResponse)(nil),  // 34: user.GetCompliteUserProfileResponse
+	(*TutorReview)(nil),                     // 35: user.TutorReview
+	(*CreateTutorReviewRequest)(nil),        // 36: user.CreateTutorReviewRequest
+	(*UpdateTutorReviewRequest)(nil),        // 37: user.UpdateTutorReviewRequest
+	(*DeleteTutorReviewRequest)(nil),        // 38: user.DeleteTutorReviewRequest
+	(*ReplyToTutorReviewRequest)(nil),       // 39: user.ReplyToTutorReviewRequest
+	(*ModerateTutorReviewRequest)(nil),      // 40: user.ModerateTutorReviewRequest
+	(*TutorReviewResponse)(nil),             // 41: user.TutorReviewResponse
+	(*ListTutorReviewsRequest)(nil),         // 42: user.ListTutorReviewsRequest
+	(*ListTutorReviewsResponse)(nil),        // 43: user.ListTutorReviewsResponse
+	(*GuardianLink)(nil),                    // 44: user.GuardianLink
+	(*InviteGuardianRequest)(nil),           // 45: user.InviteGuardianRequest
+	(*AcceptGuardianInvitationRequest)(nil), // 46: user.AcceptGuardianInvitationRequest
+	(*RevokeGuardianLinkRequest)(nil),       // 47: user.RevokeGuardianLinkRequest
+	(*GuardianLinkResponse)(nil),            // 48: user.GuardianLinkResponse
+	(*ListGuardianLinksRequest)(nil),        // 49: user.ListGuardianLinksRequest
+	(*ListGuardianLinksResponse)(nil),       // 50: user.ListGuardianLinksResponse
+	(*CheckGuardianAccessRequest)(nil),      // 51: user.CheckGuardianAccessRequest
+	(*CheckGuardianAccessResponse)(nil),     // 52: user.CheckGuardianAccessResponse
+	(*GetUserPreferencesRequest)(nil),       // 53: user.GetUserPreferencesRequest
+	(*GetUserPreferencesResponse)(nil),      // 54: user.GetUserPreferencesResponse
+	(*ResolveUsersRequest)(nil),             // 55: user.ResolveUsersRequest
+	(*ResolvedUser)(nil),                    // 56: user.ResolvedUser
+	(*ResolveUsersResponse)(nil),            // 57: user.ResolveUsersResponse
+	(*ReconcileProfilesRequest)(nil),        // 58: user.ReconcileProfilesRequest
+	(*DriftRecord)(nil),                     // 59: user.DriftRecord
+	(*ReconcileProfilesResponse)(nil),       // 60: user.ReconcileProfilesResponse
+	(*VerificationDocument)(nil),            // 61: user.VerificationDocument
+	(*TutorVerification)(nil),               // 62: user.TutorVerification
+	(*SubmitTutorVerificationRequest)(nil),  // 63: user.SubmitTutorVerificationRequest
+	(*GetTutorVerificationRequest)(nil),     // 64: user.GetTutorVerificationRequest
+	(*ReviewTutorVerificationRequest)(nil),  // 65: user.ReviewTutorVerificationRequest
+	(*ListTutorVerificationsRequest)(nil),   // 66: user.ListTutorVerificationsRequest
+	(*TutorVerificationResponse)(nil),       // 67: user.TutorVerificationResponse
+	(*ListTutorVerificationsResponse)(nil),  // 68: user.ListTutorVerificationsResponse
+	(*timestamppb.Timestamp)(nil),           // 69: google.protobuf.Timestamp
 }
 var file_user_user_service_proto_depIdxs = []int32{
-	30, // 0: user.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	30, // 1: user.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
-	30, // 2: user.StudentProfile.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: user.UserProfileResponse.profile:type_name -> user.UserProfile
-	2,  // 4: user.ListUserProfilesResponse.users:type_name -> user.UserProfile
-	13, // 5: user.UserTypesResponse.types:type_name -> user.UserTypes
-	3,  // 6: user.TutorProfileResponse.profile:type_name -> user.TutorProfile
-	4,  // 7: user.StudentProfileResponse.profile:type_name -> user.StudentProfile
-	2,  // 8: user.GetCompliteUserProfileResponse.user_profile:type_name -> user.UserProfile
-	3,  // 9: user.GetCompliteUserProfileResponse.tutor_profile:type_name -> user.TutorProfile
-	4,  // 10: user.GetCompliteUserProfileResponse.student_profile:type_name -> user.StudentProfile
-	5,  // 11: user.UserService.CreateUserProfile:input_type -> user.CreateUserProfileRequest
-	6,  // 12: user.UserService.GetUserProfileByID:input_type -> user.GetUserProfileByIDRequest
-	7,  // 13: user.UserService.GetUserProfileByEmail:input_type -> user.GetUserProfileByEmailRequest
-	8,  // 14: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
-	9,  // 15: user.UserService.DeleteUserProfile:input_type -> user.DeleteUserProfileRequest
-	11, // 16: user.UserService.ListUsers:input_type -> user.ListUserProfilesRequest
-	14, // 17: user.UserService.GetUserTypes:input_type -> user.GetUserTypesRequest
-	16, // 18: user.UserService.CreateTutorProfile:input_type -> user.CreateTutorProfileRequest
-	17, // 19: user.UserService.GetTutorProfile:input_type -> user.GetTutorProfileRequest
-	18, // 20: user.UserService.UpdateTutorProfile:input_type -> user.UpdateTutorProfileRequest
-	19, // 21: user.UserService.DeleteTutorProfile:input_type -> user.DeleteTutorProfileRequest
-	21, // 22: user.UserService.CreateStudentProfile:input_type -> user.CreateStudentProfileRequest
-	22, // 23: user.UserService.GetStudentProfile:input_type -> user.GetStudentProfileRequest
-	23, // 24: user.UserService.UpdateStudentProfile:input_type -> user.UpdateStudentProfileRequest
-	24, // 25: user.UserService.DeleteStudentProfile:input_type -> user.DeleteStudentProfileRequest
-	26, // 26: user.UserService.ValidateTutor:input_type -> user.ValidateTutorRequest
-	28, // 27: user.UserService.GetCompliteUserProfile:input_type -> user.GetCompliteUserProfileRequest
-	10, // 28: user.UserService.CreateUserProfile:output_type -> user.UserProfileResponse
-	10, // 29: user.UserService.GetUserProfileByID:output_type -> user.UserProfileResponse
-	10, // 30: user.UserService.GetUserProfileByEmail:output_type -> user.UserProfileResponse
-	10, // 31: user.UserService.UpdateUserProfile:output_type -> user.UserProfileResponse
-	0,  // 32: user.UserService.DeleteUserProfile:output_type -> user.EmptyResponse
-	12, // 33: user.UserService.ListUsers:output_type -> user.ListUserProfilesResponse
-	15, // 34: user.UserService.GetUserTypes:output_type -> user.UserTypesResponse
-	20, // 35: user.UserService.CreateTutorProfile:output_type -> user.TutorProfileResponse
-	20, // 36: user.UserService.GetTutorProfile:output_type -> user.TutorProfileResponse
-	20, // 37: user.UserService.UpdateTutorProfile:output_type -> user.TutorProfileResponse
-	0,  // 38: user.UserService.DeleteTutorProfile:output_type -> user.EmptyResponse
-	25, // 39: user.UserService.CreateStudentProfile:output_type -> user.StudentProfileResponse
-	25, // 40: user.UserService.GetStudentProfile:output_type -> user.StudentProfileResponse
-	25, // 41: user.UserService.UpdateStudentProfile:output_type -> user.StudentProfileResponse
-	0,  // 42: user.UserService.DeleteStudentProfile:output_type -> user.EmptyResponse
-	27, // 43: user.UserService.ValidateTutor:output_type -> user.ValidateTutorResponse
-	29, // 44: user.UserService.GetCompliteUserProfile:output_type -> user.GetCompliteUserProfileResponse
-	28, // [28:45] is the sub-list for method output_type
-	11, // [11:28] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	69, // 0: user.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	69, // 1: user.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: user.TutorProfile.verification_status:type_name -> user.TutorVerificationStatus
+	69, // 3: user.StudentProfile.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: user.UserProfileResponse.profile:type_name -> user.UserProfile
+	0,  // 5: user.ListUserProfilesRequest.role:type_name -> user.UserRoleFilter
+	69, // 6: user.ListUserProfilesRequest.created_from:type_name -> google.protobuf.Timestamp
+	69, // 7: user.ListUserProfilesRequest.created_to:type_name -> google.protobuf.Timestamp
+	1,  // 8: user.ListUserProfilesRequest.sort_by:type_name -> user.ProfileSortField
+	2,  // 9: user.ListUserProfilesRequest.sort_direction:type_name -> user.SortDirection
+	7,  // 10: user.ListUserProfilesResponse.users:type_name -> user.UserProfile
+	18, // 11: user.UserTypesResponse.types:type_name -> user.UserTypes
+	8,  // 12: user.TutorProfileResponse.profile:type_name -> user.TutorProfile
+	9,  // 13: user.StudentProfileResponse.profile:type_name -> user.StudentProfile
+	7,  // 14: user.GetCompliteUserProfileResponse.user_profile:type_name -> user.UserProfile
+	8,  // 15: user.GetCompliteUserProfileResponse.tutor_profile:type_name -> user.TutorProfile
+	9,  // 16: user.GetCompliteUserProfileResponse.student_profile:type_name -> user.StudentProfile
+	69, // 17: user.TutorReview.replied_at:type_name -> google.protobuf.Timestamp
+	69, // 18: user.TutorReview.created_at:type_name -> google.protobuf.Timestamp
+	69, // 19: user.TutorReview.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 20: user.TutorReviewResponse.review:type_name -> user.TutorReview
+	35, // 21: user.ListTutorReviewsResponse.reviews:type_name -> user.TutorReview
+	3,  // 22: user.GuardianLink.status:type_name -> user.GuardianLinkStatus
+	69, // 23: user.GuardianLink.invited_at:type_name -> google.protobuf.Timestamp
+	69, // 24: user.GuardianLink.consented_at:type_name -> google.protobuf.Timestamp
+	69, // 25: user.GuardianLink.revoked_at:type_name -> google.protobuf.Timestamp
+	44, // 26: user.GuardianLinkResponse.link:type_name -> user.GuardianLink
+	44, // 27: user.ListGuardianLinksResponse.links:type_name -> user.GuardianLink
+	7,  // 28: user.ResolvedUser.profile:type_name -> user.UserProfile
+	18, // 29: user.ResolvedUser.types:type_name -> user.UserTypes
+	56, // 30: user.ResolveUsersResponse.users:type_name -> user.ResolvedUser
+	69, // 31: user.ReconcileProfilesResponse.started_at:type_name -> google.protobuf.Timestamp
+	69, // 32: user.ReconcileProfilesResponse.finished_at:type_name -> google.protobuf.Timestamp
+	59, // 33: user.ReconcileProfilesResponse.records:type_name -> user.DriftRecord
+	69, // 34: user.VerificationDocument.uploaded_at:type_name -> google.protobuf.Timestamp
+	4,  // 35: user.TutorVerification.status:type_name -> user.TutorVerificationStatus
+	61, // 36: user.TutorVerification.documents:type_name -> user.VerificationDocument
+	69, // 37: user.TutorVerification.submitted_at:type_name -> google.protobuf.Timestamp
+	69, // 38: user.TutorVerification.reviewed_at:type_name -> google.protobuf.Timestamp
+	61, // 39: user.SubmitTutorVerificationRequest.documents:type_name -> user.VerificationDocument
+	4,  // 40: user.ListTutorVerificationsRequest.status:type_name -> user.TutorVerificationStatus
+	62, // 41: user.TutorVerificationResponse.verification:type_name -> user.TutorVerification
+	62, // 42: user.ListTutorVerificationsResponse.verifications:type_name -> user.TutorVerification
+	10, // 43: user.UserService.CreateUserProfile:input_type -> user.CreateUserProfileRequest
+	11, // 44: user.UserService.GetUserProfileByID:input_type -> user.GetUserProfileByIDRequest
+	12, // 45: user.UserService.GetUserProfileByEmail:input_type -> user.GetUserProfileByEmailRequest
+	13, // 46: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
+	14, // 47: user.UserService.DeleteUserProfile:input_type -> user.DeleteUserProfileRequest
+	16, // 48: user.UserService.ListUsers:input_type -> user.ListUserProfilesRequest
+	19, // 49: user.UserService.GetUserTypes:input_type -> user.GetUserTypesRequest
+	21, // 50: user.UserService.CreateTutorProfile:input_type -> user.CreateTutorProfileRequest
+	22, // 51: user.UserService.GetTutorProfile:input_type -> user.GetTutorProfileRequest
+	23, // 52: user.UserService.UpdateTutorProfile:input_type -> user.UpdateTutorProfileRequest
+	24, // 53: user.UserService.DeleteTutorProfile:input_type -> user.DeleteTutorProfileRequest
+	26, // 54: user.UserService.CreateStudentProfile:input_type -> user.CreateStudentProfileRequest
+	27, // 55: user.UserService.GetStudentProfile:input_type -> user.GetStudentProfileRequest
+	28, // 56: user.UserService.UpdateStudentProfile:input_type -> user.UpdateStudentProfileRequest
+	29, // 57: user.UserService.DeleteStudentProfile:input_type -> user.DeleteStudentProfileRequest
+	63, // 58: user.UserService.SubmitTutorVerification:input_type -> user.SubmitTutorVerificationRequest
+	64, // 59: user.UserService.GetTutorVerification:input_type -> user.GetTutorVerificationRequest
+	65, // 60: user.UserService.ReviewTutorVerification:input_type -> user.ReviewTutorVerificationRequest
+	66, // 61: user.UserService.ListTutorVerifications:input_type -> user.ListTutorVerificationsRequest
+	31, // 62: user.UserService.ValidateTutor:input_type -> user.ValidateTutorRequest
+	33, // 63: user.UserService.GetCompliteUserProfile:input_type -> user.GetCompliteUserProfileRequest
+	36, // 64: user.UserService.CreateTutorReview:input_type -> user.CreateTutorReviewRequest
+	42, // 65: user.UserService.ListTutorReviews:input_type -> user.ListTutorReviewsRequest
+	37, // 66: user.UserService.UpdateTutorReview:input_type -> user.UpdateTutorReviewRequest
+	38, // 67: user.UserService.DeleteTutorReview:input_type -> user.DeleteTutorReviewRequest
+	39, // 68: user.UserService.ReplyToTutorReview:input_type -> user.ReplyToTutorReviewRequest
+	40, // 69: user.UserService.ModerateTutorReview:input_type -> user.ModerateTutorReviewRequest
+	45, // 70: user.UserService.InviteGuardian:input_type -> user.InviteGuardianRequest
+	46, // 71: user.UserService.AcceptGuardianInvitation:input_type -> user.AcceptGuardianInvitationRequest
+	47, // 72: user.UserService.RevokeGuardianLink:input_type -> user.RevokeGuardianLinkRequest
+	49, // 73: user.UserService.ListGuardianLinks:input_type -> user.ListGuardianLinksRequest
+	51, // 74: user.UserService.CheckGuardianAccess:input_type -> user.CheckGuardianAccessRequest
+	53, // 75: user.UserService.GetUserPreferences:input_type -> user.GetUserPreferencesRequest
+	58, // 76: user.UserService.ReconcileProfiles:input_type -> user.ReconcileProfilesRequest
+	55, // 77: user.UserService.ResolveUsers:input_type -> user.ResolveUsersRequest
+	15, // 78: user.UserService.CreateUserProfile:output_type -> user.UserProfileResponse
+	15, // 79: user.UserService.GetUserProfileByID:output_type -> user.UserProfileResponse
+	15, // 80: user.UserService.GetUserProfileByEmail:output_type -> user.UserProfileResponse
+	15, // 81: user.UserService.UpdateUserProfile:output_type -> user.UserProfileResponse
+	5,  // 82: user.UserService.DeleteUserProfile:output_type -> user.EmptyResponse
+	17, // 83: user.UserService.ListUsers:output_type -> user.ListUserProfilesResponse
+	20, // 84: user.UserService.GetUserTypes:output_type -> user.UserTypesResponse
+	25, // 85: user.UserService.CreateTutorProfile:output_type -> user.TutorProfileResponse
+	25, // 86: user.UserService.GetTutorProfile:output_type -> user.TutorProfileResponse
+	25, // 87: user.UserService.UpdateTutorProfile:output_type -> user.TutorProfileResponse
+	5,  // 88: user.UserService.DeleteTutorProfile:output_type -> user.EmptyResponse
+	30, // 89: user.UserService.CreateStudentProfile:output_type -> user.StudentProfileResponse
+	30, // 90: user.UserService.GetStudentProfile:output_type -> user.StudentProfileResponse
+	30, // 91: user.UserService.UpdateStudentProfile:output_type -> user.StudentProfileResponse
+	5,  // 92: user.UserService.DeleteStudentProfile:output_type -> user.EmptyResponse
+	67, // 93: user.UserService.SubmitTutorVerification:output_type -> user.TutorVerificationResponse
+	67, // 94: user.UserService.GetTutorVerification:output_type -> user.TutorVerificationResponse
+	67, // 95: user.UserService.ReviewTutorVerification:output_type -> user.TutorVerificationResponse
+	68, // 96: user.UserService.ListTutorVerifications:output_type -> user.ListTutorVerificationsResponse
+	32, // 97: user.UserService.ValidateTutor:output_type -> user.ValidateTutorResponse
+	34, // 98: user.UserService.GetCompliteUserProfile:output_type -> user.GetCompliteUserProfileResponse
+	41, // 99: user.UserService.CreateTutorReview:output_type -> user.TutorReviewResponse
+	43, // 100: user.UserService.ListTutorReviews:output_type -> user.ListTutorReviewsResponse
+	41, // 101: user.UserService.UpdateTutorReview:output_type -> user.TutorReviewResponse
+	5,  // 102: user.UserService.DeleteTutorReview:output_type -> user.EmptyResponse
+	41, // 103: user.UserService.ReplyToTutorReview:output_type -> user.TutorReviewResponse
+	41, // 104: user.UserService.ModerateTutorReview:output_type -> user.TutorReviewResponse
+	48, // 105: user.UserService.InviteGuardian:output_type -> user.GuardianLinkResponse
+	48, // 106: user.UserService.AcceptGuardianInvitation:output_type -> user.GuardianLinkResponse
+	5,  // 107: user.UserService.RevokeGuardianLink:output_type -> user.EmptyResponse
+	50, // 108: user.UserService.ListGuardianLinks:output_type -> user.ListGuardianLinksResponse
+	52, // 109: user.UserService.CheckGuardianAccess:output_type -> user.CheckGuardianAccessResponse
+	54, // 110: user.UserService.GetUserPreferences:output_type -> user.GetUserPreferencesResponse
+	60, // 111: user.UserService.ReconcileProfiles:output_type -> user.ReconcileProfilesResponse
+	57, // 112: user.UserService.ResolveUsers:output_type -> user.ResolveUsersResponse
+	78, // [78:113] is the sub-list for method output_type
+	43, // [43:78] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_user_user_service_proto_init() }
@@ -1873,13 +4609,14 @@ func file_user_user_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_user_service_proto_rawDesc), len(file_user_user_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   30,
+			NumEnums:      5,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_user_service_proto_goTypes,
 		DependencyIndexes: file_user_user_service_proto_depIdxs,
+		EnumInfos:         file_user_user_service_proto_enumTypes,
 		MessageInfos:      file_user_user_service_proto_msgTypes,
 	}.Build()
 	File_user_user_service_proto = out.File
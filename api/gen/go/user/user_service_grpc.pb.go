@@ -19,23 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUserProfile_FullMethodName      = "/user.UserService/CreateUserProfile"
-	UserService_GetUserProfileByID_FullMethodName     = "/user.UserService/GetUserProfileByID"
-	UserService_GetUserProfileByEmail_FullMethodName  = "/user.UserService/GetUserProfileByEmail"
-	UserService_UpdateUserProfile_FullMethodName      = "/user.UserService/UpdateUserProfile"
-	UserService_DeleteUserProfile_FullMethodName      = "/user.UserService/DeleteUserProfile"
-	UserService_ListUsers_FullMethodName              = "/user.UserService/ListUsers"
-	UserService_GetUserTypes_FullMethodName           = "/user.UserService/GetUserTypes"
-	UserService_CreateTutorProfile_FullMethodName     = "/user.UserService/CreateTutorProfile"
-	UserService_GetTutorProfile_FullMethodName        = "/user.UserService/GetTutorProfile"
-	UserService_UpdateTutorProfile_FullMethodName     = "/user.UserService/UpdateTutorProfile"
-	UserService_DeleteTutorProfile_FullMethodName     = "/user.UserService/DeleteTutorProfile"
-	UserService_CreateStudentProfile_FullMethodName   = "/user.UserService/CreateStudentProfile"
-	UserService_GetStudentProfile_FullMethodName      = "/user.UserService/GetStudentProfile"
-	UserService_UpdateStudentProfile_FullMethodName   = "/user.UserService/UpdateStudentProfile"
-	UserService_DeleteStudentProfile_FullMethodName   = "/user.UserService/DeleteStudentProfile"
-	UserService_ValidateTutor_FullMethodName          = "/user.UserService/ValidateTutor"
-	UserService_GetCompliteUserProfile_FullMethodName = "/user.UserService/GetCompliteUserProfile"
+	UserService_CreateUserProfile_FullMethodName        = "/user.UserService/CreateUserProfile"
+	UserService_GetUserProfileByID_FullMethodName       = "/user.UserService/GetUserProfileByID"
+	UserService_GetUserProfileByEmail_FullMethodName    = "/user.UserService/GetUserProfileByEmail"
+	UserService_UpdateUserProfile_FullMethodName        = "/user.UserService/UpdateUserProfile"
+	UserService_DeleteUserProfile_FullMethodName        = "/user.UserService/DeleteUserProfile"
+	UserService_ListUsers_FullMethodName                = "/user.UserService/ListUsers"
+	UserService_GetUserTypes_FullMethodName             = "/user.UserService/GetUserTypes"
+	UserService_CreateTutorProfile_FullMethodName       = "/user.UserService/CreateTutorProfile"
+	UserService_GetTutorProfile_FullMethodName          = "/user.UserService/GetTutorProfile"
+	UserService_UpdateTutorProfile_FullMethodName       = "/user.UserService/UpdateTutorProfile"
+	UserService_DeleteTutorProfile_FullMethodName       = "/user.UserService/DeleteTutorProfile"
+	UserService_CreateStudentProfile_FullMethodName     = "/user.UserService/CreateStudentProfile"
+	UserService_GetStudentProfile_FullMethodName        = "/user.UserService/GetStudentProfile"
+	UserService_UpdateStudentProfile_FullMethodName     = "/user.UserService/UpdateStudentProfile"
+	UserService_DeleteStudentProfile_FullMethodName     = "/user.UserService/DeleteStudentProfile"
+	UserService_SubmitTutorVerification_FullMethodName  = "/user.UserService/SubmitTutorVerification"
+	UserService_GetTutorVerification_FullMethodName     = "/user.UserService/GetTutorVerification"
+	UserService_ReviewTutorVerification_FullMethodName  = "/user.UserService/ReviewTutorVerification"
+	UserService_ListTutorVerifications_FullMethodName   = "/user.UserService/ListTutorVerifications"
+	UserService_ValidateTutor_FullMethodName            = "/user.UserService/ValidateTutor"
+	UserService_GetCompliteUserProfile_FullMethodName   = "/user.UserService/GetCompliteUserProfile"
+	UserService_CreateTutorReview_FullMethodName        = "/user.UserService/CreateTutorReview"
+	UserService_ListTutorReviews_FullMethodName         = "/user.UserService/ListTutorReviews"
+	UserService_UpdateTutorReview_FullMethodName        = "/user.UserService/UpdateTutorReview"
+	UserService_DeleteTutorReview_FullMethodName        = "/user.UserService/DeleteTutorReview"
+	UserService_ReplyToTutorReview_FullMethodName       = "/user.UserService/ReplyToTutorReview"
+	UserService_ModerateTutorReview_FullMethodName      = "/user.UserService/ModerateTutorReview"
+	UserService_InviteGuardian_FullMethodName           = "/user.UserService/InviteGuardian"
+	UserService_AcceptGuardianInvitation_FullMethodName = "/user.UserService/AcceptGuardianInvitation"
+	UserService_RevokeGuardianLink_FullMethodName       = "/user.UserService/RevokeGuardianLink"
+	UserService_ListGuardianLinks_FullMethodName        = "/user.UserService/ListGuardianLinks"
+	UserService_CheckGuardianAccess_FullMethodName      = "/user.UserService/CheckGuardianAccess"
+	UserService_GetUserPreferences_FullMethodName       = "/user.UserService/GetUserPreferences"
+	UserService_ReconcileProfiles_FullMethodName        = "/user.UserService/ReconcileProfiles"
+	UserService_ResolveUsers_FullMethodName             = "/user.UserService/ResolveUsers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -61,10 +79,35 @@ type UserServiceClient interface {
 	GetStudentProfile(ctx context.Context, in *GetStudentProfileRequest, opts ...grpc.CallOption) (*StudentProfileResponse, error)
 	UpdateStudentProfile(ctx context.Context, in *UpdateStudentProfileRequest, opts ...grpc.CallOption) (*StudentProfileResponse, error)
 	DeleteStudentProfile(ctx context.Context, in *DeleteStudentProfileRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// валидация
+	// Проверка репетиторов: документы от репетитора, решение администратора
+	SubmitTutorVerification(ctx context.Context, in *SubmitTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error)
+	GetTutorVerification(ctx context.Context, in *GetTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error)
+	ReviewTutorVerification(ctx context.Context, in *ReviewTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error)
+	ListTutorVerifications(ctx context.Context, in *ListTutorVerificationsRequest, opts ...grpc.CallOption) (*ListTutorVerificationsResponse, error)
+	// валидация: true только для проверенных репетиторов
 	ValidateTutor(ctx context.Context, in *ValidateTutorRequest, opts ...grpc.CallOption) (*ValidateTutorResponse, error)
 	// получить полный профиль юзера
 	GetCompliteUserProfile(ctx context.Context, in *GetCompliteUserProfileRequest, opts ...grpc.CallOption) (*GetCompliteUserProfileResponse, error)
+	// Отзывы о репетиторах
+	CreateTutorReview(ctx context.Context, in *CreateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error)
+	ListTutorReviews(ctx context.Context, in *ListTutorReviewsRequest, opts ...grpc.CallOption) (*ListTutorReviewsResponse, error)
+	UpdateTutorReview(ctx context.Context, in *UpdateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error)
+	DeleteTutorReview(ctx context.Context, in *DeleteTutorReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ReplyToTutorReview(ctx context.Context, in *ReplyToTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error)
+	ModerateTutorReview(ctx context.Context, in *ModerateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error)
+	// Родители / опекуны учеников
+	InviteGuardian(ctx context.Context, in *InviteGuardianRequest, opts ...grpc.CallOption) (*GuardianLinkResponse, error)
+	AcceptGuardianInvitation(ctx context.Context, in *AcceptGuardianInvitationRequest, opts ...grpc.CallOption) (*GuardianLinkResponse, error)
+	RevokeGuardianLink(ctx context.Context, in *RevokeGuardianLinkRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListGuardianLinks(ctx context.Context, in *ListGuardianLinksRequest, opts ...grpc.CallOption) (*ListGuardianLinksResponse, error)
+	// проверка доступа опекуна, вызывается другими сервисами
+	CheckGuardianAccess(ctx context.Context, in *CheckGuardianAccessRequest, opts ...grpc.CallOption) (*CheckGuardianAccessResponse, error)
+	// часовой пояс и язык пользователя, вызывается другими сервисами
+	GetUserPreferences(ctx context.Context, in *GetUserPreferencesRequest, opts ...grpc.CallOption) (*GetUserPreferencesResponse, error)
+	// сверка профилей с auth-service по запросу администратора, через gateway не публикуется
+	ReconcileProfiles(ctx context.Context, in *ReconcileProfilesRequest, opts ...grpc.CallOption) (*ReconcileProfilesResponse, error)
+	// поиск профилей по ID и email вместе с ролями, вызывается другими сервисами
+	ResolveUsers(ctx context.Context, in *ResolveUsersRequest, opts ...grpc.CallOption) (*ResolveUsersResponse, error)
 }
 
 type userServiceClient struct {
@@ -225,6 +268,46 @@ func (c *userServiceClient) DeleteStudentProfile(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *userServiceClient) SubmitTutorVerification(ctx context.Context, in *SubmitTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_SubmitTutorVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTutorVerification(ctx context.Context, in *GetTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTutorVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReviewTutorVerification(ctx context.Context, in *ReviewTutorVerificationRequest, opts ...grpc.CallOption) (*TutorVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_ReviewTutorVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTutorVerifications(ctx context.Context, in *ListTutorVerificationsRequest, opts ...grpc.CallOption) (*ListTutorVerificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTutorVerificationsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTutorVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ValidateTutor(ctx context.Context, in *ValidateTutorRequest, opts ...grpc.CallOption) (*ValidateTutorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateTutorResponse)
@@ -245,6 +328,146 @@ func (c *userServiceClient) GetCompliteUserProfile(ctx context.Context, in *GetC
 	return out, nil
 }
 
+func (c *userServiceClient) CreateTutorReview(ctx context.Context, in *CreateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorReviewResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateTutorReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTutorReviews(ctx context.Context, in *ListTutorReviewsRequest, opts ...grpc.CallOption) (*ListTutorReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTutorReviewsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTutorReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateTutorReview(ctx context.Context, in *UpdateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorReviewResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateTutorReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteTutorReview(ctx context.Context, in *DeleteTutorReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteTutorReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReplyToTutorReview(ctx context.Context, in *ReplyToTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorReviewResponse)
+	err := c.cc.Invoke(ctx, UserService_ReplyToTutorReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ModerateTutorReview(ctx context.Context, in *ModerateTutorReviewRequest, opts ...grpc.CallOption) (*TutorReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorReviewResponse)
+	err := c.cc.Invoke(ctx, UserService_ModerateTutorReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) InviteGuardian(ctx context.Context, in *InviteGuardianRequest, opts ...grpc.CallOption) (*GuardianLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardianLinkResponse)
+	err := c.cc.Invoke(ctx, UserService_InviteGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptGuardianInvitation(ctx context.Context, in *AcceptGuardianInvitationRequest, opts ...grpc.CallOption) (*GuardianLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardianLinkResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptGuardianInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeGuardianLink(ctx context.Context, in *RevokeGuardianLinkRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeGuardianLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListGuardianLinks(ctx context.Context, in *ListGuardianLinksRequest, opts ...grpc.CallOption) (*ListGuardianLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuardianLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGuardianLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckGuardianAccess(ctx context.Context, in *CheckGuardianAccessRequest, opts ...grpc.CallOption) (*CheckGuardianAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckGuardianAccessResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckGuardianAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserPreferences(ctx context.Context, in *GetUserPreferencesRequest, opts ...grpc.CallOption) (*GetUserPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReconcileProfiles(ctx context.Context, in *ReconcileProfilesRequest, opts ...grpc.CallOption) (*ReconcileProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileProfilesResponse)
+	err := c.cc.Invoke(ctx, UserService_ReconcileProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResolveUsers(ctx context.Context, in *ResolveUsersRequest, opts ...grpc.CallOption) (*ResolveUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ResolveUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -268,10 +491,35 @@ type UserServiceServer interface {
 	GetStudentProfile(context.Context, *GetStudentProfileRequest) (*StudentProfileResponse, error)
 	UpdateStudentProfile(context.Context, *UpdateStudentProfileRequest) (*StudentProfileResponse, error)
 	DeleteStudentProfile(context.Context, *DeleteStudentProfileRequest) (*EmptyResponse, error)
-	// валидация
+	// Проверка репетиторов: документы от репетитора, решение администратора
+	SubmitTutorVerification(context.Context, *SubmitTutorVerificationRequest) (*TutorVerificationResponse, error)
+	GetTutorVerification(context.Context, *GetTutorVerificationRequest) (*TutorVerificationResponse, error)
+	ReviewTutorVerification(context.Context, *ReviewTutorVerificationRequest) (*TutorVerificationResponse, error)
+	ListTutorVerifications(context.Context, *ListTutorVerificationsRequest) (*ListTutorVerificationsResponse, error)
+	// валидация: true только для проверенных репетиторов
 	ValidateTutor(context.Context, *ValidateTutorRequest) (*ValidateTutorResponse, error)
 	// получить полный профиль юзера
 	GetCompliteUserProfile(context.Context, *GetCompliteUserProfileRequest) (*GetCompliteUserProfileResponse, error)
+	// Отзывы о репетиторах
+	CreateTutorReview(context.Context, *CreateTutorReviewRequest) (*TutorReviewResponse, error)
+	ListTutorReviews(context.Context, *ListTutorReviewsRequest) (*ListTutorReviewsResponse, error)
+	UpdateTutorReview(context.Context, *UpdateTutorReviewRequest) (*TutorReviewResponse, error)
+	DeleteTutorReview(context.Context, *DeleteTutorReviewRequest) (*EmptyResponse, error)
+	ReplyToTutorReview(context.Context, *ReplyToTutorReviewRequest) (*TutorReviewResponse, error)
+	ModerateTutorReview(context.Context, *ModerateTutorReviewRequest) (*TutorReviewResponse, error)
+	// Родители / опекуны учеников
+	InviteGuardian(context.Context, *InviteGuardianRequest) (*GuardianLinkResponse, error)
+	AcceptGuardianInvitation(context.Context, *AcceptGuardianInvitationRequest) (*GuardianLinkResponse, error)
+	RevokeGuardianLink(context.Context, *RevokeGuardianLinkRequest) (*EmptyResponse, error)
+	ListGuardianLinks(context.Context, *ListGuardianLinksRequest) (*ListGuardianLinksResponse, error)
+	// проверка доступа опекуна, вызывается другими сервисами
+	CheckGuardianAccess(context.Context, *CheckGuardianAccessRequest) (*CheckGuardianAccessResponse, error)
+	// часовой пояс и язык пользователя, вызывается другими сервисами
+	GetUserPreferences(context.Context, *GetUserPreferencesRequest) (*GetUserPreferencesResponse, error)
+	// сверка профилей с auth-service по запросу администратора, через gateway не публикуется
+	ReconcileProfiles(context.Context, *ReconcileProfilesRequest) (*ReconcileProfilesResponse, error)
+	// поиск профилей по ID и email вместе с ролями, вызывается другими сервисами
+	ResolveUsers(context.Context, *ResolveUsersRequest) (*ResolveUsersResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -327,12 +575,66 @@ func (UnimplementedUserServiceServer) UpdateStudentProfile(context.Context, *Upd
 func (UnimplementedUserServiceServer) DeleteStudentProfile(context.Context, *DeleteStudentProfileRequest) (*EmptyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteStudentProfile not implemented")
 }
+func (UnimplementedUserServiceServer) SubmitTutorVerification(context.Context, *SubmitTutorVerificationRequest) (*TutorVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitTutorVerification not implemented")
+}
+func (UnimplementedUserServiceServer) GetTutorVerification(context.Context, *GetTutorVerificationRequest) (*TutorVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTutorVerification not implemented")
+}
+func (UnimplementedUserServiceServer) ReviewTutorVerification(context.Context, *ReviewTutorVerificationRequest) (*TutorVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewTutorVerification not implemented")
+}
+func (UnimplementedUserServiceServer) ListTutorVerifications(context.Context, *ListTutorVerificationsRequest) (*ListTutorVerificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTutorVerifications not implemented")
+}
 func (UnimplementedUserServiceServer) ValidateTutor(context.Context, *ValidateTutorRequest) (*ValidateTutorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateTutor not implemented")
 }
 func (UnimplementedUserServiceServer) GetCompliteUserProfile(context.Context, *GetCompliteUserProfileRequest) (*GetCompliteUserProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCompliteUserProfile not implemented")
 }
+func (UnimplementedUserServiceServer) CreateTutorReview(context.Context, *CreateTutorReviewRequest) (*TutorReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTutorReview not implemented")
+}
+func (UnimplementedUserServiceServer) ListTutorReviews(context.Context, *ListTutorReviewsRequest) (*ListTutorReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTutorReviews not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateTutorReview(context.Context, *UpdateTutorReviewRequest) (*TutorReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTutorReview not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteTutorReview(context.Context, *DeleteTutorReviewRequest) (*EmptyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTutorReview not implemented")
+}
+func (UnimplementedUserServiceServer) ReplyToTutorReview(context.Context, *ReplyToTutorReviewRequest) (*TutorReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplyToTutorReview not implemented")
+}
+func (UnimplementedUserServiceServer) ModerateTutorReview(context.Context, *ModerateTutorReviewRequest) (*TutorReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModerateTutorReview not implemented")
+}
+func (UnimplementedUserServiceServer) InviteGuardian(context.Context, *InviteGuardianRequest) (*GuardianLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteGuardian not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptGuardianInvitation(context.Context, *AcceptGuardianInvitationRequest) (*GuardianLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptGuardianInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeGuardianLink(context.Context, *RevokeGuardianLinkRequest) (*EmptyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeGuardianLink not implemented")
+}
+func (UnimplementedUserServiceServer) ListGuardianLinks(context.Context, *ListGuardianLinksRequest) (*ListGuardianLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGuardianLinks not implemented")
+}
+func (UnimplementedUserServiceServer) CheckGuardianAccess(context.Context, *CheckGuardianAccessRequest) (*CheckGuardianAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckGuardianAccess not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserPreferences(context.Context, *GetUserPreferencesRequest) (*GetUserPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) ReconcileProfiles(context.Context, *ReconcileProfilesRequest) (*ReconcileProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileProfiles not implemented")
+}
+func (UnimplementedUserServiceServer) ResolveUsers(context.Context, *ResolveUsersRequest) (*ResolveUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveUsers not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -624,6 +926,78 @@ func _UserService_DeleteStudentProfile_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SubmitTutorVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTutorVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SubmitTutorVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SubmitTutorVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SubmitTutorVerification(ctx, req.(*SubmitTutorVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTutorVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTutorVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTutorVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTutorVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTutorVerification(ctx, req.(*GetTutorVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReviewTutorVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewTutorVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReviewTutorVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReviewTutorVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReviewTutorVerification(ctx, req.(*ReviewTutorVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTutorVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTutorVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTutorVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTutorVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTutorVerifications(ctx, req.(*ListTutorVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ValidateTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateTutorRequest)
 	if err := dec(in); err != nil {
@@ -660,6 +1034,258 @@ func _UserService_GetCompliteUserProfile_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateTutorReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTutorReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTutorReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateTutorReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTutorReview(ctx, req.(*CreateTutorReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTutorReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTutorReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTutorReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTutorReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTutorReviews(ctx, req.(*ListTutorReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateTutorReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTutorReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateTutorReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateTutorReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateTutorReview(ctx, req.(*UpdateTutorReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteTutorReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTutorReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteTutorReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteTutorReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteTutorReview(ctx, req.(*DeleteTutorReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReplyToTutorReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToTutorReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReplyToTutorReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReplyToTutorReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReplyToTutorReview(ctx, req.(*ReplyToTutorReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ModerateTutorReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateTutorReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ModerateTutorReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ModerateTutorReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ModerateTutorReview(ctx, req.(*ModerateTutorReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_InviteGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InviteGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InviteGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InviteGuardian(ctx, req.(*InviteGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptGuardianInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGuardianInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptGuardianInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptGuardianInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptGuardianInvitation(ctx, req.(*AcceptGuardianInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeGuardianLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGuardianLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeGuardianLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeGuardianLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeGuardianLink(ctx, req.(*RevokeGuardianLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListGuardianLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuardianLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGuardianLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGuardianLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGuardianLinks(ctx, req.(*ListGuardianLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckGuardianAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckGuardianAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckGuardianAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckGuardianAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckGuardianAccess(ctx, req.(*CheckGuardianAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserPreferences(ctx, req.(*GetUserPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReconcileProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReconcileProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReconcileProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReconcileProfiles(ctx, req.(*ReconcileProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveUsers(ctx, req.(*ResolveUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -727,6 +1353,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStudentProfile",
 			Handler:    _UserService_DeleteStudentProfile_Handler,
 		},
+		{
+			MethodName: "SubmitTutorVerification",
+			Handler:    _UserService_SubmitTutorVerification_Handler,
+		},
+		{
+			MethodName: "GetTutorVerification",
+			Handler:    _UserService_GetTutorVerification_Handler,
+		},
+		{
+			MethodName: "ReviewTutorVerification",
+			Handler:    _UserService_ReviewTutorVerification_Handler,
+		},
+		{
+			MethodName: "ListTutorVerifications",
+			Handler:    _UserService_ListTutorVerifications_Handler,
+		},
 		{
 			MethodName: "ValidateTutor",
 			Handler:    _UserService_ValidateTutor_Handler,
@@ -735,6 +1377,62 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompliteUserProfile",
 			Handler:    _UserService_GetCompliteUserProfile_Handler,
 		},
+		{
+			MethodName: "CreateTutorReview",
+			Handler:    _UserService_CreateTutorReview_Handler,
+		},
+		{
+			MethodName: "ListTutorReviews",
+			Handler:    _UserService_ListTutorReviews_Handler,
+		},
+		{
+			MethodName: "UpdateTutorReview",
+			Handler:    _UserService_UpdateTutorReview_Handler,
+		},
+		{
+			MethodName: "DeleteTutorReview",
+			Handler:    _UserService_DeleteTutorReview_Handler,
+		},
+		{
+			MethodName: "ReplyToTutorReview",
+			Handler:    _UserService_ReplyToTutorReview_Handler,
+		},
+		{
+			MethodName: "ModerateTutorReview",
+			Handler:    _UserService_ModerateTutorReview_Handler,
+		},
+		{
+			MethodName: "InviteGuardian",
+			Handler:    _UserService_InviteGuardian_Handler,
+		},
+		{
+			MethodName: "AcceptGuardianInvitation",
+			Handler:    _UserService_AcceptGuardianInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeGuardianLink",
+			Handler:    _UserService_RevokeGuardianLink_Handler,
+		},
+		{
+			MethodName: "ListGuardianLinks",
+			Handler:    _UserService_ListGuardianLinks_Handler,
+		},
+		{
+			MethodName: "CheckGuardianAccess",
+			Handler:    _UserService_CheckGuardianAccess_Handler,
+		},
+		{
+			MethodName: "GetUserPreferences",
+			Handler:    _UserService_GetUserPreferences_Handler,
+		},
+		{
+			MethodName: "ReconcileProfiles",
+			Handler:    _UserService_ReconcileProfiles_Handler,
+		},
+		{
+			MethodName: "ResolveUsers",
+			Handler:    _UserService_ResolveUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/user_service.proto",
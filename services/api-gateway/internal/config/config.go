@@ -18,6 +18,9 @@ type Config struct {
 	GroupGRPC string `env:"GROUP_GRPC" env-default:"group-go:50051"`
 	UserGRPC  string `env:"USER_GRPC" env-default:"user-go:50051"`
 	TasksGRPC string `env:"TASKS_GRPC" env-default:"tasks-go:50051"`
+
+	// подпись токенов потока чата, общая для всех экземпляров gateway
+	StreamTokenSecret string `env:"STREAM_TOKEN_SECRET" env-required:"true"`
 }
 
 func ParseConfigFromEnv() (*Config, error) {
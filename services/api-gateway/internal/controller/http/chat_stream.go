@@ -0,0 +1,142 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	groupv1 "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// heartbeat не дает прокси закрыть простаивающее соединение
+const chatStreamHeartbeat = 25 * time.Second
+
+// handleGroupMessagesStream отдает браузеру поток SubscribeGroupMessages как Server-Sent Events
+func (s *Server) handleGroupMessagesStream(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Shutdown не прерывает открытые запросы, поэтому потоки закрываются отдельно
+	go func() {
+		select {
+		case <-s.streamsDone:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", r.Header.Get("X-User-Id"))
+	stream, err := s.groupClient.SubscribeGroupMessages(ctx, &groupv1.SubscribeGroupMessagesRequest{
+		GroupId: r.PathValue("group_id"),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	// group-service отправляет заголовки после успешной подписки, иначе поток завершается с ошибкой.
+	// Без заголовков подписка проверяется первым событием, оно отправляется клиенту первым.
+	var first *groupv1.GroupMessageEvent
+	if md, err := stream.Header(); err != nil || md == nil {
+		if first, err = stream.Recv(); err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	// WriteTimeout сервера рассчитан на обычные запросы
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Printf("chat stream: failed to reset write deadline: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		log.Printf("chat stream: flush is not supported: %v", err)
+		return
+	}
+
+	events := make(chan *groupv1.GroupMessageEvent)
+	recvErr := make(chan error, 1)
+	go func() {
+		if first != nil {
+			select {
+			case events <- first:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	_, marshaler := runtime.MarshalerForRequest(s.gwMux, r)
+	heartbeat := time.NewTicker(chatStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-recvErr:
+			if ctx.Err() == nil {
+				log.Printf("chat stream for group %s closed: %v", r.PathValue("group_id"), err)
+			}
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case event := <-events:
+			data, err := marshaler.Marshal(event.Message)
+			if err != nil {
+				log.Printf("chat stream: failed to marshal message: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", sseEventName(event.Type), data); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func sseEventName(t groupv1.GroupMessageEventType) string {
+	switch t {
+	case groupv1.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_CREATED:
+		return "created"
+	case groupv1.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_EDITED:
+		return "edited"
+	case groupv1.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_DELETED:
+		return "deleted"
+	default:
+		return "message"
+	}
+}
+
+// writeGRPCError отвечает HTTP-статусом, соответствующим коду gRPC
+func writeGRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
@@ -15,6 +15,7 @@ func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 			"/v1/auth/refresh",
 			"/v1/auth/forgot-password",
 			"/v1/auth/reset-password",
+			"/v1/auth/email/confirm",
 		}
 
 		for _, publicPath := range publicPaths {
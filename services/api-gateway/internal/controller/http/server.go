@@ -27,6 +27,11 @@ type Server struct {
 	mux        *http.ServeMux
 	gwMux      *runtime.ServeMux
 	cfg        *config.Config
+
+	groupConn   *grpc.ClientConn
+	groupClient groupv1.GroupsServiceClient
+	// закрывается при остановке и завершает потоки чата
+	streamsDone chan struct{}
 }
 
 func NewServer(authClient AuthClient, cfg *config.Config) *Server {
@@ -43,13 +48,17 @@ func NewServer(authClient AuthClient, cfg *config.Config) *Server {
 		WriteTimeout: cfg.WriteTimeout,
 	}
 
-	return &Server{
-		srv:        srv,
-		authClient: authClient,
-		mux:        mux,
-		gwMux:      gwMux,
-		cfg:        cfg,
+	server := &Server{
+		srv:         srv,
+		authClient:  authClient,
+		mux:         mux,
+		gwMux:       gwMux,
+		cfg:         cfg,
+		streamsDone: make(chan struct{}),
 	}
+	srv.RegisterOnShutdown(func() { close(server.streamsDone) })
+
+	return server
 }
 
 func (s *Server) RegisterHandlers() error {
@@ -75,7 +84,18 @@ func (s *Server) RegisterHandlers() error {
 		return fmt.Errorf("failed to register tasks gateway: %w", err)
 	}
 
+	// серверный поток не проходит через grpc-gateway, поэтому чат подключается напрямую
+	groupConn, err := grpc.NewClient(s.cfg.GroupGRPC, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create group client: %w", err)
+	}
+	s.groupConn = groupConn
+	s.groupClient = groupv1.NewGroupsServiceClient(groupConn)
+
 	s.mux.Handle("/v1/", metrics.MetricsMiddleware(s.AuthMiddleware(s.gwMux)))
+	// без MetricsMiddleware: его ResponseWriter не поддерживает Flush
+	s.mux.Handle("GET /v1/groups/{group_id}/messages:stream", s.StreamAuthMiddleware(http.HandlerFunc(s.handleGroupMessagesStream)))
+	s.mux.Handle("POST /v1/groups/{group_id}/messages:stream-token", metrics.MetricsMiddleware(s.AuthMiddleware(http.HandlerFunc(s.handleStreamToken))))
 	s.mux.HandleFunc("/health", s.handleHealth)
 	s.mux.Handle("/metrics", metrics.Handler())
 
@@ -88,5 +108,9 @@ func (s *Server) Start() error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
-	return s.srv.Shutdown(ctx)
+	err := s.srv.Shutdown(ctx)
+	if s.groupConn != nil {
+		s.groupConn.Close()
+	}
+	return err
 }
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// токен нужен только для открытия потока: EventSource не передает заголовки,
+// а адрес с токеном попадает в журналы прокси и историю браузера
+const streamTokenTTL = time.Minute
+
+type streamTokenResponse struct {
+	StreamToken string    `json:"stream_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// handleStreamToken выдает токен потока чата группы пользователю, прошедшему AuthMiddleware
+func (s *Server) handleStreamToken(w http.ResponseWriter, r *http.Request) {
+	expiresAt := time.Now().Add(streamTokenTTL).Truncate(time.Second)
+	token := s.signStreamToken(r.Header.Get("X-User-Id"), r.PathValue("group_id"), expiresAt)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(streamTokenResponse{StreamToken: token, ExpiresAt: expiresAt.UTC()}); err != nil {
+		log.Printf("stream token: failed to write response: %v", err)
+	}
+}
+
+// StreamAuthMiddleware пропускает к потоку чата по stream_token из query,
+// без него запрос проверяется как обычный по заголовку Authorization
+func (s *Server) StreamAuthMiddleware(next http.Handler) http.Handler {
+	withHeader := s.AuthMiddleware(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("stream_token")
+		if token == "" {
+			withHeader.ServeHTTP(w, r)
+			return
+		}
+
+		userID, err := s.verifyStreamToken(token, r.PathValue("group_id"), time.Now())
+		if err != nil {
+			http.Error(w, "Unauthorized: invalid stream token", http.StatusUnauthorized)
+			return
+		}
+
+		r.Header.Set("X-User-Id", userID)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// токен: <user_id>.<unix время истечения>.<подпись>, подпись покрывает и ID группы,
+// поэтому токен не открывает поток другой группы
+func (s *Server) signStreamToken(userID, groupID string, expiresAt time.Time) string {
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	return userID + "." + exp + "." + s.streamTokenSignature(userID, groupID, exp)
+}
+
+func (s *Server) verifyStreamToken(token, groupID string, now time.Time) (string, error) {
+	userID, rest, ok := strings.Cut(token, ".")
+	if !ok || userID == "" {
+		return "", fmt.Errorf("malformed stream token")
+	}
+	exp, signature, ok := strings.Cut(rest, ".")
+	if !ok {
+		return "", fmt.Errorf("malformed stream token")
+	}
+
+	expected := s.streamTokenSignature(userID, groupID, exp)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return "", fmt.Errorf("invalid stream token signature")
+	}
+
+	expiresAt, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("malformed stream token expiration: %w", err)
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", fmt.Errorf("stream token expired")
+	}
+
+	return userID, nil
+}
+
+func (s *Server) streamTokenSignature(userID, groupID, exp string) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.StreamTokenSecret))
+	// назначение в подписи не дает выдать токен за другой вид токена с тем же секретом
+	fmt.Fprintf(mac, "chat-stream\n%s\n%s\n%s", userID, groupID, exp)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
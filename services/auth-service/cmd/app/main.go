@@ -1,9 +1,12 @@
 package main
 
 import (
+	"auth_service/internal/clients/user"
 	"auth_service/internal/config"
+	"auth_service/internal/service"
 	"auth_service/internal/transport"
 	"auth_service/pkg/kafka"
+	"auth_service/pkg/mailer"
 	"auth_service/pkg/migrator"
 	"auth_service/pkg/postgres"
 	"auth_service/pkg/redis"
@@ -56,7 +59,19 @@ func main() {
 	tokenCfg.AccessTTL = time.Duration(cfg.AccessTTL) * time.Minute
 	tokenCfg.RefreshTTL = time.Duration(cfg.RefreshTTL) * time.Hour
 
-	apiServer := transport.NewApiServer(pgDB.DB, redisDB, tokenCfg, producer, cfg.KafkaConfig.Topic)
+	emailCfg := service.EmailChangeConfig{
+		TTL:        time.Duration(cfg.EmailChangeTTL) * time.Hour,
+		ConfirmURL: cfg.EmailConfirmURL,
+	}
+
+	// язык писем берется из профиля пользователя
+	userClient, err := user.NewClient(cfg.UserServiceAddr)
+	if err != nil {
+		panic(fmt.Errorf("failed to create user service client: %w", err))
+	}
+	defer userClient.Close()
+
+	apiServer := transport.NewApiServer(pgDB.DB, redisDB, tokenCfg, producer, cfg.KafkaConfig.Topic, mailer.NewMailer(cfg.MailerConfig), userClient, emailCfg)
 
 	grpcServer := grpc.NewServer()
 	pb.RegisterAuthServiceServer(grpcServer, apiServer)
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.UserServiceClient
+	timeout time.Duration
+}
+
+func NewClient(address string, opts ...grpc.DialOption) (*Client, error) {
+	defaultOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	conn, err := grpc.NewClient(address, append(defaultOpts, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	return &Client{
+		conn:    conn,
+		service: pb.NewUserServiceClient(conn),
+		timeout: 5 * time.Second,
+	}, nil
+}
+
+// GetLocale возвращает язык из профиля пользователя, пустую строку - если профиля нет
+func (c *Client) GetLocale(ctx context.Context, userID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ResolveUsers(ctx, &pb.ResolveUsersRequest{UserIds: []string{userID}})
+	if err != nil {
+		return "", err
+	}
+	for _, u := range resp.GetUsers() {
+		if u.GetProfile().GetUserId() == userID {
+			return u.GetProfile().GetLocale(), nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"auth_service/pkg/kafka"
+	"auth_service/pkg/mailer"
 	"auth_service/pkg/postgres"
 	"auth_service/pkg/redis"
 	"fmt"
@@ -12,11 +13,14 @@ import (
 type Config struct {
 	GRPCPort    string `env:"GRPC_PORT" env-default:":50051"`
 	MigrationPath string `env:"MIGRATION_PATH" env-default:":file:///migrations"`
+	UserServiceAddr string `env:"USER_SERVICE_ADDRESS" env-default:"user-go:50051"`
 
 	postgres.PGConfig
 	redis.RedisConfig
 	kafka.KafkaConfig
 	TokenConfig
+	mailer.MailerConfig
+	EmailChangeConfig
 }
 
 type TokenConfig struct {
@@ -25,6 +29,12 @@ type TokenConfig struct {
 	Secret     []byte     `env:"SECRET,required"`   
 }
 
+type EmailChangeConfig struct {
+	EmailChangeTTL int32  `env:"EMAIL_CHANGE_TTL_H" env-default:"24"`
+	// ссылка подтверждения с плейсхолдером {token}
+	EmailConfirmURL string `env:"EMAIL_CONFIRM_URL,required"`
+}
+
 func ParseConfigFromEnv() (*Config, error) {
 	var cfg Config
 
@@ -0,0 +1,17 @@
+package events
+
+import "time"
+
+type EmailChangedEvent struct {
+	EventType  string              `json:"event_type"`
+	EventID    string              `json:"event_id"`
+	Version    int                 `json:"version"`
+	OccurredAt time.Time           `json:"occurred_at"`
+	Payload    EmailChangedPayload `json:"payload"`
+}
+
+type EmailChangedPayload struct {
+	UserID   string `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// EmailChange - неподтвержденная смена email, хранится до перехода по ссылке
+type EmailChange struct {
+	UserID   string `json:"user_id"`
+	NewEmail string `json:"new_email"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type UserStatusFilter string
+
+const (
+	UserStatusAll      UserStatusFilter = ""
+	UserStatusActive   UserStatusFilter = "active"
+	UserStatusInactive UserStatusFilter = "inactive"
+)
+
+type UserSortField string
+
+const (
+	UserSortCreatedAt UserSortField = "created_at"
+	UserSortEmail     UserSortField = "email"
+)
+
+type UserQuery struct {
+	Status      UserStatusFilter
+	EmailPrefix string
+	// CreatedFrom включительно, CreatedTo не включительно
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      UserSortField
+	Desc        bool
+	PageToken   string
+	Limit       int32
+	Offset      int32
+}
+
+// UserCursor - позиция keyset-пагинации: значение поля сортировки и id последней записи страницы
+type UserCursor struct {
+	SortBy UserSortField `json:"s"`
+	Desc   bool          `json:"d"`
+	Key    string        `json:"k"`
+	ID     string        `json:"id"`
+}
+
+type UserPage struct {
+	Users         []User
+	Total         int32
+	NextPageToken string
+}
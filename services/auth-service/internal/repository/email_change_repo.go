@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"auth_service/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// emailChangeRepository хранит запросы смены email до подтверждения.
+// На пользователя действует один запрос: новый вытесняет предыдущую ссылку.
+type emailChangeRepository struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisEmailChangeRepository(client *redis.Client, prefix string) *emailChangeRepository {
+	if prefix == "" {
+		prefix = "auth:email_change:"
+	}
+	return &emailChangeRepository{client: client, prefix: prefix}
+}
+
+func (r *emailChangeRepository) tokenKey(token string) string {
+	return fmt.Sprintf("%stoken:%s", r.prefix, token)
+}
+
+func (r *emailChangeRepository) userKey(userID string) string {
+	return fmt.Sprintf("%suser:%s", r.prefix, userID)
+}
+
+func (r *emailChangeRepository) SaveEmailChange(ctx context.Context, token string, change models.EmailChange, ttl time.Duration) error {
+	value, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	prev, err := r.client.Get(ctx, r.userKey(change.UserID)).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if prev != "" {
+			pipe.Del(ctx, r.tokenKey(prev))
+		}
+		pipe.Set(ctx, r.tokenKey(token), value, ttl)
+		pipe.Set(ctx, r.userKey(change.UserID), token, ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *emailChangeRepository) GetEmailChange(ctx context.Context, token string) (*models.EmailChange, error) {
+	val, err := r.client.Get(ctx, r.tokenKey(token)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrEmailChangeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var change models.EmailChange
+	if err := json.Unmarshal(val, &change); err != nil {
+		return nil, fmt.Errorf("invalid email change record: %w", err)
+	}
+	return &change, nil
+}
+
+func (r *emailChangeRepository) DeleteEmailChange(ctx context.Context, token, userID string) error {
+	return r.client.Del(ctx, r.tokenKey(token), r.userKey(userID)).Err()
+}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrUserNotFound = errors.New("user not found")
 	ErrUserExists   = errors.New("user already exists")
+
+	ErrEmailChangeNotFound = errors.New("email change request not found or expired")
 )
@@ -5,6 +5,8 @@ import (
 	"auth_service/pkg/postgres"
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 )
 
 type userRepository struct{
@@ -75,7 +77,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
     query := `
         SELECT id, email, password_hash, is_active, created_at
         FROM users 
-        WHERE email = $1
+        WHERE LOWER(email) = LOWER($1)
     `
     
     var user models.User
@@ -124,6 +126,34 @@ func (r *userRepository) UpdatePassword(ctx context.Context, id string, password
 }
 
 
+func (r *userRepository) UpdateEmail(ctx context.Context, id string, email string) error {
+    query := `
+        UPDATE users
+        SET email = $1
+        WHERE id = $2
+    `
+
+    res, err := r.db.ExecContext(ctx, query, email, id)
+    if err != nil {
+        if postgres.IsDuplicateKeyError(err){
+            return ErrUserExists
+        }
+        return err
+    }
+
+    rowsAffected, err := res.RowsAffected()
+    if err != nil {
+        return err
+    }
+
+    if rowsAffected == 0 {
+        return ErrUserNotFound
+    }
+
+    return nil
+}
+
+
 func (r *userRepository) SetIsActive(ctx context.Context, id string, status bool) error {
 	query := `
         UPDATE users
@@ -170,19 +200,72 @@ func (r *userRepository) DeleteUser(ctx context.Context, id string) error {
     return nil
 }
 
-func (r *userRepository) SelectAllUsers(ctx context.Context, limit, offset int32, isActive bool) ([]models.User, error){
-	query := `
+// sortColumns - поля сортировки и приведение значения курсора к типу колонки
+var sortColumns = map[models.UserSortField]struct{ column, cast string }{
+	models.UserSortCreatedAt: {"created_at", "::timestamp"},
+	models.UserSortEmail:     {"email", ""},
+}
+
+// ListUsers возвращает пользователей по фильтру и общее число подходящих записей.
+// Если передан after, страница начинается после этой записи, offset не применяется.
+func (r *userRepository) ListUsers(ctx context.Context, q models.UserQuery, after *models.UserCursor) ([]models.User, int32, error) {
+	sort, ok := sortColumns[q.SortBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown sort field %q", q.SortBy)
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	switch q.Status {
+	case models.UserStatusActive:
+		where = append(where, "is_active = true")
+	case models.UserStatusInactive:
+		where = append(where, "is_active = false")
+	}
+	if q.EmailPrefix != "" {
+		where = append(where, "email ILIKE "+arg(postgres.LikePrefix(q.EmailPrefix)))
+	}
+	if q.CreatedFrom != nil {
+		where = append(where, "created_at >= "+arg(postgres.Timestamp(*q.CreatedFrom))+"::timestamp")
+	}
+	if q.CreatedTo != nil {
+		where = append(where, "created_at < "+arg(postgres.Timestamp(*q.CreatedTo))+"::timestamp")
+	}
+
+	var total int32
+	countQuery := "SELECT COUNT(*) FROM users" + whereClause(where)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	direction, op := "ASC", ">"
+	if q.Desc {
+		direction, op = "DESC", "<"
+	}
+	offset := q.Offset
+	if after != nil {
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s%s, %s)", sort.column, op, arg(after.Key), sort.cast, arg(after.ID)))
+		offset = 0
+	}
+
+	query := fmt.Sprintf(`
 		SELECT id, email, is_active, created_at
-		FROM users
-		WHERE is_active = $1
-		ORDER BY created_at DESC, id
-		LIMIT $2
-		OFFSET $3
-	`
+		FROM users%s
+		ORDER BY %s %s, id %s
+		LIMIT %s
+		OFFSET %s
+	`, whereClause(where), sort.column, direction, direction, arg(q.Limit), arg(offset))
 
-	rows, err := r.db.QueryContext(ctx, query, isActive, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil{
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -190,14 +273,21 @@ func (r *userRepository) SelectAllUsers(ctx context.Context, limit, offset int32
 	for rows.Next(){
 		var user models.User
 		if err := rows.Scan(&user.ID, &user.Email, &user.IsActive, &user.CreatedAt); err != nil{
-			return nil, err
+			return nil, 0, err
 		}
 		users = append(users, user)
 	}
 
 	if err = rows.Err(); err != nil{
-		return nil, err
+		return nil, 0, err
 	}
 
-	return users, nil
-}
\ No newline at end of file
+	return users, total, nil
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "\n\t\tWHERE " + strings.Join(conditions, " AND ")
+}
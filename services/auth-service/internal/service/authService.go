@@ -44,6 +44,10 @@ func (s *authService) Register(ctx context.Context, email, password, confirmPass
     if  password != confirmPassword{
         return nil, nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("password not equal")}
     }
+    email, err := normalizeEmail(email)
+    if err != nil {
+        return nil, nil, &models.Error{Code: models.INVALIDINPUT, Message: err}
+    }
     hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
     if err != nil {
         return nil, nil, &models.Error{Code: models.INVALIDINPUT, Message: err}
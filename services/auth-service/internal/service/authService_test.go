@@ -6,6 +6,8 @@ import (
 	"auth_service/pkg/token"
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -88,14 +90,62 @@ func (m *mockUserRepository) UpdatePassword(ctx context.Context, id string, pass
 	return nil
 }
 
-func (m *mockUserRepository) SelectAllUsers(ctx context.Context, limit, offset int32, isActive bool) ([]models.User, error) {
+func (m *mockUserRepository) UpdateEmail(ctx context.Context, id string, email string) error {
+	user, exists := m.users[id]
+	if !exists {
+		return repository.ErrUserNotFound
+	}
+	if other, taken := m.usersByEmail[email]; taken && other.ID != id {
+		return repository.ErrUserExists
+	}
+	delete(m.usersByEmail, user.Email)
+	user.Email = email
+	m.usersByEmail[email] = user
+	return nil
+}
+
+func (m *mockUserRepository) ListUsers(ctx context.Context, q models.UserQuery, after *models.UserCursor) ([]models.User, int32, error) {
 	var result []models.User
 	for _, user := range m.users {
-		if user.IsActive == isActive {
-			result = append(result, *user)
+		if q.Status == models.UserStatusActive && !user.IsActive || q.Status == models.UserStatusInactive && user.IsActive {
+			continue
+		}
+		if !strings.HasPrefix(strings.ToLower(user.Email), strings.ToLower(q.EmailPrefix)) {
+			continue
 		}
+		if q.CreatedFrom != nil && user.CreatedAt.Before(*q.CreatedFrom) || q.CreatedTo != nil && !user.CreatedAt.Before(*q.CreatedTo) {
+			continue
+		}
+		result = append(result, *user)
+	}
+	total := int32(len(result))
+
+	// сравнение по (ключ сортировки, id) в направлении сортировки
+	before := func(a, b models.UserCursor) bool {
+		if a.Key != b.Key {
+			return (a.Key < b.Key) != q.Desc
+		}
+		return a.ID != b.ID && (a.ID < b.ID) != q.Desc
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return before(userCursor(result[i], q), userCursor(result[j], q))
+	})
+
+	offset := int(q.Offset)
+	if after != nil {
+		offset = 0
+		for len(result) > 0 && !before(*after, userCursor(result[0], q)) {
+			result = result[1:]
+		}
+	}
+	if offset >= len(result) {
+		return nil, total, nil
+	}
+	result = result[offset:]
+	if int(q.Limit) < len(result) {
+		result = result[:q.Limit]
 	}
-	return result, nil
+	return result, total, nil
 }
 
 type mockTokenRepository struct {
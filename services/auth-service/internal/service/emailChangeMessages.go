@@ -0,0 +1,56 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultLocale - язык профиля по умолчанию в user-service, им же пишутся письма,
+// если язык получателя неизвестен или не поддерживается
+const defaultLocale = "ru"
+
+type emailChangeMessages struct {
+	ConfirmSubject string
+	// ссылка подтверждения и срок ее действия
+	ConfirmBody   func(link string, ttl time.Duration) string
+	NoticeSubject string
+	// новый адрес
+	NoticeBody func(newEmail string) string
+}
+
+var emailChangeTexts = map[string]emailChangeMessages{
+	"ru": {
+		ConfirmSubject: "Подтверждение нового email",
+		ConfirmBody: func(link string, ttl time.Duration) string {
+			return fmt.Sprintf("Чтобы подтвердить смену email, перейдите по ссылке:\n%s\n\nСсылка действует %d ч.", link, ttlHours(ttl))
+		},
+		NoticeSubject: "Запрошена смена email",
+		NoticeBody: func(newEmail string) string {
+			return fmt.Sprintf("Для вашего аккаунта запрошена смена email на %s.\nЕсли это были не вы, смените пароль.", newEmail)
+		},
+	},
+	"en": {
+		ConfirmSubject: "Confirm your new email",
+		ConfirmBody: func(link string, ttl time.Duration) string {
+			return fmt.Sprintf("To confirm the email change, follow the link:\n%s\n\nThe link is valid for %d h.", link, ttlHours(ttl))
+		},
+		NoticeSubject: "Email change requested",
+		NoticeBody: func(newEmail string) string {
+			return fmt.Sprintf("An email change to %s was requested for your account.\nIf it was not you, change your password.", newEmail)
+		},
+	},
+}
+
+// emailChangeTextsFor выбирает тексты по основному языку локали: en-US -> en
+func emailChangeTextsFor(locale string) emailChangeMessages {
+	lang, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	if texts, ok := emailChangeTexts[lang]; ok {
+		return texts
+	}
+	return emailChangeTexts[defaultLocale]
+}
+
+func ttlHours(ttl time.Duration) int {
+	return max(int(ttl.Round(time.Hour)/time.Hour), 1)
+}
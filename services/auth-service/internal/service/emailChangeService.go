@@ -0,0 +1,196 @@
+package service
+
+import (
+	"auth_service/internal/events"
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"auth_service/pkg/mailer"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const confirmTokenPlaceholder = "{token}"
+
+type EmailChangeConfig struct {
+	TTL        time.Duration
+	ConfirmURL string
+}
+
+type emailChangeService struct {
+	userRepo UserRepository
+	changes  EmailChangeRepository
+	locales  LocaleResolver
+	mailer   mailer.Mailer
+	producer EventPublisher
+	topic    string
+	cfg      EmailChangeConfig
+}
+
+func NewEmailChangeService(cfg EmailChangeConfig, userRepo UserRepository, changes EmailChangeRepository, locales LocaleResolver, m mailer.Mailer, producer EventPublisher, topic string) *emailChangeService {
+	return &emailChangeService{
+		userRepo: userRepo,
+		changes:  changes,
+		locales:  locales,
+		mailer:   m,
+		producer: producer,
+		topic:    topic,
+		cfg:      cfg,
+	}
+}
+
+// RequestEmailChange проверяет пароль и свободность адреса, отправляет ссылку подтверждения на новый адрес
+// и уведомление на текущий. Email меняется только в ConfirmEmailChange.
+// Ссылка строится только из EMAIL_CONFIRM_URL: письмо уходит с нашего адреса, и ссылку из запроса
+// можно было бы использовать для фишинга.
+func (s *emailChangeService) RequestEmailChange(ctx context.Context, userID, newEmail, password string) *models.Error {
+	newEmail, err := normalizeEmail(newEmail)
+	if err != nil {
+		return &models.Error{Code: models.INVALIDINPUT, Message: err}
+	}
+
+	if !strings.Contains(s.cfg.ConfirmURL, confirmTokenPlaceholder) {
+		return &models.Error{Code: models.INTERNALERROR, Message: fmt.Errorf("EMAIL_CONFIRM_URL must contain %s", confirmTokenPlaceholder)}
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &models.Error{Code: models.USERNOTFOUND, Message: err}
+		}
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if strings.EqualFold(user.Email, newEmail) {
+		return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("new email is the same as current")}
+	}
+
+	// GetUserByID не отдает хеш пароля
+	current, err := s.userRepo.GetUserByEmail(ctx, user.Email)
+	if err != nil {
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if bcrypt.CompareHashAndPassword([]byte(current.Password), []byte(password)) != nil {
+		return &models.Error{Code: models.INVALIDCREDENTIALS, Message: fmt.Errorf("invalid password")}
+	}
+
+	if e := s.checkEmailFree(ctx, newEmail); e != nil {
+		return e
+	}
+
+	token, err := newConfirmToken()
+	if err != nil {
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	change := models.EmailChange{UserID: userID, NewEmail: newEmail}
+	if err := s.changes.SaveEmailChange(ctx, token, change, s.cfg.TTL); err != nil {
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	texts := emailChangeTextsFor(s.locale(ctx, userID))
+	link := strings.ReplaceAll(s.cfg.ConfirmURL, confirmTokenPlaceholder, token)
+	if err := s.mailer.Send(ctx, newEmail, texts.ConfirmSubject, texts.ConfirmBody(link, s.cfg.TTL)); err != nil {
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	if err := s.mailer.Send(ctx, user.Email, texts.NoticeSubject, texts.NoticeBody(newEmail)); err != nil {
+		log.Printf("cant send email change notice to user %s: %v", userID, err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange применяет смену email по токену из письма и публикует EmailChanged
+func (s *emailChangeService) ConfirmEmailChange(ctx context.Context, token string) *models.Error {
+	if token == "" {
+		return &models.Error{Code: models.INVALIDTOKEN, Message: repository.ErrEmailChangeNotFound}
+	}
+
+	change, err := s.changes.GetEmailChange(ctx, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailChangeNotFound) {
+			return &models.Error{Code: models.INVALIDTOKEN, Message: err}
+		}
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, change.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &models.Error{Code: models.USERNOTFOUND, Message: err}
+		}
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	oldEmail := user.Email
+
+	// адрес могли занять, пока письмо шло
+	if err := s.userRepo.UpdateEmail(ctx, change.UserID, change.NewEmail); err != nil {
+		if errors.Is(err, repository.ErrUserExists) {
+			return &models.Error{Code: models.USEREXISTS, Message: err}
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &models.Error{Code: models.USERNOTFOUND, Message: err}
+		}
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	if err := s.changes.DeleteEmailChange(ctx, token, change.UserID); err != nil {
+		log.Printf("cant delete email change request of user %s: %v", change.UserID, err)
+	}
+
+	event := events.EmailChangedEvent{
+		EventType:  "EmailChanged",
+		EventID:    uuid.New().String(),
+		Version:    1,
+		OccurredAt: time.Now().UTC(),
+		Payload: events.EmailChangedPayload{
+			UserID:   change.UserID,
+			OldEmail: oldEmail,
+			NewEmail: change.NewEmail,
+		},
+	}
+
+	// потерянное событие исправит сверка профилей в user-service
+	if err := s.producer.Publish(ctx, s.topic, change.UserID, event); err != nil {
+		log.Printf("cant publish email changed event for user %s: %v", change.UserID, err)
+	}
+
+	return nil
+}
+
+// locale - язык профиля в user-service. Письмо важнее языка, поэтому при ошибке
+// оно уходит на языке по умолчанию.
+func (s *emailChangeService) locale(ctx context.Context, userID string) string {
+	locale, err := s.locales.GetLocale(ctx, userID)
+	if err != nil {
+		log.Printf("cant get locale of user %s: %v", userID, err)
+		return defaultLocale
+	}
+	return locale
+}
+
+func (s *emailChangeService) checkEmailFree(ctx context.Context, email string) *models.Error {
+	_, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err == nil {
+		return &models.Error{Code: models.USEREXISTS, Message: repository.ErrUserExists}
+	}
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	return nil
+}
+
+func newConfirmToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"auth_service/internal/events"
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type mockEmailChangeRepository struct {
+	changes map[string]models.EmailChange
+	byUser  map[string]string
+}
+
+func newMockEmailChangeRepository() *mockEmailChangeRepository {
+	return &mockEmailChangeRepository{
+		changes: make(map[string]models.EmailChange),
+		byUser:  make(map[string]string),
+	}
+}
+
+func (m *mockEmailChangeRepository) SaveEmailChange(ctx context.Context, token string, change models.EmailChange, ttl time.Duration) error {
+	if prev, ok := m.byUser[change.UserID]; ok {
+		delete(m.changes, prev)
+	}
+	m.changes[token] = change
+	m.byUser[change.UserID] = token
+	return nil
+}
+
+func (m *mockEmailChangeRepository) GetEmailChange(ctx context.Context, token string) (*models.EmailChange, error) {
+	change, ok := m.changes[token]
+	if !ok {
+		return nil, repository.ErrEmailChangeNotFound
+	}
+	return &change, nil
+}
+
+func (m *mockEmailChangeRepository) DeleteEmailChange(ctx context.Context, token, userID string) error {
+	delete(m.changes, token)
+	delete(m.byUser, userID)
+	return nil
+}
+
+type sentMail struct {
+	to, subject, body string
+}
+
+type mockMailer struct {
+	sent []sentMail
+}
+
+func (m *mockMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.sent = append(m.sent, sentMail{to: to, subject: subject, body: body})
+	return nil
+}
+
+type mockLocaleResolver struct {
+	locales map[string]string
+	err     error
+}
+
+func (m *mockLocaleResolver) GetLocale(ctx context.Context, userID string) (string, error) {
+	return m.locales[userID], m.err
+}
+
+type publishedEvent struct {
+	topic, key string
+	value      any
+}
+
+type recordingProducer struct {
+	events []publishedEvent
+}
+
+func (p *recordingProducer) Publish(ctx context.Context, topic, key string, value any) error {
+	p.events = append(p.events, publishedEvent{topic: topic, key: key, value: value})
+	return nil
+}
+
+func newTestEmailChangeService() (*emailChangeService, *mockUserRepository, *mockEmailChangeRepository, *mockMailer, *recordingProducer) {
+	userRepo := newMockUserRepository()
+	changes := newMockEmailChangeRepository()
+	m := &mockMailer{}
+	producer := &recordingProducer{}
+	cfg := EmailChangeConfig{TTL: time.Hour, ConfirmURL: "https://tutors.local/email/confirm?token={token}"}
+	return NewEmailChangeService(cfg, userRepo, changes, &mockLocaleResolver{}, m, producer, "test-topic"), userRepo, changes, m, producer
+}
+
+func seedUser(t *testing.T, repo *mockUserRepository, id, email, password string) {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	if _, err := repo.CreateUser(context.Background(), &models.User{ID: id, Email: email, Password: string(hash)}); err != nil {
+		t.Fatalf("failed to seed user: %v", err)
+	}
+}
+
+func onlyToken(t *testing.T, changes *mockEmailChangeRepository) string {
+	t.Helper()
+	if len(changes.changes) != 1 {
+		t.Fatalf("expected one pending change, got %d", len(changes.changes))
+	}
+	for token := range changes.changes {
+		return token
+	}
+	return ""
+}
+
+func TestRequestEmailChange_SendsConfirmationAndNotice(t *testing.T) {
+	s, userRepo, changes, m, _ := newTestEmailChangeService()
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+
+	if err := s.RequestEmailChange(context.Background(), "user-1", "new@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+
+	token := onlyToken(t, changes)
+	if len(m.sent) != 2 {
+		t.Fatalf("expected two emails, got %d", len(m.sent))
+	}
+	if m.sent[0].to != "new@example.com" || !strings.Contains(m.sent[0].body, "https://tutors.local/email/confirm?token="+token) {
+		t.Errorf("expected confirmation link to new address, got %+v", m.sent[0])
+	}
+	if m.sent[1].to != "old@example.com" || strings.Contains(m.sent[1].body, token) {
+		t.Errorf("expected notice without token to old address, got %+v", m.sent[1])
+	}
+	if m.sent[0].subject != "Подтверждение нового email" {
+		t.Errorf("expected default locale for user without locale, got %q", m.sent[0].subject)
+	}
+	if userRepo.users["user-1"].Email != "old@example.com" {
+		t.Error("expected email not to change before confirmation")
+	}
+}
+
+func TestRequestEmailChange_NormalizesAddress(t *testing.T) {
+	s, userRepo, changes, m, _ := newTestEmailChangeService()
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+
+	if err := s.RequestEmailChange(context.Background(), "user-1", "  New@Example.COM ", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	if change := changes.changes[onlyToken(t, changes)]; change.NewEmail != "new@example.com" {
+		t.Errorf("expected lowercased address to be stored, got %q", change.NewEmail)
+	}
+	if m.sent[0].to != "new@example.com" {
+		t.Errorf("expected confirmation to normalized address, got %q", m.sent[0].to)
+	}
+}
+
+func TestRequestEmailChange_UsesRecipientLocale(t *testing.T) {
+	s, userRepo, _, m, _ := newTestEmailChangeService()
+	s.locales = &mockLocaleResolver{locales: map[string]string{"user-1": "en-US"}}
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+
+	if err := s.RequestEmailChange(context.Background(), "user-1", "new@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	if m.sent[0].subject != "Confirm your new email" || m.sent[1].subject != "Email change requested" {
+		t.Errorf("expected english emails, got %q and %q", m.sent[0].subject, m.sent[1].subject)
+	}
+
+	// user-service недоступен - письмо все равно уходит
+	s.locales = &mockLocaleResolver{err: errors.New("unavailable")}
+	m.sent = nil
+	if err := s.RequestEmailChange(context.Background(), "user-1", "new@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	if len(m.sent) != 2 || m.sent[0].subject != "Подтверждение нового email" {
+		t.Errorf("expected emails in default locale, got %+v", m.sent)
+	}
+}
+
+func TestRequestEmailChange_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		wantCode models.ErrorResponseErrorCode
+	}{
+		{name: "invalid email", email: "not-an-email", password: "password", wantCode: models.INVALIDINPUT},
+		{name: "display name", email: "Support <new@example.com>", password: "password", wantCode: models.INVALIDINPUT},
+		{name: "angle brackets", email: "<new@example.com>", password: "password", wantCode: models.INVALIDINPUT},
+		{name: "same email", email: "OLD@example.com", password: "password", wantCode: models.INVALIDINPUT},
+		{name: "wrong password", email: "new@example.com", password: "wrong", wantCode: models.INVALIDCREDENTIALS},
+		{name: "email taken", email: "taken@example.com", password: "password", wantCode: models.USEREXISTS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, userRepo, changes, m, _ := newTestEmailChangeService()
+			seedUser(t, userRepo, "user-1", "old@example.com", "password")
+			seedUser(t, userRepo, "user-2", "taken@example.com", "password")
+
+			err := s.RequestEmailChange(context.Background(), "user-1", tt.email, tt.password)
+			if err == nil || err.Code != tt.wantCode {
+				t.Fatalf("expected %s, got %+v", tt.wantCode, err)
+			}
+			if len(changes.changes) != 0 || len(m.sent) != 0 {
+				t.Error("expected no pending change and no emails")
+			}
+		})
+	}
+}
+
+func TestRequestEmailChange_NewRequestReplacesPrevious(t *testing.T) {
+	s, userRepo, changes, _, _ := newTestEmailChangeService()
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+	ctx := context.Background()
+
+	if err := s.RequestEmailChange(ctx, "user-1", "first@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	first := onlyToken(t, changes)
+	if err := s.RequestEmailChange(ctx, "user-1", "second@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	onlyToken(t, changes)
+
+	if err := s.ConfirmEmailChange(ctx, first); err == nil || err.Code != models.INVALIDTOKEN {
+		t.Fatalf("expected INVALIDTOKEN for replaced link, got %+v", err)
+	}
+}
+
+func TestConfirmEmailChange_UpdatesEmailAndPublishesEvent(t *testing.T) {
+	s, userRepo, changes, _, producer := newTestEmailChangeService()
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+	ctx := context.Background()
+
+	if err := s.RequestEmailChange(ctx, "user-1", "new@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	token := onlyToken(t, changes)
+
+	if err := s.ConfirmEmailChange(ctx, token); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	if _, err := userRepo.GetUserByEmail(ctx, "new@example.com"); err != nil {
+		t.Error("expected user to be found by new email")
+	}
+	if len(changes.changes) != 0 {
+		t.Error("expected pending change to be removed")
+	}
+
+	if len(producer.events) != 1 {
+		t.Fatalf("expected one event, got %d", len(producer.events))
+	}
+	ev, ok := producer.events[0].value.(events.EmailChangedEvent)
+	if !ok || producer.events[0].key != "user-1" {
+		t.Fatalf("expected EmailChanged event keyed by user id, got %+v", producer.events[0])
+	}
+	if ev.EventType != "EmailChanged" || ev.Payload.OldEmail != "old@example.com" || ev.Payload.NewEmail != "new@example.com" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+
+	// ссылка одноразовая
+	if err := s.ConfirmEmailChange(ctx, token); err == nil || err.Code != models.INVALIDTOKEN {
+		t.Errorf("expected INVALIDTOKEN on reuse, got %+v", err)
+	}
+}
+
+func TestConfirmEmailChange_EmailTakenMeanwhile(t *testing.T) {
+	s, userRepo, changes, _, producer := newTestEmailChangeService()
+	seedUser(t, userRepo, "user-1", "old@example.com", "password")
+	ctx := context.Background()
+
+	if err := s.RequestEmailChange(ctx, "user-1", "new@example.com", "password"); err != nil {
+		t.Fatalf("expected no error, got %v", err.Message)
+	}
+	token := onlyToken(t, changes)
+	seedUser(t, userRepo, "user-2", "new@example.com", "password")
+
+	if err := s.ConfirmEmailChange(ctx, token); err == nil || err.Code != models.USEREXISTS {
+		t.Fatalf("expected USEREXISTS, got %+v", err)
+	}
+	if userRepo.users["user-1"].Email != "old@example.com" {
+		t.Error("expected email to stay unchanged")
+	}
+	if len(producer.events) != 0 {
+		t.Error("expected no events")
+	}
+}
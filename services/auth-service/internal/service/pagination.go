@@ -0,0 +1,48 @@
+package service
+
+import (
+	"auth_service/internal/models"
+	"auth_service/pkg/postgres"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	defaultUsersLimit = 50
+	maxUsersLimit     = 500
+)
+
+func encodeUserCursor(c models.UserCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeUserCursor проверяет, что токен выдан для того же порядка сортировки
+func decodeUserCursor(token string, q models.UserQuery) (*models.UserCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+
+	var c models.UserCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+	if c.SortBy != q.SortBy || c.Desc != q.Desc {
+		return nil, fmt.Errorf("page_token was issued for another sort order")
+	}
+
+	return &c, nil
+}
+
+func userCursor(u models.User, q models.UserQuery) models.UserCursor {
+	c := models.UserCursor{SortBy: q.SortBy, Desc: q.Desc, ID: u.ID}
+	switch q.SortBy {
+	case models.UserSortEmail:
+		c.Key = u.Email
+	default:
+		c.Key = postgres.Timestamp(u.CreatedAt)
+	}
+	return c
+}
@@ -13,11 +13,23 @@ type UserRepository interface {
     GetUserByEmail(ctx context.Context, email string) (*models.User, error)
     SetIsActive(ctx context.Context, id string, status bool) error
     UpdatePassword(ctx context.Context, id string, password string) error
-	SelectAllUsers(ctx context.Context, limit, offset int32, isActive bool) ([]models.User, error)
+    UpdateEmail(ctx context.Context, id string, email string) error
+	ListUsers(ctx context.Context, q models.UserQuery, after *models.UserCursor) ([]models.User, int32, error)
 }
 
 type RefreshTokenRepository interface {
 	DeleteToken(ctx context.Context, token string) error
 	GetToken(ctx context.Context, token string) (string, error)
 	SaveToken(ctx context.Context, userID string, token string, expiresAt time.Time) error
-}
\ No newline at end of file
+}
+
+type EmailChangeRepository interface {
+	SaveEmailChange(ctx context.Context, token string, change models.EmailChange, ttl time.Duration) error
+	GetEmailChange(ctx context.Context, token string) (*models.EmailChange, error)
+	DeleteEmailChange(ctx context.Context, token, userID string) error
+}
+
+// LocaleResolver - язык пользователя из профиля в user-service
+type LocaleResolver interface {
+	GetLocale(ctx context.Context, userID string) (string, error)
+}
@@ -5,6 +5,7 @@ import (
 	"auth_service/internal/repository"
 	"context"
 	"errors"
+	"fmt"
 )
 
 type userService struct {
@@ -17,8 +18,39 @@ func NewUserService(userRepo UserRepository) *userService {
     }
 }
 
-func (s *userService) GetAllUsers(ctx context.Context, limit, offset int32, isActive bool) ([]models.User, *models.Error){
-	users, err := s.userRepo.SelectAllUsers(ctx, limit, offset, isActive)
+func (s *userService) GetAllUsers(ctx context.Context, q models.UserQuery) (*models.UserPage, *models.Error){
+	if q.Limit <= 0 || q.Limit > maxUsersLimit {
+		q.Limit = defaultUsersLimit
+	}
+	if q.Offset < 0 {
+		q.Offset = 0
+	}
+	if q.SortBy == "" {
+		q.SortBy = models.UserSortCreatedAt
+	}
+	if q.CreatedFrom != nil && q.CreatedTo != nil && !q.CreatedFrom.Before(*q.CreatedTo) {
+		return nil, &models.Error{
+			Code: models.INVALIDINPUT,
+			Message: fmt.Errorf("created_from must be before created_to"),
+		}
+	}
+
+	var after *models.UserCursor
+	if q.PageToken != "" {
+		cursor, err := decodeUserCursor(q.PageToken, q)
+		if err != nil {
+			return nil, &models.Error{
+				Code: models.INVALIDINPUT,
+				Message: err,
+			}
+		}
+		after = cursor
+	}
+
+	// лишняя запись показывает, есть ли следующая страница
+	limit := q.Limit
+	q.Limit++
+	users, total, err := s.userRepo.ListUsers(ctx, q, after)
 	if err != nil{
 		return nil, &models.Error{
 			Code: models.INTERNALERROR,
@@ -26,7 +58,13 @@ func (s *userService) GetAllUsers(ctx context.Context, limit, offset int32, isAc
 		}
 	}
 
-	return users, nil
+	page := &models.UserPage{Users: users, Total: total}
+	if int32(len(users)) > limit {
+		page.Users = users[:limit]
+		page.NextPageToken = encodeUserCursor(userCursor(page.Users[limit-1], q))
+	}
+
+	return page, nil
 } 
 
 func (s *userService) GetUserByID(ctx context.Context, id string) (*models.User, *models.Error) {
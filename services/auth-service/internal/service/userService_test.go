@@ -3,7 +3,10 @@ package service
 import (
 	"auth_service/internal/models"
 	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 func newTestUserService(userRepo *mockUserRepository) *userService {
@@ -192,26 +195,110 @@ func TestUserService_GetAllUsers_Success(t *testing.T) {
 	_, _ = userRepo.CreateUser(ctx, user1)
 	_, _ = userRepo.CreateUser(ctx, user2)
 
-	users, err := svc.GetAllUsers(ctx, 10, 0, true)
+	page, err := svc.GetAllUsers(ctx, models.UserQuery{Status: models.UserStatusActive, Limit: 10})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	users := page.Users
 	if len(users) != 2 {
 		t.Errorf("expected 2 users, got %d", len(users))
 	}
 }
 
+func seedListUsers(ctx context.Context, userRepo *mockUserRepository) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, email := range []string{"anna@example.com", "boris@example.com", "alex@example.com", "vera@example.com", "anton@example.com"} {
+		user := &models.User{ID: fmt.Sprintf("id%d", i), Email: email}
+		_, _ = userRepo.CreateUser(ctx, user)
+		user.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+	}
+	_ = userRepo.SetIsActive(ctx, "id3", false)
+}
+
+func TestUserService_GetAllUsers_Filters(t *testing.T) {
+	userRepo := newMockUserRepository()
+	svc := newTestUserService(userRepo)
+	ctx := context.Background()
+	seedListUsers(ctx, userRepo)
+
+	page, err := svc.GetAllUsers(ctx, models.UserQuery{Status: models.UserStatusAll})
+	if err != nil || page.Total != 5 {
+		t.Fatalf("expected all 5 users, got %+v, %v", page, err)
+	}
+
+	page, _ = svc.GetAllUsers(ctx, models.UserQuery{Status: models.UserStatusInactive})
+	if page.Total != 1 || page.Users[0].ID != "id3" {
+		t.Errorf("expected only inactive user, got %+v", page.Users)
+	}
+
+	page, _ = svc.GetAllUsers(ctx, models.UserQuery{EmailPrefix: "AN", SortBy: models.UserSortEmail})
+	if page.Total != 2 || page.Users[0].Email != "anna@example.com" || page.Users[1].Email != "anton@example.com" {
+		t.Errorf("expected users with prefix sorted by email, got %+v", page.Users)
+	}
+
+	from := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	page, _ = svc.GetAllUsers(ctx, models.UserQuery{CreatedFrom: &from, CreatedTo: &to})
+	if page.Total != 2 {
+		t.Errorf("expected 2 users in created range, got %+v", page.Users)
+	}
+
+	if _, err := svc.GetAllUsers(ctx, models.UserQuery{CreatedFrom: &to, CreatedTo: &from}); err == nil || err.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for reversed range, got %v", err)
+	}
+}
+
+func TestUserService_GetAllUsers_KeysetPagination(t *testing.T) {
+	userRepo := newMockUserRepository()
+	svc := newTestUserService(userRepo)
+	ctx := context.Background()
+	seedListUsers(ctx, userRepo)
+
+	q := models.UserQuery{SortBy: models.UserSortCreatedAt, Desc: true, Limit: 2}
+	var ids []string
+	for {
+		page, err := svc.GetAllUsers(ctx, q)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err.Message)
+		}
+		if page.Total != 5 {
+			t.Errorf("expected total 5 on every page, got %d", page.Total)
+		}
+		for _, u := range page.Users {
+			ids = append(ids, u.ID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		q.PageToken = page.NextPageToken
+	}
+
+	if strings.Join(ids, ",") != "id4,id3,id2,id1,id0" {
+		t.Errorf("expected newest users first without gaps, got %v", ids)
+	}
+
+	// токен нельзя использовать с другой сортировкой
+	q.SortBy = models.UserSortEmail
+	if _, err := svc.GetAllUsers(ctx, q); err == nil || err.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for mismatched page token, got %v", err)
+	}
+	if _, err := svc.GetAllUsers(ctx, models.UserQuery{PageToken: "garbage"}); err == nil || err.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for broken page token, got %v", err)
+	}
+}
+
 func TestUserService_GetAllUsers_Empty(t *testing.T) {
 	userRepo := newMockUserRepository()
 	svc := newTestUserService(userRepo)
 	ctx := context.Background()
 
-	users, err := svc.GetAllUsers(ctx, 10, 0, true)
+	page, err := svc.GetAllUsers(ctx, models.UserQuery{Status: models.UserStatusActive, Limit: 10})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	users := page.Users
 	if users == nil {
 		users = []models.User{}
 	}
@@ -1,9 +1,27 @@
 package service
 
-import "github.com/google/uuid"
+import (
+    "fmt"
+    "net/mail"
+    "strings"
+
+    "github.com/google/uuid"
+)
 
 func generateID() string {
     return "user-" + uuid.New().String()
 }
 
-
+// normalizeEmail принимает только голый адрес, без имени и угловых скобок, и приводит его
+// к нижнему регистру: так хранятся все адреса, и поиск по email не зависит от регистра
+func normalizeEmail(email string) (string, error) {
+    email = strings.TrimSpace(email)
+    addr, err := mail.ParseAddress(email)
+    if err != nil {
+        return "", fmt.Errorf("invalid email: %w", err)
+    }
+    if addr.Name != "" || addr.Address != email {
+        return "", fmt.Errorf("invalid email: display name is not allowed")
+    }
+    return strings.ToLower(addr.Address), nil
+}
@@ -0,0 +1,30 @@
+package transport
+
+import (
+	"context"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/auth"
+)
+
+func (h *ApiServer) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.EmptyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e := h.emailChangeService.RequestEmailChange(ctx, userID, req.NewEmail, req.Password); e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+func (h *ApiServer) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.EmptyResponse, error) {
+	if e := h.emailChangeService.ConfirmEmailChange(ctx, req.Token); e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
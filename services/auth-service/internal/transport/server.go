@@ -4,6 +4,7 @@ import (
 	"auth_service/pkg/kafka"
 	"auth_service/internal/repository"
 	"auth_service/internal/service"
+	"auth_service/pkg/mailer"
 	"auth_service/pkg/token"
 	"database/sql"
 
@@ -17,17 +18,21 @@ type ApiServer struct{
 
 	userService UserService
 	authService AuthService
-	
+	emailChangeService EmailChangeService
+
 }
 
-func NewApiServer(pgDB *sql.DB, redisClient *redis.Client, tokenCfg token.TokenConfig, producer *kafka.Producer, topic string) *ApiServer{
+func NewApiServer(pgDB *sql.DB, redisClient *redis.Client, tokenCfg token.TokenConfig, producer *kafka.Producer, topic string, m mailer.Mailer, locales service.LocaleResolver, emailCfg service.EmailChangeConfig) *ApiServer{
 	userRepo := repository.NewUserRepository(pgDB)
 	tokenRepo := repository.NewRedisTokenRepository(redisClient, "auth:refresh_token:")
 	userService := service.NewUserService(userRepo)
 	authService := service.NewAuthService(tokenCfg, userRepo, tokenRepo, producer, topic)
+	emailChangeRepo := repository.NewRedisEmailChangeRepository(redisClient, "auth:email_change:")
+	emailChangeService := service.NewEmailChangeService(emailCfg, userRepo, emailChangeRepo, locales, m, producer, topic)
 
 	return &ApiServer{
 		userService: userService,
 		authService: authService,
+		emailChangeService: emailChangeService,
 	}
 }
\ No newline at end of file
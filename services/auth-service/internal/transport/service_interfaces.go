@@ -7,7 +7,7 @@ import (
 
 type UserService interface{
 	DeleteUser(ctx context.Context, id string) *models.Error
-	GetAllUsers(ctx context.Context, limit int32, offset int32, isActive bool) ([]models.User, *models.Error)
+	GetAllUsers(ctx context.Context, q models.UserQuery) (*models.UserPage, *models.Error)
 	GetUserByEmail(ctx context.Context, email string) (*models.User, *models.Error)
 	GetUserByID(ctx context.Context, id string) (*models.User, *models.Error)
 	UpdateIsActiveStatus(ctx context.Context, id string, status bool) *models.Error
@@ -20,3 +20,8 @@ type AuthService interface{
 	Refresh(ctx context.Context, rt string) (*models.Tokens, *models.Error)
 	ValidateAccessToken(ctx context.Context, tokenStr string) (string, int64, *models.Error)
 }
+
+type EmailChangeService interface{
+	RequestEmailChange(ctx context.Context, userID string, newEmail string, password string) *models.Error
+	ConfirmEmailChange(ctx context.Context, token string) *models.Error
+}
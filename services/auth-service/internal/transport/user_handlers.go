@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"auth_service/internal/models"
 	"context"
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/auth"
@@ -8,15 +9,64 @@ import (
 )
 
 
+var userSortFromPb = map[pb.UserSortField]models.UserSortField{
+	pb.UserSortField_USER_SORT_CREATED_AT: models.UserSortCreatedAt,
+	pb.UserSortField_USER_SORT_EMAIL: models.UserSortEmail,
+}
+
+func userQueryFromPb(req *pb.ListUsersRequest) models.UserQuery {
+	q := models.UserQuery{
+		EmailPrefix: req.EmailPrefix,
+		SortBy: userSortFromPb[req.SortBy],
+		PageToken: req.PageToken,
+		Limit: req.Limit,
+		Offset: req.Offset,
+	}
+
+	switch req.Status {
+	case pb.UserStatusFilter_USER_STATUS_ACTIVE:
+		q.Status = models.UserStatusActive
+	case pb.UserStatusFilter_USER_STATUS_INACTIVE:
+		q.Status = models.UserStatusInactive
+	case pb.UserStatusFilter_USER_STATUS_FILTER_UNSPECIFIED:
+		// старые клиенты передают только is_active
+		q.Status = models.UserStatusInactive
+		if req.IsActive {
+			q.Status = models.UserStatusActive
+		}
+	}
+
+	switch req.SortDirection {
+	case pb.SortDirection_SORT_ASC:
+		q.Desc = false
+	case pb.SortDirection_SORT_DESC:
+		q.Desc = true
+	default:
+		q.Desc = q.SortBy == models.UserSortCreatedAt
+	}
+
+	if req.CreatedFrom != nil {
+		t := req.CreatedFrom.AsTime()
+		q.CreatedFrom = &t
+	}
+	if req.CreatedTo != nil {
+		t := req.CreatedTo.AsTime()
+		q.CreatedTo = &t
+	}
+
+	return q
+}
+
 func (h *ApiServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error){
-	users, err := h.userService.GetAllUsers(ctx, req.Limit, req.Offset, req.IsActive)
+	q := userQueryFromPb(req)
+	page, err := h.userService.GetAllUsers(ctx, q)
 	if err != nil{
 		st :=  parseError(err)
 		return nil, st.Err()
 	}
 
 	var usersResp []*pb.User
-	for _,user := range users{
+	for _,user := range page.Users{
 		usersResp = append(usersResp,
 			&pb.User{
 				Id: user.ID, 
@@ -25,7 +75,13 @@ func (h *ApiServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*p
 				CreatedAt: timestamppb.New(user.CreatedAt)})
 	}
 
-	return &pb.ListUsersResponse{Users: usersResp}, nil
+	return &pb.ListUsersResponse{
+		Users: usersResp,
+		Limit: req.Limit,
+		Offset: req.Offset,
+		Total: page.Total,
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
 
 
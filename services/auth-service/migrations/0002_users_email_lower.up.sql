@@ -0,0 +1,2 @@
+-- поиск по email без учета регистра, новые адреса хранятся в нижнем регистре
+CREATE INDEX IF NOT EXISTS idx_users_email_lower ON users(LOWER(email));
@@ -6,6 +6,8 @@ import (
 	"group_service/internal/app"
 	"group_service/internal/config"
 	"group_service/pkg/migrator"
+	// база часовых поясов для писем, в образе может не быть tzdata
+	_ "time/tzdata"
 )
 
 func main() {
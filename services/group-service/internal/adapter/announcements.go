@@ -0,0 +1,223 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var announcementColumns = []string{
+	"a.id", "a.group_id", "a.author_id", "a.body", "a.pinned",
+	"a.publish_at", "a.published_at", "a.created_at", "a.updated_at",
+	// прочтения считаются только у текущих участников группы
+	`(SELECT COUNT(*) FROM group_announcement_reads r
+		JOIN group_members m ON m.group_id = a.group_id AND m.student_id = r.student_id
+		WHERE r.announcement_id = a.id)`,
+	"(SELECT COUNT(*) FROM group_members m WHERE m.group_id = a.group_id)",
+}
+
+func scanAnnouncement(row pgx.Row, dest ...any) (*models.Announcement, error) {
+	a := &models.Announcement{}
+	fields := []any{&a.ID, &a.GroupID, &a.AuthorID, &a.Body, &a.Pinned,
+		&a.PublishAt, &a.PublishedAt, &a.CreatedAt, &a.UpdatedAt, &a.ReadCount, &a.MemberCount}
+	if err := row.Scan(append(fields, dest...)...); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// selectAnnouncements - выборка с отметкой о прочтении для readerID
+func (r *GroupsRepo) selectAnnouncements(readerID string) squirrel.SelectBuilder {
+	return r.builder.Select(append(announcementColumns, "rd.read_at")...).
+		From("group_announcements a").
+		LeftJoin("group_announcement_reads rd ON rd.announcement_id = a.id AND rd.student_id = ?", readerID)
+}
+
+func (r *GroupsRepo) CreateAnnouncement(ctx context.Context, a *models.Announcement) error {
+	query, args, err := r.builder.Insert("group_announcements").
+		Columns("id", "group_id", "author_id", "body", "pinned", "publish_at", "published_at", "created_at", "updated_at").
+		Values(a.ID, a.GroupID, a.AuthorID, a.Body, a.Pinned, a.PublishAt, a.PublishedAt, a.CreatedAt, a.UpdatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert announcement: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetAnnouncement(ctx context.Context, groupID, announcementID, readerID string) (*models.Announcement, error) {
+	query, args, err := r.selectAnnouncements(readerID).
+		Where(squirrel.Eq{"a.id": announcementID, "a.group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var readAt *time.Time
+	a, err := scanAnnouncement(r.conn(ctx).QueryRow(ctx, query, args...), &readAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrAnnouncementNotFound
+		}
+		return nil, fmt.Errorf("failed to query announcement: %w", err)
+	}
+	a.ReadAt = readAt
+
+	return a, nil
+}
+
+// ListAnnouncements - закрепленные объявления первыми, затем новые
+func (r *GroupsRepo) ListAnnouncements(ctx context.Context, filter models.AnnouncementFilter) ([]*models.Announcement, error) {
+	selectBuilder := r.selectAnnouncements(filter.ReaderID).
+		Where(squirrel.Eq{"a.group_id": filter.GroupID}).
+		OrderBy("a.pinned DESC", "a.publish_at DESC")
+	if !filter.IncludeScheduled {
+		selectBuilder = selectBuilder.Where(squirrel.NotEq{"a.published_at": nil})
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query announcements: %w", err)
+	}
+	defer rows.Close()
+
+	announcements := make([]*models.Announcement, 0)
+	for rows.Next() {
+		var readAt *time.Time
+		a, err := scanAnnouncement(rows, &readAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan announcement: %w", err)
+		}
+		a.ReadAt = readAt
+		announcements = append(announcements, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return announcements, nil
+}
+
+// UpdateAnnouncement сохраняет текст, закрепление и время публикации. Время публикации
+// меняется только у неопубликованного объявления, иначе ErrAnnouncementPublished.
+func (r *GroupsRepo) UpdateAnnouncement(ctx context.Context, a *models.Announcement, reschedule bool) error {
+	updateBuilder := r.builder.Update("group_announcements").
+		Set("body", a.Body).
+		Set("pinned", a.Pinned).
+		Set("updated_at", a.UpdatedAt).
+		Where(squirrel.Eq{"id": a.ID, "group_id": a.GroupID})
+	if reschedule {
+		updateBuilder = updateBuilder.
+			Set("publish_at", a.PublishAt).
+			Set("published_at", a.PublishedAt).
+			Where(squirrel.Eq{"published_at": nil})
+	}
+
+	query, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update announcement: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		if reschedule {
+			return models.ErrAnnouncementPublished
+		}
+		return models.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) DeleteAnnouncement(ctx context.Context, groupID, announcementID string) error {
+	query, args, err := r.builder.Delete("group_announcements").
+		Where(squirrel.Eq{"id": announcementID, "group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete announcement: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+// MarkAnnouncementRead сохраняет первое прочтение, повторные отметки время не меняют
+func (r *GroupsRepo) MarkAnnouncementRead(ctx context.Context, announcementID, studentID string, readAt time.Time) error {
+	query, args, err := r.builder.Insert("group_announcement_reads").
+		Columns("announcement_id", "student_id", "read_at").
+		Values(announcementID, studentID, readAt).
+		Suffix("ON CONFLICT (announcement_id, student_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark announcement read: %w", err)
+	}
+
+	return nil
+}
+
+// PublishDueAnnouncements отмечает опубликованными отложенные объявления, время которых наступило.
+// UPDATE ... RETURNING забирает каждое объявление ровно одному экземпляру сервиса.
+// Объявления архивных групп ждут восстановления группы.
+func (r *GroupsRepo) PublishDueAnnouncements(ctx context.Context, now time.Time) ([]*models.Announcement, error) {
+	query, args, err := r.builder.Update("group_announcements a").
+		Set("published_at", now).
+		Where(squirrel.Eq{"a.published_at": nil}).
+		Where(squirrel.LtOrEq{"a.publish_at": now}).
+		Where("EXISTS (SELECT 1 FROM student_groups g WHERE g.id = a.group_id AND g.archived_at IS NULL)").
+		Suffix("RETURNING a.id, a.group_id, a.author_id, a.body, a.pinned, a.publish_at, a.published_at, a.created_at, a.updated_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish announcements: %w", err)
+	}
+	defer rows.Close()
+
+	announcements := make([]*models.Announcement, 0)
+	for rows.Next() {
+		a := &models.Announcement{}
+		if err := rows.Scan(&a.ID, &a.GroupID, &a.AuthorID, &a.Body, &a.Pinned,
+			&a.PublishAt, &a.PublishedAt, &a.CreatedAt, &a.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan announcement: %w", err)
+		}
+		announcements = append(announcements, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return announcements, nil
+}
@@ -0,0 +1,86 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"group_service/internal/models"
+	"log"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const chatChannelPrefix = "group-chat"
+
+// ChatBroker рассылает события чата через redis pub/sub, чтобы подписчики
+// на любом экземпляре сервиса получали сообщения, отправленные через другой
+type ChatBroker struct {
+	client *redis.Client
+}
+
+func NewChatBroker(client *redis.Client) *ChatBroker {
+	return &ChatBroker{client: client}
+}
+
+func chatChannel(groupID string) string {
+	return fmt.Sprintf("%s:%s", chatChannelPrefix, groupID)
+}
+
+func (b *ChatBroker) PublishMessageEvent(ctx context.Context, event *models.MessageEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message event: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, chatChannel(event.Message.GroupID), data).Err(); err != nil {
+		return fmt.Errorf("failed to publish message event: %w", err)
+	}
+
+	return nil
+}
+
+// SubscribeGroup подписывается на чат группы. Канал закрывается после отмены ctx.
+func (b *ChatBroker) SubscribeGroup(ctx context.Context, groupID string) (<-chan *models.MessageEvent, error) {
+	pubsub := b.client.Subscribe(ctx, chatChannel(groupID))
+	// дожидаемся подтверждения, иначе первые сообщения после подписки могут потеряться
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to group chat: %w", err)
+	}
+
+	events := make(chan *models.MessageEvent)
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var event models.MessageEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					log.Printf("failed to decode chat event for group %s: %v", groupID, err)
+					continue
+				}
+
+				select {
+				case events <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (b *ChatBroker) Close() error {
+	return b.client.Close()
+}
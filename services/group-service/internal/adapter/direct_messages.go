@@ -0,0 +1,454 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// sharedGroupQuery - есть ли у двух пользователей общая неархивная группа, где хотя бы один из них
+// в персонале: ученики одной группы друг другу не пишут
+const sharedGroupQuery = `
+WITH participants AS (
+	SELECT g.id AS group_id, g.tutor_id AS user_id, TRUE AS staff
+	FROM student_groups g WHERE g.archived_at IS NULL
+	UNION ALL
+	SELECT s.group_id, s.user_id, TRUE
+	FROM group_staff s JOIN student_groups g ON g.id = s.group_id WHERE g.archived_at IS NULL
+	UNION ALL
+	SELECT m.group_id, m.student_id, FALSE
+	FROM group_members m JOIN student_groups g ON g.id = m.group_id WHERE g.archived_at IS NULL
+)
+SELECT EXISTS (
+	SELECT 1 FROM participants a
+	JOIN participants b ON b.group_id = a.group_id
+	WHERE a.user_id = $1 AND b.user_id = $2 AND (a.staff OR b.staff)
+)`
+
+var conversationColumns = []string{"c.id", "c.user1_id", "c.user2_id", "c.created_at", "c.last_message_at"}
+
+var directMessageColumns = []string{"dm.id", "dm.conversation_id", "dm.sender_id", "dm.body", "dm.created_at"}
+
+func scanConversation(row pgx.Row, dest ...any) (*models.Conversation, error) {
+	c := &models.Conversation{}
+	fields := []any{&c.ID, &c.UserIDs[0], &c.UserIDs[1], &c.CreatedAt, &c.LastMessageAt}
+	if err := row.Scan(append(fields, dest...)...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *GroupsRepo) ShareGroup(ctx context.Context, userID, peerID string) (bool, error) {
+	var shared bool
+	if err := r.conn(ctx).QueryRow(ctx, sharedGroupQuery, userID, peerID).Scan(&shared); err != nil {
+		return false, fmt.Errorf("failed to check shared group: %w", err)
+	}
+	return shared, nil
+}
+
+// GetOrCreateConversation возвращает переписку пары пользователей, создавая ее при первом обращении.
+// UserIDs должны быть упорядочены.
+func (r *GroupsRepo) GetOrCreateConversation(ctx context.Context, c *models.Conversation) (*models.Conversation, error) {
+	query, args, err := r.builder.Insert("direct_conversations").
+		Columns("id", "user1_id", "user2_id", "created_at").
+		Values(c.ID, c.UserIDs[0], c.UserIDs[1], c.CreatedAt).
+		// пустое обновление, чтобы RETURNING вернул уже существующую переписку
+		Suffix(`ON CONFLICT (user1_id, user2_id) DO UPDATE SET user1_id = EXCLUDED.user1_id
+			RETURNING id, user1_id, user2_id, created_at, last_message_at`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	conversation, err := scanConversation(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert conversation: %w", err)
+	}
+
+	return conversation, nil
+}
+
+func (r *GroupsRepo) GetConversation(ctx context.Context, conversationID string) (*models.Conversation, error) {
+	query, args, err := r.builder.Select(conversationColumns...).
+		From("direct_conversations c").
+		Where(squirrel.Eq{"c.id": conversationID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	conversation, err := scanConversation(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrConversationNotFound
+		}
+		return nil, fmt.Errorf("failed to query conversation: %w", err)
+	}
+
+	return conversation, nil
+}
+
+// ListConversations - переписки пользователя с последним сообщением и числом непрочитанных,
+// сначала с новыми сообщениями. Переписки без сообщений не показываются.
+func (r *GroupsRepo) ListConversations(ctx context.Context, userID string) ([]*models.Conversation, error) {
+	query, args, err := r.builder.Select(conversationColumns...).
+		Columns("lm.id", "lm.sender_id", "lm.body", "lm.created_at").
+		Column(squirrel.Expr(`(SELECT COUNT(*) FROM direct_messages u
+			WHERE u.conversation_id = c.id AND u.sender_id <> ?
+			AND u.created_at > COALESCE(CASE WHEN c.user1_id = ? THEN c.user1_read_at ELSE c.user2_read_at END, '-infinity'))`,
+			userID, userID)).
+		From("direct_conversations c").
+		JoinClause(`JOIN LATERAL (SELECT dm.id, dm.sender_id, dm.body, dm.created_at FROM direct_messages dm
+			WHERE dm.conversation_id = c.id ORDER BY dm.created_at DESC, dm.id DESC LIMIT 1) lm ON TRUE`).
+		Where(squirrel.Or{squirrel.Eq{"c.user1_id": userID}, squirrel.Eq{"c.user2_id": userID}}).
+		OrderBy("c.last_message_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query conversations: %w", err)
+	}
+	defer rows.Close()
+
+	conversations := make([]*models.Conversation, 0)
+	for rows.Next() {
+		last := &models.DirectMessage{}
+		var unread int
+		c, err := scanConversation(rows, &last.ID, &last.SenderID, &last.Body, &last.CreatedAt, &unread)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan conversation: %w", err)
+		}
+		last.ConversationID = c.ID
+		c.LastMessage = last
+		c.UnreadCount = unread
+		conversations = append(conversations, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return conversations, nil
+}
+
+// CreateDirectMessage сохраняет сообщение с вложениями и сдвигает время последнего сообщения переписки
+func (r *GroupsRepo) CreateDirectMessage(ctx context.Context, m *models.DirectMessage) error {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := r.builder.Insert("direct_messages").
+		Columns("id", "conversation_id", "sender_id", "body", "created_at").
+		Values(m.ID, m.ConversationID, m.SenderID, m.Body, m.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert direct message: %w", err)
+	}
+
+	if len(m.Attachments) > 0 {
+		insertBuilder := r.builder.Insert("direct_message_attachments").
+			Columns("message_id", "position", "name", "url", "content_type", "size")
+		for i, a := range m.Attachments {
+			insertBuilder = insertBuilder.Values(m.ID, i, a.Name, a.URL, a.ContentType, a.Size)
+		}
+
+		query, args, err = insertBuilder.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert attachments: %w", err)
+		}
+	}
+
+	query, args, err = r.builder.Update("direct_conversations").
+		Set("last_message_at", m.CreatedAt).
+		Where(squirrel.Eq{"id": m.ConversationID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetDirectMessage(ctx context.Context, conversationID, messageID string) (*models.DirectMessage, error) {
+	selectBuilder := r.builder.Select(directMessageColumns...).
+		From("direct_messages dm").
+		Where(squirrel.Eq{"dm.conversation_id": conversationID, "dm.id": messageID})
+
+	messages, err := r.queryDirectMessages(ctx, selectBuilder, "", 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, models.ErrMessageNotFound
+	}
+
+	return messages[0], nil
+}
+
+// ListDirectMessages возвращает историю переписки от новых к старым, beforeID - курсор
+func (r *GroupsRepo) ListDirectMessages(ctx context.Context, conversationID, beforeID string, limit int) ([]*models.DirectMessage, error) {
+	selectBuilder := r.builder.Select(directMessageColumns...).
+		From("direct_messages dm").
+		Where(squirrel.Eq{"dm.conversation_id": conversationID})
+
+	return r.queryDirectMessages(ctx, selectBuilder, beforeID, limit)
+}
+
+// SearchDirectMessages ищет по словам во всех переписках пользователя или в одной переписке
+func (r *GroupsRepo) SearchDirectMessages(ctx context.Context, filter models.DirectMessageSearch) ([]*models.DirectMessage, error) {
+	selectBuilder := r.builder.Select(directMessageColumns...).
+		From("direct_messages dm").
+		Join("direct_conversations c ON c.id = dm.conversation_id").
+		Where(squirrel.Or{squirrel.Eq{"c.user1_id": filter.UserID}, squirrel.Eq{"c.user2_id": filter.UserID}}).
+		Where("dm.search_vector @@ plainto_tsquery('simple', ?)", filter.Query)
+	if filter.ConversationID != "" {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"dm.conversation_id": filter.ConversationID})
+	}
+
+	return r.queryDirectMessages(ctx, selectBuilder, filter.BeforeID, filter.Limit)
+}
+
+func (r *GroupsRepo) queryDirectMessages(ctx context.Context, selectBuilder squirrel.SelectBuilder, beforeID string, limit int) ([]*models.DirectMessage, error) {
+	selectBuilder = selectBuilder.
+		OrderBy("dm.created_at DESC", "dm.id DESC").
+		Limit(uint64(limit))
+	if beforeID != "" {
+		selectBuilder = selectBuilder.Where(
+			"(dm.created_at, dm.id) < (SELECT created_at, id FROM direct_messages WHERE id = ?)", beforeID,
+		)
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query direct messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*models.DirectMessage, 0)
+	byID := make(map[string]*models.DirectMessage)
+	for rows.Next() {
+		m := &models.DirectMessage{Attachments: make([]models.Attachment, 0)}
+		if err := rows.Scan(&m.ID, &m.ConversationID, &m.SenderID, &m.Body, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan direct message: %w", err)
+		}
+		messages = append(messages, m)
+		byID[m.ID] = m
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, byID); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *GroupsRepo) loadAttachments(ctx context.Context, byID map[string]*models.DirectMessage) error {
+	if len(byID) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+
+	query, args, err := r.builder.Select("message_id", "name", "url", "content_type", "size").
+		From("direct_message_attachments").
+		Where(squirrel.Eq{"message_id": ids}).
+		OrderBy("message_id", "position").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID string
+			a         models.Attachment
+		)
+		if err := rows.Scan(&messageID, &a.Name, &a.URL, &a.ContentType, &a.Size); err != nil {
+			return fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		if m, ok := byID[messageID]; ok {
+			m.Attachments = append(m.Attachments, a)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return nil
+}
+
+// MarkConversationRead отмечает переписку прочитанной участником до readAt, время не сдвигается назад
+func (r *GroupsRepo) MarkConversationRead(ctx context.Context, c *models.Conversation, userID string, readAt time.Time) error {
+	column := "user1_read_at"
+	if c.UserIDs[1] == userID {
+		column = "user2_read_at"
+	}
+
+	query, args, err := r.builder.Update("direct_conversations").
+		Set(column, squirrel.Expr(fmt.Sprintf("GREATEST(COALESCE(%s, ?), ?)", column), readAt, readAt)).
+		Where(squirrel.Eq{"id": c.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark conversation read: %w", err)
+	}
+
+	return nil
+}
+
+// BlockUser - повторная блокировка сохраняет исходное время
+func (r *GroupsRepo) BlockUser(ctx context.Context, b *models.UserBlock) error {
+	query, args, err := r.builder.Insert("user_blocks").
+		Columns("blocker_id", "blocked_id", "created_at").
+		Values(b.BlockerID, b.BlockedID, b.CreatedAt).
+		Suffix("ON CONFLICT (blocker_id, blocked_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to block user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	query, args, err := r.builder.Delete("user_blocks").
+		Where(squirrel.Eq{"blocker_id": blockerID, "blocked_id": blockedID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to unblock user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) ListBlockedUsers(ctx context.Context, blockerID string) ([]*models.UserBlock, error) {
+	query, args, err := r.builder.Select("blocker_id", "blocked_id", "created_at").
+		From("user_blocks").
+		Where(squirrel.Eq{"blocker_id": blockerID}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blocks: %w", err)
+	}
+	defer rows.Close()
+
+	blocks := make([]*models.UserBlock, 0)
+	for rows.Next() {
+		b := &models.UserBlock{}
+		if err := rows.Scan(&b.BlockerID, &b.BlockedID, &b.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan block: %w", err)
+		}
+		blocks = append(blocks, b)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return blocks, nil
+}
+
+// IsBlocked - заблокировал ли кто-то из двух пользователей другого
+func (r *GroupsRepo) IsBlocked(ctx context.Context, userID, peerID string) (bool, error) {
+	query, args, err := r.builder.Select("1").
+		From("user_blocks").
+		Where(squirrel.Or{
+			squirrel.Eq{"blocker_id": userID, "blocked_id": peerID},
+			squirrel.Eq{"blocker_id": peerID, "blocked_id": userID},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var blocked bool
+	if err := r.conn(ctx).QueryRow(ctx, query, args...).Scan(&blocked); err != nil {
+		return false, fmt.Errorf("failed to check block: %w", err)
+	}
+
+	return blocked, nil
+}
+
+func (r *GroupsRepo) CreateReport(ctx context.Context, report *models.UserReport) error {
+	query, args, err := r.builder.Insert("user_reports").
+		Columns("id", "reporter_id", "reported_id", "conversation_id", "message_id", "reason", "created_at").
+		Values(report.ID, report.ReporterID, report.ReportedID, nullIfEmpty(report.ConversationID),
+			nullIfEmpty(report.MessageID), report.Reason, report.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert report: %w", err)
+	}
+
+	return nil
+}
+
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
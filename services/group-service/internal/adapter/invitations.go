@@ -0,0 +1,213 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var invitationColumns = []string{
+	"id", "group_id", "code", "COALESCE(email, '')", "created_by",
+	"max_uses", "use_count", "expires_at", "revoked_at", "created_at",
+}
+
+func scanInvitation(row pgx.Row) (*models.GroupInvitation, error) {
+	inv := &models.GroupInvitation{}
+	err := row.Scan(&inv.ID, &inv.GroupID, &inv.Code, &inv.Email, &inv.CreatedBy,
+		&inv.MaxUses, &inv.UseCount, &inv.ExpiresAt, &inv.RevokedAt, &inv.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+func (r *GroupsRepo) CreateInvitation(ctx context.Context, inv *models.GroupInvitation) error {
+	var email any
+	if inv.Email != "" {
+		email = inv.Email
+	}
+
+	query, args, err := r.builder.Insert("group_invitations").
+		Columns("id", "group_id", "code", "email", "created_by", "max_uses", "expires_at", "created_at").
+		Values(inv.ID, inv.GroupID, inv.Code, email, inv.CreatedBy, inv.MaxUses, inv.ExpiresAt, inv.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err = r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetInvitationByCode(ctx context.Context, code string) (*models.GroupInvitation, error) {
+	query, args, err := r.builder.Select(invitationColumns...).
+		From("group_invitations").
+		Where(squirrel.Eq{"code": code}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	inv, err := scanInvitation(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to query invitation: %w", err)
+	}
+
+	return inv, nil
+}
+
+func (r *GroupsRepo) ListInvitations(ctx context.Context, groupID string) ([]*models.GroupInvitation, error) {
+	query, args, err := r.builder.Select(invitationColumns...).
+		From("group_invitations").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invitations: %w", err)
+	}
+	defer rows.Close()
+
+	invitations := make([]*models.GroupInvitation, 0)
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+		invitations = append(invitations, inv)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (r *GroupsRepo) RevokeInvitation(ctx context.Context, groupID, invitationID string, now time.Time) error {
+	query, args, err := r.builder.Update("group_invitations").
+		Set("revoked_at", now).
+		Where(squirrel.Eq{"id": invitationID, "group_id": groupID}).
+		Where("revoked_at IS NULL").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return models.ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+// RedeemInvitation списывает использование приглашения и добавляет участника в одной транзакции.
+// Если пользователь уже в группе, использование не списывается. ErrWaitlisted возвращается
+// после сохранения: группа заполнена, и ученик встал в очередь.
+func (r *GroupsRepo) RedeemInvitation(ctx context.Context, inv *models.GroupInvitation, studentID string, now time.Time) error {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// условие повторяет CheckUsable, чтобы параллельные вступления не превысили max_uses
+	query, args, err := r.builder.Update("group_invitations").
+		Set("use_count", squirrel.Expr("use_count + 1")).
+		Where(squirrel.Eq{"id": inv.ID}).
+		Where("revoked_at IS NULL").
+		Where(squirrel.Gt{"expires_at": now}).
+		Where("(max_uses = 0 OR use_count < max_uses)").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to use invitation: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrInvitationExhausted
+	}
+
+	waitlisted, err := r.addMemberTx(ctx, tx, inv.GroupID, studentID, now)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if waitlisted {
+		return models.ErrWaitlisted
+	}
+	return nil
+}
+
+// addMemberTx добавляет участника внутри транзакции. В заполненной группе ученик встает
+// в очередь, тогда возвращается true.
+func (r *GroupsRepo) addMemberTx(ctx context.Context, tx pgx.Tx, groupID, studentID string, joinedAt time.Time) (bool, error) {
+	free, limited, err := r.lockCapacityTx(ctx, tx, groupID)
+	if err != nil {
+		return false, err
+	}
+
+	if limited && free <= 0 {
+		member, err := r.isMemberTx(ctx, tx, groupID, studentID)
+		if err != nil {
+			return false, err
+		}
+		if member {
+			return false, models.ErrAlreadyMember
+		}
+		if err := r.addToWaitlistTx(ctx, tx, groupID, studentID, joinedAt); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	query, args, err := r.builder.Insert("group_members").
+		Columns("student_id", "group_id", "joined_at").
+		Values(studentID, groupID, joinedAt).
+		Suffix("ON CONFLICT (group_id, student_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert member: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return false, models.ErrAlreadyMember
+	}
+
+	if err := r.removeFromWaitlistTx(ctx, tx, groupID, studentID); err != nil && !errors.Is(err, models.ErrNotOnWaitlist) {
+		return false, err
+	}
+
+	return false, nil
+}
@@ -0,0 +1,183 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const uniqueViolation = "23505"
+
+var joinRequestColumns = []string{
+	"id", "group_id", "student_id", "message", "status",
+	"COALESCE(decided_by, '')", "decided_at", "reject_reason", "created_at",
+}
+
+func scanJoinRequest(row pgx.Row) (*models.JoinRequest, error) {
+	req := &models.JoinRequest{}
+	err := row.Scan(&req.ID, &req.GroupID, &req.StudentID, &req.Message, &req.Status,
+		&req.DecidedBy, &req.DecidedAt, &req.RejectReason, &req.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+// CreateJoinRequest сохраняет заявку. Одобренная заявка (открытая группа) сразу добавляет участника
+// и возвращает true, в заполненной группе ученик встает в очередь и возвращается ErrWaitlisted.
+func (r *GroupsRepo) CreateJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error) {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var decidedBy any
+	if req.DecidedBy != "" {
+		decidedBy = req.DecidedBy
+	}
+
+	query, args, err := r.builder.Insert("group_join_requests").
+		Columns("id", "group_id", "student_id", "message", "status", "decided_by", "decided_at", "created_at").
+		Values(req.ID, req.GroupID, req.StudentID, req.Message, req.Status, decidedBy, req.DecidedAt, req.CreatedAt).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return false, models.ErrJoinRequestExists
+		}
+		return false, fmt.Errorf("failed to insert join request: %w", err)
+	}
+
+	waitlisted := false
+	if req.Status == models.JoinRequestApproved {
+		if waitlisted, err = r.addMemberTx(ctx, tx, req.GroupID, req.StudentID, req.CreatedAt); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if waitlisted {
+		return false, models.ErrWaitlisted
+	}
+	return req.Status == models.JoinRequestApproved, nil
+}
+
+func (r *GroupsRepo) GetJoinRequest(ctx context.Context, groupID, requestID string) (*models.JoinRequest, error) {
+	query, args, err := r.builder.Select(joinRequestColumns...).
+		From("group_join_requests").
+		Where(squirrel.Eq{"id": requestID, "group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	req, err := scanJoinRequest(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrJoinRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to query join request: %w", err)
+	}
+
+	return req, nil
+}
+
+func (r *GroupsRepo) ListJoinRequests(ctx context.Context, groupID string, status models.JoinRequestStatus) ([]*models.JoinRequest, error) {
+	query, args, err := r.builder.Select(joinRequestColumns...).
+		From("group_join_requests").
+		Where(squirrel.Eq{"group_id": groupID, "status": status}).
+		OrderBy("created_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query join requests: %w", err)
+	}
+	defer rows.Close()
+
+	requests := make([]*models.JoinRequest, 0)
+	for rows.Next() {
+		req, err := scanJoinRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan join request: %w", err)
+		}
+		requests = append(requests, req)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return requests, nil
+}
+
+// DecideJoinRequest записывает решение по ожидающей заявке, при одобрении добавляет участника
+// или ставит его в очередь заполненной группы (ErrWaitlisted). true - участник действительно
+// добавлен: ученик мог вступить по приглашению, пока заявка ждала решения.
+func (r *GroupsRepo) DecideJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error) {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := r.builder.Update("group_join_requests").
+		Set("status", req.Status).
+		Set("decided_by", req.DecidedBy).
+		Set("decided_at", req.DecidedAt).
+		Set("reject_reason", req.RejectReason).
+		Where(squirrel.Eq{"id": req.ID, "group_id": req.GroupID, "status": models.JoinRequestPending}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update join request: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return false, models.ErrJoinRequestDecided
+	}
+
+	added, waitlisted := false, false
+	if req.Status == models.JoinRequestApproved {
+		waitlisted, err = r.addMemberTx(ctx, tx, req.GroupID, req.StudentID, *req.DecidedAt)
+		switch {
+		case errors.Is(err, models.ErrAlreadyMember):
+		case err != nil:
+			return false, err
+		default:
+			added = !waitlisted
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if waitlisted {
+		return false, models.ErrWaitlisted
+	}
+	return added, nil
+}
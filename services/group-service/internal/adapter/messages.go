@@ -0,0 +1,144 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var messageColumns = []string{"id", "group_id", "author_id", "body", "created_at", "edited_at", "deleted_at"}
+
+func scanMessage(row pgx.Row) (*models.Message, error) {
+	m := &models.Message{}
+	if err := row.Scan(&m.ID, &m.GroupID, &m.AuthorID, &m.Body, &m.CreatedAt, &m.EditedAt, &m.DeletedAt); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (r *GroupsRepo) CreateMessage(ctx context.Context, m *models.Message) error {
+	query, args, err := r.builder.Insert("group_messages").
+		Columns("id", "group_id", "author_id", "body", "created_at").
+		Values(m.ID, m.GroupID, m.AuthorID, m.Body, m.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert message: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetMessage(ctx context.Context, groupID, messageID string) (*models.Message, error) {
+	query, args, err := r.builder.Select(messageColumns...).
+		From("group_messages").
+		Where(squirrel.Eq{"id": messageID, "group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	m, err := scanMessage(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrMessageNotFound
+		}
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+
+	return m, nil
+}
+
+// ListMessages возвращает историю от новых к старым. beforeID - курсор: сообщения,
+// отправленные раньше указанного.
+func (r *GroupsRepo) ListMessages(ctx context.Context, groupID, beforeID string, limit int) ([]*models.Message, error) {
+	selectBuilder := r.builder.Select(messageColumns...).
+		From("group_messages").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(limit))
+	if beforeID != "" {
+		selectBuilder = selectBuilder.Where(
+			"(created_at, id) < (SELECT created_at, id FROM group_messages WHERE id = ? AND group_id = ?)",
+			beforeID, groupID,
+		)
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*models.Message, 0)
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return messages, nil
+}
+
+// UpdateMessage сохраняет новый текст, удаленное сообщение не редактируется
+func (r *GroupsRepo) UpdateMessage(ctx context.Context, m *models.Message) error {
+	query, args, err := r.builder.Update("group_messages").
+		Set("body", m.Body).
+		Set("edited_at", m.EditedAt).
+		Where(squirrel.Eq{"id": m.ID, "group_id": m.GroupID, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update message: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrMessageDeleted
+	}
+
+	return nil
+}
+
+// DeleteMessage оставляет в истории пустую запись, чтобы не ломать курсоры клиентов
+func (r *GroupsRepo) DeleteMessage(ctx context.Context, groupID, messageID string, deletedAt time.Time) error {
+	query, args, err := r.builder.Update("group_messages").
+		Set("body", "").
+		Set("deleted_at", deletedAt).
+		Where(squirrel.Eq{"id": messageID, "group_id": groupID, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrMessageDeleted
+	}
+
+	return nil
+}
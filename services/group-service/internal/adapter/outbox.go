@@ -0,0 +1,157 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"group_service/internal/events"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// outboxLockID - ключ advisory-блокировки, одновременно события отправляет один экземпляр сервиса
+const outboxLockID = 7351001
+
+type txKey struct{}
+
+// querier - общие методы пула и транзакции
+type querier interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn возвращает транзакцию из контекста, если она открыта через InTx.
+// Транзакции внутри методов репозитория тогда становятся точками сохранения.
+func (r *GroupsRepo) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return r.db
+}
+
+// InTx выполняет fn в одной транзакции, вложенный вызов использует уже открытую
+func (r *GroupsRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// AddOutboxEvents блокирует строку группы до конца транзакции перед вставкой. Транзакции
+// одной группы пишут события по очереди, и события группы видны отправителю в порядке id:
+// событие с меньшим id не может зафиксироваться позже уже отправленного.
+func (r *GroupsRepo) AddOutboxEvents(ctx context.Context, topic, key string, envelopes ...events.Envelope) error {
+	if len(envelopes) == 0 {
+		return nil
+	}
+
+	// удаленной группы уже нет, ее строку держит сама транзакция удаления
+	if _, err := r.conn(ctx).Exec(ctx, "SELECT 1 FROM student_groups WHERE id = $1 FOR UPDATE", key); err != nil {
+		return fmt.Errorf("failed to lock group %s: %w", key, err)
+	}
+
+	insertBuilder := r.builder.Insert("group_outbox").Columns("topic", "event_key", "event")
+	for _, e := range envelopes {
+		raw, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", e.EventType, err)
+		}
+		insertBuilder = insertBuilder.Values(topic, key, raw)
+	}
+
+	query, args, err := insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert outbox events: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxMessages возвращает первые неотправленные события. Вызывается внутри InTx:
+// блокировка держится до конца транзакции, и пока другой экземпляр отправляет события,
+// список пуст.
+func (r *GroupsRepo) ClaimOutboxMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error) {
+	var locked bool
+	if err := r.conn(ctx).QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1)", outboxLockID).Scan(&locked); err != nil {
+		return nil, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	query, args, err := r.builder.Select("id", "topic", "event_key", "event").
+		From("group_outbox").
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*events.OutboxMessage, 0)
+	for rows.Next() {
+		m := &events.OutboxMessage{}
+		var raw []byte
+		if err := rows.Scan(&m.ID, &m.Topic, &m.Key, &raw); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		if err := json.Unmarshal(raw, &m.Event); err != nil {
+			return nil, fmt.Errorf("failed to decode outbox message %d: %w", m.ID, err)
+		}
+		messages = append(messages, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *GroupsRepo) DeleteOutboxMessages(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := r.builder.Delete("group_outbox").
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete outbox messages: %w", err)
+	}
+
+	return nil
+}
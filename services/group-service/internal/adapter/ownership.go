@@ -0,0 +1,157 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveOwnershipTransfer создает передачу владения или заменяет предыдущую для этой группы
+func (r *GroupsRepo) SaveOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer) error {
+	query, args, err := r.builder.Insert("group_ownership_transfers").
+		Columns("group_id", "from_user_id", "to_user_id", "keep_as_co_tutor", "created_at", "expires_at").
+		Values(t.GroupID, t.FromUserID, t.ToUserID, t.KeepAsCoTutor, t.CreatedAt, t.ExpiresAt).
+		Suffix(`ON CONFLICT (group_id) DO UPDATE SET
+			from_user_id = EXCLUDED.from_user_id,
+			to_user_id = EXCLUDED.to_user_id,
+			keep_as_co_tutor = EXCLUDED.keep_as_co_tutor,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at`).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to save ownership transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetOwnershipTransfer(ctx context.Context, groupID string) (*models.OwnershipTransfer, error) {
+	query, args, err := r.builder.Select("group_id", "from_user_id", "to_user_id", "keep_as_co_tutor", "created_at", "expires_at").
+		From("group_ownership_transfers").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	t := &models.OwnershipTransfer{}
+	err = r.conn(ctx).QueryRow(ctx, query, args...).Scan(&t.GroupID, &t.FromUserID, &t.ToUserID, &t.KeepAsCoTutor, &t.CreatedAt, &t.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to query ownership transfer: %w", err)
+	}
+
+	return t, nil
+}
+
+func (r *GroupsRepo) DeleteOwnershipTransfer(ctx context.Context, groupID string) error {
+	query, args, err := r.builder.Delete("group_ownership_transfers").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete ownership transfer: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrTransferNotFound
+	}
+
+	return nil
+}
+
+// CompleteOwnershipTransfer меняет владельца группы. Передача и смена владельца проверяются
+// условиями в одной транзакции: параллельная отмена или другая передача не применятся дважды.
+func (r *GroupsRepo) CompleteOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer, now time.Time) error {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := r.builder.Delete("group_ownership_transfers").
+		Where(squirrel.Eq{"group_id": t.GroupID, "from_user_id": t.FromUserID, "to_user_id": t.ToUserID}).
+		Where(squirrel.Gt{"expires_at": now}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete ownership transfer: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrTransferNotFound
+	}
+
+	query, args, err = r.builder.Update("student_groups").
+		Set("tutor_id", t.ToUserID).
+		Where(squirrel.Eq{"id": t.GroupID, "tutor_id": t.FromUserID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+	res, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update group owner: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrTransferNotFound
+	}
+
+	// новый владелец больше не числится в персонале
+	query, args, err = r.builder.Delete("group_staff").
+		Where(squirrel.Eq{"group_id": t.GroupID, "user_id": t.ToUserID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete staff member: %w", err)
+	}
+
+	query, args, err = r.builder.Insert("group_former_owners").
+		Columns("group_id", "user_id", "created_at").
+		Values(t.GroupID, t.FromUserID, now).
+		Suffix("ON CONFLICT (group_id, user_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to save former owner: %w", err)
+	}
+
+	if t.KeepAsCoTutor {
+		query, args, err = r.builder.Insert("group_staff").
+			Columns("group_id", "user_id", "role", "added_by", "created_at").
+			Values(t.GroupID, t.FromUserID, models.StaffRoleCoTutor, t.ToUserID, now).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to add previous owner to staff: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"group_service/internal/models"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -26,14 +27,14 @@ func NewGroupsRepo(db *pgxpool.Pool) *GroupsRepo {
 
 func (r *GroupsRepo) CreateGroup(ctx context.Context, group *models.Group) error {
 	query, args, err := r.builder.Insert("student_groups").
-		Columns("id", "tutor_id", "name", "description", "created_at").
-		Values(group.ID, group.TutorID, group.Name, group.Description, group.CreatedAt).
+		Columns("id", "tutor_id", "name", "description", "join_policy", "max_members", "created_at").
+		Values(group.ID, group.TutorID, group.Name, group.Description, group.JoinPolicy, group.MaxMembers, group.CreatedAt).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = r.conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to insert group: %w", err)
 	}
@@ -41,68 +42,89 @@ func (r *GroupsRepo) CreateGroup(ctx context.Context, group *models.Group) error
 	return nil
 }
 
-func (r *GroupsRepo) ListTutorGroups(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error) {
-	query, args, err := r.builder.Select("id", "name", "description", "created_at").
-		From("student_groups").
-		Where(squirrel.Eq{"tutor_id": tutorID}).
-		OrderBy("created_at DESC").
-		ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build select query: %w", err)
-	}
+// groupSortColumns - колонки сортировки списка групп, последней всегда идет id
+var groupSortColumns = map[models.GroupSortField]string{
+	models.GroupSortCreatedAt:   "sg.created_at",
+	models.GroupSortName:        "sg.name",
+	models.GroupSortMemberCount: "sg.member_count",
+}
 
-	rows, err := r.db.Query(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query tutor groups: %w", err)
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListGroups возвращает страницу групп по фильтру. Если передан q.After,
+// страница начинается после этой группы.
+func (r *GroupsRepo) ListGroups(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+	sortCol, ok := groupSortColumns[q.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field %q", q.SortBy)
 	}
-	defer rows.Close()
 
-	groups := make([]*models.Group, 0)
-	for rows.Next() {
-		g := &models.Group{TutorID: tutorID}
-		if err := rows.Scan(&g.ID, &g.Name, &g.Description, &g.CreatedAt); err != nil {
-			return nil, fmt.Errorf("failed to scan group: %w", err)
-		}
-		groups = append(groups, g)
+	builder := r.selectGroups()
+
+	staffOf := func(userID string) squirrel.Sqlizer {
+		return squirrel.Expr("sg.id IN (SELECT group_id FROM group_staff WHERE user_id = ?)", userID)
+	}
+	memberOf := func(studentID string) squirrel.Sqlizer {
+		return squirrel.Expr("sg.id IN (SELECT group_id FROM group_members WHERE student_id = ?)", studentID)
+	}
+	switch {
+	case q.TutorID != "":
+		// группы, где репетитор владелец или входит в персонал
+		builder = builder.Where(squirrel.Or{squirrel.Eq{"sg.tutor_id": q.TutorID}, staffOf(q.TutorID)})
+	case q.StudentID != "":
+		builder = builder.Where(memberOf(q.StudentID))
+	default:
+		builder = builder.Where(squirrel.Or{squirrel.Eq{"sg.tutor_id": q.UserID}, staffOf(q.UserID), memberOf(q.UserID)})
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+	switch q.Archived {
+	case models.ArchiveFilterActive:
+		builder = builder.Where(squirrel.Eq{"sg.archived_at": nil})
+	case models.ArchiveFilterArchived:
+		builder = builder.Where(squirrel.NotEq{"sg.archived_at": nil})
+	}
+	if q.TermID != "" {
+		builder = builder.Where(squirrel.Eq{"sg.term_id": q.TermID})
+	}
+	if q.Search != "" {
+		builder = builder.Where("sg.name ILIKE ?", "%"+likeEscaper.Replace(q.Search)+"%")
 	}
 
-	if includeMembers {
-		for _, g := range groups {
-			g.Members, err = r.GetGroupMembers(ctx, g.ID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get members for group %s: %w", g.ID, err)
-			}
+	direction, op := "ASC", ">"
+	if q.Desc {
+		direction, op = "DESC", "<"
+	}
+	if c := q.After; c != nil {
+		var key any
+		switch q.SortBy {
+		case models.GroupSortName:
+			key = c.Name
+		case models.GroupSortMemberCount:
+			key = c.MemberCount
+		default:
+			key = c.CreatedAt
 		}
+		builder = builder.Where(fmt.Sprintf("(%s, sg.id) %s (?, ?)", sortCol, op), key, c.ID)
 	}
 
-	return groups, nil
-}
-
-func (r *GroupsRepo) ListStudentGroups(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error) {
-	query, args, err := r.builder.Select("sg.id", "sg.tutor_id", "sg.name", "sg.description", "sg.created_at").
-		From("student_groups sg").
-		Join("group_members gm ON gm.group_id = sg.id").
-		Where(squirrel.Eq{"gm.student_id": studentID}).
-		OrderBy("sg.created_at DESC").
+	query, args, err := builder.
+		OrderBy(sortCol+" "+direction, "sg.id "+direction).
+		Limit(uint64(q.Limit)).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query student groups: %w", err)
+		return nil, fmt.Errorf("failed to query groups: %w", err)
 	}
 	defer rows.Close()
 
 	groups := make([]*models.Group, 0)
 	for rows.Next() {
-		g := &models.Group{}
-		if err := rows.Scan(&g.ID, &g.TutorID, &g.Name, &g.Description, &g.CreatedAt); err != nil {
+		g, err := scanGroup(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan group: %w", err)
 		}
 		groups = append(groups, g)
@@ -113,7 +135,7 @@ func (r *GroupsRepo) ListStudentGroups(ctx context.Context, studentID string, in
 	}
 
 	// только если запрошено
-	if includeMembers {
+	if q.IncludeMembers {
 		for _, g := range groups {
 			g.Members, err = r.GetGroupMembers(ctx, g.ID)
 			if err != nil {
@@ -125,20 +147,41 @@ func (r *GroupsRepo) ListStudentGroups(ctx context.Context, studentID string, in
 	return groups, nil
 }
 
+// selectGroups выбирает группы вместе с учебным периодом, колонки в порядке scanGroup
+func (r *GroupsRepo) selectGroups() squirrel.SelectBuilder {
+	return r.builder.Select("sg.id", "sg.tutor_id", "sg.name", "sg.description", "sg.join_policy", "sg.max_members", "sg.created_at", "sg.archived_at", "sg.member_count",
+		"t.id", "t.owner_id", "t.name", "t.starts_at", "t.ends_at", "t.created_at").
+		From("student_groups sg").
+		LeftJoin("academic_terms t ON t.id = sg.term_id")
+}
+
+func scanGroup(row pgx.Row) (*models.Group, error) {
+	g := &models.Group{}
+	var termID, ownerID, name *string
+	var startsAt, endsAt, createdAt *time.Time
+	if err := row.Scan(&g.ID, &g.TutorID, &g.Name, &g.Description, &g.JoinPolicy, &g.MaxMembers, &g.CreatedAt, &g.ArchivedAt, &g.MemberCount,
+		&termID, &ownerID, &name, &startsAt, &endsAt, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if termID != nil {
+		g.Term = &models.Term{ID: *termID, OwnerID: *ownerID, Name: *name, StartsAt: *startsAt, EndsAt: *endsAt, CreatedAt: *createdAt}
+	}
+	return g, nil
+}
+
 func (r *GroupsRepo) GetGroup(ctx context.Context, id string, includeMembers bool) (*models.Group, error) {
-	query, args, err := r.builder.Select("tutor_id", "name", "description", "created_at").
-		From("student_groups").
-		Where(squirrel.Eq{"id": id}).
+	query, args, err := r.selectGroups().
+		Where(squirrel.Eq{"sg.id": id}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	g := &models.Group{ID: id}
-	err = r.db.QueryRow(ctx, query, args...).Scan(&g.TutorID, &g.Name, &g.Description, &g.CreatedAt)
+	g, err := scanGroup(r.conn(ctx).QueryRow(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("group with id %s not found", id)
+			return nil, fmt.Errorf("group with id %s: %w", id, models.ErrGroupNotFound)
 		}
 		return nil, fmt.Errorf("failed to query group: %w", err)
 	}
@@ -155,7 +198,7 @@ func (r *GroupsRepo) GetGroup(ctx context.Context, id string, includeMembers boo
 	return g, nil
 }
 
-func (r *GroupsRepo) UpdateGroup(ctx context.Context, id string, name, desc *string) error {
+func (r *GroupsRepo) UpdateGroup(ctx context.Context, id string, name, desc *string, policy *models.JoinPolicy, maxMembers *int) error {
 	updateBuilder := r.builder.Update("student_groups")
 
 	hasUpdates := false
@@ -167,6 +210,14 @@ func (r *GroupsRepo) UpdateGroup(ctx context.Context, id string, name, desc *str
 		updateBuilder = updateBuilder.Set("description", *desc)
 		hasUpdates = true
 	}
+	if policy != nil {
+		updateBuilder = updateBuilder.Set("join_policy", *policy)
+		hasUpdates = true
+	}
+	if maxMembers != nil {
+		updateBuilder = updateBuilder.Set("max_members", *maxMembers)
+		hasUpdates = true
+	}
 
 	if !hasUpdates {
 		return nil // Нет полей для обновления
@@ -177,7 +228,7 @@ func (r *GroupsRepo) UpdateGroup(ctx context.Context, id string, name, desc *str
 		return fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update group: %w", err)
 	}
@@ -201,7 +252,7 @@ func (r *GroupsRepo) DeleteGroup(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to build delete query: %w", err)
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to delete group: %w", err)
 	}
@@ -213,6 +264,49 @@ func (r *GroupsRepo) DeleteGroup(ctx context.Context, id string) error {
 	return nil
 }
 
+// ArchiveGroup переводит группу в архив, повторная архивация не меняет дату
+func (r *GroupsRepo) ArchiveGroup(ctx context.Context, id string, archivedAt time.Time) error {
+	query, args, err := r.builder.Update("student_groups").
+		Set("archived_at", archivedAt).
+		Where(squirrel.Eq{"id": id, "archived_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to archive group: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return models.ErrGroupArchived
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) RestoreGroup(ctx context.Context, id string) error {
+	query, args, err := r.builder.Update("student_groups").
+		Set("archived_at", nil).
+		Where(squirrel.And{squirrel.Eq{"id": id}, squirrel.NotEq{"archived_at": nil}}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to restore group: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return models.ErrGroupNotArchived
+	}
+
+	return nil
+}
+
 func (r *GroupsRepo) deleteGroupMembers(ctx context.Context, groupID string) error {
 	query, args, err := r.builder.Delete("group_members").
 		Where(squirrel.Eq{"group_id": groupID}).
@@ -221,7 +315,7 @@ func (r *GroupsRepo) deleteGroupMembers(ctx context.Context, groupID string) err
 		return fmt.Errorf("failed to build delete query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = r.conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to delete members: %w", err)
 	}
@@ -229,55 +323,125 @@ func (r *GroupsRepo) deleteGroupMembers(ctx context.Context, groupID string) err
 	return nil
 }
 
-func (r *GroupsRepo) AddMembers(ctx context.Context, groupID string, studentIDs []string) (int, error) {
+// AddMembers добавляет учеников в пределах max_members, остальные встают в очередь.
+// Строка группы блокируется, поэтому параллельные вступления не превысят лимит.
+// Возвращает ID добавленных и ID поставленных в очередь, уже состоящие в группе пропускаются.
+func (r *GroupsRepo) AddMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error) {
 	if len(studentIDs) == 0 {
-		return 0, nil
+		return nil, nil, nil
 	}
 
-	now := time.Now()
-	insertBuilder := r.builder.Insert("group_members").
-		Columns("student_id", "group_id", "joined_at")
-
-	for _, sid := range studentIDs {
-		insertBuilder = insertBuilder.Values(sid, groupID, now)
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	query, args, err := insertBuilder.
-		Suffix("ON CONFLICT (group_id, student_id) DO NOTHING").
-		ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("failed to build insert query: %w", err)
+	now := time.Now()
+	added, waitlisted := make([]string, 0, len(studentIDs)), make([]string, 0)
+	for _, sid := range studentIDs {
+		onWaitlist, err := r.addMemberTx(ctx, tx, groupID, sid, now)
+		if errors.Is(err, models.ErrAlreadyMember) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if onWaitlist {
+			waitlisted = append(waitlisted, sid)
+		} else {
+			added = append(added, sid)
+		}
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
-	if err != nil {
-		return 0, fmt.Errorf("failed to insert members: %w", err)
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return int(res.RowsAffected()), nil
+	return added, waitlisted, nil
 }
 
-func (r *GroupsRepo) RemoveMembers(ctx context.Context, groupID string, studentIDs []string) (int, error) {
+// RemoveMembers удаляет участников и переводит в группу первых из очереди на освободившиеся места.
+// Возвращает ID исключенных и ID переведенных из очереди.
+func (r *GroupsRepo) RemoveMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error) {
 	if len(studentIDs) == 0 {
-		return 0, nil
+		return nil, nil, nil
+	}
+
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
 	query, args, err := r.builder.Delete("group_members").
 		Where(squirrel.And{
 			squirrel.Eq{"group_id": groupID},
 			squirrel.Eq{"student_id": studentIDs},
 		}).
+		Suffix("RETURNING student_id").
+		ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to delete members: %w", err)
+	}
+	removed := make([]string, 0, len(studentIDs))
+	for rows.Next() {
+		var sid string
+		if err := rows.Scan(&sid); err != nil {
+			rows.Close()
+			return nil, nil, fmt.Errorf("failed to scan removed member: %w", err)
+		}
+		removed = append(removed, sid)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	// история участия нужна для отзывов о репетиторе после выхода из группы
+	if len(removed) > 0 {
+		now := time.Now()
+		insertBuilder := r.builder.Insert("group_former_members").Columns("group_id", "student_id", "left_at")
+		for _, sid := range removed {
+			insertBuilder = insertBuilder.Values(groupID, sid, now)
+		}
+		query, args, err = insertBuilder.
+			Suffix("ON CONFLICT (group_id, student_id) DO UPDATE SET left_at = EXCLUDED.left_at").
+			ToSql()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return nil, nil, fmt.Errorf("failed to save former members: %w", err)
+		}
+	}
+
+	// удаленные из группы не остаются и в очереди
+	query, args, err = r.builder.Delete("group_waitlist").
+		Where(squirrel.Eq{"group_id": groupID, "student_id": studentIDs}).
 		ToSql()
 	if err != nil {
-		return 0, fmt.Errorf("failed to build delete query: %w", err)
+		return nil, nil, fmt.Errorf("failed to build delete query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return nil, nil, fmt.Errorf("failed to delete waitlist entries: %w", err)
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
+	promoted, err := r.promoteWaitlistTx(ctx, tx, groupID, time.Now())
 	if err != nil {
-		return 0, fmt.Errorf("failed to delete members: %w", err)
+		return nil, nil, err
 	}
 
-	return int(res.RowsAffected()), nil
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return removed, promoted, nil
 }
 
 func (r *GroupsRepo) GetGroupMembers(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
@@ -290,7 +454,7 @@ func (r *GroupsRepo) GetGroupMembers(ctx context.Context, groupID string) ([]*mo
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query group members: %w", err)
 	}
@@ -311,3 +475,22 @@ func (r *GroupsRepo) GetGroupMembers(ctx context.Context, groupID string) ([]*mo
 
 	return members, nil
 }
+
+func (r *GroupsRepo) IsMember(ctx context.Context, groupID, studentID string) (bool, error) {
+	query, args, err := r.builder.Select("1").
+		From("group_members").
+		Where(squirrel.Eq{"group_id": groupID, "student_id": studentID}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var exists bool
+	if err := r.conn(ctx).QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check membership: %w", err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,147 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var rosterImportColumns = []string{
+	"id", "group_id", "created_by", "status", "rows", "error", "created_at", "started_at", "finished_at",
+}
+
+func scanRosterImport(row pgx.Row) (*models.RosterImport, error) {
+	imp := &models.RosterImport{}
+	var raw []byte
+	if err := row.Scan(&imp.ID, &imp.GroupID, &imp.CreatedBy, &imp.Status, &raw, &imp.Error,
+		&imp.CreatedAt, &imp.StartedAt, &imp.FinishedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &imp.Rows); err != nil {
+		return nil, fmt.Errorf("failed to decode roster import %s rows: %w", imp.ID, err)
+	}
+	return imp, nil
+}
+
+func (r *GroupsRepo) CreateRosterImport(ctx context.Context, imp *models.RosterImport) error {
+	raw, err := json.Marshal(imp.Rows)
+	if err != nil {
+		return fmt.Errorf("failed to marshal roster rows: %w", err)
+	}
+
+	query, args, err := r.builder.Insert("group_roster_imports").
+		Columns("id", "group_id", "created_by", "status", "rows", "created_at").
+		Values(imp.ID, imp.GroupID, imp.CreatedBy, imp.Status, raw, imp.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert roster import: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetRosterImport(ctx context.Context, groupID, importID string) (*models.RosterImport, error) {
+	query, args, err := r.builder.Select(rosterImportColumns...).
+		From("group_roster_imports").
+		Where(squirrel.Eq{"id": importID, "group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	imp, err := scanRosterImport(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrRosterImportNotFound
+		}
+		return nil, fmt.Errorf("failed to query roster import: %w", err)
+	}
+
+	return imp, nil
+}
+
+// ClaimRosterImport переводит самый старый ожидающий импорт в processing и возвращает его.
+// Импорт, который обрабатывается с момента до staleBefore, считается брошенным и забирается снова.
+// Возвращает nil, если забирать нечего.
+func (r *GroupsRepo) ClaimRosterImport(ctx context.Context, now, staleBefore time.Time) (*models.RosterImport, error) {
+	query, args, err := r.builder.Update("group_roster_imports").
+		Set("status", models.RosterImportProcessing).
+		Set("started_at", now).
+		Where(`id = (SELECT id FROM group_roster_imports
+			WHERE status = ? OR (status = ? AND started_at < ?)
+			ORDER BY created_at LIMIT 1 FOR UPDATE SKIP LOCKED)`,
+			models.RosterImportPending, models.RosterImportProcessing, staleBefore).
+		Suffix("RETURNING " + strings.Join(rosterImportColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	imp, err := scanRosterImport(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim roster import: %w", err)
+	}
+
+	return imp, nil
+}
+
+// UpdateRosterRows сохраняет итоги строк обрабатываемого импорта
+func (r *GroupsRepo) UpdateRosterRows(ctx context.Context, imp *models.RosterImport) error {
+	raw, err := json.Marshal(imp.Rows)
+	if err != nil {
+		return fmt.Errorf("failed to marshal roster rows: %w", err)
+	}
+
+	query, args, err := r.builder.Update("group_roster_imports").
+		Set("rows", raw).
+		Where(squirrel.Eq{"id": imp.ID, "status": models.RosterImportProcessing}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update roster rows: %w", err)
+	}
+
+	return nil
+}
+
+// FinishRosterImport сохраняет итог обработки: статус, строки с итогами и причину ошибки
+func (r *GroupsRepo) FinishRosterImport(ctx context.Context, imp *models.RosterImport) error {
+	raw, err := json.Marshal(imp.Rows)
+	if err != nil {
+		return fmt.Errorf("failed to marshal roster rows: %w", err)
+	}
+
+	query, args, err := r.builder.Update("group_roster_imports").
+		Set("status", imp.Status).
+		Set("rows", raw).
+		Set("error", imp.Error).
+		Set("finished_at", imp.FinishedAt).
+		Where(squirrel.Eq{"id": imp.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update roster import: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *GroupsRepo) AddStaff(ctx context.Context, member *models.StaffMember) error {
+	query, args, err := r.builder.Insert("group_staff").
+		Columns("group_id", "user_id", "role", "added_by", "created_at").
+		Values(member.GroupID, member.UserID, member.Role, member.AddedBy, member.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return models.ErrStaffExists
+		}
+		return fmt.Errorf("failed to insert staff member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) UpdateStaffRole(ctx context.Context, groupID, userID string, role models.StaffRole) (*models.StaffMember, error) {
+	query, args, err := r.builder.Update("group_staff").
+		Set("role", role).
+		Where(squirrel.Eq{"group_id": groupID, "user_id": userID}).
+		Suffix("RETURNING group_id, user_id, role, added_by, created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	m := &models.StaffMember{}
+	err = r.conn(ctx).QueryRow(ctx, query, args...).Scan(&m.GroupID, &m.UserID, &m.Role, &m.AddedBy, &m.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrStaffNotFound
+		}
+		return nil, fmt.Errorf("failed to update staff role: %w", err)
+	}
+
+	return m, nil
+}
+
+func (r *GroupsRepo) RemoveStaff(ctx context.Context, groupID, userID string) error {
+	query, args, err := r.builder.Delete("group_staff").
+		Where(squirrel.Eq{"group_id": groupID, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete staff member: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrStaffNotFound
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) ListStaff(ctx context.Context, groupID string) ([]*models.StaffMember, error) {
+	query, args, err := r.builder.Select("group_id", "user_id", "role", "added_by", "created_at").
+		From("group_staff").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query staff: %w", err)
+	}
+	defer rows.Close()
+
+	staff := make([]*models.StaffMember, 0)
+	for rows.Next() {
+		m := &models.StaffMember{}
+		if err := rows.Scan(&m.GroupID, &m.UserID, &m.Role, &m.AddedBy, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan staff member: %w", err)
+		}
+		staff = append(staff, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return staff, nil
+}
+
+// GetStaffRole возвращает роль соведущего или ассистента, владелец группы в group_staff не хранится
+func (r *GroupsRepo) GetStaffRole(ctx context.Context, groupID, userID string) (models.StaffRole, error) {
+	query, args, err := r.builder.Select("role").
+		From("group_staff").
+		Where(squirrel.Eq{"group_id": groupID, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var role models.StaffRole
+	if err := r.conn(ctx).QueryRow(ctx, query, args...).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", models.ErrStaffNotFound
+		}
+		return "", fmt.Errorf("failed to query staff role: %w", err)
+	}
+
+	return role, nil
+}
+
+// HasSharedGroup проверяет, учится или учился ли ученик в группе, где репетитор владелец,
+// в персонале или был владельцем до передачи. Архивные группы учитываются.
+func (r *GroupsRepo) HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error) {
+	query, args, err := r.builder.Select("1").
+		From("(SELECT group_id, student_id FROM group_members UNION ALL SELECT group_id, student_id FROM group_former_members) gm").
+		Join("student_groups sg ON sg.id = gm.group_id").
+		Where(squirrel.Eq{"gm.student_id": studentID}).
+		Where(squirrel.Or{
+			squirrel.Eq{"sg.tutor_id": tutorID},
+			squirrel.Expr("EXISTS (SELECT 1 FROM group_staff gs WHERE gs.group_id = sg.id AND gs.user_id = ?)", tutorID),
+			squirrel.Expr("EXISTS (SELECT 1 FROM group_former_owners fo WHERE fo.group_id = sg.id AND fo.user_id = ?)", tutorID),
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var shared bool
+	if err := r.conn(ctx).QueryRow(ctx, query, args...).Scan(&shared); err != nil {
+		return false, fmt.Errorf("failed to check shared group: %w", err)
+	}
+
+	return shared, nil
+}
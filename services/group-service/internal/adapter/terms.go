@@ -0,0 +1,198 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// activeTermGroups - число активных групп периода t
+const activeTermGroups = "(SELECT COUNT(*) FROM student_groups sg WHERE sg.term_id = t.id AND sg.archived_at IS NULL)"
+
+var termColumns = []string{"t.id", "t.owner_id", "t.name", "t.starts_at", "t.ends_at", "t.created_at", activeTermGroups}
+
+func scanTerm(row pgx.Row) (*models.Term, error) {
+	t := &models.Term{}
+	if err := row.Scan(&t.ID, &t.OwnerID, &t.Name, &t.StartsAt, &t.EndsAt, &t.CreatedAt, &t.GroupCount); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *GroupsRepo) CreateTerm(ctx context.Context, t *models.Term) error {
+	query, args, err := r.builder.Insert("academic_terms").
+		Columns("id", "owner_id", "name", "starts_at", "ends_at", "created_at").
+		Values(t.ID, t.OwnerID, t.Name, t.StartsAt, t.EndsAt, t.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert term: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) GetTerm(ctx context.Context, id string) (*models.Term, error) {
+	query, args, err := r.builder.Select(termColumns...).
+		From("academic_terms t").
+		Where(squirrel.Eq{"t.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	t, err := scanTerm(r.conn(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrTermNotFound
+		}
+		return nil, fmt.Errorf("failed to query term: %w", err)
+	}
+
+	return t, nil
+}
+
+// ListTerms возвращает периоды репетитора, последние по началу первыми
+func (r *GroupsRepo) ListTerms(ctx context.Context, ownerID string) ([]*models.Term, error) {
+	query, args, err := r.builder.Select(termColumns...).
+		From("academic_terms t").
+		Where(squirrel.Eq{"t.owner_id": ownerID}).
+		OrderBy("t.starts_at DESC", "t.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query terms: %w", err)
+	}
+	defer rows.Close()
+
+	terms := make([]*models.Term, 0)
+	for rows.Next() {
+		t, err := scanTerm(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan term: %w", err)
+		}
+		terms = append(terms, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return terms, nil
+}
+
+func (r *GroupsRepo) UpdateTerm(ctx context.Context, t *models.Term) error {
+	query, args, err := r.builder.Update("academic_terms").
+		Set("name", t.Name).
+		Set("starts_at", t.StartsAt).
+		Set("ends_at", t.EndsAt).
+		Where(squirrel.Eq{"id": t.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update term: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return models.ErrTermNotFound
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) DeleteTerm(ctx context.Context, id string) error {
+	query, args, err := r.builder.Delete("academic_terms").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete term: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return models.ErrTermNotFound
+	}
+
+	return nil
+}
+
+// SetGroupTerm привязывает группу к периоду, пустой termID снимает привязку
+func (r *GroupsRepo) SetGroupTerm(ctx context.Context, groupID, termID string) error {
+	var value any
+	if termID != "" {
+		value = termID
+	}
+
+	query, args, err := r.builder.Update("student_groups").
+		Set("term_id", value).
+		Where(squirrel.Eq{"id": groupID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	res, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set group term: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("group with id %s: %w", groupID, models.ErrGroupNotFound)
+	}
+
+	return nil
+}
+
+// ListEndedTermGroups возвращает активные группы, чей период закончился к now
+func (r *GroupsRepo) ListEndedTermGroups(ctx context.Context, now time.Time, limit int) ([]*models.Group, error) {
+	query, args, err := r.selectGroups().
+		Where(squirrel.Eq{"sg.archived_at": nil}).
+		Where(squirrel.LtOrEq{"t.ends_at": now}).
+		OrderBy("t.ends_at", "sg.id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ended term groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := make([]*models.Group, 0)
+	for rows.Next() {
+		g, err := scanGroup(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return groups, nil
+}
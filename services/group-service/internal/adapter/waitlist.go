@@ -0,0 +1,245 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *GroupsRepo) ListWaitlist(ctx context.Context, groupID string) ([]*models.WaitlistEntry, error) {
+	query, args, err := r.builder.Select("group_id", "student_id", "created_at").
+		From("group_waitlist").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("seq ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*models.WaitlistEntry, 0)
+	for rows.Next() {
+		e := &models.WaitlistEntry{Position: len(entries) + 1}
+		if err := rows.Scan(&e.GroupID, &e.StudentID, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan waitlist entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *GroupsRepo) RemoveFromWaitlist(ctx context.Context, groupID, studentID string) error {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.removeFromWaitlistTx(ctx, tx, groupID, studentID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PromoteWaitlist переводит в группу первых из очереди на свободные места, например после
+// увеличения max_members. Возвращает ID переведенных учеников по порядку очереди.
+func (r *GroupsRepo) PromoteWaitlist(ctx context.Context, groupID string) ([]string, error) {
+	tx, err := r.conn(ctx).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	promoted, err := r.promoteWaitlistTx(ctx, tx, groupID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// lockCapacityTx блокирует строку группы до конца транзакции и возвращает число свободных мест.
+// limited = false - у группы нет ограничения.
+func (r *GroupsRepo) lockCapacityTx(ctx context.Context, tx pgx.Tx, groupID string) (int, bool, error) {
+	query, args, err := r.builder.Select("max_members").
+		From("student_groups").
+		Where(squirrel.Eq{"id": groupID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var maxMembers int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&maxMembers); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, fmt.Errorf("group with id %s: %w", groupID, models.ErrGroupNotFound)
+		}
+		return 0, false, fmt.Errorf("failed to lock group: %w", err)
+	}
+	if maxMembers == 0 {
+		return 0, false, nil
+	}
+
+	query, args, err = r.builder.Select("COUNT(*)").
+		From("group_members").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var count int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, false, fmt.Errorf("failed to count members: %w", err)
+	}
+
+	return maxMembers - count, true, nil
+}
+
+func (r *GroupsRepo) isMemberTx(ctx context.Context, tx pgx.Tx, groupID, studentID string) (bool, error) {
+	query, args, err := r.builder.Select("1").
+		From("group_members").
+		Where(squirrel.Eq{"group_id": groupID, "student_id": studentID}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	var exists bool
+	if err := tx.QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check membership: %w", err)
+	}
+
+	return exists, nil
+}
+
+// addToWaitlistTx ставит ученика в конец очереди, повторная постановка сохраняет место
+func (r *GroupsRepo) addToWaitlistTx(ctx context.Context, tx pgx.Tx, groupID, studentID string, createdAt time.Time) error {
+	query, args, err := r.builder.Insert("group_waitlist").
+		Columns("group_id", "student_id", "created_at").
+		Values(groupID, studentID, createdAt).
+		Suffix("ON CONFLICT (group_id, student_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) removeFromWaitlistTx(ctx context.Context, tx pgx.Tx, groupID, studentID string) error {
+	query, args, err := r.builder.Delete("group_waitlist").
+		Where(squirrel.Eq{"group_id": groupID, "student_id": studentID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete waitlist entry: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return models.ErrNotOnWaitlist
+	}
+
+	return nil
+}
+
+func (r *GroupsRepo) promoteWaitlistTx(ctx context.Context, tx pgx.Tx, groupID string, joinedAt time.Time) ([]string, error) {
+	free, limited, err := r.lockCapacityTx(ctx, tx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if limited && free <= 0 {
+		return nil, nil
+	}
+
+	selectBuilder := r.builder.Select("student_id").
+		From("group_waitlist").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("seq ASC")
+	if limited {
+		selectBuilder = selectBuilder.Limit(uint64(free))
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist: %w", err)
+	}
+	studentIDs := make([]string, 0)
+	for rows.Next() {
+		var sid string
+		if err := rows.Scan(&sid); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan waitlist entry: %w", err)
+		}
+		studentIDs = append(studentIDs, sid)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	promoted := make([]string, 0, len(studentIDs))
+	for _, sid := range studentIDs {
+		if err := r.removeFromWaitlistTx(ctx, tx, groupID, sid); err != nil {
+			return nil, err
+		}
+
+		query, args, err := r.builder.Insert("group_members").
+			Columns("student_id", "group_id", "joined_at").
+			Values(sid, groupID, joinedAt).
+			Suffix("ON CONFLICT (group_id, student_id) DO NOTHING").
+			ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build insert query: %w", err)
+		}
+
+		res, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert member: %w", err)
+		}
+		if res.RowsAffected() > 0 {
+			promoted = append(promoted, sid)
+		}
+	}
+
+	return promoted, nil
+}
@@ -8,7 +8,10 @@ import (
 	"group_service/internal/config"
 	"group_service/internal/controller/grpc"
 	"group_service/internal/usecase"
+	"group_service/pkg/cache"
 	postgres "group_service/pkg/db"
+	"group_service/pkg/kafka"
+	"group_service/pkg/mailer"
 	"os"
 	"os/signal"
 	"sync"
@@ -17,8 +20,18 @@ import (
 )
 
 type App struct {
-	grpcServer *grpc.Server
-	postgresDB *postgres.Database
+	grpcServer           *grpc.Server
+	postgresDB           *postgres.Database
+	producer             *kafka.Producer
+	chatBroker           *adapter.ChatBroker
+	announcementsUsecase *usecase.AnnouncementsUsecase
+	publishInterval      time.Duration
+	outboxRelay          *usecase.OutboxRelay
+	outboxInterval       time.Duration
+	rosterUsecase        *usecase.RosterUsecase
+	rosterInterval       time.Duration
+	termsUsecase         *usecase.TermsUsecase
+	termArchiveInterval  time.Duration
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -34,17 +47,62 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	groupsUsecase := usecase.NewGroupsUsecase(groupsRepo, userClient)
+	producer := kafka.NewProducer([]string{cfg.KafkaConfig.Brokers}, cfg.GroupEventsTopic)
 
-	server := grpc.NewServer(groupsUsecase)
+	groupsUsecase := usecase.NewGroupsUsecase(groupsRepo, groupsRepo, userClient, cfg.GroupEventsTopic)
+
+	invitationsUsecase := usecase.NewInvitationsUsecase(groupsRepo, groupsRepo, userClient, groupsRepo, cfg.GroupEventsTopic, mailer.NewMailer(cfg.MailerConfig), cfg.JoinURL)
+
+	joinRequestsUsecase := usecase.NewJoinRequestsUsecase(groupsRepo, groupsRepo, userClient, groupsRepo, cfg.GroupEventsTopic)
+
+	staffUsecase := usecase.NewStaffUsecase(groupsRepo, groupsRepo, userClient)
+
+	ownershipUsecase := usecase.NewOwnershipUsecase(groupsRepo, groupsRepo, userClient, groupsRepo, cfg.GroupEventsTopic)
+
+	archiveUsecase := usecase.NewArchiveUsecase(groupsRepo, groupsRepo, cfg.GroupEventsTopic, cfg.ArchiveRetention)
+
+	announcementsUsecase := usecase.NewAnnouncementsUsecase(groupsRepo, groupsRepo, userClient, groupsRepo, cfg.GroupEventsTopic)
+
+	redisClient, err := cache.NewClient(cfg.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	chatBroker := adapter.NewChatBroker(redisClient)
+
+	chatUsecase := usecase.NewChatUsecase(groupsRepo, groupsRepo, chatBroker, cfg.ChatAccessCheckInterval)
+
+	directMessagesUsecase := usecase.NewDirectMessagesUsecase(groupsRepo, userClient, producer, cfg.GroupEventsTopic, cfg.UploadHosts)
+
+	rosterUsecase := usecase.NewRosterUsecase(groupsRepo, groupsRepo, groupsRepo, invitationsUsecase, groupsRepo, userClient, cfg.GroupEventsTopic)
+
+	termsUsecase := usecase.NewTermsUsecase(groupsRepo, groupsRepo, groupsRepo, userClient, cfg.GroupEventsTopic)
+
+	server := grpc.NewServer(groupsUsecase, invitationsUsecase, joinRequestsUsecase, staffUsecase, ownershipUsecase, archiveUsecase, announcementsUsecase, chatUsecase, directMessagesUsecase, rosterUsecase, termsUsecase)
 
 	return &App{
-		grpcServer: server,
-		postgresDB: db,
+		grpcServer:           server,
+		postgresDB:           db,
+		producer:             producer,
+		chatBroker:           chatBroker,
+		announcementsUsecase: announcementsUsecase,
+		publishInterval:      cfg.AnnouncementPublishInterval,
+		outboxRelay:          usecase.NewOutboxRelay(groupsRepo, producer),
+		outboxInterval:       cfg.OutboxPublishInterval,
+		rosterUsecase:        rosterUsecase,
+		rosterInterval:       cfg.RosterImportInterval,
+		termsUsecase:         termsUsecase,
+		termArchiveInterval:  cfg.TermArchiveInterval,
 	}, nil
 }
 
 func (a *App) MustRun(ctx context.Context, grpcPort int, timeout time.Duration) {
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	defer stopScheduler()
+	a.announcementsUsecase.StartScheduler(schedulerCtx, a.publishInterval)
+	a.outboxRelay.Start(schedulerCtx, a.outboxInterval)
+	a.rosterUsecase.Start(schedulerCtx, a.rosterInterval)
+	a.termsUsecase.StartScheduler(schedulerCtx, a.termArchiveInterval)
+
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -58,8 +116,11 @@ func (a *App) MustRun(ctx context.Context, grpcPort int, timeout time.Duration)
 	signal.Notify(graceSh, os.Interrupt, syscall.SIGTERM)
 	<-graceSh
 
+	stopScheduler()
 	a.grpcServer.Stop()
 
+	a.producer.Close()
+	a.chatBroker.Close()
 	a.postgresDB.Close()
 
 	wg.Wait()
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"fmt"
+	"group_service/internal/models"
 	"group_service/pkg/resilience"
 	"time"
 
@@ -69,6 +70,42 @@ func (c *Client) ValidateTutor(ctx context.Context, tutorId string) (bool, error
 	return resp.IsValidTutor, nil
 }
 
+func (c *Client) CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error) {
+	resp, err := c.service.CheckGuardianAccess(ctx, &pb.CheckGuardianAccessRequest{
+		GuardianId: guardianID,
+		StudentId:  studentID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.HasAccess, nil
+}
+
+// ResolveUsers ищет пользователей по ID и email, ненайденные в ответ не попадают
+func (c *Client) ResolveUsers(ctx context.Context, ids, emails []string) ([]*models.UserInfo, error) {
+	resp, err := c.service.ResolveUsers(ctx, &pb.ResolveUsersRequest{UserIds: ids, Emails: emails})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.UserInfo, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		profile := u.GetProfile()
+		users = append(users, &models.UserInfo{
+			ID:        profile.GetUserId(),
+			Email:     profile.GetEmail(),
+			Name:      profile.GetName(),
+			Surname:   profile.GetSurname(),
+			AvatarURL: profile.GetAvatarUrl(),
+			IsTutor:   u.GetTypes().GetIsTutor(),
+			IsStudent: u.GetTypes().GetIsStudent(),
+			Timezone:  profile.GetTimezone(),
+			Locale:    profile.GetLocale(),
+		})
+	}
+	return users, nil
+}
+
 func (c *Client) Close() error {
 	return c.conn.Close()
 }
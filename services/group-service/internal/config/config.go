@@ -2,7 +2,10 @@ package config
 
 import (
 	"fmt"
+	"group_service/pkg/cache"
 	postgres "group_service/pkg/db"
+	"group_service/pkg/kafka"
+	"group_service/pkg/mailer"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -13,8 +16,28 @@ type Config struct {
 	GHTimeout       time.Duration `env:"GRACEFUL_SHUTDOWN_TIMEOUT" env-default:"15s"`
 	UserServiceAddr string        `env:"USER_SERVICE_ADDRESS" env-default:"localhost:50051"`
 	MigrationPath   string        `env:"MIGRATION_PATH" env-default:":file://migrations"`
+	// ссылка на вступление в группу с плейсхолдером {code}
+	JoinURL string `env:"GROUP_JOIN_URL" env-default:""`
+	// через сколько после архивации группу можно удалить окончательно
+	ArchiveRetention time.Duration `env:"GROUP_ARCHIVE_RETENTION" env-default:"720h"`
+	// как часто публикуются отложенные объявления, 0 отключает публикацию
+	AnnouncementPublishInterval time.Duration `env:"ANNOUNCEMENT_PUBLISH_INTERVAL" env-default:"1m"`
+	// как часто события из outbox отправляются в kafka, 0 отключает отправку
+	OutboxPublishInterval time.Duration `env:"OUTBOX_PUBLISH_INTERVAL" env-default:"1s"`
+	// как часто проверяются ожидающие импорты списка учеников, 0 отключает обработку
+	RosterImportInterval time.Duration `env:"ROSTER_IMPORT_INTERVAL" env-default:"5s"`
+	// как часто архивируются группы закончившихся учебных периодов, 0 отключает архивацию
+	TermArchiveInterval time.Duration `env:"TERM_ARCHIVE_INTERVAL" env-default:"1m"`
+	// как часто перепроверяется доступ подписчиков чата
+	ChatAccessCheckInterval time.Duration `env:"CHAT_ACCESS_CHECK_INTERVAL" env-default:"1m"`
+	// хосты сервиса загрузок, только на них могут указывать вложения личных сообщений
+	UploadHosts []string `env:"UPLOAD_HOSTS" env-separator:","`
 
 	postgres.PostgresConfig
+	mailer.MailerConfig
+	kafka.KafkaConfig
+	// redis для рассылки сообщений чата между экземплярами сервиса
+	Redis cache.Config
 }
 
 func ParseConfigFromEnv() (*Config, error) {
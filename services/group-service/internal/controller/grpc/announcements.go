@@ -0,0 +1,188 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"group_service/internal/usecase"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) CreateGroupAnnouncement(ctx context.Context, req *pb.CreateGroupAnnouncementRequest) (*pb.AnnouncementResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	params := usecase.CreateAnnouncementParams{
+		GroupID: req.GroupId,
+		UserID:  userID,
+		Body:    req.Body,
+		Pinned:  req.Pinned,
+	}
+	if req.PublishAt != nil {
+		publishAt := req.PublishAt.AsTime()
+		params.PublishAt = &publishAt
+	}
+
+	announcement, err := s.announcementsUsecase.CreateAnnouncement(ctx, params)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "create announcement")
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.AnnouncementResponse{
+		Result: &pb.AnnouncementResponse_Announcement{
+			Announcement: convertAnnouncement(announcement),
+		},
+	}, nil
+}
+
+func (s *Server) ListGroupAnnouncements(ctx context.Context, req *pb.ListGroupAnnouncementsRequest) (*pb.ListGroupAnnouncementsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.ListGroupAnnouncementsResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	announcements, err := s.announcementsUsecase.ListAnnouncements(ctx, req.GroupId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list announcements")
+		return &pb.ListGroupAnnouncementsResponse{Error: pbErr}, stErr
+	}
+
+	pbAnnouncements := make([]*pb.Announcement, len(announcements))
+	for i, a := range announcements {
+		pbAnnouncements[i] = convertAnnouncement(a)
+	}
+
+	return &pb.ListGroupAnnouncementsResponse{Announcements: pbAnnouncements}, nil
+}
+
+func (s *Server) GetGroupAnnouncement(ctx context.Context, req *pb.GetGroupAnnouncementRequest) (*pb.AnnouncementResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.AnnouncementId == "" {
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and announcement_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and announcement_id are required")
+	}
+
+	announcement, err := s.announcementsUsecase.GetAnnouncement(ctx, req.GroupId, userID, req.AnnouncementId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "get announcement")
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.AnnouncementResponse{
+		Result: &pb.AnnouncementResponse_Announcement{
+			Announcement: convertAnnouncement(announcement),
+		},
+	}, nil
+}
+
+func (s *Server) UpdateGroupAnnouncement(ctx context.Context, req *pb.UpdateGroupAnnouncementRequest) (*pb.AnnouncementResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.AnnouncementId == "" {
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and announcement_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and announcement_id are required")
+	}
+
+	params := usecase.UpdateAnnouncementParams{
+		GroupID:        req.GroupId,
+		UserID:         userID,
+		AnnouncementID: req.AnnouncementId,
+		Body:           req.Body,
+		Pinned:         req.Pinned,
+	}
+	if req.PublishAt != nil {
+		publishAt := req.PublishAt.AsTime()
+		params.PublishAt = &publishAt
+	}
+
+	announcement, err := s.announcementsUsecase.UpdateAnnouncement(ctx, params)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "update announcement")
+		return &pb.AnnouncementResponse{
+			Result: &pb.AnnouncementResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.AnnouncementResponse{
+		Result: &pb.AnnouncementResponse_Announcement{
+			Announcement: convertAnnouncement(announcement),
+		},
+	}, nil
+}
+
+func (s *Server) DeleteGroupAnnouncement(ctx context.Context, req *pb.DeleteGroupAnnouncementRequest) (*pb.DeleteGroupAnnouncementResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.AnnouncementId == "" {
+		return &pb.DeleteGroupAnnouncementResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and announcement_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and announcement_id are required")
+	}
+
+	if err := s.announcementsUsecase.DeleteAnnouncement(ctx, req.GroupId, userID, req.AnnouncementId); err != nil {
+		pbErr, stErr := usecaseError(err, "delete announcement")
+		return &pb.DeleteGroupAnnouncementResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.DeleteGroupAnnouncementResponse{}, nil
+}
+
+func (s *Server) MarkGroupAnnouncementRead(ctx context.Context, req *pb.MarkGroupAnnouncementReadRequest) (*pb.MarkGroupAnnouncementReadResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.AnnouncementId == "" {
+		return &pb.MarkGroupAnnouncementReadResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and announcement_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and announcement_id are required")
+	}
+
+	if err := s.announcementsUsecase.MarkAnnouncementRead(ctx, req.GroupId, userID, req.AnnouncementId); err != nil {
+		pbErr, stErr := usecaseError(err, "mark announcement read")
+		return &pb.MarkGroupAnnouncementReadResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.MarkGroupAnnouncementReadResponse{}, nil
+}
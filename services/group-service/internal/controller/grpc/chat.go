@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"group_service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) SendGroupMessage(ctx context.Context, req *pb.SendGroupMessageRequest) (*pb.GroupMessageResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.GroupMessageResponse{
+			Result: &pb.GroupMessageResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	message, err := s.chatUsecase.SendMessage(ctx, req.GroupId, userID, req.Body)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "send message")
+		return &pb.GroupMessageResponse{
+			Result: &pb.GroupMessageResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.GroupMessageResponse{
+		Result: &pb.GroupMessageResponse_Message{Message: convertMessage(message)},
+	}, nil
+}
+
+func (s *Server) ListGroupMessages(ctx context.Context, req *pb.ListGroupMessagesRequest) (*pb.ListGroupMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.ListGroupMessagesResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	messages, nextPageToken, err := s.chatUsecase.ListMessages(ctx, req.GroupId, userID, req.PageToken, int(req.PageSize))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list messages")
+		return &pb.ListGroupMessagesResponse{Error: pbErr}, stErr
+	}
+
+	pbMessages := make([]*pb.GroupMessage, len(messages))
+	for i, m := range messages {
+		pbMessages[i] = convertMessage(m)
+	}
+
+	return &pb.ListGroupMessagesResponse{Messages: pbMessages, NextPageToken: nextPageToken}, nil
+}
+
+func (s *Server) EditGroupMessage(ctx context.Context, req *pb.EditGroupMessageRequest) (*pb.GroupMessageResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.MessageId == "" {
+		return &pb.GroupMessageResponse{
+			Result: &pb.GroupMessageResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and message_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and message_id are required")
+	}
+
+	message, err := s.chatUsecase.EditMessage(ctx, req.GroupId, userID, req.MessageId, req.Body)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "edit message")
+		return &pb.GroupMessageResponse{
+			Result: &pb.GroupMessageResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.GroupMessageResponse{
+		Result: &pb.GroupMessageResponse_Message{Message: convertMessage(message)},
+	}, nil
+}
+
+func (s *Server) DeleteGroupMessage(ctx context.Context, req *pb.DeleteGroupMessageRequest) (*pb.DeleteGroupMessageResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.MessageId == "" {
+		return &pb.DeleteGroupMessageResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and message_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and message_id are required")
+	}
+
+	if err := s.chatUsecase.DeleteMessage(ctx, req.GroupId, userID, req.MessageId); err != nil {
+		pbErr, stErr := usecaseError(err, "delete message")
+		return &pb.DeleteGroupMessageResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.DeleteGroupMessageResponse{}, nil
+}
+
+// SubscribeGroupMessages держит поток, пока клиент не отключится или сервер не начнет остановку
+func (s *Server) SubscribeGroupMessages(req *pb.SubscribeGroupMessagesRequest, stream pb.GroupsService_SubscribeGroupMessagesServer) error {
+	ctx := stream.Context()
+
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if req.GroupId == "" {
+		return status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := s.chatUsecase.Subscribe(ctx, req.GroupId, userID)
+	if err != nil {
+		_, stErr := usecaseError(err, "subscribe to messages")
+		return stErr
+	}
+	// заголовки сразу после подписки: клиент узнает, что поток открыт, не дожидаясь первого сообщения
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription closed")
+			}
+			if err := stream.Send(convertMessageEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func convertMessageEvent(e *models.MessageEvent) *pb.GroupMessageEvent {
+	return &pb.GroupMessageEvent{
+		Type:    messageEventTypeToPb(e.Type),
+		Message: convertMessage(e.Message),
+	}
+}
+
+func messageEventTypeToPb(t models.MessageEventType) pb.GroupMessageEventType {
+	switch t {
+	case models.MessageCreated:
+		return pb.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_CREATED
+	case models.MessageEdited:
+		return pb.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_EDITED
+	case models.MessageDeleted:
+		return pb.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_DELETED
+	default:
+		return pb.GroupMessageEventType_GROUP_MESSAGE_EVENT_TYPE_UNSPECIFIED
+	}
+}
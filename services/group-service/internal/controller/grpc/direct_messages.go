@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) StartConversation(ctx context.Context, req *pb.StartConversationRequest) (*pb.ConversationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" {
+		return &pb.ConversationResponse{
+			Result: &pb.ConversationResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "user_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	conversation, err := s.directMessagesUsecase.StartConversation(ctx, userID, req.UserId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "start conversation")
+		return &pb.ConversationResponse{
+			Result: &pb.ConversationResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.ConversationResponse{
+		Result: &pb.ConversationResponse_Conversation{
+			Conversation: convertConversation(conversation, userID),
+		},
+	}, nil
+}
+
+func (s *Server) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, unread, err := s.directMessagesUsecase.ListConversations(ctx, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list conversations")
+		return &pb.ListConversationsResponse{Error: pbErr}, stErr
+	}
+
+	pbConversations := make([]*pb.Conversation, len(conversations))
+	for i, c := range conversations {
+		pbConversations[i] = convertConversation(c, userID)
+	}
+
+	return &pb.ListConversationsResponse{Conversations: pbConversations, UnreadCount: int32(unread)}, nil
+}
+
+func (s *Server) SendDirectMessage(ctx context.Context, req *pb.SendDirectMessageRequest) (*pb.DirectMessageResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ConversationId == "" {
+		return &pb.DirectMessageResponse{
+			Result: &pb.DirectMessageResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "conversation_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	attachments := make([]models.Attachment, len(req.Attachments))
+	for i, a := range req.Attachments {
+		attachments[i] = models.Attachment{Name: a.Name, URL: a.Url, ContentType: a.ContentType, Size: a.Size}
+	}
+
+	message, err := s.directMessagesUsecase.SendMessage(ctx, usecase.SendDirectMessageParams{
+		ConversationID: req.ConversationId,
+		UserID:         userID,
+		Body:           req.Body,
+		Attachments:    attachments,
+	})
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "send direct message")
+		return &pb.DirectMessageResponse{
+			Result: &pb.DirectMessageResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.DirectMessageResponse{
+		Result: &pb.DirectMessageResponse_Message{Message: convertDirectMessage(message)},
+	}, nil
+}
+
+func (s *Server) ListDirectMessages(ctx context.Context, req *pb.ListDirectMessagesRequest) (*pb.ListDirectMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ConversationId == "" {
+		return &pb.ListDirectMessagesResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "conversation_id is required"),
+		}, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	messages, nextPageToken, err := s.directMessagesUsecase.ListMessages(ctx, req.ConversationId, userID, req.PageToken, int(req.PageSize))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list direct messages")
+		return &pb.ListDirectMessagesResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.ListDirectMessagesResponse{Messages: convertDirectMessages(messages), NextPageToken: nextPageToken}, nil
+}
+
+func (s *Server) MarkConversationRead(ctx context.Context, req *pb.MarkConversationReadRequest) (*pb.MarkConversationReadResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ConversationId == "" {
+		return &pb.MarkConversationReadResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "conversation_id is required"),
+		}, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	if err := s.directMessagesUsecase.MarkRead(ctx, req.ConversationId, userID); err != nil {
+		pbErr, stErr := usecaseError(err, "mark conversation read")
+		return &pb.MarkConversationReadResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.MarkConversationReadResponse{}, nil
+}
+
+func (s *Server) SearchDirectMessages(ctx context.Context, req *pb.SearchDirectMessagesRequest) (*pb.ListDirectMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, nextPageToken, err := s.directMessagesUsecase.SearchMessages(ctx, userID, req.Query, req.ConversationId, req.PageToken, int(req.PageSize))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "search direct messages")
+		return &pb.ListDirectMessagesResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.ListDirectMessagesResponse{Messages: convertDirectMessages(messages), NextPageToken: nextPageToken}, nil
+}
+
+func (s *Server) ReportConversation(ctx context.Context, req *pb.ReportConversationRequest) (*pb.ReportConversationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ConversationId == "" {
+		return &pb.ReportConversationResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "conversation_id is required"),
+		}, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	report, err := s.directMessagesUsecase.ReportUser(ctx, usecase.ReportUserParams{
+		UserID:         userID,
+		ConversationID: req.ConversationId,
+		MessageID:      req.MessageId,
+		Reason:         req.Reason,
+		Block:          req.Block,
+	})
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "report user")
+		return &pb.ReportConversationResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.ReportConversationResponse{ReportId: report.ID}, nil
+}
+
+func (s *Server) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.directMessagesUsecase.BlockUser(ctx, userID, req.UserId); err != nil {
+		pbErr, stErr := usecaseError(err, "block user")
+		return &pb.BlockUserResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.BlockUserResponse{}, nil
+}
+
+func (s *Server) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" {
+		return &pb.UnblockUserResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "user_id is required"),
+		}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.directMessagesUsecase.UnblockUser(ctx, userID, req.UserId); err != nil {
+		pbErr, stErr := usecaseError(err, "unblock user")
+		return &pb.UnblockUserResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.UnblockUserResponse{}, nil
+}
+
+func (s *Server) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.directMessagesUsecase.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list blocked users")
+		return &pb.ListBlockedUsersResponse{Error: pbErr}, stErr
+	}
+
+	users := make([]*pb.BlockedUser, len(blocks))
+	for i, b := range blocks {
+		users[i] = &pb.BlockedUser{UserId: b.BlockedID, CreatedAt: timestamppb.New(b.CreatedAt)}
+	}
+
+	return &pb.ListBlockedUsersResponse{Users: users}, nil
+}
+
+func convertConversation(c *models.Conversation, userID string) *pb.Conversation {
+	pbC := &pb.Conversation{
+		Id:          c.ID,
+		UserIds:     []string{c.UserIDs[0], c.UserIDs[1]},
+		PeerId:      c.Peer(userID),
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UnreadCount: int32(c.UnreadCount),
+	}
+	if c.LastMessageAt != nil {
+		pbC.LastMessageAt = timestamppb.New(*c.LastMessageAt)
+	}
+	if c.LastMessage != nil {
+		pbC.LastMessage = convertDirectMessage(c.LastMessage)
+	}
+	return pbC
+}
+
+func convertDirectMessages(messages []*models.DirectMessage) []*pb.DirectMessage {
+	pbMessages := make([]*pb.DirectMessage, len(messages))
+	for i, m := range messages {
+		pbMessages[i] = convertDirectMessage(m)
+	}
+	return pbMessages
+}
+
+func convertDirectMessage(m *models.DirectMessage) *pb.DirectMessage {
+	attachments := make([]*pb.Attachment, len(m.Attachments))
+	for i, a := range m.Attachments {
+		attachments[i] = &pb.Attachment{Name: a.Name, Url: a.URL, ContentType: a.ContentType, Size: a.Size}
+	}
+
+	return &pb.DirectMessage{
+		Id:             m.ID,
+		ConversationId: m.ConversationID,
+		SenderId:       m.SenderID,
+		Body:           m.Body,
+		Attachments:    attachments,
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+	}
+}
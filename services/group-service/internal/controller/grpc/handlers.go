@@ -41,8 +41,15 @@ func (s *Server) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*
 		}, status.Error(codes.PermissionDenied, "you can only create groups for yourself")
 	}
 
-	group, err := s.groupsUsecase.CreateGroup(ctx, req.TutorId, req.Name, req.Description)
+	group, err := s.groupsUsecase.CreateGroup(ctx, req.TutorId, req.Name, req.Description, joinPolicyFromPb(req.JoinPolicy), int(req.MaxMembers))
 	if err != nil {
+		if err == models.ErrInvalidJoinPolicy || err == models.ErrInvalidCapacity {
+			return &pb.CreateGroupResponse{
+				Result: &pb.CreateGroupResponse_Error{
+					Error: errorResponse("INVALID_ARGUMENT", err.Error()),
+				},
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if err == models.ErrTutorIsNotValid {
 			return &pb.CreateGroupResponse{
 				Result: &pb.CreateGroupResponse_Error{
@@ -65,9 +72,24 @@ func (s *Server) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*
 }
 
 func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
-	var groups []*models.Group
-	var err error
+	q := models.GroupQuery{
+		TermID:         req.TermId,
+		Search:         req.Search,
+		SortBy:         groupSortFieldFromPb(req.SortBy),
+		Archived:       archiveFilterFromPb(req.ArchiveFilter, req.IncludeArchived),
+		IncludeMembers: req.IncludeMembers,
+	}
+	// по умолчанию название по алфавиту, остальное от больших значений к меньшим
+	switch req.SortDirection {
+	case pb.SortDirection_SORT_ASC:
+	case pb.SortDirection_SORT_DESC:
+		q.Desc = true
+	default:
+		q.Desc = q.SortBy != models.GroupSortName
+	}
 
+	// внутренние вызовы сервисов идут без x-user-id
+	callerID := getOptionalUserIDFromContext(ctx)
 	switch filter := req.Filter.(type) {
 	case *pb.ListGroupsRequest_TutorId:
 		if filter.TutorId == "" {
@@ -75,7 +97,7 @@ func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb
 				Error: errorResponse("INVALID_ARGUMENT", "tutor_id cannot be empty"),
 			}, status.Error(codes.InvalidArgument, "tutor_id cannot be empty")
 		}
-		groups, err = s.groupsUsecase.ListGroupsByTutor(ctx, filter.TutorId, req.IncludeMembers)
+		q.TutorID = filter.TutorId
 
 	case *pb.ListGroupsRequest_StudentId:
 		if filter.StudentId == "" {
@@ -83,23 +105,30 @@ func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb
 				Error: errorResponse("INVALID_ARGUMENT", "student_id cannot be empty"),
 			}, status.Error(codes.InvalidArgument, "student_id cannot be empty")
 		}
-		groups, err = s.groupsUsecase.ListGroupsByStudent(ctx, filter.StudentId, req.IncludeMembers)
+		q.StudentID = filter.StudentId
 
 	default:
-		return &pb.ListGroupsResponse{
-			Error: errorResponse("INVALID_ARGUMENT", "filter (tutor_id or student_id) is required"),
-		}, status.Error(codes.InvalidArgument, "filter (tutor_id or student_id) is required")
+		if callerID == "" {
+			return &pb.ListGroupsResponse{
+				Error: errorResponse("INVALID_ARGUMENT", "filter (tutor_id or student_id) is required"),
+			}, status.Error(codes.InvalidArgument, "filter (tutor_id or student_id) is required")
+		}
 	}
 
+	groups, nextPageToken, err := s.groupsUsecase.ListGroups(ctx, callerID, q, req.PageToken, int(req.PageSize))
 	if err != nil {
 		if err == models.ErrTutorIsNotValid {
 			return &pb.ListGroupsResponse{
 				Error: errorResponse("PERMISSION_DENIED", "invalid tutor"),
 			}, status.Error(codes.PermissionDenied, "invalid tutor")
 		}
-		return &pb.ListGroupsResponse{
-			Error: errorResponse("INTERNAL", err.Error()),
-		}, status.Error(codes.Internal, "failed to list groups: "+err.Error())
+		if err == models.ErrGuardianAccessDenied {
+			return &pb.ListGroupsResponse{
+				Error: errorResponse("PERMISSION_DENIED", "you are not a guardian of this student"),
+			}, status.Error(codes.PermissionDenied, "you are not a guardian of this student")
+		}
+		pbErr, grpcErr := usecaseError(err, "list groups")
+		return &pb.ListGroupsResponse{Error: pbErr}, grpcErr
 	}
 
 	pbGroups := make([]*pb.Group, 0, len(groups))
@@ -107,7 +136,7 @@ func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb
 		pbGroups = append(pbGroups, convertGroup(g))
 	}
 
-	return &pb.ListGroupsResponse{Groups: pbGroups}, nil
+	return &pb.ListGroupsResponse{Groups: pbGroups, NextPageToken: nextPageToken}, nil
 }
 
 func (s *Server) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupResponse, error) {
@@ -156,9 +185,26 @@ func (s *Server) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*
 	if req.Description != nil {
 		desc = req.Description
 	}
+	var policy *models.JoinPolicy
+	if req.JoinPolicy != nil {
+		p := joinPolicyFromPb(*req.JoinPolicy)
+		policy = &p
+	}
+	var maxMembers *int
+	if req.MaxMembers != nil {
+		m := int(*req.MaxMembers)
+		maxMembers = &m
+	}
 
-	updatedGroup, err := s.groupsUsecase.UpdateGroup(ctx, req.Id, userID, name, desc)
+	updatedGroup, err := s.groupsUsecase.UpdateGroup(ctx, req.Id, userID, name, desc, policy, maxMembers)
 	if err != nil {
+		if err == models.ErrInvalidJoinPolicy {
+			return &pb.UpdateGroupResponse{
+				Result: &pb.UpdateGroupResponse_Error{
+					Error: errorResponse("INVALID_ARGUMENT", err.Error()),
+				},
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if err == models.ErrTutorIsNotValid {
 			return &pb.UpdateGroupResponse{
 				Result: &pb.UpdateGroupResponse_Error{
@@ -166,11 +212,10 @@ func (s *Server) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*
 				},
 			}, status.Error(codes.PermissionDenied, "you do not have permission to modify this group")
 		}
+		pbErr, grpcErr := usecaseError(err, "update group")
 		return &pb.UpdateGroupResponse{
-			Result: &pb.UpdateGroupResponse_Error{
-				Error: errorResponse("INTERNAL", err.Error()),
-			},
-		}, status.Error(codes.Internal, "failed to update group: "+err.Error())
+			Result: &pb.UpdateGroupResponse_Error{Error: pbErr},
+		}, grpcErr
 	}
 
 	return &pb.UpdateGroupResponse{
@@ -192,20 +237,77 @@ func (s *Server) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*
 		return nil, err
 	}
 
-	if err := s.groupsUsecase.DeleteGroup(ctx, req.Id, userID); err != nil {
+	if err := s.archiveUsecase.DeleteGroup(ctx, req.Id, userID); err != nil {
 		if err == models.ErrTutorIsNotValid {
 			return &pb.DeleteGroupResponse{
 				Error: errorResponse("PERMISSION_DENIED", "you do not have permission to delete this group"),
 			}, status.Error(codes.PermissionDenied, "you do not have permission to delete this group")
 		}
-		return &pb.DeleteGroupResponse{
-			Error: errorResponse("INTERNAL", err.Error()),
-		}, status.Error(codes.Internal, "failed to delete group: "+err.Error())
+		pbErr, grpcErr := usecaseError(err, "delete group")
+		return &pb.DeleteGroupResponse{Error: pbErr}, grpcErr
 	}
 
 	return &pb.DeleteGroupResponse{}, nil
 }
 
+func (s *Server) ArchiveGroup(ctx context.Context, req *pb.ArchiveGroupRequest) (*pb.ArchiveGroupResponse, error) {
+	if req.Id == "" {
+		return &pb.ArchiveGroupResponse{
+			Result: &pb.ArchiveGroupResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.archiveUsecase.ArchiveGroup(ctx, req.Id, userID)
+	if err != nil {
+		pbErr, grpcErr := usecaseError(err, "archive group")
+		return &pb.ArchiveGroupResponse{
+			Result: &pb.ArchiveGroupResponse_Error{Error: pbErr},
+		}, grpcErr
+	}
+
+	return &pb.ArchiveGroupResponse{
+		Result: &pb.ArchiveGroupResponse_Group{
+			Group: convertGroup(group),
+		},
+	}, nil
+}
+
+func (s *Server) RestoreGroup(ctx context.Context, req *pb.RestoreGroupRequest) (*pb.RestoreGroupResponse, error) {
+	if req.Id == "" {
+		return &pb.RestoreGroupResponse{
+			Result: &pb.RestoreGroupResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.archiveUsecase.RestoreGroup(ctx, req.Id, userID)
+	if err != nil {
+		pbErr, grpcErr := usecaseError(err, "restore group")
+		return &pb.RestoreGroupResponse{
+			Result: &pb.RestoreGroupResponse_Error{Error: pbErr},
+		}, grpcErr
+	}
+
+	return &pb.RestoreGroupResponse{
+		Result: &pb.RestoreGroupResponse_Group{
+			Group: convertGroup(group),
+		},
+	}, nil
+}
+
 func (s *Server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersRequest) (*pb.ListGroupMembersResponse, error) {
 	if req.GroupId == "" {
 		return &pb.ListGroupMembersResponse{
@@ -213,11 +315,19 @@ func (s *Server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersR
 		}, status.Error(codes.InvalidArgument, "group_id is required")
 	}
 
-	members, err := s.groupsUsecase.ListGroupMembers(ctx, req.GroupId)
+	// профили участников видят только персонал и ученики группы
+	var userID string
+	if req.IncludeProfiles {
+		var err error
+		if userID, err = getUserIDFromContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := s.groupsUsecase.ListGroupMembers(ctx, req.GroupId, userID, req.IncludeProfiles)
 	if err != nil {
-		return &pb.ListGroupMembersResponse{
-			Error: errorResponse("INTERNAL", err.Error()),
-		}, status.Error(codes.Internal, "failed to list members: "+err.Error())
+		pbErr, grpcErr := usecaseError(err, "list members")
+		return &pb.ListGroupMembersResponse{Error: pbErr}, grpcErr
 	}
 
 	pbMembers := make([]*pb.GroupMember, len(members))
@@ -226,6 +336,9 @@ func (s *Server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersR
 			GroupId:   m.GroupID,
 			StudentId: m.StudentID,
 			JoinedAt:  timestamppb.New(m.CreatedAt),
+			Name:      m.Name,
+			Surname:   m.Surname,
+			AvatarUrl: m.AvatarURL,
 		}
 	}
 
@@ -249,19 +362,39 @@ func (s *Server) AddGroupMembers(ctx context.Context, req *pb.AddGroupMembersReq
 		}, status.Error(codes.InvalidArgument, "student_ids cannot be empty")
 	}
 
-	addedCount, err := s.groupsUsecase.AddGroupMembers(ctx, req.GroupId, userID, req.StudentIds)
+	results, err := s.groupsUsecase.AddGroupMembers(ctx, req.GroupId, userID, req.StudentIds)
 	if err != nil {
 		if err == models.ErrTutorIsNotValid {
 			return &pb.AddGroupMembersResponse{
 				Error: errorResponse("PERMISSION_DENIED", "you do not have permission to modify this group"),
 			}, status.Error(codes.PermissionDenied, "you do not have permission to modify this group")
 		}
-		return &pb.AddGroupMembersResponse{
-			Error: errorResponse("INTERNAL", err.Error()),
-		}, status.Error(codes.Internal, "failed to add members: "+err.Error())
+		pbErr, grpcErr := usecaseError(err, "add members")
+		return &pb.AddGroupMembersResponse{Error: pbErr}, grpcErr
+	}
+
+	resp := &pb.AddGroupMembersResponse{Results: make([]*pb.AddMemberResult, len(results))}
+	counted := make(map[string]bool, len(results))
+	for i, r := range results {
+		// ID и email одного ученика считаются один раз
+		if !counted[r.StudentID] {
+			switch r.Status {
+			case models.AddMemberAdded:
+				resp.AddedCount++
+				counted[r.StudentID] = true
+			case models.AddMemberWaitlisted:
+				resp.WaitlistedCount++
+				counted[r.StudentID] = true
+			}
+		}
+		resp.Results[i] = &pb.AddMemberResult{
+			Input:     r.Input,
+			StudentId: r.StudentID,
+			Status:    addMemberStatusToPb(r.Status),
+		}
 	}
 
-	return &pb.AddGroupMembersResponse{AddedCount: int32(addedCount)}, nil
+	return resp, nil
 }
 
 func (s *Server) RemoveGroupMembers(ctx context.Context, req *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersResponse, error) {
@@ -281,17 +414,16 @@ func (s *Server) RemoveGroupMembers(ctx context.Context, req *pb.RemoveGroupMemb
 		}, status.Error(codes.InvalidArgument, "student_ids cannot be empty")
 	}
 
-	removedCount, err := s.groupsUsecase.RemoveGroupMembers(ctx, req.GroupId, userID, req.StudentIds)
+	removedCount, promoted, err := s.groupsUsecase.RemoveGroupMembers(ctx, req.GroupId, userID, req.StudentIds)
 	if err != nil {
 		if err == models.ErrTutorIsNotValid {
 			return &pb.RemoveGroupMembersResponse{
 				Error: errorResponse("PERMISSION_DENIED", "you do not have permission to modify this group"),
 			}, status.Error(codes.PermissionDenied, "you do not have permission to modify this group")
 		}
-		return &pb.RemoveGroupMembersResponse{
-			Error: errorResponse("INTERNAL", err.Error()),
-		}, status.Error(codes.Internal, "failed to remove members: "+err.Error())
+		pbErr, grpcErr := usecaseError(err, "remove members")
+		return &pb.RemoveGroupMembersResponse{Error: pbErr}, grpcErr
 	}
 
-	return &pb.RemoveGroupMembersResponse{RemovedCount: int32(removedCount)}, nil
+	return &pb.RemoveGroupMembersResponse{RemovedCount: int32(removedCount), PromotedStudentIds: promoted}, nil
 }
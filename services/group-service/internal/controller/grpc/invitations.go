@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) convertInvitation(inv *models.GroupInvitation) *pb.GroupInvitation {
+	return &pb.GroupInvitation{
+		Id:        inv.ID,
+		GroupId:   inv.GroupID,
+		Code:      inv.Code,
+		Link:      s.invitationsUsecase.JoinLink(inv.Code),
+		Email:     inv.Email,
+		MaxUses:   int32(inv.MaxUses),
+		UseCount:  int32(inv.UseCount),
+		ExpiresAt: timestamppb.New(inv.ExpiresAt),
+		CreatedAt: timestamppb.New(inv.CreatedAt),
+		Revoked:   inv.RevokedAt != nil,
+	}
+}
+
+func (s *Server) CreateGroupInvitation(ctx context.Context, req *pb.CreateGroupInvitationRequest) (*pb.CreateGroupInvitationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.CreateGroupInvitationResponse{
+			Result: &pb.CreateGroupInvitationResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	params := usecase.CreateInvitationParams{
+		GroupID: req.GroupId,
+		UserID:  userID,
+		MaxUses: int(req.MaxUses),
+		Email:   req.Email,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	inv, err := s.invitationsUsecase.CreateInvitation(ctx, params)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "create invitation")
+		return &pb.CreateGroupInvitationResponse{
+			Result: &pb.CreateGroupInvitationResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.CreateGroupInvitationResponse{
+		Result: &pb.CreateGroupInvitationResponse_Invitation{
+			Invitation: s.convertInvitation(inv),
+		},
+	}, nil
+}
+
+func (s *Server) ListGroupInvitations(ctx context.Context, req *pb.ListGroupInvitationsRequest) (*pb.ListGroupInvitationsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.ListGroupInvitationsResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	invitations, err := s.invitationsUsecase.ListInvitations(ctx, req.GroupId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list invitations")
+		return &pb.ListGroupInvitationsResponse{Error: pbErr}, stErr
+	}
+
+	pbInvitations := make([]*pb.GroupInvitation, len(invitations))
+	for i, inv := range invitations {
+		pbInvitations[i] = s.convertInvitation(inv)
+	}
+
+	return &pb.ListGroupInvitationsResponse{Invitations: pbInvitations}, nil
+}
+
+func (s *Server) RevokeGroupInvitation(ctx context.Context, req *pb.RevokeGroupInvitationRequest) (*pb.RevokeGroupInvitationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.InvitationId == "" {
+		return &pb.RevokeGroupInvitationResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and invitation_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and invitation_id are required")
+	}
+
+	if err := s.invitationsUsecase.RevokeInvitation(ctx, req.GroupId, userID, req.InvitationId); err != nil {
+		pbErr, stErr := usecaseError(err, "revoke invitation")
+		return &pb.RevokeGroupInvitationResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.RevokeGroupInvitationResponse{}, nil
+}
+
+func (s *Server) JoinGroup(ctx context.Context, req *pb.JoinGroupRequest) (*pb.JoinGroupResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return &pb.JoinGroupResponse{
+			Result: &pb.JoinGroupResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "code is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	group, waitlisted, err := s.invitationsUsecase.JoinGroup(ctx, userID, req.Code)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "join group")
+		return &pb.JoinGroupResponse{
+			Result: &pb.JoinGroupResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.JoinGroupResponse{
+		Result: &pb.JoinGroupResponse_Group{
+			Group: convertGroup(group),
+		},
+		Waitlisted: waitlisted,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) RequestToJoinGroup(ctx context.Context, req *pb.RequestToJoinGroupRequest) (*pb.JoinRequestResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	joinRequest, err := s.joinRequestsUsecase.RequestToJoin(ctx, req.GroupId, userID, req.Message)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "request to join group")
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.JoinRequestResponse{
+		Result: &pb.JoinRequestResponse_Request{
+			Request: convertJoinRequest(joinRequest),
+		},
+	}, nil
+}
+
+func (s *Server) ListJoinRequests(ctx context.Context, req *pb.ListJoinRequestsRequest) (*pb.ListJoinRequestsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.ListJoinRequestsResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	requests, err := s.joinRequestsUsecase.ListJoinRequests(ctx, req.GroupId, userID, joinRequestStatusFromPb(req.Status))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list join requests")
+		return &pb.ListJoinRequestsResponse{Error: pbErr}, stErr
+	}
+
+	pbRequests := make([]*pb.JoinRequest, len(requests))
+	for i, r := range requests {
+		pbRequests[i] = convertJoinRequest(r)
+	}
+
+	return &pb.ListJoinRequestsResponse{Requests: pbRequests}, nil
+}
+
+func (s *Server) ApproveJoinRequest(ctx context.Context, req *pb.ApproveJoinRequestRequest) (*pb.JoinRequestResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.RequestId == "" {
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and request_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and request_id are required")
+	}
+
+	joinRequest, err := s.joinRequestsUsecase.ApproveJoinRequest(ctx, req.GroupId, userID, req.RequestId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "approve join request")
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.JoinRequestResponse{
+		Result: &pb.JoinRequestResponse_Request{
+			Request: convertJoinRequest(joinRequest),
+		},
+	}, nil
+}
+
+func (s *Server) RejectJoinRequest(ctx context.Context, req *pb.RejectJoinRequestRequest) (*pb.JoinRequestResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.RequestId == "" {
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and request_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and request_id are required")
+	}
+
+	joinRequest, err := s.joinRequestsUsecase.RejectJoinRequest(ctx, req.GroupId, userID, req.RequestId, req.Reason)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "reject join request")
+		return &pb.JoinRequestResponse{
+			Result: &pb.JoinRequestResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.JoinRequestResponse{
+		Result: &pb.JoinRequestResponse_Request{
+			Request: convertJoinRequest(joinRequest),
+		},
+	}, nil
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) TransferGroupOwnership(ctx context.Context, req *pb.TransferGroupOwnershipRequest) (*pb.OwnershipTransferResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.NewOwnerId == "" {
+		return &pb.OwnershipTransferResponse{
+			Result: &pb.OwnershipTransferResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and new_owner_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and new_owner_id are required")
+	}
+
+	transfer, err := s.ownershipUsecase.TransferOwnership(ctx, req.GroupId, userID, req.NewOwnerId, req.KeepAsCoTutor)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "transfer group ownership")
+		return &pb.OwnershipTransferResponse{
+			Result: &pb.OwnershipTransferResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.OwnershipTransferResponse{
+		Result: &pb.OwnershipTransferResponse_Transfer{
+			Transfer: convertOwnershipTransfer(transfer),
+		},
+	}, nil
+}
+
+func (s *Server) GetGroupOwnershipTransfer(ctx context.Context, req *pb.GetGroupOwnershipTransferRequest) (*pb.OwnershipTransferResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.OwnershipTransferResponse{
+			Result: &pb.OwnershipTransferResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	transfer, err := s.ownershipUsecase.GetTransfer(ctx, req.GroupId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "get ownership transfer")
+		return &pb.OwnershipTransferResponse{
+			Result: &pb.OwnershipTransferResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.OwnershipTransferResponse{
+		Result: &pb.OwnershipTransferResponse_Transfer{
+			Transfer: convertOwnershipTransfer(transfer),
+		},
+	}, nil
+}
+
+func (s *Server) AcceptGroupOwnershipTransfer(ctx context.Context, req *pb.AcceptGroupOwnershipTransferRequest) (*pb.AcceptGroupOwnershipTransferResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.AcceptGroupOwnershipTransferResponse{
+			Result: &pb.AcceptGroupOwnershipTransferResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	group, err := s.ownershipUsecase.AcceptTransfer(ctx, req.GroupId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "accept ownership transfer")
+		return &pb.AcceptGroupOwnershipTransferResponse{
+			Result: &pb.AcceptGroupOwnershipTransferResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.AcceptGroupOwnershipTransferResponse{
+		Result: &pb.AcceptGroupOwnershipTransferResponse_Group{
+			Group: convertGroup(group),
+		},
+	}, nil
+}
+
+func (s *Server) CancelGroupOwnershipTransfer(ctx context.Context, req *pb.CancelGroupOwnershipTransferRequest) (*pb.CancelGroupOwnershipTransferResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.CancelGroupOwnershipTransferResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	if err := s.ownershipUsecase.CancelTransfer(ctx, req.GroupId, userID); err != nil {
+		pbErr, stErr := usecaseError(err, "cancel ownership transfer")
+		return &pb.CancelGroupOwnershipTransferResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.CancelGroupOwnershipTransferResponse{}, nil
+}
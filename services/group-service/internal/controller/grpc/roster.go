@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"group_service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) ImportGroupRoster(ctx context.Context, req *pb.ImportGroupRosterRequest) (*pb.RosterImportResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.Csv == "" {
+		return &pb.RosterImportResponse{
+			Result: &pb.RosterImportResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and csv are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and csv are required")
+	}
+
+	imp, err := s.rosterUsecase.ImportRoster(ctx, req.GroupId, userID, req.Csv, req.DryRun)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "import roster")
+		return &pb.RosterImportResponse{
+			Result: &pb.RosterImportResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.RosterImportResponse{
+		Result: &pb.RosterImportResponse_RosterImport{RosterImport: convertRosterImport(imp)},
+	}, nil
+}
+
+func (s *Server) GetGroupRosterImport(ctx context.Context, req *pb.GetGroupRosterImportRequest) (*pb.RosterImportResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.ImportId == "" {
+		return &pb.RosterImportResponse{
+			Result: &pb.RosterImportResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and import_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and import_id are required")
+	}
+
+	imp, err := s.rosterUsecase.GetRosterImport(ctx, req.GroupId, userID, req.ImportId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "get roster import")
+		return &pb.RosterImportResponse{
+			Result: &pb.RosterImportResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.RosterImportResponse{
+		Result: &pb.RosterImportResponse_RosterImport{RosterImport: convertRosterImport(imp)},
+	}, nil
+}
+
+func (s *Server) GetGroupRosterImportReport(ctx context.Context, req *pb.GetGroupRosterImportRequest) (*pb.GetGroupRosterImportReportResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.ImportId == "" {
+		return &pb.GetGroupRosterImportReportResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and import_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and import_id are required")
+	}
+
+	fileName, content, err := s.rosterUsecase.GetRosterReport(ctx, req.GroupId, userID, req.ImportId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "get roster import report")
+		return &pb.GetGroupRosterImportReportResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.GetGroupRosterImportReportResponse{
+		FileName:    fileName,
+		ContentType: "text/csv",
+		Content:     content,
+	}, nil
+}
+
+func convertRosterImport(imp *models.RosterImport) *pb.RosterImport {
+	summary := imp.Summary()
+	pbImp := &pb.RosterImport{
+		Id:              imp.ID,
+		GroupId:         imp.GroupID,
+		CreatedBy:       imp.CreatedBy,
+		Status:          rosterImportStatusToPb(imp.Status),
+		TotalRows:       int32(len(imp.Rows)),
+		AddedCount:      int32(summary.Added),
+		WaitlistedCount: int32(summary.Waitlisted),
+		InvitedCount:    int32(summary.Invited),
+		SkippedCount:    int32(summary.Skipped),
+		FailedCount:     int32(summary.Failed),
+		Rows:            make([]*pb.RosterRow, len(imp.Rows)),
+		ErrorMessage:    imp.Error,
+		CreatedAt:       timestamppb.New(imp.CreatedAt),
+	}
+	for i, row := range imp.Rows {
+		pbImp.Rows[i] = &pb.RosterRow{
+			Line:      int32(row.Line),
+			Email:     row.Email,
+			Name:      row.Name,
+			Surname:   row.Surname,
+			StudentId: row.StudentID,
+			Status:    rosterRowStatusToPb(row.Status),
+			Message:   row.Message,
+		}
+	}
+	if imp.FinishedAt != nil {
+		pbImp.FinishedAt = timestamppb.New(*imp.FinishedAt)
+	}
+	return pbImp
+}
+
+func rosterImportStatusToPb(s models.RosterImportStatus) pb.RosterImportStatus {
+	switch s {
+	case models.RosterImportPending:
+		return pb.RosterImportStatus_ROSTER_IMPORT_STATUS_PENDING
+	case models.RosterImportProcessing:
+		return pb.RosterImportStatus_ROSTER_IMPORT_STATUS_PROCESSING
+	case models.RosterImportCompleted:
+		return pb.RosterImportStatus_ROSTER_IMPORT_STATUS_COMPLETED
+	case models.RosterImportFailed:
+		return pb.RosterImportStatus_ROSTER_IMPORT_STATUS_FAILED
+	}
+	return pb.RosterImportStatus_ROSTER_IMPORT_STATUS_UNSPECIFIED
+}
+
+func rosterRowStatusToPb(s models.RosterRowStatus) pb.RosterRowStatus {
+	switch s {
+	case models.RosterRowAdded:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_ADDED
+	case models.RosterRowWaitlisted:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_WAITLISTED
+	case models.RosterRowInvited:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_INVITED
+	case models.RosterRowAlreadyMember:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_ALREADY_MEMBER
+	case models.RosterRowAlreadyInvited:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_ALREADY_INVITED
+	case models.RosterRowNotAStudent:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_NOT_A_STUDENT
+	case models.RosterRowInvalid:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_INVALID
+	case models.RosterRowFailed:
+		return pb.RosterRowStatus_ROSTER_ROW_STATUS_FAILED
+	}
+	return pb.RosterRowStatus_ROSTER_ROW_STATUS_UNSPECIFIED
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"group_service/internal/models"
+	"group_service/internal/usecase"
 	"net"
+	"time"
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
 
@@ -13,34 +15,142 @@ import (
 
 type GroupsUsecase interface {
 	// Управление группами
-	CreateGroup(ctx context.Context, tutorID, name, desc string) (*models.Group, error)
+	CreateGroup(ctx context.Context, tutorID, name, desc string, policy models.JoinPolicy, maxMembers int) (*models.Group, error)
 	GetGroup(ctx context.Context, id string, includeMembers bool) (*models.Group, error)
-	UpdateGroup(ctx context.Context, groupIdStr, userIdStr string, name, desc *string) (*models.Group, error)
-	DeleteGroup(ctx context.Context, groupIdStr, userIdStr string) error
+	UpdateGroup(ctx context.Context, groupIdStr, userIdStr string, name, desc *string, policy *models.JoinPolicy, maxMembers *int) (*models.Group, error)
 
 	// Получение списков групп
-	ListGroupsByTutor(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error)
-	ListGroupsByStudent(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error)
+	ListGroups(ctx context.Context, callerID string, q models.GroupQuery, pageToken string, pageSize int) ([]*models.Group, string, error)
 
 	// Управление участниками
-	ListGroupMembers(ctx context.Context, groupID string) ([]*models.GroupMember, error)
-	AddGroupMembers(ctx context.Context, groupIDStr, userIdStr string, studentIDStrs []string) (int, error)
-	RemoveGroupMembers(ctx context.Context, groupIDStr, userIdStr string, studentIDStrs []string) (int, error)
+	ListGroupMembers(ctx context.Context, groupID, userID string, includeProfiles bool) ([]*models.GroupMember, error)
+	AddGroupMembers(ctx context.Context, groupIDStr, userIdStr string, inputs []string) ([]*models.AddMemberResult, error)
+	RemoveGroupMembers(ctx context.Context, groupIDStr, userIdStr string, studentIDStrs []string) (int, []string, error)
+
+	// Очередь заполненной группы
+	ListWaitlist(ctx context.Context, groupID, userID string) ([]*models.WaitlistEntry, error)
+	RemoveFromWaitlist(ctx context.Context, groupID, userID, studentID string) error
+}
+
+type ArchiveUsecase interface {
+	ArchiveGroup(ctx context.Context, groupID, userID string) (*models.Group, error)
+	RestoreGroup(ctx context.Context, groupID, userID string) (*models.Group, error)
+	DeleteGroup(ctx context.Context, groupID, userID string) error
+}
+
+type InvitationsUsecase interface {
+	CreateInvitation(ctx context.Context, p usecase.CreateInvitationParams) (*models.GroupInvitation, error)
+	ListInvitations(ctx context.Context, groupID, userID string) ([]*models.GroupInvitation, error)
+	RevokeInvitation(ctx context.Context, groupID, userID, invitationID string) error
+	JoinGroup(ctx context.Context, userID, code string) (*models.Group, bool, error)
+	JoinLink(code string) string
+}
+
+type JoinRequestsUsecase interface {
+	RequestToJoin(ctx context.Context, groupID, studentID, message string) (*models.JoinRequest, error)
+	ListJoinRequests(ctx context.Context, groupID, userID string, status models.JoinRequestStatus) ([]*models.JoinRequest, error)
+	ApproveJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.JoinRequest, error)
+	RejectJoinRequest(ctx context.Context, groupID, userID, requestID, reason string) (*models.JoinRequest, error)
+}
+
+type StaffUsecase interface {
+	AddStaff(ctx context.Context, groupID, userID, staffUserID string, role models.StaffRole) (*models.StaffMember, error)
+	UpdateStaffRole(ctx context.Context, groupID, userID, staffUserID string, role models.StaffRole) (*models.StaffMember, error)
+	RemoveStaff(ctx context.Context, groupID, userID, staffUserID string) error
+	ListStaff(ctx context.Context, groupID string) ([]*models.StaffMember, error)
+	CheckPermission(ctx context.Context, groupID, userID string, perm models.Permission) (bool, models.StaffRole, error)
+	HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error)
+}
+
+type OwnershipUsecase interface {
+	TransferOwnership(ctx context.Context, groupID, userID, newOwnerID string, keepAsCoTutor bool) (*models.OwnershipTransfer, error)
+	GetTransfer(ctx context.Context, groupID, userID string) (*models.OwnershipTransfer, error)
+	AcceptTransfer(ctx context.Context, groupID, userID string) (*models.Group, error)
+	CancelTransfer(ctx context.Context, groupID, userID string) error
+}
+
+type AnnouncementsUsecase interface {
+	CreateAnnouncement(ctx context.Context, p usecase.CreateAnnouncementParams) (*models.Announcement, error)
+	ListAnnouncements(ctx context.Context, groupID, userID string) ([]*models.Announcement, error)
+	GetAnnouncement(ctx context.Context, groupID, userID, announcementID string) (*models.Announcement, error)
+	UpdateAnnouncement(ctx context.Context, p usecase.UpdateAnnouncementParams) (*models.Announcement, error)
+	DeleteAnnouncement(ctx context.Context, groupID, userID, announcementID string) error
+	MarkAnnouncementRead(ctx context.Context, groupID, userID, announcementID string) error
+}
+
+type RosterUsecase interface {
+	ImportRoster(ctx context.Context, groupID, userID, content string, dryRun bool) (*models.RosterImport, error)
+	GetRosterImport(ctx context.Context, groupID, userID, importID string) (*models.RosterImport, error)
+	GetRosterReport(ctx context.Context, groupID, userID, importID string) (string, string, error)
+}
+
+type TermsUsecase interface {
+	CreateTerm(ctx context.Context, userID, name string, startsAt, endsAt time.Time) (*models.Term, error)
+	ListTerms(ctx context.Context, userID string) ([]*models.Term, error)
+	GetTerm(ctx context.Context, termID, userID string) (*models.Term, error)
+	UpdateTerm(ctx context.Context, p usecase.UpdateTermParams) (*models.Term, error)
+	DeleteTerm(ctx context.Context, termID, userID string) error
+	SetGroupTerm(ctx context.Context, groupID, userID, termID string) (*models.Group, error)
+}
+
+type ChatUsecase interface {
+	SendMessage(ctx context.Context, groupID, userID, body string) (*models.Message, error)
+	ListMessages(ctx context.Context, groupID, userID, pageToken string, pageSize int) ([]*models.Message, string, error)
+	EditMessage(ctx context.Context, groupID, userID, messageID, body string) (*models.Message, error)
+	DeleteMessage(ctx context.Context, groupID, userID, messageID string) error
+	Subscribe(ctx context.Context, groupID, userID string) (<-chan *models.MessageEvent, error)
+}
+
+type DirectMessagesUsecase interface {
+	StartConversation(ctx context.Context, userID, peerID string) (*models.Conversation, error)
+	ListConversations(ctx context.Context, userID string) ([]*models.Conversation, int, error)
+	SendMessage(ctx context.Context, p usecase.SendDirectMessageParams) (*models.DirectMessage, error)
+	ListMessages(ctx context.Context, conversationID, userID, pageToken string, pageSize int) ([]*models.DirectMessage, string, error)
+	MarkRead(ctx context.Context, conversationID, userID string) error
+	SearchMessages(ctx context.Context, userID, query, conversationID, pageToken string, pageSize int) ([]*models.DirectMessage, string, error)
+	BlockUser(ctx context.Context, userID, blockedID string) error
+	UnblockUser(ctx context.Context, userID, blockedID string) error
+	ListBlockedUsers(ctx context.Context, userID string) ([]*models.UserBlock, error)
+	ReportUser(ctx context.Context, p usecase.ReportUserParams) (*models.UserReport, error)
 }
 
 type Server struct {
 	pb.GroupsServiceServer
 	srv *grpc.Server
 
-	groupsUsecase GroupsUsecase
+	groupsUsecase         GroupsUsecase
+	invitationsUsecase    InvitationsUsecase
+	joinRequestsUsecase   JoinRequestsUsecase
+	staffUsecase          StaffUsecase
+	ownershipUsecase      OwnershipUsecase
+	archiveUsecase        ArchiveUsecase
+	announcementsUsecase  AnnouncementsUsecase
+	chatUsecase           ChatUsecase
+	directMessagesUsecase DirectMessagesUsecase
+	rosterUsecase         RosterUsecase
+	termsUsecase          TermsUsecase
+
+	// закрывается при остановке, чтобы завершить потоки чата до GracefulStop
+	shutdown chan struct{}
 }
 
-func NewServer(groupsUsecase GroupsUsecase) *Server {
+func NewServer(groupsUsecase GroupsUsecase, invitationsUsecase InvitationsUsecase, joinRequestsUsecase JoinRequestsUsecase, staffUsecase StaffUsecase, ownershipUsecase OwnershipUsecase, archiveUsecase ArchiveUsecase, announcementsUsecase AnnouncementsUsecase, chatUsecase ChatUsecase, directMessagesUsecase DirectMessagesUsecase, rosterUsecase RosterUsecase, termsUsecase TermsUsecase) *Server {
 	grpcSrv := grpc.NewServer()
 
 	server := &Server{
-		srv:           grpcSrv,
-		groupsUsecase: groupsUsecase,
+		srv:                   grpcSrv,
+		groupsUsecase:         groupsUsecase,
+		invitationsUsecase:    invitationsUsecase,
+		joinRequestsUsecase:   joinRequestsUsecase,
+		staffUsecase:          staffUsecase,
+		ownershipUsecase:      ownershipUsecase,
+		archiveUsecase:        archiveUsecase,
+		announcementsUsecase:  announcementsUsecase,
+		chatUsecase:           chatUsecase,
+		directMessagesUsecase: directMessagesUsecase,
+		rosterUsecase:         rosterUsecase,
+		termsUsecase:          termsUsecase,
+		shutdown:              make(chan struct{}),
 	}
 
 	pb.RegisterGroupsServiceServer(grpcSrv, server)
@@ -62,5 +172,6 @@ func (s *Server) Run(grpcPort int) error {
 }
 
 func (s *Server) Stop() {
+	close(s.shutdown)
 	s.srv.GracefulStop()
 }
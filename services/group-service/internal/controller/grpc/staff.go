@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) AddGroupStaff(ctx context.Context, req *pb.AddGroupStaffRequest) (*pb.GroupStaffResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.UserId == "" {
+		return &pb.GroupStaffResponse{
+			Result: &pb.GroupStaffResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and user_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and user_id are required")
+	}
+
+	member, err := s.staffUsecase.AddStaff(ctx, req.GroupId, userID, req.UserId, staffRoleFromPb(req.Role))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "add group staff")
+		return &pb.GroupStaffResponse{
+			Result: &pb.GroupStaffResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.GroupStaffResponse{
+		Result: &pb.GroupStaffResponse_Member{
+			Member: convertStaffMember(member),
+		},
+	}, nil
+}
+
+func (s *Server) ListGroupStaff(ctx context.Context, req *pb.ListGroupStaffRequest) (*pb.ListGroupStaffResponse, error) {
+	if req.GroupId == "" {
+		return &pb.ListGroupStaffResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	staff, err := s.staffUsecase.ListStaff(ctx, req.GroupId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list group staff")
+		return &pb.ListGroupStaffResponse{Error: pbErr}, stErr
+	}
+
+	pbStaff := make([]*pb.GroupStaffMember, len(staff))
+	for i, m := range staff {
+		pbStaff[i] = convertStaffMember(m)
+	}
+
+	return &pb.ListGroupStaffResponse{Staff: pbStaff}, nil
+}
+
+func (s *Server) UpdateGroupStaffRole(ctx context.Context, req *pb.UpdateGroupStaffRoleRequest) (*pb.GroupStaffResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.UserId == "" {
+		return &pb.GroupStaffResponse{
+			Result: &pb.GroupStaffResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id and user_id are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id and user_id are required")
+	}
+
+	member, err := s.staffUsecase.UpdateStaffRole(ctx, req.GroupId, userID, req.UserId, staffRoleFromPb(req.Role))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "update group staff role")
+		return &pb.GroupStaffResponse{
+			Result: &pb.GroupStaffResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.GroupStaffResponse{
+		Result: &pb.GroupStaffResponse_Member{
+			Member: convertStaffMember(member),
+		},
+	}, nil
+}
+
+func (s *Server) RemoveGroupStaff(ctx context.Context, req *pb.RemoveGroupStaffRequest) (*pb.RemoveGroupStaffResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.UserId == "" {
+		return &pb.RemoveGroupStaffResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and user_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and user_id are required")
+	}
+
+	if err := s.staffUsecase.RemoveStaff(ctx, req.GroupId, userID, req.UserId); err != nil {
+		pbErr, stErr := usecaseError(err, "remove group staff")
+		return &pb.RemoveGroupStaffResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.RemoveGroupStaffResponse{}, nil
+}
+
+// CheckPermission вызывается другими сервисами, пользователь передается в запросе, а не в метаданных
+func (s *Server) CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.CheckPermissionResponse, error) {
+	if req.GroupId == "" || req.UserId == "" {
+		return &pb.CheckPermissionResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and user_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and user_id are required")
+	}
+
+	allowed, role, err := s.staffUsecase.CheckPermission(ctx, req.GroupId, req.UserId, permissionFromPb(req.Permission))
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "check permission")
+		return &pb.CheckPermissionResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.CheckPermissionResponse{
+		Allowed: allowed,
+		Role:    staffRoleToPb(role),
+	}, nil
+}
+
+// HasSharedGroup вызывается user-service перед отзывом ученика о репетиторе
+func (s *Server) HasSharedGroup(ctx context.Context, req *pb.HasSharedGroupRequest) (*pb.HasSharedGroupResponse, error) {
+	if req.TutorId == "" || req.StudentId == "" {
+		return &pb.HasSharedGroupResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "tutor_id and student_id are required"),
+		}, status.Error(codes.InvalidArgument, "tutor_id and student_id are required")
+	}
+
+	shared, err := s.staffUsecase.HasSharedGroup(ctx, req.TutorId, req.StudentId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "check shared group")
+		return &pb.HasSharedGroupResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.HasSharedGroupResponse{Shared: shared}, nil
+}
@@ -0,0 +1,197 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) CreateTerm(ctx context.Context, req *pb.CreateTermRequest) (*pb.TermResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartsAt == nil || req.EndsAt == nil {
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "starts_at and ends_at are required"),
+			},
+		}, status.Error(codes.InvalidArgument, "starts_at and ends_at are required")
+	}
+
+	term, err := s.termsUsecase.CreateTerm(ctx, userID, req.Name, req.StartsAt.AsTime(), req.EndsAt.AsTime())
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "create term")
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.TermResponse{
+		Result: &pb.TermResponse_Term{Term: convertTerm(term)},
+	}, nil
+}
+
+func (s *Server) ListTerms(ctx context.Context, req *pb.ListTermsRequest) (*pb.ListTermsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := s.termsUsecase.ListTerms(ctx, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list terms")
+		return &pb.ListTermsResponse{Error: pbErr}, stErr
+	}
+
+	pbTerms := make([]*pb.Term, len(terms))
+	for i, t := range terms {
+		pbTerms[i] = convertTerm(t)
+	}
+
+	return &pb.ListTermsResponse{Terms: pbTerms}, nil
+}
+
+func (s *Server) GetTerm(ctx context.Context, req *pb.GetTermRequest) (*pb.TermResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TermId == "" {
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "term_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "term_id is required")
+	}
+
+	term, err := s.termsUsecase.GetTerm(ctx, req.TermId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "get term")
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.TermResponse{
+		Result: &pb.TermResponse_Term{Term: convertTerm(term)},
+	}, nil
+}
+
+func (s *Server) UpdateTerm(ctx context.Context, req *pb.UpdateTermRequest) (*pb.TermResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TermId == "" {
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "term_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "term_id is required")
+	}
+
+	params := usecase.UpdateTermParams{
+		TermID: req.TermId,
+		UserID: userID,
+		Name:   req.Name,
+	}
+	if req.StartsAt != nil {
+		startsAt := req.StartsAt.AsTime()
+		params.StartsAt = &startsAt
+	}
+	if req.EndsAt != nil {
+		endsAt := req.EndsAt.AsTime()
+		params.EndsAt = &endsAt
+	}
+
+	term, err := s.termsUsecase.UpdateTerm(ctx, params)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "update term")
+		return &pb.TermResponse{
+			Result: &pb.TermResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.TermResponse{
+		Result: &pb.TermResponse_Term{Term: convertTerm(term)},
+	}, nil
+}
+
+func (s *Server) DeleteTerm(ctx context.Context, req *pb.DeleteTermRequest) (*pb.DeleteTermResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TermId == "" {
+		return &pb.DeleteTermResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "term_id is required"),
+		}, status.Error(codes.InvalidArgument, "term_id is required")
+	}
+
+	if err := s.termsUsecase.DeleteTerm(ctx, req.TermId, userID); err != nil {
+		pbErr, stErr := usecaseError(err, "delete term")
+		return &pb.DeleteTermResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.DeleteTermResponse{}, nil
+}
+
+func (s *Server) SetGroupTerm(ctx context.Context, req *pb.SetGroupTermRequest) (*pb.SetGroupTermResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.SetGroupTermResponse{
+			Result: &pb.SetGroupTermResponse_Error{
+				Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+			},
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	group, err := s.termsUsecase.SetGroupTerm(ctx, req.GroupId, userID, req.TermId)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "set group term")
+		return &pb.SetGroupTermResponse{
+			Result: &pb.SetGroupTermResponse_Error{Error: pbErr},
+		}, stErr
+	}
+
+	return &pb.SetGroupTermResponse{
+		Result: &pb.SetGroupTermResponse_Group{Group: convertGroup(group)},
+	}, nil
+}
+
+func convertTerm(t *models.Term) *pb.Term {
+	return &pb.Term{
+		Id:         t.ID,
+		OwnerId:    t.OwnerID,
+		Name:       t.Name,
+		StartsAt:   timestamppb.New(t.StartsAt),
+		EndsAt:     timestamppb.New(t.EndsAt),
+		CreatedAt:  timestampOrNil(t.CreatedAt),
+		GroupCount: int32(t.GroupCount),
+	}
+}
+
+// timestampOrNil - у периода в описании группы нет даты создания
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"group_service/internal/models"
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
@@ -28,6 +29,99 @@ func getUserIDFromContext(ctx context.Context) (string, error) {
 	return values[0], nil
 }
 
+// getOptionalUserIDFromContext возвращает пустую строку для внутренних вызовов без метаданных
+func getOptionalUserIDFromContext(ctx context.Context) string {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return userID
+}
+
+// usecaseError сопоставляет ошибки usecase кодам ответа
+func usecaseError(err error, action string) (*pb.Error, error) {
+	var (
+		code     = "INTERNAL"
+		grpcCode = codes.Internal
+		message  = "failed to " + action + ": " + err.Error()
+	)
+
+	switch {
+	case errors.Is(err, models.ErrTutorIsNotValid):
+		code, grpcCode, message = "PERMISSION_DENIED", codes.PermissionDenied, "you do not have permission to manage this group"
+	case errors.Is(err, models.ErrGroupNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, "group not found"
+	case errors.Is(err, models.ErrInvitationNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrInvitationNotFound.Error()
+	case errors.Is(err, models.ErrJoinRequestNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrJoinRequestNotFound.Error()
+	case errors.Is(err, models.ErrTransferNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrTransferNotFound.Error()
+	case errors.Is(err, models.ErrStaffNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrStaffNotFound.Error()
+	case errors.Is(err, models.ErrAnnouncementNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrAnnouncementNotFound.Error()
+	case errors.Is(err, models.ErrNotMessageAuthor):
+		code, grpcCode, message = "PERMISSION_DENIED", codes.PermissionDenied, "only the author can change this message"
+	case errors.Is(err, models.ErrMessageNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrMessageNotFound.Error()
+	case errors.Is(err, models.ErrConversationNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrConversationNotFound.Error()
+	case errors.Is(err, models.ErrNoSharedGroup):
+		code, grpcCode, message = "PERMISSION_DENIED", codes.PermissionDenied, models.ErrNoSharedGroup.Error()
+	case errors.Is(err, models.ErrNotStudent):
+		code, grpcCode, message = "PERMISSION_DENIED", codes.PermissionDenied, models.ErrNotStudent.Error()
+	case errors.Is(err, models.ErrNotGroupMember):
+		code, grpcCode, message = "PERMISSION_DENIED", codes.PermissionDenied, models.ErrNotGroupMember.Error()
+	case errors.Is(err, models.ErrRosterImportNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrRosterImportNotFound.Error()
+	case errors.Is(err, models.ErrTermNotFound):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrTermNotFound.Error()
+	case errors.Is(err, models.ErrNotOnWaitlist):
+		code, grpcCode, message = "NOT_FOUND", codes.NotFound, models.ErrNotOnWaitlist.Error()
+	case errors.Is(err, models.ErrInvalidInvitation),
+		errors.Is(err, models.ErrInvalidJoinRequest),
+		errors.Is(err, models.ErrInvalidJoinPolicy),
+		errors.Is(err, models.ErrOwnerCannotJoin),
+		errors.Is(err, models.ErrInvalidStaffRole),
+		errors.Is(err, models.ErrInvalidPermission),
+		errors.Is(err, models.ErrStaffIsMember),
+		errors.Is(err, models.ErrInvalidTransfer),
+		errors.Is(err, models.ErrInvalidCapacity),
+		errors.Is(err, models.ErrInvalidMembers),
+		errors.Is(err, models.ErrInvalidGroupQuery),
+		errors.Is(err, models.ErrInvalidRoster),
+		errors.Is(err, models.ErrInvalidAnnouncement),
+		errors.Is(err, models.ErrInvalidMessage),
+		errors.Is(err, models.ErrInvalidDirectMessage),
+		errors.Is(err, models.ErrInvalidReport),
+		errors.Is(err, models.ErrInvalidTerm):
+		code, grpcCode, message = "INVALID_ARGUMENT", codes.InvalidArgument, err.Error()
+	case errors.Is(err, models.ErrJoinRequestExists),
+		errors.Is(err, models.ErrAlreadyMember),
+		errors.Is(err, models.ErrStaffExists):
+		code, grpcCode, message = "ALREADY_EXISTS", codes.AlreadyExists, err.Error()
+	case errors.Is(err, models.ErrInvitationRevoked),
+		errors.Is(err, models.ErrInvitationExpired),
+		errors.Is(err, models.ErrInvitationExhausted),
+		errors.Is(err, models.ErrJoinByRequestDisabled),
+		errors.Is(err, models.ErrJoinRequestDecided),
+		errors.Is(err, models.ErrTransferExpired),
+		errors.Is(err, models.ErrGroupArchived),
+		errors.Is(err, models.ErrGroupNotArchived),
+		errors.Is(err, models.ErrRosterImportInProgress),
+		errors.Is(err, models.ErrRetentionNotElapsed),
+		errors.Is(err, models.ErrAnnouncementPublished),
+		errors.Is(err, models.ErrMessageDeleted),
+		errors.Is(err, models.ErrUserBlocked),
+		errors.Is(err, models.ErrTermInUse),
+		errors.Is(err, models.ErrTermEnded):
+		code, grpcCode, message = "FAILED_PRECONDITION", codes.FailedPrecondition, err.Error()
+	}
+
+	return errorResponse(code, message), status.Error(grpcCode, message)
+}
+
 func convertGroup(g *models.Group) *pb.Group {
 	pbG := &pb.Group{
 		Id:          g.ID,
@@ -35,7 +129,15 @@ func convertGroup(g *models.Group) *pb.Group {
 		Name:        g.Name,
 		Description: g.Description,
 		CreatedAt:   timestamppb.New(g.CreatedAt),
-		MemberCount: int32(len(g.Members)),
+		MemberCount: int32(g.MemberCount),
+		JoinPolicy:  joinPolicyToPb(g.JoinPolicy),
+		MaxMembers:  int32(g.MaxMembers),
+	}
+	if g.ArchivedAt != nil {
+		pbG.ArchivedAt = timestamppb.New(*g.ArchivedAt)
+	}
+	if g.Term != nil {
+		pbG.Term = convertTerm(g.Term)
 	}
 
 	if g.Members != nil {
@@ -51,3 +153,227 @@ func convertGroup(g *models.Group) *pb.Group {
 
 	return pbG
 }
+
+func joinPolicyToPb(p models.JoinPolicy) pb.JoinPolicy {
+	switch p {
+	case models.JoinPolicyOpen:
+		return pb.JoinPolicy_JOIN_POLICY_OPEN
+	case models.JoinPolicyRequest:
+		return pb.JoinPolicy_JOIN_POLICY_REQUEST
+	case models.JoinPolicyInviteOnly:
+		return pb.JoinPolicy_JOIN_POLICY_INVITE_ONLY
+	}
+	return pb.JoinPolicy_JOIN_POLICY_UNSPECIFIED
+}
+
+// joinPolicyFromPb - для UNSPECIFIED пустая политика, значение по умолчанию выбирает usecase
+func joinPolicyFromPb(p pb.JoinPolicy) models.JoinPolicy {
+	switch p {
+	case pb.JoinPolicy_JOIN_POLICY_OPEN:
+		return models.JoinPolicyOpen
+	case pb.JoinPolicy_JOIN_POLICY_REQUEST:
+		return models.JoinPolicyRequest
+	case pb.JoinPolicy_JOIN_POLICY_INVITE_ONLY:
+		return models.JoinPolicyInviteOnly
+	}
+	return ""
+}
+
+// groupSortFieldFromPb - для UNSPECIFIED пустое поле, сортировку по умолчанию выбирает usecase
+func groupSortFieldFromPb(f pb.GroupSortField) models.GroupSortField {
+	switch f {
+	case pb.GroupSortField_GROUP_SORT_CREATED_AT:
+		return models.GroupSortCreatedAt
+	case pb.GroupSortField_GROUP_SORT_NAME:
+		return models.GroupSortName
+	case pb.GroupSortField_GROUP_SORT_MEMBER_COUNT:
+		return models.GroupSortMemberCount
+	}
+	return ""
+}
+
+// archiveFilterFromPb - без фильтра архивные группы показываются по старому флагу include_archived,
+// пустой фильтр выбирает usecase
+func archiveFilterFromPb(f pb.ArchiveFilter, includeArchived bool) models.ArchiveFilter {
+	switch f {
+	case pb.ArchiveFilter_ARCHIVE_FILTER_ACTIVE:
+		return models.ArchiveFilterActive
+	case pb.ArchiveFilter_ARCHIVE_FILTER_ARCHIVED:
+		return models.ArchiveFilterArchived
+	case pb.ArchiveFilter_ARCHIVE_FILTER_ALL:
+		return models.ArchiveFilterAll
+	}
+	if includeArchived {
+		return models.ArchiveFilterAll
+	}
+	return ""
+}
+
+func joinRequestStatusToPb(s models.JoinRequestStatus) pb.JoinRequestStatus {
+	switch s {
+	case models.JoinRequestPending:
+		return pb.JoinRequestStatus_JOIN_REQUEST_PENDING
+	case models.JoinRequestApproved:
+		return pb.JoinRequestStatus_JOIN_REQUEST_APPROVED
+	case models.JoinRequestRejected:
+		return pb.JoinRequestStatus_JOIN_REQUEST_REJECTED
+	}
+	return pb.JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+func addMemberStatusToPb(s models.AddMemberStatus) pb.AddMemberStatus {
+	switch s {
+	case models.AddMemberAdded:
+		return pb.AddMemberStatus_ADD_MEMBER_STATUS_ADDED
+	case models.AddMemberWaitlisted:
+		return pb.AddMemberStatus_ADD_MEMBER_STATUS_WAITLISTED
+	case models.AddMemberAlreadyMember:
+		return pb.AddMemberStatus_ADD_MEMBER_STATUS_ALREADY_MEMBER
+	case models.AddMemberNotFound:
+		return pb.AddMemberStatus_ADD_MEMBER_STATUS_NOT_FOUND
+	case models.AddMemberNotAStudent:
+		return pb.AddMemberStatus_ADD_MEMBER_STATUS_NOT_A_STUDENT
+	}
+	return pb.AddMemberStatus_ADD_MEMBER_STATUS_UNSPECIFIED
+}
+
+func joinRequestStatusFromPb(s pb.JoinRequestStatus) models.JoinRequestStatus {
+	switch s {
+	case pb.JoinRequestStatus_JOIN_REQUEST_PENDING:
+		return models.JoinRequestPending
+	case pb.JoinRequestStatus_JOIN_REQUEST_APPROVED:
+		return models.JoinRequestApproved
+	case pb.JoinRequestStatus_JOIN_REQUEST_REJECTED:
+		return models.JoinRequestRejected
+	}
+	return ""
+}
+
+func convertJoinRequest(r *models.JoinRequest) *pb.JoinRequest {
+	pbR := &pb.JoinRequest{
+		Id:           r.ID,
+		GroupId:      r.GroupID,
+		StudentId:    r.StudentID,
+		Message:      r.Message,
+		Status:       joinRequestStatusToPb(r.Status),
+		DecidedBy:    r.DecidedBy,
+		RejectReason: r.RejectReason,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		Waitlisted:   r.Waitlisted,
+	}
+	if r.DecidedAt != nil {
+		pbR.DecidedAt = timestamppb.New(*r.DecidedAt)
+	}
+	return pbR
+}
+
+func staffRoleToPb(r models.StaffRole) pb.StaffRole {
+	switch r {
+	case models.StaffRoleOwner:
+		return pb.StaffRole_STAFF_ROLE_OWNER
+	case models.StaffRoleCoTutor:
+		return pb.StaffRole_STAFF_ROLE_CO_TUTOR
+	case models.StaffRoleAssistant:
+		return pb.StaffRole_STAFF_ROLE_ASSISTANT
+	}
+	return pb.StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func staffRoleFromPb(r pb.StaffRole) models.StaffRole {
+	switch r {
+	case pb.StaffRole_STAFF_ROLE_OWNER:
+		return models.StaffRoleOwner
+	case pb.StaffRole_STAFF_ROLE_CO_TUTOR:
+		return models.StaffRoleCoTutor
+	case pb.StaffRole_STAFF_ROLE_ASSISTANT:
+		return models.StaffRoleAssistant
+	}
+	return ""
+}
+
+func permissionFromPb(p pb.Permission) models.Permission {
+	switch p {
+	case pb.Permission_PERMISSION_UPDATE_GROUP:
+		return models.PermissionUpdateGroup
+	case pb.Permission_PERMISSION_DELETE_GROUP:
+		return models.PermissionDeleteGroup
+	case pb.Permission_PERMISSION_MANAGE_STAFF:
+		return models.PermissionManageStaff
+	case pb.Permission_PERMISSION_MANAGE_MEMBERS:
+		return models.PermissionManageMembers
+	case pb.Permission_PERMISSION_MANAGE_TASKS:
+		return models.PermissionManageTasks
+	case pb.Permission_PERMISSION_GRADE_SUBMISSIONS:
+		return models.PermissionGradeSubmissions
+	}
+	return ""
+}
+
+func convertStaffMember(m *models.StaffMember) *pb.GroupStaffMember {
+	return &pb.GroupStaffMember{
+		GroupId:   m.GroupID,
+		UserId:    m.UserID,
+		Role:      staffRoleToPb(m.Role),
+		AddedBy:   m.AddedBy,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+func convertWaitlistEntry(e *models.WaitlistEntry) *pb.WaitlistEntry {
+	return &pb.WaitlistEntry{
+		GroupId:   e.GroupID,
+		StudentId: e.StudentID,
+		Position:  int32(e.Position),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func convertAnnouncement(a *models.Announcement) *pb.Announcement {
+	pbA := &pb.Announcement{
+		Id:          a.ID,
+		GroupId:     a.GroupID,
+		AuthorId:    a.AuthorID,
+		Body:        a.Body,
+		Pinned:      a.Pinned,
+		PublishAt:   timestamppb.New(a.PublishAt),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		UpdatedAt:   timestamppb.New(a.UpdatedAt),
+		ReadCount:   int32(a.ReadCount),
+		MemberCount: int32(a.MemberCount),
+	}
+	if a.PublishedAt != nil {
+		pbA.PublishedAt = timestamppb.New(*a.PublishedAt)
+	}
+	if a.ReadAt != nil {
+		pbA.ReadAt = timestamppb.New(*a.ReadAt)
+	}
+	return pbA
+}
+
+func convertMessage(m *models.Message) *pb.GroupMessage {
+	pbM := &pb.GroupMessage{
+		Id:        m.ID,
+		GroupId:   m.GroupID,
+		AuthorId:  m.AuthorID,
+		Body:      m.Body,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+	if m.EditedAt != nil {
+		pbM.EditedAt = timestamppb.New(*m.EditedAt)
+	}
+	if m.DeletedAt != nil {
+		pbM.DeletedAt = timestamppb.New(*m.DeletedAt)
+	}
+	return pbM
+}
+
+func convertOwnershipTransfer(t *models.OwnershipTransfer) *pb.OwnershipTransfer {
+	return &pb.OwnershipTransfer{
+		GroupId:       t.GroupID,
+		FromUserId:    t.FromUserID,
+		ToUserId:      t.ToUserID,
+		KeepAsCoTutor: t.KeepAsCoTutor,
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		ExpiresAt:     timestamppb.New(t.ExpiresAt),
+	}
+}
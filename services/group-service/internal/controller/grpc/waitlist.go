@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+)
+
+func (s *Server) ListGroupWaitlist(ctx context.Context, req *pb.ListGroupWaitlistRequest) (*pb.ListGroupWaitlistResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" {
+		return &pb.ListGroupWaitlistResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id is required"),
+		}, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	entries, err := s.groupsUsecase.ListWaitlist(ctx, req.GroupId, userID)
+	if err != nil {
+		pbErr, stErr := usecaseError(err, "list group waitlist")
+		return &pb.ListGroupWaitlistResponse{Error: pbErr}, stErr
+	}
+
+	pbEntries := make([]*pb.WaitlistEntry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = convertWaitlistEntry(e)
+	}
+
+	return &pb.ListGroupWaitlistResponse{Entries: pbEntries}, nil
+}
+
+func (s *Server) RemoveFromGroupWaitlist(ctx context.Context, req *pb.RemoveFromGroupWaitlistRequest) (*pb.RemoveFromGroupWaitlistResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == "" || req.StudentId == "" {
+		return &pb.RemoveFromGroupWaitlistResponse{
+			Error: errorResponse("INVALID_ARGUMENT", "group_id and student_id are required"),
+		}, status.Error(codes.InvalidArgument, "group_id and student_id are required")
+	}
+
+	if err := s.groupsUsecase.RemoveFromWaitlist(ctx, req.GroupId, userID, req.StudentId); err != nil {
+		pbErr, stErr := usecaseError(err, "remove from group waitlist")
+		return &pb.RemoveFromGroupWaitlistResponse{Error: pbErr}, stErr
+	}
+
+	return &pb.RemoveFromGroupWaitlistResponse{}, nil
+}
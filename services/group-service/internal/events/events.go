@@ -0,0 +1,187 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	JoinRequestCreated  = "JoinRequestCreated"
+	JoinRequestApproved = "JoinRequestApproved"
+	JoinRequestRejected = "JoinRequestRejected"
+
+	GroupOwnershipTransferRequested = "GroupOwnershipTransferRequested"
+	GroupOwnershipTransferred       = "GroupOwnershipTransferred"
+
+	GroupCreated  = "GroupCreated"
+	GroupUpdated  = "GroupUpdated"
+	GroupArchived = "GroupArchived"
+	GroupRestored = "GroupRestored"
+	GroupDeleted  = "GroupDeleted"
+
+	MembersAdded   = "MembersAdded"
+	MembersRemoved = "MembersRemoved"
+
+	GroupWaitlistPromoted = "GroupWaitlistPromoted"
+
+	AnnouncementPublished = "AnnouncementPublished"
+
+	DirectMessageSent = "DirectMessageSent"
+	UserReported      = "UserReported"
+)
+
+// Envelope - формат событий group-service, как у событий auth-service и user-service
+type Envelope struct {
+	EventType  string          `json:"event_type"`
+	EventID    string          `json:"event_id"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+func NewEnvelope(eventType string, payload any) (Envelope, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		EventType:  eventType,
+		EventID:    uuid.New().String(),
+		Version:    1,
+		OccurredAt: time.Now().UTC(),
+		Payload:    raw,
+	}, nil
+}
+
+// JoinRequestPayload - заявка на вступление, уведомление получают репетитор (создание) и ученик (решение)
+type JoinRequestPayload struct {
+	RequestID    string `json:"request_id"`
+	GroupID      string `json:"group_id"`
+	GroupName    string `json:"group_name"`
+	TutorID      string `json:"tutor_id"`
+	StudentID    string `json:"student_id"`
+	Message      string `json:"message,omitempty"`
+	RejectReason string `json:"reject_reason,omitempty"`
+	// AutoApproved - ученик вступил в открытую группу без решения репетитора
+	AutoApproved bool `json:"auto_approved,omitempty"`
+	// Waitlisted - заявка одобрена, но ученик ждет места в заполненной группе
+	Waitlisted bool `json:"waitlisted,omitempty"`
+}
+
+// OwnershipTransferPayload - передача владения группой. По GroupOwnershipTransferred
+// task-service переводит задания прежнего владельца на нового.
+type OwnershipTransferPayload struct {
+	GroupID         string `json:"group_id"`
+	GroupName       string `json:"group_name"`
+	PreviousOwnerID string `json:"previous_owner_id"`
+	NewOwnerID      string `json:"new_owner_id"`
+}
+
+// GroupPayload - смена состояния группы. По GroupArchived и GroupRestored task-service
+// замораживает и размораживает задания группы, по GroupDeleted удаляет их.
+type GroupPayload struct {
+	GroupID    string     `json:"group_id"`
+	GroupName  string     `json:"group_name"`
+	TutorID    string     `json:"tutor_id"`
+	ActorID    string     `json:"actor_id"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+// GroupSnapshotPayload - состояние группы после создания или изменения вместе с составом.
+// По нему потребители заводят и сверяют локальную копию состава группы.
+type GroupSnapshotPayload struct {
+	GroupID     string     `json:"group_id"`
+	TutorID     string     `json:"tutor_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	JoinPolicy  string     `json:"join_policy"`
+	MaxMembers  int        `json:"max_members"`
+	TermID      string     `json:"term_id,omitempty"`
+	MemberIDs   []string   `json:"member_ids"`
+	ActorID     string     `json:"actor_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+}
+
+// Источники изменения состава группы
+const (
+	MembersSourceManual      = "manual"
+	MembersSourceWaitlist    = "waitlist"
+	MembersSourceInvitation  = "invitation"
+	MembersSourceJoinRequest = "join_request"
+	MembersSourceRoster      = "roster_import"
+)
+
+// MembersPayload - ученики, которые вступили в группу или покинули ее. ActorID пуст,
+// если ученик вступил сам или перешел из очереди.
+type MembersPayload struct {
+	GroupID    string   `json:"group_id"`
+	TutorID    string   `json:"tutor_id"`
+	StudentIDs []string `json:"student_ids"`
+	Source     string   `json:"source"`
+	ActorID    string   `json:"actor_id,omitempty"`
+}
+
+// OutboxMessage - сохраненное событие, ожидающее отправки
+type OutboxMessage struct {
+	ID    int64
+	Topic string
+	Key   string
+	Event Envelope
+}
+
+// WaitlistPromotedPayload - ученик из очереди заполненной группы стал участником,
+// уведомление получают ученик и репетитор
+type WaitlistPromotedPayload struct {
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	TutorID   string `json:"tutor_id"`
+	StudentID string `json:"student_id"`
+}
+
+// Recipient - получатель уведомления с языком и часовым поясом из профиля user-service
+type Recipient struct {
+	UserID   string `json:"user_id"`
+	Locale   string `json:"locale"`
+	Timezone string `json:"timezone"`
+}
+
+// AnnouncementPayload - опубликованное объявление группы, уведомление получают участники.
+// Отложенное объявление публикуется при наступлении времени публикации.
+type AnnouncementPayload struct {
+	AnnouncementID string      `json:"announcement_id"`
+	GroupID        string      `json:"group_id"`
+	GroupName      string      `json:"group_name"`
+	TutorID        string      `json:"tutor_id"`
+	AuthorID       string      `json:"author_id"`
+	Body           string      `json:"body"`
+	Pinned         bool        `json:"pinned"`
+	PublishedAt    time.Time   `json:"published_at"`
+	Recipients     []Recipient `json:"recipients"`
+}
+
+// DirectMessageSentPayload - личное сообщение, уведомление получает получатель. Текст обрезан до превью.
+type DirectMessageSentPayload struct {
+	ConversationID  string    `json:"conversation_id"`
+	MessageID       string    `json:"message_id"`
+	SenderID        string    `json:"sender_id"`
+	RecipientID     string    `json:"recipient_id"`
+	Recipient       Recipient `json:"recipient"`
+	Preview         string    `json:"preview"`
+	AttachmentCount int       `json:"attachment_count"`
+	SentAt          time.Time `json:"sent_at"`
+}
+
+// UserReportedPayload - жалоба на пользователя для модерации
+type UserReportedPayload struct {
+	ReportID       string    `json:"report_id"`
+	ReporterID     string    `json:"reporter_id"`
+	ReportedID     string    `json:"reported_id"`
+	ConversationID string    `json:"conversation_id,omitempty"`
+	MessageID      string    `json:"message_id,omitempty"`
+	Reason         string    `json:"reason"`
+	CreatedAt      time.Time `json:"created_at"`
+}
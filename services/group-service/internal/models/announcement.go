@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// Announcement - объявление группы, текст в markdown. Отложенное объявление видно ученикам
+// и рассылается с момента PublishAt.
+type Announcement struct {
+	ID          string     `json:"id" db:"id"`
+	GroupID     string     `json:"group_id" db:"group_id"`
+	AuthorID    string     `json:"author_id" db:"author_id"`
+	Body        string     `json:"body" db:"body"`
+	Pinned      bool       `json:"pinned" db:"pinned"`
+	PublishAt   time.Time  `json:"publish_at" db:"publish_at"`
+	PublishedAt *time.Time `json:"published_at" db:"published_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+
+	// ReadCount и MemberCount - сколько текущих участников прочитали объявление, для персонала
+	ReadCount   int `json:"read_count" db:"-"`
+	MemberCount int `json:"member_count" db:"-"`
+	// ReadAt - когда объявление прочитал ученик, который его запрашивает
+	ReadAt *time.Time `json:"read_at" db:"-"`
+}
+
+func (a *Announcement) Published() bool {
+	return a.PublishedAt != nil
+}
+
+// AnnouncementFilter - ReaderID заполняет ReadAt, без IncludeScheduled возвращаются только опубликованные
+type AnnouncementFilter struct {
+	GroupID          string
+	ReaderID         string
+	IncludeScheduled bool
+}
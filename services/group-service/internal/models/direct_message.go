@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+)
+
+// Conversation - личная переписка двух пользователей, у которых есть общая группа
+type Conversation struct {
+	ID            string     `json:"id" db:"id"`
+	UserIDs       [2]string  `json:"user_ids" db:"-"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	LastMessageAt *time.Time `json:"last_message_at" db:"last_message_at"`
+	// заполняются для конкретного участника
+	LastMessage *DirectMessage `json:"last_message" db:"-"`
+	UnreadCount int            `json:"unread_count" db:"-"`
+}
+
+// Peer возвращает собеседника userID
+func (c *Conversation) Peer(userID string) string {
+	if c.UserIDs[0] == userID {
+		return c.UserIDs[1]
+	}
+	return c.UserIDs[0]
+}
+
+func (c *Conversation) HasParticipant(userID string) bool {
+	return c.UserIDs[0] == userID || c.UserIDs[1] == userID
+}
+
+type DirectMessage struct {
+	ID             string       `json:"id" db:"id"`
+	ConversationID string       `json:"conversation_id" db:"conversation_id"`
+	SenderID       string       `json:"sender_id" db:"sender_id"`
+	Body           string       `json:"body" db:"body"`
+	Attachments    []Attachment `json:"attachments" db:"-"`
+	CreatedAt      time.Time    `json:"created_at" db:"created_at"`
+}
+
+// Attachment - ссылка на файл, который клиент загрузил в файловое хранилище заранее
+type Attachment struct {
+	Name        string `json:"name" db:"name"`
+	URL         string `json:"url" db:"url"`
+	ContentType string `json:"content_type" db:"content_type"`
+	Size        int64  `json:"size" db:"size"`
+}
+
+type UserBlock struct {
+	BlockerID string    `json:"blocker_id" db:"blocker_id"`
+	BlockedID string    `json:"blocked_id" db:"blocked_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// UserReport - жалоба на пользователя, разбирается модерацией
+type UserReport struct {
+	ID             string    `json:"id" db:"id"`
+	ReporterID     string    `json:"reporter_id" db:"reporter_id"`
+	ReportedID     string    `json:"reported_id" db:"reported_id"`
+	ConversationID string    `json:"conversation_id" db:"conversation_id"`
+	MessageID      string    `json:"message_id" db:"message_id"`
+	Reason         string    `json:"reason" db:"reason"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// DirectMessageSearch - поиск по личным сообщениям пользователя
+type DirectMessageSearch struct {
+	UserID         string
+	Query          string
+	ConversationID string // пусто - во всех переписках
+	BeforeID       string
+	Limit          int
+}
@@ -3,5 +3,69 @@ package models
 import "errors"
 
 var (
-	ErrTutorIsNotValid = errors.New("tutor_id is not valid")
+	ErrTutorIsNotValid      = errors.New("tutor_id is not valid")
+	ErrGuardianAccessDenied = errors.New("user is not a guardian of this student")
+	ErrGroupNotFound        = errors.New("group not found")
+
+	ErrInvalidInvitation   = errors.New("invalid invitation")
+	ErrInvitationNotFound  = errors.New("invitation not found")
+	ErrInvitationRevoked   = errors.New("invitation has been revoked")
+	ErrInvitationExpired   = errors.New("invitation has expired")
+	ErrInvitationExhausted = errors.New("invitation has no uses left")
+	ErrAlreadyMember       = errors.New("user is already a member of the group")
+	ErrOwnerCannotJoin     = errors.New("group staff cannot join own group as a student")
+	ErrNotStudent          = errors.New("only students can join groups")
+
+	ErrInvalidJoinPolicy     = errors.New("invalid join policy")
+	ErrJoinByRequestDisabled = errors.New("group accepts new members by invitation only")
+	ErrInvalidJoinRequest    = errors.New("invalid join request")
+	ErrJoinRequestExists     = errors.New("join request is already pending")
+	ErrJoinRequestNotFound   = errors.New("join request not found")
+	ErrJoinRequestDecided    = errors.New("join request has already been decided")
+
+	ErrInvalidStaffRole  = errors.New("invalid staff role")
+	ErrInvalidPermission = errors.New("invalid permission")
+	ErrStaffExists       = errors.New("user is already on the group staff")
+	ErrStaffNotFound     = errors.New("user is not on the group staff")
+	ErrStaffIsMember     = errors.New("group student cannot be added to staff")
+
+	ErrInvalidTransfer  = errors.New("invalid ownership transfer")
+	ErrTransferNotFound = errors.New("ownership transfer not found")
+	ErrTransferExpired  = errors.New("ownership transfer has expired")
+
+	ErrGroupArchived       = errors.New("group is archived")
+	ErrGroupNotArchived    = errors.New("group is not archived")
+	ErrRetentionNotElapsed = errors.New("archived group cannot be deleted before retention period ends")
+
+	ErrInvalidCapacity = errors.New("max_members must not be negative")
+	ErrInvalidMembers  = errors.New("invalid members list")
+	ErrWaitlisted      = errors.New("group is full, student is on the waitlist")
+	ErrNotOnWaitlist   = errors.New("student is not on the waitlist")
+
+	ErrInvalidAnnouncement   = errors.New("invalid announcement")
+	ErrAnnouncementNotFound  = errors.New("announcement not found")
+	ErrAnnouncementPublished = errors.New("announcement has already been published")
+	ErrNotGroupMember        = errors.New("user is not a member of the group")
+
+	ErrInvalidMessage   = errors.New("invalid message")
+	ErrMessageNotFound  = errors.New("message not found")
+	ErrMessageDeleted   = errors.New("message has been deleted")
+	ErrNotMessageAuthor = errors.New("message belongs to another user")
+
+	ErrInvalidDirectMessage = errors.New("invalid direct message")
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrNoSharedGroup        = errors.New("users do not share a group")
+	ErrUserBlocked          = errors.New("messaging between these users is blocked")
+	ErrInvalidReport        = errors.New("invalid report")
+
+	ErrInvalidGroupQuery = errors.New("invalid groups query")
+
+	ErrInvalidTerm  = errors.New("invalid term")
+	ErrTermNotFound = errors.New("term not found")
+	ErrTermInUse    = errors.New("term has active groups")
+	ErrTermEnded    = errors.New("term of the group has ended")
+
+	ErrInvalidRoster          = errors.New("invalid roster")
+	ErrRosterImportNotFound   = errors.New("roster import not found")
+	ErrRosterImportInProgress = errors.New("roster import is not finished yet")
 )
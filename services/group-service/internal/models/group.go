@@ -9,6 +9,15 @@ type Group struct {
 	TutorID     string         `json:"tutor_id" db:"tutor_id"`
 	Name        string         `json:"name" db:"name"`
 	Description string         `json:"description" db:"description"`
+	JoinPolicy  JoinPolicy     `json:"join_policy" db:"join_policy"`
+	MaxMembers  int            `json:"max_members" db:"max_members"` // 0 - без ограничения
 	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+	ArchivedAt  *time.Time     `json:"archived_at" db:"archived_at"`
+	MemberCount int            `json:"member_count" db:"member_count"`
+	Term        *Term          `json:"term" db:"-"` // учебный период, если группа к нему привязана
 	Members     []*GroupMember `json:"members" db:"-"`
 }
+
+func (g *Group) Archived() bool {
+	return g.ArchivedAt != nil
+}
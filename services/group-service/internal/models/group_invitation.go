@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// GroupInvitation - код для вступления в группу. Приглашение по email одноразовое
+// и отправляется на адрес, который еще может быть не зарегистрирован.
+type GroupInvitation struct {
+	ID        string     `json:"id" db:"id"`
+	GroupID   string     `json:"group_id" db:"group_id"`
+	Code      string     `json:"code" db:"code"`
+	Email     string     `json:"email" db:"email"`
+	CreatedBy string     `json:"created_by" db:"created_by"`
+	MaxUses   int        `json:"max_uses" db:"max_uses"`
+	UseCount  int        `json:"use_count" db:"use_count"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at" db:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// CheckUsable возвращает причину, по которой по приглашению нельзя вступить
+func (i *GroupInvitation) CheckUsable(now time.Time) error {
+	switch {
+	case i.RevokedAt != nil:
+		return ErrInvitationRevoked
+	case !now.Before(i.ExpiresAt):
+		return ErrInvitationExpired
+	case i.MaxUses > 0 && i.UseCount >= i.MaxUses:
+		return ErrInvitationExhausted
+	}
+	return nil
+}
@@ -8,4 +8,54 @@ type GroupMember struct {
 	StudentID string    `json:"student_id" db:"student_id"`
 	GroupID   string    `json:"group_id" db:"group_id"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// профиль из user-service, заполняется только по запросу
+	Name      string `json:"name,omitempty" db:"-"`
+	Surname   string `json:"surname,omitempty" db:"-"`
+	AvatarURL string `json:"avatar_url,omitempty" db:"-"`
+}
+
+type AddMemberStatus string
+
+const (
+	AddMemberAdded         AddMemberStatus = "added"
+	AddMemberWaitlisted    AddMemberStatus = "waitlisted"
+	AddMemberAlreadyMember AddMemberStatus = "already_member"
+	AddMemberNotFound      AddMemberStatus = "not_found"
+	AddMemberNotAStudent   AddMemberStatus = "not_a_student"
+)
+
+// AddMemberResult - итог добавления одного элемента запроса, Input - ID или email как в запросе
+type AddMemberResult struct {
+	Input     string          `json:"input"`
+	StudentID string          `json:"student_id"`
+	Status    AddMemberStatus `json:"status"`
+}
+
+// UserInfo - профиль пользователя из user-service
+type UserInfo struct {
+	ID        string
+	Email     string
+	Name      string
+	Surname   string
+	AvatarURL string
+	IsTutor   bool
+	IsStudent bool
+	Timezone  string
+	Locale    string
+}
+
+// Язык и часовой пояс профиля по умолчанию в user-service, ими же пользуются,
+// если профиль не найден или user-service недоступен
+const (
+	DefaultLocale   = "ru"
+	DefaultTimezone = "UTC"
+)
+
+// WaitlistEntry - место в очереди заполненной группы, Position начинается с 1
+type WaitlistEntry struct {
+	GroupID   string    `json:"group_id" db:"group_id"`
+	StudentID string    `json:"student_id" db:"student_id"`
+	Position  int       `json:"position" db:"-"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+type GroupSortField string
+
+const (
+	GroupSortCreatedAt   GroupSortField = "created_at"
+	GroupSortName        GroupSortField = "name"
+	GroupSortMemberCount GroupSortField = "member_count"
+)
+
+func (f GroupSortField) Valid() bool {
+	switch f {
+	case GroupSortCreatedAt, GroupSortName, GroupSortMemberCount:
+		return true
+	}
+	return false
+}
+
+type ArchiveFilter string
+
+const (
+	ArchiveFilterActive   ArchiveFilter = "active"
+	ArchiveFilterArchived ArchiveFilter = "archived"
+	ArchiveFilterAll      ArchiveFilter = "all"
+)
+
+func (f ArchiveFilter) Valid() bool {
+	switch f {
+	case ArchiveFilterActive, ArchiveFilterArchived, ArchiveFilterAll:
+		return true
+	}
+	return false
+}
+
+// GroupQuery - фильтр списка групп, задается одно из TutorID, StudentID и UserID
+type GroupQuery struct {
+	TutorID   string // владелец или персонал
+	StudentID string // ученик группы
+	UserID    string // любое участие
+	TermID    string // необязательно, группы учебного периода
+	// Search - подстрока названия без учета регистра
+	Search         string
+	Archived       ArchiveFilter
+	SortBy         GroupSortField
+	Desc           bool
+	IncludeMembers bool
+	After          *GroupCursor
+	Limit          int
+}
+
+// GroupCursor - позиция keyset-пагинации: значение поля сортировки и id последней группы страницы
+type GroupCursor struct {
+	SortBy      GroupSortField `json:"s"`
+	Desc        bool           `json:"d"`
+	CreatedAt   time.Time      `json:"c,omitempty"`
+	Name        string         `json:"n,omitempty"`
+	MemberCount int            `json:"m,omitempty"`
+	ID          string         `json:"id"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+type JoinPolicy string
+
+const (
+	JoinPolicyOpen       JoinPolicy = "open"
+	JoinPolicyRequest    JoinPolicy = "request"
+	JoinPolicyInviteOnly JoinPolicy = "invite_only"
+)
+
+func (p JoinPolicy) Valid() bool {
+	switch p {
+	case JoinPolicyOpen, JoinPolicyRequest, JoinPolicyInviteOnly:
+		return true
+	}
+	return false
+}
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestRejected JoinRequestStatus = "rejected"
+)
+
+// JoinRequest - заявка ученика на вступление в группу
+type JoinRequest struct {
+	ID           string            `json:"id" db:"id"`
+	GroupID      string            `json:"group_id" db:"group_id"`
+	StudentID    string            `json:"student_id" db:"student_id"`
+	Message      string            `json:"message" db:"message"`
+	Status       JoinRequestStatus `json:"status" db:"status"`
+	DecidedBy    string            `json:"decided_by" db:"decided_by"`
+	DecidedAt    *time.Time        `json:"decided_at" db:"decided_at"`
+	RejectReason string            `json:"reject_reason" db:"reject_reason"`
+	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
+	// Waitlisted - заявка одобрена, но группа заполнена и ученик встал в очередь.
+	// Заполняется только в ответе на одобрение, не хранится.
+	Waitlisted bool `json:"waitlisted" db:"-"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// Message - сообщение в чате группы. У удаленного сообщения пустой текст и заполнено DeletedAt.
+type Message struct {
+	ID        string     `json:"id" db:"id"`
+	GroupID   string     `json:"group_id" db:"group_id"`
+	AuthorID  string     `json:"author_id" db:"author_id"`
+	Body      string     `json:"body" db:"body"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	EditedAt  *time.Time `json:"edited_at" db:"edited_at"`
+	DeletedAt *time.Time `json:"deleted_at" db:"deleted_at"`
+}
+
+func (m *Message) Deleted() bool {
+	return m.DeletedAt != nil
+}
+
+type MessageEventType string
+
+const (
+	MessageCreated MessageEventType = "created"
+	MessageEdited  MessageEventType = "edited"
+	MessageDeleted MessageEventType = "deleted"
+)
+
+// MessageEvent - изменение в чате, которое рассылается подписчикам группы
+type MessageEvent struct {
+	Type    MessageEventType `json:"type"`
+	Message *Message         `json:"message"`
+}
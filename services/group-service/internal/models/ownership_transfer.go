@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+)
+
+// OwnershipTransfer - предложение владельца передать группу другому репетитору, действует до принятия
+type OwnershipTransfer struct {
+	GroupID    string `json:"group_id" db:"group_id"`
+	FromUserID string `json:"from_user_id" db:"from_user_id"`
+	ToUserID   string `json:"to_user_id" db:"to_user_id"`
+	// KeepAsCoTutor - прежний владелец остается в группе соведущим
+	KeepAsCoTutor bool      `json:"keep_as_co_tutor" db:"keep_as_co_tutor"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	ExpiresAt     time.Time `json:"expires_at" db:"expires_at"`
+}
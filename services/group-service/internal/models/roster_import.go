@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+)
+
+type RosterImportStatus string
+
+const (
+	RosterImportPending    RosterImportStatus = "pending"
+	RosterImportProcessing RosterImportStatus = "processing"
+	RosterImportCompleted  RosterImportStatus = "completed"
+	RosterImportFailed     RosterImportStatus = "failed"
+)
+
+type RosterRowStatus string
+
+const (
+	RosterRowAdded          RosterRowStatus = "added"
+	RosterRowWaitlisted     RosterRowStatus = "waitlisted"
+	RosterRowInvited        RosterRowStatus = "invited"
+	RosterRowAlreadyMember  RosterRowStatus = "already_member"
+	RosterRowAlreadyInvited RosterRowStatus = "already_invited"
+	RosterRowNotAStudent    RosterRowStatus = "not_a_student"
+	RosterRowInvalid        RosterRowStatus = "invalid"
+	RosterRowFailed         RosterRowStatus = "failed"
+)
+
+// RosterRow - строка CSV и итог ее обработки. При предпросмотре статус - ожидаемый итог.
+type RosterRow struct {
+	Line      int             `json:"line"`
+	Email     string          `json:"email"`
+	Name      string          `json:"name"`
+	Surname   string          `json:"surname"`
+	StudentID string          `json:"student_id,omitempty"`
+	Status    RosterRowStatus `json:"status,omitempty"`
+	Message   string          `json:"message,omitempty"`
+}
+
+// RosterImport - импорт списка учеников из CSV, обрабатывается в фоне
+type RosterImport struct {
+	ID         string             `json:"id" db:"id"`
+	GroupID    string             `json:"group_id" db:"group_id"`
+	CreatedBy  string             `json:"created_by" db:"created_by"`
+	Status     RosterImportStatus `json:"status" db:"status"`
+	Rows       []*RosterRow       `json:"rows" db:"rows"`
+	Error      string             `json:"error" db:"error"` // причина статуса failed
+	CreatedAt  time.Time          `json:"created_at" db:"created_at"`
+	StartedAt  *time.Time         `json:"started_at" db:"started_at"`
+	FinishedAt *time.Time         `json:"finished_at" db:"finished_at"`
+}
+
+// RosterImportSummary - число строк по итогам, пропущенные не требовали изменений
+type RosterImportSummary struct {
+	Added, Waitlisted, Invited, Skipped, Failed int
+}
+
+func (i *RosterImport) Summary() RosterImportSummary {
+	var s RosterImportSummary
+	for _, row := range i.Rows {
+		switch row.Status {
+		case RosterRowAdded:
+			s.Added++
+		case RosterRowWaitlisted:
+			s.Waitlisted++
+		case RosterRowInvited:
+			s.Invited++
+		case RosterRowAlreadyMember, RosterRowAlreadyInvited, RosterRowNotAStudent:
+			s.Skipped++
+		case RosterRowInvalid, RosterRowFailed:
+			s.Failed++
+		}
+	}
+	return s
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+)
+
+type StaffRole string
+
+const (
+	StaffRoleOwner     StaffRole = "owner"
+	StaffRoleCoTutor   StaffRole = "co_tutor"
+	StaffRoleAssistant StaffRole = "assistant"
+)
+
+// Valid - роль, которую можно выдать через управление персоналом, владелец назначается только при создании группы
+func (r StaffRole) Valid() bool {
+	return r == StaffRoleCoTutor || r == StaffRoleAssistant
+}
+
+type Permission string
+
+const (
+	PermissionUpdateGroup      Permission = "update_group"
+	PermissionDeleteGroup      Permission = "delete_group"
+	PermissionManageStaff      Permission = "manage_staff"
+	PermissionManageMembers    Permission = "manage_members"
+	PermissionManageTasks      Permission = "manage_tasks"
+	PermissionGradeSubmissions Permission = "grade_submissions"
+	PermissionAnnounce         Permission = "announce"
+)
+
+// Valid - у владельца есть все права, поэтому известное право есть в его списке
+func (p Permission) Valid() bool {
+	return StaffRoleOwner.Can(p)
+}
+
+var rolePermissions = map[StaffRole][]Permission{
+	StaffRoleOwner: {
+		PermissionUpdateGroup, PermissionDeleteGroup, PermissionManageStaff,
+		PermissionManageMembers, PermissionManageTasks, PermissionGradeSubmissions, PermissionAnnounce,
+	},
+	StaffRoleCoTutor: {
+		PermissionUpdateGroup, PermissionManageMembers, PermissionManageTasks, PermissionGradeSubmissions,
+		PermissionAnnounce,
+	},
+	StaffRoleAssistant: {
+		PermissionGradeSubmissions,
+	},
+}
+
+// Can проверяет право роли по матрице, пустая роль не имеет прав
+func (r StaffRole) Can(p Permission) bool {
+	for _, perm := range rolePermissions[r] {
+		if perm == p {
+			return true
+		}
+	}
+	return false
+}
+
+// StaffMember - сотрудник группы: владелец, соведущий или ассистент
+type StaffMember struct {
+	GroupID   string    `json:"group_id" db:"group_id"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	Role      StaffRole `json:"role" db:"role"`
+	AddedBy   string    `json:"added_by" db:"added_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
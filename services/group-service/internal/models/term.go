@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Term - учебный период репетитора. Группы периода архивируются в момент EndsAt,
+// сроки заданий в них должны попадать в период.
+type Term struct {
+	ID        string    `json:"id" db:"id"`
+	OwnerID   string    `json:"owner_id" db:"owner_id"`
+	Name      string    `json:"name" db:"name"`
+	StartsAt  time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt    time.Time `json:"ends_at" db:"ends_at"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	// GroupCount - активные группы периода, заполняется в списке
+	GroupCount int `json:"group_count" db:"-"`
+}
+
+func (t *Term) Ended(now time.Time) bool {
+	return !now.Before(t.EndsAt)
+}
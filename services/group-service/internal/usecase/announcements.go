@@ -0,0 +1,350 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxAnnouncementBodyLength = 10000
+
+type AnnouncementsRepo interface {
+	CreateAnnouncement(ctx context.Context, a *models.Announcement) error
+	GetAnnouncement(ctx context.Context, groupID, announcementID, readerID string) (*models.Announcement, error)
+	ListAnnouncements(ctx context.Context, filter models.AnnouncementFilter) ([]*models.Announcement, error)
+	UpdateAnnouncement(ctx context.Context, a *models.Announcement, reschedule bool) error
+	DeleteAnnouncement(ctx context.Context, groupID, announcementID string) error
+	MarkAnnouncementRead(ctx context.Context, announcementID, studentID string, readAt time.Time) error
+	PublishDueAnnouncements(ctx context.Context, now time.Time) ([]*models.Announcement, error)
+}
+
+type CreateAnnouncementParams struct {
+	GroupID   string
+	UserID    string
+	Body      string
+	Pinned    bool
+	PublishAt *time.Time // пусто или в прошлом - опубликовать сразу
+}
+
+type UpdateAnnouncementParams struct {
+	GroupID        string
+	UserID         string
+	AnnouncementID string
+	Body           *string
+	Pinned         *bool
+	PublishAt      *time.Time // меняется только до публикации
+}
+
+type AnnouncementsUsecase struct {
+	announcementsRepo AnnouncementsRepo
+	groupsRepo        GroupsRepo
+	userClient        UserClient
+	outbox            Outbox
+	topic             string
+	now               func() time.Time
+}
+
+func NewAnnouncementsUsecase(announcementsRepo AnnouncementsRepo, groupsRepo GroupsRepo, userClient UserClient, outbox Outbox, topic string) *AnnouncementsUsecase {
+	return &AnnouncementsUsecase{
+		announcementsRepo: announcementsRepo,
+		groupsRepo:        groupsRepo,
+		userClient:        userClient,
+		outbox:            outbox,
+		topic:             topic,
+		now:               time.Now,
+	}
+}
+
+func (u *AnnouncementsUsecase) CreateAnnouncement(ctx context.Context, p CreateAnnouncementParams) (*models.Announcement, error) {
+	body, err := validateAnnouncementBody(p.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := authorizeChange(ctx, u.groupsRepo, p.GroupID, p.UserID, models.PermissionAnnounce)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	a := &models.Announcement{
+		ID:        uuid.New().String(),
+		GroupID:   p.GroupID,
+		AuthorID:  p.UserID,
+		Body:      body,
+		Pinned:    p.Pinned,
+		PublishAt: now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if p.PublishAt != nil && p.PublishAt.After(now) {
+		a.PublishAt = *p.PublishAt
+	} else {
+		a.PublishedAt = &now
+	}
+
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.announcementsRepo.CreateAnnouncement(ctx, a); err != nil {
+			return fmt.Errorf("failed to create announcement: %w", err)
+		}
+		if !a.Published() {
+			return nil
+		}
+		return u.savePublished(ctx, group, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// ListAnnouncements - персонал видит и отложенные объявления со счетчиком прочтений,
+// ученик группы - опубликованные с отметкой о своем прочтении
+func (u *AnnouncementsUsecase) ListAnnouncements(ctx context.Context, groupID, userID string) ([]*models.Announcement, error) {
+	_, role, err := authorizeRead(ctx, u.groupsRepo, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	staff := role != ""
+
+	filter := models.AnnouncementFilter{GroupID: groupID, IncludeScheduled: staff}
+	if !staff {
+		filter.ReaderID = userID
+	}
+
+	announcements, err := u.announcementsRepo.ListAnnouncements(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	if !staff {
+		for _, a := range announcements {
+			hideReadStats(a)
+		}
+	}
+
+	return announcements, nil
+}
+
+func (u *AnnouncementsUsecase) GetAnnouncement(ctx context.Context, groupID, userID, announcementID string) (*models.Announcement, error) {
+	_, role, err := authorizeRead(ctx, u.groupsRepo, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	staff := role != ""
+
+	readerID := ""
+	if !staff {
+		readerID = userID
+	}
+	a, err := u.announcementsRepo.GetAnnouncement(ctx, groupID, announcementID, readerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+	if !staff {
+		if !a.Published() {
+			return nil, models.ErrAnnouncementNotFound
+		}
+		hideReadStats(a)
+	}
+
+	return a, nil
+}
+
+// UpdateAnnouncement изменяет объявление. Перенос времени публикации в прошлое публикует
+// отложенное объявление сразу.
+func (u *AnnouncementsUsecase) UpdateAnnouncement(ctx context.Context, p UpdateAnnouncementParams) (*models.Announcement, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, p.GroupID, p.UserID, models.PermissionAnnounce)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := u.announcementsRepo.GetAnnouncement(ctx, p.GroupID, p.AnnouncementID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+
+	if p.Body != nil {
+		body, err := validateAnnouncementBody(*p.Body)
+		if err != nil {
+			return nil, err
+		}
+		a.Body = body
+	}
+	if p.Pinned != nil {
+		a.Pinned = *p.Pinned
+	}
+
+	now := u.now()
+	reschedule := p.PublishAt != nil
+	if reschedule {
+		if a.Published() {
+			return nil, models.ErrAnnouncementPublished
+		}
+		if p.PublishAt.After(now) {
+			a.PublishAt = *p.PublishAt
+		} else {
+			a.PublishAt = now
+			a.PublishedAt = &now
+		}
+	}
+	a.UpdatedAt = now
+
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.announcementsRepo.UpdateAnnouncement(ctx, a, reschedule); err != nil {
+			if errors.Is(err, models.ErrAnnouncementPublished) || errors.Is(err, models.ErrAnnouncementNotFound) {
+				return err
+			}
+			return fmt.Errorf("failed to update announcement: %w", err)
+		}
+		if !reschedule || !a.Published() {
+			return nil
+		}
+		return u.savePublished(ctx, group, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (u *AnnouncementsUsecase) DeleteAnnouncement(ctx context.Context, groupID, userID, announcementID string) error {
+	if _, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionAnnounce); err != nil {
+		return err
+	}
+
+	if err := u.announcementsRepo.DeleteAnnouncement(ctx, groupID, announcementID); err != nil {
+		if errors.Is(err, models.ErrAnnouncementNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete announcement: %w", err)
+	}
+
+	return nil
+}
+
+// MarkAnnouncementRead - отметка о прочтении ставится только участником группы
+func (u *AnnouncementsUsecase) MarkAnnouncementRead(ctx context.Context, groupID, userID, announcementID string) error {
+	member, err := u.groupsRepo.IsMember(ctx, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check membership: %w", err)
+	}
+	if !member {
+		return models.ErrNotGroupMember
+	}
+
+	a, err := u.announcementsRepo.GetAnnouncement(ctx, groupID, announcementID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get announcement: %w", err)
+	}
+	if !a.Published() {
+		return models.ErrAnnouncementNotFound
+	}
+	if a.ReadAt != nil {
+		return nil
+	}
+
+	if err := u.announcementsRepo.MarkAnnouncementRead(ctx, announcementID, userID, u.now()); err != nil {
+		return fmt.Errorf("failed to mark announcement read: %w", err)
+	}
+
+	return nil
+}
+
+// PublishDue публикует отложенные объявления, время которых наступило.
+// При ошибке откатываются и отметки о публикации, объявления заберет следующий проход.
+func (u *AnnouncementsUsecase) PublishDue(ctx context.Context) error {
+	return u.outbox.InTx(ctx, func(ctx context.Context) error {
+		announcements, err := u.announcementsRepo.PublishDueAnnouncements(ctx, u.now())
+		if err != nil {
+			return fmt.Errorf("failed to publish due announcements: %w", err)
+		}
+
+		for _, a := range announcements {
+			group, err := u.groupsRepo.GetGroup(ctx, a.GroupID, false)
+			if err != nil {
+				return fmt.Errorf("failed to get group %s for announcement %s: %w", a.GroupID, a.ID, err)
+			}
+			if err := u.savePublished(ctx, group, a); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// StartScheduler запускает публикацию отложенных объявлений, interval <= 0 отключает расписание
+func (u *AnnouncementsUsecase) StartScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := u.PublishDue(ctx); err != nil {
+					log.Printf("announcement scheduler: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// savePublished сохраняет AnnouncementPublished для участников на их языке в транзакции публикации
+func (u *AnnouncementsUsecase) savePublished(ctx context.Context, group *models.Group, a *models.Announcement) error {
+	members, err := u.groupsRepo.GetGroupMembers(ctx, group.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get group members: %w", err)
+	}
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.StudentID
+	}
+
+	var batch outboxBatch
+	batch.add(events.AnnouncementPublished, events.AnnouncementPayload{
+		AnnouncementID: a.ID,
+		GroupID:        group.ID,
+		GroupName:      group.Name,
+		TutorID:        group.TutorID,
+		AuthorID:       a.AuthorID,
+		Body:           a.Body,
+		Pinned:         a.Pinned,
+		PublishedAt:    *a.PublishedAt,
+		Recipients:     recipients(ctx, u.userClient, ids),
+	})
+	return batch.save(ctx, u.outbox, u.topic, group.ID)
+}
+
+func validateAnnouncementBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", fmt.Errorf("%w: body is required", models.ErrInvalidAnnouncement)
+	}
+	if utf8.RuneCountInString(body) > maxAnnouncementBodyLength {
+		return "", fmt.Errorf("%w: body is longer than %d characters", models.ErrInvalidAnnouncement, maxAnnouncementBodyLength)
+	}
+	return body, nil
+}
+
+// hideReadStats - ученику не показываются прочтения других участников
+func hideReadStats(a *models.Announcement) {
+	a.ReadCount = 0
+	a.MemberCount = 0
+}
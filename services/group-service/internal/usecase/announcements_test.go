@@ -0,0 +1,290 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockAnnouncementsRepo struct {
+	announcements map[string]*models.Announcement
+	reads         map[string]time.Time // announcementID/studentID -> время прочтения
+}
+
+func newMockAnnouncementsRepo() *mockAnnouncementsRepo {
+	return &mockAnnouncementsRepo{
+		announcements: make(map[string]*models.Announcement),
+		reads:         make(map[string]time.Time),
+	}
+}
+
+func (m *mockAnnouncementsRepo) withReadAt(a *models.Announcement, readerID string) *models.Announcement {
+	copied := *a
+	if readAt, ok := m.reads[a.ID+"/"+readerID]; ok && readerID != "" {
+		copied.ReadAt = &readAt
+	}
+	return &copied
+}
+
+func (m *mockAnnouncementsRepo) CreateAnnouncement(ctx context.Context, a *models.Announcement) error {
+	copied := *a
+	m.announcements[a.ID] = &copied
+	return nil
+}
+
+func (m *mockAnnouncementsRepo) GetAnnouncement(ctx context.Context, groupID, announcementID, readerID string) (*models.Announcement, error) {
+	a, ok := m.announcements[announcementID]
+	if !ok || a.GroupID != groupID {
+		return nil, models.ErrAnnouncementNotFound
+	}
+	return m.withReadAt(a, readerID), nil
+}
+
+func (m *mockAnnouncementsRepo) ListAnnouncements(ctx context.Context, filter models.AnnouncementFilter) ([]*models.Announcement, error) {
+	var result []*models.Announcement
+	for _, a := range m.announcements {
+		if a.GroupID == filter.GroupID && (filter.IncludeScheduled || a.Published()) {
+			result = append(result, m.withReadAt(a, filter.ReaderID))
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAnnouncementsRepo) UpdateAnnouncement(ctx context.Context, a *models.Announcement, reschedule bool) error {
+	stored, ok := m.announcements[a.ID]
+	if !ok {
+		return models.ErrAnnouncementNotFound
+	}
+	if reschedule && stored.Published() {
+		return models.ErrAnnouncementPublished
+	}
+	copied := *a
+	m.announcements[a.ID] = &copied
+	return nil
+}
+
+func (m *mockAnnouncementsRepo) DeleteAnnouncement(ctx context.Context, groupID, announcementID string) error {
+	if _, ok := m.announcements[announcementID]; !ok {
+		return models.ErrAnnouncementNotFound
+	}
+	delete(m.announcements, announcementID)
+	return nil
+}
+
+func (m *mockAnnouncementsRepo) MarkAnnouncementRead(ctx context.Context, announcementID, studentID string, readAt time.Time) error {
+	if _, ok := m.reads[announcementID+"/"+studentID]; !ok {
+		m.reads[announcementID+"/"+studentID] = readAt
+	}
+	return nil
+}
+
+func (m *mockAnnouncementsRepo) PublishDueAnnouncements(ctx context.Context, now time.Time) ([]*models.Announcement, error) {
+	var due []*models.Announcement
+	for _, a := range m.announcements {
+		if !a.Published() && !a.PublishAt.After(now) {
+			a.PublishedAt = &now
+			copied := *a
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func newTestAnnouncementsUsecase(isMember bool) (*usecase.AnnouncementsUsecase, *mockAnnouncementsRepo, *mockOutbox) {
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"})
+	repo.staff = map[string]models.StaffRole{
+		"tutor2":     models.StaffRoleCoTutor,
+		"assistant1": models.StaffRoleAssistant,
+	}
+	repo.isMember = isMember
+	repo.getGroupMembersFunc = func(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
+		return []*models.GroupMember{{GroupID: groupID, StudentID: "student1"}, {GroupID: groupID, StudentID: "student2"}}, nil
+	}
+	// у student2 нет профиля в user-service
+	userClient := &mockUserClient{users: []*models.UserInfo{{ID: "student1", IsStudent: true, Locale: "en-US", Timezone: "Europe/Berlin"}}}
+	announcements := newMockAnnouncementsRepo()
+	outbox := &mockOutbox{}
+	return usecase.NewAnnouncementsUsecase(announcements, repo, userClient, outbox, "group-events"), announcements, outbox
+}
+
+func TestCreateAnnouncement(t *testing.T) {
+	ctx := context.Background()
+	u, _, outbox := newTestAnnouncementsUsecase(false)
+
+	a, err := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{
+		GroupID: "group1", UserID: "tutor2", Body: "  **Контрольная** в пятницу  ", Pinned: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !a.Published() || !a.Pinned || a.Body != "**Контрольная** в пятницу" {
+		t.Errorf("unexpected announcement: %+v", a)
+	}
+
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.AnnouncementPublished {
+		t.Fatalf("expected AnnouncementPublished event, got %+v", outbox.events)
+	}
+	var payload events.AnnouncementPayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.AnnouncementID != a.ID || payload.GroupID != "group1" || payload.AuthorID != "tutor2" || !payload.Pinned {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+	wantRecipients := []events.Recipient{
+		{UserID: "student1", Locale: "en-US", Timezone: "Europe/Berlin"},
+		{UserID: "student2", Locale: models.DefaultLocale, Timezone: models.DefaultTimezone},
+	}
+	if !slices.Equal(payload.Recipients, wantRecipients) {
+		t.Errorf("expected recipients %+v, got %+v", wantRecipients, payload.Recipients)
+	}
+
+	// отложенное объявление не рассылается сразу
+	publishAt := time.Now().Add(time.Hour)
+	scheduled, err := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{
+		GroupID: "group1", UserID: "tutor1", Body: "Завтра занятия нет", PublishAt: &publishAt,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if scheduled.Published() || !scheduled.PublishAt.Equal(publishAt) {
+		t.Errorf("expected scheduled announcement, got %+v", scheduled)
+	}
+	if len(outbox.events) != 1 {
+		t.Errorf("expected no event for scheduled announcement, got %d events", len(outbox.events))
+	}
+}
+
+func TestCreateAnnouncement_Rejected(t *testing.T) {
+	ctx := context.Background()
+	u, _, _ := newTestAnnouncementsUsecase(false)
+
+	if _, err := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{
+		GroupID: "group1", UserID: "tutor1", Body: "   ",
+	}); !errors.Is(err, models.ErrInvalidAnnouncement) {
+		t.Errorf("expected ErrInvalidAnnouncement, got %v", err)
+	}
+	if _, err := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{
+		GroupID: "group1", UserID: "assistant1", Body: "Привет",
+	}); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected assistant not to post announcements, got %v", err)
+	}
+}
+
+func TestListAnnouncements_Visibility(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _ := newTestAnnouncementsUsecase(true)
+
+	published, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Опубликовано"})
+	publishAt := time.Now().Add(time.Hour)
+	u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Позже", PublishAt: &publishAt})
+	repo.announcements[published.ID].ReadCount = 3
+
+	staffList, err := u.ListAnnouncements(ctx, "group1", "tutor1")
+	if err != nil || len(staffList) != 2 {
+		t.Fatalf("expected staff to see 2 announcements, got %d (%v)", len(staffList), err)
+	}
+
+	studentList, err := u.ListAnnouncements(ctx, "group1", "student1")
+	if err != nil || len(studentList) != 1 {
+		t.Fatalf("expected student to see 1 announcement, got %d (%v)", len(studentList), err)
+	}
+	if studentList[0].ReadCount != 0 {
+		t.Error("read stats must be hidden from students")
+	}
+
+	outsider, _, _ := newTestAnnouncementsUsecase(false)
+	if _, err := outsider.ListAnnouncements(ctx, "group1", "student2"); !errors.Is(err, models.ErrNotGroupMember) {
+		t.Errorf("expected ErrNotGroupMember, got %v", err)
+	}
+}
+
+func TestMarkAnnouncementRead(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _ := newTestAnnouncementsUsecase(true)
+
+	a, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Домашка"})
+	if err := u.MarkAnnouncementRead(ctx, "group1", "student1", a.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	firstRead := repo.reads[a.ID+"/student1"]
+	if err := u.MarkAnnouncementRead(ctx, "group1", "student1", a.ID); err != nil {
+		t.Fatalf("expected repeated read to succeed, got %v", err)
+	}
+	if !repo.reads[a.ID+"/student1"].Equal(firstRead) {
+		t.Error("repeated read must keep first read time")
+	}
+
+	got, err := u.GetAnnouncement(ctx, "group1", "student1", a.ID)
+	if err != nil || got.ReadAt == nil {
+		t.Errorf("expected read receipt for student, got %+v (%v)", got, err)
+	}
+
+	publishAt := time.Now().Add(time.Hour)
+	scheduled, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Позже", PublishAt: &publishAt})
+	if err := u.MarkAnnouncementRead(ctx, "group1", "student1", scheduled.ID); !errors.Is(err, models.ErrAnnouncementNotFound) {
+		t.Errorf("expected scheduled announcement to be hidden, got %v", err)
+	}
+}
+
+func TestUpdateAnnouncement_Reschedule(t *testing.T) {
+	ctx := context.Background()
+	u, _, outbox := newTestAnnouncementsUsecase(false)
+
+	published, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Сразу"})
+	later := time.Now().Add(2 * time.Hour)
+	if _, err := u.UpdateAnnouncement(ctx, usecase.UpdateAnnouncementParams{
+		GroupID: "group1", UserID: "tutor1", AnnouncementID: published.ID, PublishAt: &later,
+	}); !errors.Is(err, models.ErrAnnouncementPublished) {
+		t.Errorf("expected ErrAnnouncementPublished, got %v", err)
+	}
+
+	scheduled, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Позже", PublishAt: &later})
+	pinned := true
+	now := time.Now()
+	updated, err := u.UpdateAnnouncement(ctx, usecase.UpdateAnnouncementParams{
+		GroupID: "group1", UserID: "tutor1", AnnouncementID: scheduled.ID, Pinned: &pinned, PublishAt: &now,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !updated.Published() || !updated.Pinned {
+		t.Errorf("expected announcement to be published and pinned, got %+v", updated)
+	}
+	if len(outbox.events) != 2 {
+		t.Errorf("expected event on publishing rescheduled announcement, got %d events", len(outbox.events))
+	}
+}
+
+func TestPublishDueAnnouncements(t *testing.T) {
+	ctx := context.Background()
+	u, repo, outbox := newTestAnnouncementsUsecase(false)
+
+	later := time.Now().Add(time.Hour)
+	a, _ := u.CreateAnnouncement(ctx, usecase.CreateAnnouncementParams{GroupID: "group1", UserID: "tutor1", Body: "Позже", PublishAt: &later})
+
+	if err := u.PublishDue(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 0 {
+		t.Fatalf("expected no events before publish time, got %d", len(outbox.events))
+	}
+
+	repo.announcements[a.ID].PublishAt = time.Now().Add(-time.Minute)
+	if err := u.PublishDue(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.AnnouncementPublished || outbox.keys[0] != "group1" {
+		t.Errorf("expected AnnouncementPublished event keyed by group, got %+v", outbox.events)
+	}
+	if !repo.announcements[a.ID].Published() {
+		t.Error("expected announcement to be published")
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"time"
+)
+
+// ArchiveUsecase публикует смену состояния группы через outbox, в одном порядке с событиями состава
+type ArchiveUsecase struct {
+	groupsRepo GroupsRepo
+	outbox     Outbox
+	topic      string
+	retention  time.Duration
+	now        func() time.Time
+}
+
+func NewArchiveUsecase(groupsRepo GroupsRepo, outbox Outbox, topic string, retention time.Duration) *ArchiveUsecase {
+	return &ArchiveUsecase{
+		groupsRepo: groupsRepo,
+		outbox:     outbox,
+		topic:      topic,
+		retention:  retention,
+		now:        time.Now,
+	}
+}
+
+// ArchiveGroup переводит группу в архив: она скрыта из списков, изменения и вступление запрещены,
+// task-service по событию замораживает задания группы
+func (u *ArchiveUsecase) ArchiveGroup(ctx context.Context, groupID, userID string) (*models.Group, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionDeleteGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.groupsRepo.ArchiveGroup(ctx, groupID, now); err != nil {
+			if errors.Is(err, models.ErrGroupArchived) {
+				return err
+			}
+			return fmt.Errorf("failed to archive group: %w", err)
+		}
+		group.ArchivedAt = &now
+
+		return saveGroupState(ctx, u.outbox, u.topic, events.GroupArchived, group, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (u *ArchiveUsecase) RestoreGroup(ctx context.Context, groupID, userID string) (*models.Group, error) {
+	group, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionDeleteGroup)
+	if err != nil {
+		return nil, err
+	}
+	if !group.Archived() {
+		return nil, models.ErrGroupNotArchived
+	}
+	// иначе группу снова архивирует расписание периода
+	if group.Term != nil && group.Term.Ended(u.now()) {
+		return nil, fmt.Errorf("%w: extend the term to restore the group", models.ErrTermEnded)
+	}
+
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.groupsRepo.RestoreGroup(ctx, groupID); err != nil {
+			if errors.Is(err, models.ErrGroupNotArchived) {
+				return err
+			}
+			return fmt.Errorf("failed to restore group: %w", err)
+		}
+		group.ArchivedAt = nil
+
+		return saveGroupState(ctx, u.outbox, u.topic, events.GroupRestored, group, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// DeleteGroup окончательно удаляет группу. Удалить можно только архивную группу
+// по истечении срока хранения, задания группы task-service удаляет по событию.
+func (u *ArchiveUsecase) DeleteGroup(ctx context.Context, groupID, userID string) error {
+	group, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionDeleteGroup)
+	if err != nil {
+		return err
+	}
+	if !group.Archived() {
+		return models.ErrGroupNotArchived
+	}
+	if u.now().Before(group.ArchivedAt.Add(u.retention)) {
+		return fmt.Errorf("%w: deletion is available after %s", models.ErrRetentionNotElapsed,
+			group.ArchivedAt.Add(u.retention).Format(time.RFC3339))
+	}
+
+	return u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.groupsRepo.DeleteGroup(ctx, groupID); err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
+		}
+
+		return saveGroupState(ctx, u.outbox, u.topic, events.GroupDeleted, group, userID)
+	})
+}
+
+// saveGroupState сохраняет событие о состоянии группы в одной транзакции с изменением
+func saveGroupState(ctx context.Context, outbox Outbox, topic, eventType string, group *models.Group, actorID string) error {
+	var batch outboxBatch
+	batch.add(eventType, events.GroupPayload{
+		GroupID:    group.ID,
+		GroupName:  group.Name,
+		TutorID:    group.TutorID,
+		ActorID:    actorID,
+		ArchivedAt: group.ArchivedAt,
+	})
+	return batch.save(ctx, outbox, topic, group.ID)
+}
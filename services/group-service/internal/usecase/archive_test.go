@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+const testRetention = 30 * 24 * time.Hour
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+func newTestArchiveUsecase(group *models.Group) (*usecase.ArchiveUsecase, *mockRepo, *mockOutbox) {
+	repo := groupRepoFor(group)
+	repo.staff = map[string]models.StaffRole{"tutor2": models.StaffRoleCoTutor}
+	outbox := &mockOutbox{}
+	return usecase.NewArchiveUsecase(repo, outbox, "group-events", testRetention), repo, outbox
+}
+
+func TestArchiveGroup(t *testing.T) {
+	ctx := context.Background()
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"}
+	u, repo, outbox := newTestArchiveUsecase(group)
+
+	if _, err := u.ArchiveGroup(ctx, "group1", "tutor2"); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Fatalf("expected co-tutor not to archive group, got %v", err)
+	}
+
+	archived, err := u.ArchiveGroup(ctx, "group1", "tutor1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.archiveGroupCalled || !archived.Archived() {
+		t.Error("expected group to be archived")
+	}
+
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupArchived {
+		t.Fatalf("expected GroupArchived event, got %+v", outbox.events)
+	}
+	if outbox.keys[0] != "group1" {
+		t.Errorf("expected event key group1, got %s", outbox.keys[0])
+	}
+	var payload events.GroupPayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.GroupID != "group1" || payload.ActorID != "tutor1" || payload.ArchivedAt == nil {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestArchivedGroupIsReadOnly(t *testing.T) {
+	ctx := context.Background()
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", JoinPolicy: models.JoinPolicyOpen,
+		ArchivedAt: ptrTime(time.Now().Add(-time.Hour))}
+	repo := groupRepoFor(group)
+	groups := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{validateResult: true}, "group-events")
+
+	name := "Math 11A"
+	if _, err := groups.UpdateGroup(ctx, "group1", "tutor1", &name, nil, nil, nil); !errors.Is(err, models.ErrGroupArchived) {
+		t.Errorf("expected update to fail with ErrGroupArchived, got %v", err)
+	}
+	if _, err := groups.AddGroupMembers(ctx, "group1", "tutor1", []string{"student1"}); !errors.Is(err, models.ErrGroupArchived) {
+		t.Errorf("expected add members to fail with ErrGroupArchived, got %v", err)
+	}
+	if repo.updateGroupCalled || repo.addMembersCalled {
+		t.Error("repository must not be changed for archived group")
+	}
+
+	joinRequests := usecase.NewJoinRequestsUsecase(newMockJoinRequestsRepo(), repo, &mockUserClient{}, &mockOutbox{}, "group-events")
+	if _, err := joinRequests.RequestToJoin(ctx, "group1", "student1", ""); !errors.Is(err, models.ErrGroupArchived) {
+		t.Errorf("expected join request to fail with ErrGroupArchived, got %v", err)
+	}
+
+	// чтение архивной группы доступно
+	if _, err := groups.GetGroup(ctx, "group1", false); err != nil {
+		t.Errorf("expected archived group to be readable, got %v", err)
+	}
+}
+
+func TestRestoreGroup(t *testing.T) {
+	ctx := context.Background()
+
+	u, _, _ := newTestArchiveUsecase(&models.Group{ID: "group1", TutorID: "tutor1"})
+	if _, err := u.RestoreGroup(ctx, "group1", "tutor1"); !errors.Is(err, models.ErrGroupNotArchived) {
+		t.Errorf("expected ErrGroupNotArchived, got %v", err)
+	}
+
+	u, repo, outbox := newTestArchiveUsecase(&models.Group{ID: "group1", TutorID: "tutor1",
+		ArchivedAt: ptrTime(time.Now().Add(-time.Hour))})
+	restored, err := u.RestoreGroup(ctx, "group1", "tutor1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.restoreGroupCalled || restored.Archived() {
+		t.Error("expected group to be restored")
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupRestored {
+		t.Errorf("expected GroupRestored event, got %+v", outbox.events)
+	}
+
+	// группу закончившегося периода нельзя вернуть, пока период не продлят
+	u, repo, _ = newTestArchiveUsecase(&models.Group{ID: "group1", TutorID: "tutor1",
+		ArchivedAt: ptrTime(time.Now().Add(-time.Hour)),
+		Term:       &models.Term{ID: "term1", EndsAt: time.Now().Add(-2 * time.Hour)}})
+	if _, err := u.RestoreGroup(ctx, "group1", "tutor1"); !errors.Is(err, models.ErrTermEnded) {
+		t.Errorf("expected ErrTermEnded, got %v", err)
+	}
+	if repo.restoreGroupCalled {
+		t.Error("group of ended term must not be restored")
+	}
+}
+
+func TestDeleteGroup_Retention(t *testing.T) {
+	tests := []struct {
+		name       string
+		archivedAt *time.Time
+		wantErr    error
+	}{
+		{"not archived", nil, models.ErrGroupNotArchived},
+		{"retention not elapsed", ptrTime(time.Now().Add(-testRetention + time.Hour)), models.ErrRetentionNotElapsed},
+		{"retention elapsed", ptrTime(time.Now().Add(-testRetention - time.Hour)), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, repo, outbox := newTestArchiveUsecase(&models.Group{ID: "group1", TutorID: "tutor1", ArchivedAt: tt.archivedAt})
+
+			err := u.DeleteGroup(context.Background(), "group1", "tutor1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if repo.deleteGroupCalled != (tt.wantErr == nil) {
+				t.Errorf("unexpected DeleteGroup call: %v", repo.deleteGroupCalled)
+			}
+			if tt.wantErr == nil && (len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupDeleted) {
+				t.Errorf("expected GroupDeleted event, got %+v", outbox.events)
+			}
+		})
+	}
+}
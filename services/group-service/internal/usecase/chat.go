@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxMessageBodyLength    = 4000
+	defaultMessagesPageSize = 50
+	maxMessagesPageSize     = 100
+	// период перепроверки доступа подписчика, если он не задан
+	chatAccessCheckInterval = time.Minute
+)
+
+type MessagesRepo interface {
+	CreateMessage(ctx context.Context, m *models.Message) error
+	GetMessage(ctx context.Context, groupID, messageID string) (*models.Message, error)
+	ListMessages(ctx context.Context, groupID, beforeID string, limit int) ([]*models.Message, error)
+	UpdateMessage(ctx context.Context, m *models.Message) error
+	DeleteMessage(ctx context.Context, groupID, messageID string, deletedAt time.Time) error
+}
+
+// MessageBroker доставляет события чата подписчикам на всех экземплярах сервиса
+type MessageBroker interface {
+	PublishMessageEvent(ctx context.Context, event *models.MessageEvent) error
+	SubscribeGroup(ctx context.Context, groupID string) (<-chan *models.MessageEvent, error)
+}
+
+// ChatUsecase - чат группы: писать и читать могут ученики и персонал группы
+type ChatUsecase struct {
+	messagesRepo MessagesRepo
+	groupsRepo   GroupsRepo
+	broker       MessageBroker
+	now          func() time.Time
+
+	accessCheckInterval time.Duration
+}
+
+// accessCheckInterval <= 0 - проверка раз в минуту
+func NewChatUsecase(messagesRepo MessagesRepo, groupsRepo GroupsRepo, broker MessageBroker, accessCheckInterval time.Duration) *ChatUsecase {
+	if accessCheckInterval <= 0 {
+		accessCheckInterval = chatAccessCheckInterval
+	}
+	return &ChatUsecase{
+		messagesRepo: messagesRepo,
+		groupsRepo:   groupsRepo,
+		broker:       broker,
+		now:          time.Now,
+
+		accessCheckInterval: accessCheckInterval,
+	}
+}
+
+func (u *ChatUsecase) SendMessage(ctx context.Context, groupID, userID, body string) (*models.Message, error) {
+	body, err := validateMessageBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	group, _, err := authorizeRead(ctx, u.groupsRepo, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Archived() {
+		return nil, models.ErrGroupArchived
+	}
+
+	m := &models.Message{
+		ID:        uuid.New().String(),
+		GroupID:   groupID,
+		AuthorID:  userID,
+		Body:      body,
+		CreatedAt: u.now(),
+	}
+	if err := u.messagesRepo.CreateMessage(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to create message: %w", err)
+	}
+
+	u.broadcast(ctx, models.MessageCreated, m)
+
+	return m, nil
+}
+
+// ListMessages возвращает страницу истории от новых к старым. pageToken - ID последнего
+// сообщения предыдущей страницы, пустой nextPageToken - история закончилась.
+func (u *ChatUsecase) ListMessages(ctx context.Context, groupID, userID, pageToken string, pageSize int) ([]*models.Message, string, error) {
+	if _, _, err := authorizeRead(ctx, u.groupsRepo, groupID, userID); err != nil {
+		return nil, "", err
+	}
+
+	pageSize = messagesPageSize(pageSize)
+	messages, err := u.messagesRepo.ListMessages(ctx, groupID, pageToken, pageSize+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	nextPageToken := ""
+	if len(messages) > pageSize {
+		messages = messages[:pageSize]
+		nextPageToken = messages[pageSize-1].ID
+	}
+
+	return messages, nextPageToken, nil
+}
+
+// EditMessage - редактировать сообщение может только автор
+func (u *ChatUsecase) EditMessage(ctx context.Context, groupID, userID, messageID, body string) (*models.Message, error) {
+	body, err := validateMessageBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := u.changeableMessage(ctx, groupID, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if m.AuthorID != userID {
+		return nil, models.ErrNotMessageAuthor
+	}
+
+	now := u.now()
+	m.Body = body
+	m.EditedAt = &now
+	if err := u.messagesRepo.UpdateMessage(ctx, m); err != nil {
+		if errors.Is(err, models.ErrMessageDeleted) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update message: %w", err)
+	}
+
+	u.broadcast(ctx, models.MessageEdited, m)
+
+	return m, nil
+}
+
+// DeleteMessage - удалить сообщение может автор или персонал с правом управлять участниками
+func (u *ChatUsecase) DeleteMessage(ctx context.Context, groupID, userID, messageID string) error {
+	m, err := u.changeableMessage(ctx, groupID, userID, messageID)
+	if err != nil {
+		return err
+	}
+	if m.AuthorID != userID {
+		group, err := u.groupsRepo.GetGroup(ctx, groupID, false)
+		if err != nil {
+			return fmt.Errorf("failed to get group: %w", err)
+		}
+		role, err := roleInGroup(ctx, u.groupsRepo, group, userID)
+		if err != nil {
+			return err
+		}
+		if !role.Can(models.PermissionManageMembers) {
+			return models.ErrNotMessageAuthor
+		}
+	}
+
+	now := u.now()
+	if err := u.messagesRepo.DeleteMessage(ctx, groupID, messageID, now); err != nil {
+		if errors.Is(err, models.ErrMessageDeleted) {
+			return err
+		}
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	m.Body = ""
+	m.DeletedAt = &now
+	u.broadcast(ctx, models.MessageDeleted, m)
+
+	return nil
+}
+
+// Subscribe подписывает участника на события чата открытой группы до отмены ctx.
+// Доступ перепроверяется раз в accessCheckInterval, а не на каждое событие, чтобы сообщение
+// в группе с N подписчиками не стоило N запросов к БД: после исключения из группы или персонала,
+// удаления или архивации группы канал закрывается не позже следующей проверки.
+func (u *ChatUsecase) Subscribe(ctx context.Context, groupID, userID string) (<-chan *models.MessageEvent, error) {
+	if err := u.checkSubscription(ctx, groupID, userID); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	events, err := u.broker.SubscribeGroup(ctx, groupID)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	out := make(chan *models.MessageEvent)
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		ticker := time.NewTicker(u.accessCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := u.checkSubscription(ctx, groupID, userID); err != nil {
+					log.Printf("closing chat subscription of %s in group %s: %v", userID, groupID, err)
+					return
+				}
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// checkSubscription - чат архивной группы только для чтения истории, новых событий в нем нет
+func (u *ChatUsecase) checkSubscription(ctx context.Context, groupID, userID string) error {
+	group, _, err := authorizeRead(ctx, u.groupsRepo, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if group.Archived() {
+		return models.ErrGroupArchived
+	}
+	return nil
+}
+
+// changeableMessage проверяет доступ к чату открытой группы и загружает неудаленное сообщение
+func (u *ChatUsecase) changeableMessage(ctx context.Context, groupID, userID, messageID string) (*models.Message, error) {
+	group, _, err := authorizeRead(ctx, u.groupsRepo, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Archived() {
+		return nil, models.ErrGroupArchived
+	}
+
+	m, err := u.messagesRepo.GetMessage(ctx, groupID, messageID)
+	if err != nil {
+		if errors.Is(err, models.ErrMessageNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	if m.Deleted() {
+		return nil, models.ErrMessageDeleted
+	}
+
+	return m, nil
+}
+
+// broadcast рассылает событие подписчикам, сообщение уже сохранено и ошибка только логируется
+func (u *ChatUsecase) broadcast(ctx context.Context, eventType models.MessageEventType, m *models.Message) {
+	if err := u.broker.PublishMessageEvent(ctx, &models.MessageEvent{Type: eventType, Message: m}); err != nil {
+		log.Printf("failed to broadcast %s message %s in group %s: %v", eventType, m.ID, m.GroupID, err)
+	}
+}
+
+func messagesPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultMessagesPageSize
+	}
+	if pageSize > maxMessagesPageSize {
+		return maxMessagesPageSize
+	}
+	return pageSize
+}
+
+func validateMessageBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", fmt.Errorf("%w: body is required", models.ErrInvalidMessage)
+	}
+	if utf8.RuneCountInString(body) > maxMessageBodyLength {
+		return "", fmt.Errorf("%w: body is longer than %d characters", models.ErrInvalidMessage, maxMessageBodyLength)
+	}
+	return body, nil
+}
@@ -0,0 +1,312 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockMessagesRepo struct {
+	messages map[string]*models.Message
+}
+
+func (m *mockMessagesRepo) CreateMessage(ctx context.Context, msg *models.Message) error {
+	copied := *msg
+	m.messages[msg.ID] = &copied
+	return nil
+}
+
+func (m *mockMessagesRepo) GetMessage(ctx context.Context, groupID, messageID string) (*models.Message, error) {
+	msg, ok := m.messages[messageID]
+	if !ok || msg.GroupID != groupID {
+		return nil, models.ErrMessageNotFound
+	}
+	copied := *msg
+	return &copied, nil
+}
+
+func (m *mockMessagesRepo) ListMessages(ctx context.Context, groupID, beforeID string, limit int) ([]*models.Message, error) {
+	var all []*models.Message
+	for _, msg := range m.messages {
+		if msg.GroupID == groupID {
+			all = append(all, msg)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt.After(all[j].CreatedAt) })
+
+	result := make([]*models.Message, 0)
+	skip := beforeID != ""
+	for _, msg := range all {
+		if skip {
+			skip = msg.ID != beforeID
+			continue
+		}
+		if len(result) == limit {
+			break
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
+
+func (m *mockMessagesRepo) UpdateMessage(ctx context.Context, msg *models.Message) error {
+	stored, ok := m.messages[msg.ID]
+	if !ok || stored.Deleted() {
+		return models.ErrMessageDeleted
+	}
+	copied := *msg
+	m.messages[msg.ID] = &copied
+	return nil
+}
+
+func (m *mockMessagesRepo) DeleteMessage(ctx context.Context, groupID, messageID string, deletedAt time.Time) error {
+	stored, ok := m.messages[messageID]
+	if !ok || stored.Deleted() {
+		return models.ErrMessageDeleted
+	}
+	stored.Body = ""
+	stored.DeletedAt = &deletedAt
+	return nil
+}
+
+type mockBroker struct {
+	events []*models.MessageEvent
+	stream chan *models.MessageEvent // события для подписчика, если задан
+}
+
+func (b *mockBroker) PublishMessageEvent(ctx context.Context, event *models.MessageEvent) error {
+	b.events = append(b.events, event)
+	return nil
+}
+
+func (b *mockBroker) SubscribeGroup(ctx context.Context, groupID string) (<-chan *models.MessageEvent, error) {
+	if b.stream != nil {
+		return b.stream, nil
+	}
+	ch := make(chan *models.MessageEvent)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+func newTestChatUsecase(group *models.Group, isMember bool) (*usecase.ChatUsecase, *mockMessagesRepo, *mockBroker) {
+	repo := groupRepoFor(group)
+	repo.staff = map[string]models.StaffRole{"assistant1": models.StaffRoleAssistant}
+	repo.isMember = isMember
+	messages := &mockMessagesRepo{messages: make(map[string]*models.Message)}
+	broker := &mockBroker{}
+	return usecase.NewChatUsecase(messages, repo, broker, 0), messages, broker
+}
+
+func TestSendMessage(t *testing.T) {
+	ctx := context.Background()
+	u, _, broker := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1"}, true)
+
+	m, err := u.SendMessage(ctx, "group1", "student1", "  Привет  ")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if m.Body != "Привет" || m.AuthorID != "student1" {
+		t.Errorf("unexpected message: %+v", m)
+	}
+	if len(broker.events) != 1 || broker.events[0].Type != models.MessageCreated || broker.events[0].Message.ID != m.ID {
+		t.Errorf("expected created event, got %+v", broker.events)
+	}
+
+	if _, err := u.SendMessage(ctx, "group1", "student1", " "); !errors.Is(err, models.ErrInvalidMessage) {
+		t.Errorf("expected ErrInvalidMessage, got %v", err)
+	}
+
+	outsider, _, _ := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1"}, false)
+	if _, err := outsider.SendMessage(ctx, "group1", "student2", "Привет"); !errors.Is(err, models.ErrNotGroupMember) {
+		t.Errorf("expected ErrNotGroupMember, got %v", err)
+	}
+
+	archived, _, _ := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1", ArchivedAt: ptrTime(time.Now())}, true)
+	if _, err := archived.SendMessage(ctx, "group1", "tutor1", "Привет"); !errors.Is(err, models.ErrGroupArchived) {
+		t.Errorf("expected ErrGroupArchived, got %v", err)
+	}
+}
+
+func TestListMessages_Pagination(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _ := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1"}, true)
+
+	start := time.Now()
+	for i, id := range []string{"m1", "m2", "m3"} {
+		repo.messages[id] = &models.Message{ID: id, GroupID: "group1", AuthorID: "student1", Body: id,
+			CreatedAt: start.Add(time.Duration(i) * time.Minute)}
+	}
+
+	page, token, err := u.ListMessages(ctx, "group1", "student1", "", 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page) != 2 || page[0].ID != "m3" || page[1].ID != "m2" || token != "m2" {
+		t.Fatalf("unexpected first page: %d messages, token %q", len(page), token)
+	}
+
+	page, token, err = u.ListMessages(ctx, "group1", "student1", token, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "m1" || token != "" {
+		t.Errorf("unexpected last page: %d messages, token %q", len(page), token)
+	}
+}
+
+func TestEditAndDeleteMessage(t *testing.T) {
+	ctx := context.Background()
+	u, repo, broker := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1"}, true)
+
+	m, _ := u.SendMessage(ctx, "group1", "student1", "Черновик")
+
+	if _, err := u.EditMessage(ctx, "group1", "student2", m.ID, "Чужое"); !errors.Is(err, models.ErrNotMessageAuthor) {
+		t.Errorf("expected only author to edit, got %v", err)
+	}
+	edited, err := u.EditMessage(ctx, "group1", "student1", m.ID, "Итог")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if edited.Body != "Итог" || edited.EditedAt == nil {
+		t.Errorf("unexpected edited message: %+v", edited)
+	}
+
+	if err := u.DeleteMessage(ctx, "group1", "student2", m.ID); !errors.Is(err, models.ErrNotMessageAuthor) {
+		t.Errorf("expected other student not to delete, got %v", err)
+	}
+	if err := u.DeleteMessage(ctx, "group1", "tutor1", m.ID); err != nil {
+		t.Fatalf("expected tutor to delete message, got %v", err)
+	}
+	if stored := repo.messages[m.ID]; !stored.Deleted() || stored.Body != "" {
+		t.Errorf("expected tombstone, got %+v", stored)
+	}
+	if last := broker.events[len(broker.events)-1]; last.Type != models.MessageDeleted || last.Message.Body != "" {
+		t.Errorf("expected deleted event without body, got %+v", last)
+	}
+
+	if _, err := u.EditMessage(ctx, "group1", "student1", m.ID, "Снова"); !errors.Is(err, models.ErrMessageDeleted) {
+		t.Errorf("expected ErrMessageDeleted, got %v", err)
+	}
+	if err := u.DeleteMessage(ctx, "group1", "student1", "missing"); !errors.Is(err, models.ErrMessageNotFound) {
+		t.Errorf("expected ErrMessageNotFound, got %v", err)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	u, _, _ := newTestChatUsecase(&models.Group{ID: "group1", TutorID: "tutor1"}, false)
+
+	if _, err := u.Subscribe(ctx, "group1", "student2"); !errors.Is(err, models.ErrNotGroupMember) {
+		t.Errorf("expected ErrNotGroupMember, got %v", err)
+	}
+
+	events, err := u.Subscribe(ctx, "group1", "assistant1")
+	if err != nil {
+		t.Fatalf("expected staff to subscribe, got %v", err)
+	}
+	cancel()
+	if _, ok := <-events; ok {
+		t.Error("expected channel to be closed after cancel")
+	}
+}
+
+// lockedGroupsRepo - проверка доступа подписки идет в своей горутине, изменения теста
+// под мьютексом не гоняются с ней
+type lockedGroupsRepo struct {
+	*mockRepo
+	mu sync.Mutex
+}
+
+func (r *lockedGroupsRepo) GetGroup(ctx context.Context, id string, includeMembers bool) (*models.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mockRepo.GetGroup(ctx, id, includeMembers)
+}
+
+func (r *lockedGroupsRepo) IsMember(ctx context.Context, groupID, studentID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mockRepo.IsMember(ctx, groupID, studentID)
+}
+
+func TestSubscribe_ClosedAfterAccessLost(t *testing.T) {
+	tests := []struct {
+		name   string
+		revoke func(repo *mockRepo)
+	}{
+		{"removed from group", func(repo *mockRepo) { repo.isMember = false }},
+		{"group archived", func(repo *mockRepo) {
+			archivedAt := time.Now()
+			archived := *repo.getGroupSecondResult
+			archived.ArchivedAt = &archivedAt
+			repo.getGroupFirstResult, repo.getGroupSecondResult = &archived, &archived
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			repo := &lockedGroupsRepo{mockRepo: groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1"})}
+			repo.isMember = true
+			broker := &mockBroker{stream: make(chan *models.MessageEvent)}
+			u := usecase.NewChatUsecase(&mockMessagesRepo{messages: make(map[string]*models.Message)}, repo, broker, 10*time.Millisecond)
+
+			events, err := u.Subscribe(ctx, "group1", "student1")
+			if err != nil {
+				t.Fatalf("expected member to subscribe, got %v", err)
+			}
+
+			first := &models.MessageEvent{Type: models.MessageCreated, Message: &models.Message{ID: "m1", GroupID: "group1"}}
+			broker.stream <- first
+			if event := <-events; event != first {
+				t.Fatalf("expected event to be delivered, got %+v", event)
+			}
+
+			repo.mu.Lock()
+			tt.revoke(repo.mockRepo)
+			repo.mu.Unlock()
+			select {
+			case event, ok := <-events:
+				if ok {
+					t.Errorf("expected subscription to be closed, got %+v", event)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("expected subscription to be closed by the periodic check")
+			}
+		})
+	}
+}
+
+func TestSubscribe_NoAccessCheckPerEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1"})
+	repo.isMember = true
+	broker := &mockBroker{stream: make(chan *models.MessageEvent)}
+	u := usecase.NewChatUsecase(&mockMessagesRepo{messages: make(map[string]*models.Message)}, repo, broker, time.Hour)
+
+	events, err := u.Subscribe(ctx, "group1", "student1")
+	if err != nil {
+		t.Fatalf("expected member to subscribe, got %v", err)
+	}
+	calls := repo.getGroupCallCount
+
+	for i := 0; i < 3; i++ {
+		broker.stream <- &models.MessageEvent{Type: models.MessageCreated, Message: &models.Message{ID: "m" + strconv.Itoa(i), GroupID: "group1"}}
+		<-events
+	}
+	if repo.getGroupCallCount != calls {
+		t.Errorf("expected no access queries per event, got %d", repo.getGroupCallCount-calls)
+	}
+}
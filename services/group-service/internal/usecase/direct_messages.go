@@ -0,0 +1,439 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxAttachments             = 10
+	maxAttachmentNameLen       = 255
+	maxSearchQueryLength       = 200
+	maxReportReasonLength      = 1000
+	directMessagePreviewLength = 100
+)
+
+type DirectMessagesRepo interface {
+	ShareGroup(ctx context.Context, userID, peerID string) (bool, error)
+	GetOrCreateConversation(ctx context.Context, c *models.Conversation) (*models.Conversation, error)
+	GetConversation(ctx context.Context, conversationID string) (*models.Conversation, error)
+	ListConversations(ctx context.Context, userID string) ([]*models.Conversation, error)
+	CreateDirectMessage(ctx context.Context, m *models.DirectMessage) error
+	GetDirectMessage(ctx context.Context, conversationID, messageID string) (*models.DirectMessage, error)
+	ListDirectMessages(ctx context.Context, conversationID, beforeID string, limit int) ([]*models.DirectMessage, error)
+	SearchDirectMessages(ctx context.Context, filter models.DirectMessageSearch) ([]*models.DirectMessage, error)
+	MarkConversationRead(ctx context.Context, c *models.Conversation, userID string, readAt time.Time) error
+	BlockUser(ctx context.Context, b *models.UserBlock) error
+	UnblockUser(ctx context.Context, blockerID, blockedID string) error
+	ListBlockedUsers(ctx context.Context, blockerID string) ([]*models.UserBlock, error)
+	IsBlocked(ctx context.Context, userID, peerID string) (bool, error)
+	CreateReport(ctx context.Context, report *models.UserReport) error
+}
+
+type SendDirectMessageParams struct {
+	ConversationID string
+	UserID         string
+	Body           string
+	Attachments    []models.Attachment
+}
+
+type ReportUserParams struct {
+	UserID         string
+	ConversationID string
+	MessageID      string // необязательно, сообщение собеседника из этой переписки
+	Reason         string
+	Block          bool // сразу заблокировать собеседника
+}
+
+// DirectMessagesUsecase - личная переписка персонала группы с учениками
+type DirectMessagesUsecase struct {
+	repo       DirectMessagesRepo
+	userClient UserClient
+	publisher  EventPublisher
+	topic      string
+	now        func() time.Time
+	// вложения принимаются только со своего хранилища загрузок
+	uploadHosts map[string]struct{}
+}
+
+func NewDirectMessagesUsecase(repo DirectMessagesRepo, userClient UserClient, publisher EventPublisher, topic string, uploadHosts []string) *DirectMessagesUsecase {
+	hosts := make(map[string]struct{}, len(uploadHosts))
+	for _, h := range uploadHosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts[h] = struct{}{}
+		}
+	}
+
+	return &DirectMessagesUsecase{
+		repo:        repo,
+		userClient:  userClient,
+		publisher:   publisher,
+		topic:       topic,
+		now:         time.Now,
+		uploadHosts: hosts,
+	}
+}
+
+// StartConversation возвращает переписку с peerID, создавая ее при первом обращении.
+// Писать можно только тому, с кем есть общая группа, и если никто из двоих не заблокировал другого.
+func (u *DirectMessagesUsecase) StartConversation(ctx context.Context, userID, peerID string) (*models.Conversation, error) {
+	if peerID == "" || peerID == userID {
+		return nil, fmt.Errorf("%w: choose another user to message", models.ErrInvalidDirectMessage)
+	}
+
+	if err := u.checkCanMessage(ctx, userID, peerID); err != nil {
+		return nil, err
+	}
+
+	pair := [2]string{userID, peerID}
+	if pair[0] > pair[1] {
+		pair[0], pair[1] = pair[1], pair[0]
+	}
+	conversation, err := u.repo.GetOrCreateConversation(ctx, &models.Conversation{
+		ID:        uuid.New().String(),
+		UserIDs:   pair,
+		CreatedAt: u.now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+
+	return conversation, nil
+}
+
+// ListConversations возвращает переписки пользователя и общее число непрочитанных сообщений
+func (u *DirectMessagesUsecase) ListConversations(ctx context.Context, userID string) ([]*models.Conversation, int, error) {
+	conversations, err := u.repo.ListConversations(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	unread := 0
+	for _, c := range conversations {
+		unread += c.UnreadCount
+	}
+
+	return conversations, unread, nil
+}
+
+func (u *DirectMessagesUsecase) SendMessage(ctx context.Context, p SendDirectMessageParams) (*models.DirectMessage, error) {
+	body, attachments, err := u.validateDirectMessage(p.Body, p.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := u.participantConversation(ctx, p.ConversationID, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	peerID := conversation.Peer(p.UserID)
+	if err := u.checkCanMessage(ctx, p.UserID, peerID); err != nil {
+		return nil, err
+	}
+
+	m := &models.DirectMessage{
+		ID:             uuid.New().String(),
+		ConversationID: conversation.ID,
+		SenderID:       p.UserID,
+		Body:           body,
+		Attachments:    attachments,
+		CreatedAt:      u.now(),
+	}
+	if err := u.repo.CreateDirectMessage(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to create direct message: %w", err)
+	}
+
+	// свое сообщение не считается непрочитанным
+	if err := u.repo.MarkConversationRead(ctx, conversation, p.UserID, m.CreatedAt); err != nil {
+		log.Printf("failed to mark conversation %s read for sender %s: %v", conversation.ID, p.UserID, err)
+	}
+
+	u.publishSent(ctx, m, peerID)
+
+	return m, nil
+}
+
+// ListMessages возвращает страницу переписки от новых к старым, как история чата группы
+func (u *DirectMessagesUsecase) ListMessages(ctx context.Context, conversationID, userID, pageToken string, pageSize int) ([]*models.DirectMessage, string, error) {
+	if _, err := u.participantConversation(ctx, conversationID, userID); err != nil {
+		return nil, "", err
+	}
+
+	pageSize = messagesPageSize(pageSize)
+	messages, err := u.repo.ListDirectMessages(ctx, conversationID, pageToken, pageSize+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list direct messages: %w", err)
+	}
+
+	messages, nextPageToken := directMessagesPage(messages, pageSize)
+	return messages, nextPageToken, nil
+}
+
+func (u *DirectMessagesUsecase) MarkRead(ctx context.Context, conversationID, userID string) error {
+	conversation, err := u.participantConversation(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.MarkConversationRead(ctx, conversation, userID, u.now()); err != nil {
+		return fmt.Errorf("failed to mark conversation read: %w", err)
+	}
+
+	return nil
+}
+
+// SearchMessages ищет сообщения по словам во всех переписках пользователя или в одной из них
+func (u *DirectMessagesUsecase) SearchMessages(ctx context.Context, userID, query, conversationID, pageToken string, pageSize int) ([]*models.DirectMessage, string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, "", fmt.Errorf("%w: search query is required", models.ErrInvalidDirectMessage)
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, "", fmt.Errorf("%w: search query is longer than %d characters", models.ErrInvalidDirectMessage, maxSearchQueryLength)
+	}
+
+	if conversationID != "" {
+		if _, err := u.participantConversation(ctx, conversationID, userID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	pageSize = messagesPageSize(pageSize)
+	messages, err := u.repo.SearchDirectMessages(ctx, models.DirectMessageSearch{
+		UserID:         userID,
+		Query:          query,
+		ConversationID: conversationID,
+		BeforeID:       pageToken,
+		Limit:          pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search direct messages: %w", err)
+	}
+
+	messages, nextPageToken := directMessagesPage(messages, pageSize)
+	return messages, nextPageToken, nil
+}
+
+// BlockUser запрещает переписку в обе стороны, история остается доступной
+func (u *DirectMessagesUsecase) BlockUser(ctx context.Context, userID, blockedID string) error {
+	if blockedID == "" || blockedID == userID {
+		return fmt.Errorf("%w: choose another user to block", models.ErrInvalidDirectMessage)
+	}
+
+	if err := u.repo.BlockUser(ctx, &models.UserBlock{BlockerID: userID, BlockedID: blockedID, CreatedAt: u.now()}); err != nil {
+		return fmt.Errorf("failed to block user: %w", err)
+	}
+
+	return nil
+}
+
+func (u *DirectMessagesUsecase) UnblockUser(ctx context.Context, userID, blockedID string) error {
+	if err := u.repo.UnblockUser(ctx, userID, blockedID); err != nil {
+		return fmt.Errorf("failed to unblock user: %w", err)
+	}
+	return nil
+}
+
+func (u *DirectMessagesUsecase) ListBlockedUsers(ctx context.Context, userID string) ([]*models.UserBlock, error) {
+	blocks, err := u.repo.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked users: %w", err)
+	}
+	return blocks, nil
+}
+
+// ReportUser сохраняет жалобу на собеседника и передает ее модерации событием UserReported
+func (u *DirectMessagesUsecase) ReportUser(ctx context.Context, p ReportUserParams) (*models.UserReport, error) {
+	reason := strings.TrimSpace(p.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", models.ErrInvalidReport)
+	}
+	if utf8.RuneCountInString(reason) > maxReportReasonLength {
+		return nil, fmt.Errorf("%w: reason is longer than %d characters", models.ErrInvalidReport, maxReportReasonLength)
+	}
+
+	conversation, err := u.participantConversation(ctx, p.ConversationID, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	reportedID := conversation.Peer(p.UserID)
+
+	if p.MessageID != "" {
+		m, err := u.repo.GetDirectMessage(ctx, conversation.ID, p.MessageID)
+		if err != nil {
+			if errors.Is(err, models.ErrMessageNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to get direct message: %w", err)
+		}
+		if m.SenderID != reportedID {
+			return nil, fmt.Errorf("%w: only messages of the other participant can be reported", models.ErrInvalidReport)
+		}
+	}
+
+	report := &models.UserReport{
+		ID:             uuid.New().String(),
+		ReporterID:     p.UserID,
+		ReportedID:     reportedID,
+		ConversationID: conversation.ID,
+		MessageID:      p.MessageID,
+		Reason:         reason,
+		CreatedAt:      u.now(),
+	}
+	if err := u.repo.CreateReport(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	if p.Block {
+		if err := u.BlockUser(ctx, p.UserID, reportedID); err != nil {
+			return nil, err
+		}
+	}
+
+	u.publishReport(ctx, report)
+
+	return report, nil
+}
+
+// participantConversation загружает переписку, чужая переписка выглядит несуществующей
+func (u *DirectMessagesUsecase) participantConversation(ctx context.Context, conversationID, userID string) (*models.Conversation, error) {
+	if conversationID == "" {
+		return nil, models.ErrConversationNotFound
+	}
+
+	conversation, err := u.repo.GetConversation(ctx, conversationID)
+	if err != nil {
+		if errors.Is(err, models.ErrConversationNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	if !conversation.HasParticipant(userID) {
+		return nil, models.ErrConversationNotFound
+	}
+
+	return conversation, nil
+}
+
+func (u *DirectMessagesUsecase) checkCanMessage(ctx context.Context, userID, peerID string) error {
+	blocked, err := u.repo.IsBlocked(ctx, userID, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to check block: %w", err)
+	}
+	if blocked {
+		return models.ErrUserBlocked
+	}
+
+	shared, err := u.repo.ShareGroup(ctx, userID, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to check shared group: %w", err)
+	}
+	if !shared {
+		return models.ErrNoSharedGroup
+	}
+
+	return nil
+}
+
+func (u *DirectMessagesUsecase) publishSent(ctx context.Context, m *models.DirectMessage, recipientID string) {
+	preview := m.Body
+	if utf8.RuneCountInString(preview) > directMessagePreviewLength {
+		preview = string([]rune(preview)[:directMessagePreviewLength]) + "…"
+	}
+
+	resolved := recipients(ctx, u.userClient, []string{recipientID})
+	if len(resolved) == 0 {
+		log.Printf("skip %s event for message %s: recipient %s not resolved", events.DirectMessageSent, m.ID, recipientID)
+		return
+	}
+
+	u.publishEvent(ctx, events.DirectMessageSent, m.ConversationID, events.DirectMessageSentPayload{
+		ConversationID:  m.ConversationID,
+		MessageID:       m.ID,
+		SenderID:        m.SenderID,
+		RecipientID:     recipientID,
+		Recipient:       resolved[0],
+		Preview:         preview,
+		AttachmentCount: len(m.Attachments),
+		SentAt:          m.CreatedAt,
+	})
+}
+
+func (u *DirectMessagesUsecase) publishReport(ctx context.Context, r *models.UserReport) {
+	u.publishEvent(ctx, events.UserReported, r.ReportedID, events.UserReportedPayload{
+		ReportID:       r.ID,
+		ReporterID:     r.ReporterID,
+		ReportedID:     r.ReportedID,
+		ConversationID: r.ConversationID,
+		MessageID:      r.MessageID,
+		Reason:         r.Reason,
+		CreatedAt:      r.CreatedAt,
+	})
+}
+
+// publishEvent - ошибка публикации только логируется, сообщение или жалоба уже сохранены
+func (u *DirectMessagesUsecase) publishEvent(ctx context.Context, eventType, key string, payload any) {
+	event, err := events.NewEnvelope(eventType, payload)
+	if err != nil {
+		log.Printf("failed to build %s event for %s: %v", eventType, key, err)
+		return
+	}
+
+	if err := u.publisher.Publish(ctx, u.topic, key, event); err != nil {
+		log.Printf("failed to publish %s event for %s: %v", eventType, key, err)
+	}
+}
+
+func directMessagesPage(messages []*models.DirectMessage, pageSize int) ([]*models.DirectMessage, string) {
+	if len(messages) <= pageSize {
+		return messages, ""
+	}
+	messages = messages[:pageSize]
+	return messages, messages[pageSize-1].ID
+}
+
+// validateDirectMessage - нужен текст или хотя бы одно вложение, загруженное через сервис загрузок
+func (u *DirectMessagesUsecase) validateDirectMessage(body string, attachments []models.Attachment) (string, []models.Attachment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" && len(attachments) == 0 {
+		return "", nil, fmt.Errorf("%w: body or attachment is required", models.ErrInvalidDirectMessage)
+	}
+	if utf8.RuneCountInString(body) > maxMessageBodyLength {
+		return "", nil, fmt.Errorf("%w: body is longer than %d characters", models.ErrInvalidDirectMessage, maxMessageBodyLength)
+	}
+	if len(attachments) > maxAttachments {
+		return "", nil, fmt.Errorf("%w: no more than %d attachments", models.ErrInvalidDirectMessage, maxAttachments)
+	}
+
+	cleaned := make([]models.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		a.Name = strings.TrimSpace(a.Name)
+		if a.Name == "" || utf8.RuneCountInString(a.Name) > maxAttachmentNameLen {
+			return "", nil, fmt.Errorf("%w: attachment name must be 1-%d characters", models.ErrInvalidDirectMessage, maxAttachmentNameLen)
+		}
+		parsed, err := url.Parse(strings.TrimSpace(a.URL))
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" || parsed.User != nil {
+			return "", nil, fmt.Errorf("%w: attachment %q must have an absolute https url", models.ErrInvalidDirectMessage, a.Name)
+		}
+		if _, ok := u.uploadHosts[strings.ToLower(parsed.Hostname())]; !ok {
+			return "", nil, fmt.Errorf("%w: attachment %q must point to the uploads storage", models.ErrInvalidDirectMessage, a.Name)
+		}
+		a.URL = parsed.String()
+		if a.Size < 0 {
+			return "", nil, fmt.Errorf("%w: attachment %q has negative size", models.ErrInvalidDirectMessage, a.Name)
+		}
+		cleaned = append(cleaned, a)
+	}
+
+	return body, cleaned, nil
+}
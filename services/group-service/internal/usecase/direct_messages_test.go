@@ -0,0 +1,286 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockDirectMessagesRepo struct {
+	shared        map[[2]string]bool // упорядоченная пара -> есть общая группа
+	conversations map[string]*models.Conversation
+	messages      []*models.DirectMessage
+	blocks        map[[2]string]time.Time // blocker, blocked
+	reports       []*models.UserReport
+	readAt        map[string]time.Time // conversationID/userID
+}
+
+func newMockDirectMessagesRepo() *mockDirectMessagesRepo {
+	return &mockDirectMessagesRepo{
+		shared:        make(map[[2]string]bool),
+		conversations: make(map[string]*models.Conversation),
+		blocks:        make(map[[2]string]time.Time),
+		readAt:        make(map[string]time.Time),
+	}
+}
+
+func pairOf(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
+
+func (m *mockDirectMessagesRepo) ShareGroup(ctx context.Context, userID, peerID string) (bool, error) {
+	return m.shared[pairOf(userID, peerID)], nil
+}
+
+func (m *mockDirectMessagesRepo) GetOrCreateConversation(ctx context.Context, c *models.Conversation) (*models.Conversation, error) {
+	for _, existing := range m.conversations {
+		if existing.UserIDs == c.UserIDs {
+			return existing, nil
+		}
+	}
+	copied := *c
+	m.conversations[c.ID] = &copied
+	return &copied, nil
+}
+
+func (m *mockDirectMessagesRepo) GetConversation(ctx context.Context, conversationID string) (*models.Conversation, error) {
+	c, ok := m.conversations[conversationID]
+	if !ok {
+		return nil, models.ErrConversationNotFound
+	}
+	return c, nil
+}
+
+func (m *mockDirectMessagesRepo) ListConversations(ctx context.Context, userID string) ([]*models.Conversation, error) {
+	var result []*models.Conversation
+	for _, c := range m.conversations {
+		if !c.HasParticipant(userID) || c.LastMessageAt == nil {
+			continue
+		}
+		copied := *c
+		for _, msg := range m.messages {
+			if msg.ConversationID == c.ID && msg.SenderID != userID && msg.CreatedAt.After(m.readAt[c.ID+"/"+userID]) {
+				copied.UnreadCount++
+			}
+		}
+		result = append(result, &copied)
+	}
+	return result, nil
+}
+
+func (m *mockDirectMessagesRepo) CreateDirectMessage(ctx context.Context, msg *models.DirectMessage) error {
+	m.messages = append(m.messages, msg)
+	at := msg.CreatedAt
+	m.conversations[msg.ConversationID].LastMessageAt = &at
+	return nil
+}
+
+func (m *mockDirectMessagesRepo) GetDirectMessage(ctx context.Context, conversationID, messageID string) (*models.DirectMessage, error) {
+	for _, msg := range m.messages {
+		if msg.ID == messageID && msg.ConversationID == conversationID {
+			return msg, nil
+		}
+	}
+	return nil, models.ErrMessageNotFound
+}
+
+func (m *mockDirectMessagesRepo) ListDirectMessages(ctx context.Context, conversationID, beforeID string, limit int) ([]*models.DirectMessage, error) {
+	var result []*models.DirectMessage
+	for i := len(m.messages) - 1; i >= 0 && len(result) < limit; i-- {
+		if m.messages[i].ConversationID == conversationID {
+			result = append(result, m.messages[i])
+		}
+	}
+	return result, nil
+}
+
+func (m *mockDirectMessagesRepo) SearchDirectMessages(ctx context.Context, filter models.DirectMessageSearch) ([]*models.DirectMessage, error) {
+	return nil, nil
+}
+
+func (m *mockDirectMessagesRepo) MarkConversationRead(ctx context.Context, c *models.Conversation, userID string, readAt time.Time) error {
+	m.readAt[c.ID+"/"+userID] = readAt
+	return nil
+}
+
+func (m *mockDirectMessagesRepo) BlockUser(ctx context.Context, b *models.UserBlock) error {
+	m.blocks[[2]string{b.BlockerID, b.BlockedID}] = b.CreatedAt
+	return nil
+}
+
+func (m *mockDirectMessagesRepo) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	delete(m.blocks, [2]string{blockerID, blockedID})
+	return nil
+}
+
+func (m *mockDirectMessagesRepo) ListBlockedUsers(ctx context.Context, blockerID string) ([]*models.UserBlock, error) {
+	return nil, nil
+}
+
+func (m *mockDirectMessagesRepo) IsBlocked(ctx context.Context, userID, peerID string) (bool, error) {
+	_, forward := m.blocks[[2]string{userID, peerID}]
+	_, backward := m.blocks[[2]string{peerID, userID}]
+	return forward || backward, nil
+}
+
+func (m *mockDirectMessagesRepo) CreateReport(ctx context.Context, report *models.UserReport) error {
+	m.reports = append(m.reports, report)
+	return nil
+}
+
+func newTestDirectMessagesUsecase() (*usecase.DirectMessagesUsecase, *mockDirectMessagesRepo, *mockPublisher) {
+	repo := newMockDirectMessagesRepo()
+	repo.shared[pairOf("tutor1", "student1")] = true
+	publisher := &mockPublisher{}
+	return usecase.NewDirectMessagesUsecase(repo, &mockUserClient{users: []*models.UserInfo{
+		{ID: "student1", IsStudent: true, Locale: "en-US", Timezone: "Europe/Berlin"},
+	}}, publisher, "group-events", []string{"uploads.tutors.local"}), repo, publisher
+}
+
+func TestStartConversation(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _ := newTestDirectMessagesUsecase()
+
+	c, err := u.StartConversation(ctx, "student1", "tutor1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if c.UserIDs != [2]string{"student1", "tutor1"} || c.Peer("student1") != "tutor1" {
+		t.Errorf("unexpected conversation: %+v", c)
+	}
+
+	again, err := u.StartConversation(ctx, "tutor1", "student1")
+	if err != nil || again.ID != c.ID {
+		t.Errorf("expected the same conversation, got %+v (%v)", again, err)
+	}
+
+	if _, err := u.StartConversation(ctx, "student1", "student2"); !errors.Is(err, models.ErrNoSharedGroup) {
+		t.Errorf("expected ErrNoSharedGroup, got %v", err)
+	}
+	if _, err := u.StartConversation(ctx, "student1", "student1"); !errors.Is(err, models.ErrInvalidDirectMessage) {
+		t.Errorf("expected ErrInvalidDirectMessage, got %v", err)
+	}
+
+	repo.blocks[[2]string{"tutor1", "student1"}] = time.Now()
+	if _, err := u.StartConversation(ctx, "student1", "tutor1"); !errors.Is(err, models.ErrUserBlocked) {
+		t.Errorf("expected ErrUserBlocked, got %v", err)
+	}
+}
+
+func TestSendDirectMessage(t *testing.T) {
+	ctx := context.Background()
+	u, repo, publisher := newTestDirectMessagesUsecase()
+	c, _ := u.StartConversation(ctx, "tutor1", "student1")
+
+	m, err := u.SendMessage(ctx, usecase.SendDirectMessageParams{
+		ConversationID: c.ID,
+		UserID:         "tutor1",
+		Body:           "  Разберем оценку за контрольную  ",
+		Attachments:    []models.Attachment{{Name: " work.pdf ", URL: "https://uploads.tutors.local/work.pdf", Size: 1024}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if m.Body != "Разберем оценку за контрольную" || len(m.Attachments) != 1 || m.Attachments[0].Name != "work.pdf" {
+		t.Errorf("unexpected message: %+v", m)
+	}
+
+	if len(publisher.events) != 1 || publisher.events[0].EventType != events.DirectMessageSent || publisher.keys[0] != c.ID {
+		t.Fatalf("expected DirectMessageSent event, got %+v", publisher.events)
+	}
+	var payload events.DirectMessageSentPayload
+	if err := json.Unmarshal(publisher.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.RecipientID != "student1" || payload.AttachmentCount != 1 {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+	// уведомление отправляется на языке и в часовом поясе получателя
+	if payload.Recipient != (events.Recipient{UserID: "student1", Locale: "en-US", Timezone: "Europe/Berlin"}) {
+		t.Errorf("unexpected recipient: %+v", payload.Recipient)
+	}
+
+	conversations, unread, err := u.ListConversations(ctx, "student1")
+	if err != nil || len(conversations) != 1 || unread != 1 {
+		t.Fatalf("expected 1 unread message for student, got %d (%v)", unread, err)
+	}
+	if _, unread, _ := u.ListConversations(ctx, "tutor1"); unread != 0 {
+		t.Errorf("own message must not be unread, got %d", unread)
+	}
+
+	if err := u.MarkRead(ctx, c.ID, "student1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, unread, _ := u.ListConversations(ctx, "student1"); unread != 0 {
+		t.Errorf("expected no unread after read, got %d", unread)
+	}
+
+	// ученик вышел из группы - написать больше нельзя, история остается
+	repo.shared[pairOf("tutor1", "student1")] = false
+	if _, err := u.SendMessage(ctx, usecase.SendDirectMessageParams{ConversationID: c.ID, UserID: "student1", Body: "Спасибо"}); !errors.Is(err, models.ErrNoSharedGroup) {
+		t.Errorf("expected ErrNoSharedGroup, got %v", err)
+	}
+	if messages, _, err := u.ListMessages(ctx, c.ID, "student1", "", 0); err != nil || len(messages) != 1 {
+		t.Errorf("expected history to stay available, got %d (%v)", len(messages), err)
+	}
+}
+
+func TestSendDirectMessage_Rejected(t *testing.T) {
+	ctx := context.Background()
+	u, _, _ := newTestDirectMessagesUsecase()
+	c, _ := u.StartConversation(ctx, "tutor1", "student1")
+
+	cases := []usecase.SendDirectMessageParams{
+		{ConversationID: c.ID, UserID: "tutor1", Body: "  "},
+		{ConversationID: c.ID, UserID: "tutor1", Attachments: []models.Attachment{{Name: "a.pdf", URL: "ftp://files/a.pdf"}}},
+		{ConversationID: c.ID, UserID: "tutor1", Attachments: []models.Attachment{{URL: "https://uploads.tutors.local/a.pdf"}}},
+		{ConversationID: c.ID, UserID: "tutor1", Attachments: []models.Attachment{{Name: "a.pdf", URL: "http://uploads.tutors.local/a.pdf"}}},
+		{ConversationID: c.ID, UserID: "tutor1", Attachments: []models.Attachment{{Name: "a.pdf", URL: "https://evil.example.com/a.pdf"}}},
+		{ConversationID: c.ID, UserID: "tutor1", Attachments: []models.Attachment{{Name: "a.pdf", URL: "https://user@uploads.tutors.local/a.pdf"}}},
+	}
+	for _, p := range cases {
+		if _, err := u.SendMessage(ctx, p); !errors.Is(err, models.ErrInvalidDirectMessage) {
+			t.Errorf("expected ErrInvalidDirectMessage for %+v, got %v", p, err)
+		}
+	}
+
+	if _, err := u.SendMessage(ctx, usecase.SendDirectMessageParams{ConversationID: c.ID, UserID: "student2", Body: "Привет"}); !errors.Is(err, models.ErrConversationNotFound) {
+		t.Errorf("expected outsider to get ErrConversationNotFound, got %v", err)
+	}
+}
+
+func TestReportUser(t *testing.T) {
+	ctx := context.Background()
+	u, repo, publisher := newTestDirectMessagesUsecase()
+	c, _ := u.StartConversation(ctx, "tutor1", "student1")
+	own, _ := u.SendMessage(ctx, usecase.SendDirectMessageParams{ConversationID: c.ID, UserID: "student1", Body: "Спам"})
+	publisher.events = nil
+
+	if _, err := u.ReportUser(ctx, usecase.ReportUserParams{UserID: "student1", ConversationID: c.ID, MessageID: own.ID, Reason: "спам"}); !errors.Is(err, models.ErrInvalidReport) {
+		t.Errorf("expected not to report own message, got %v", err)
+	}
+
+	report, err := u.ReportUser(ctx, usecase.ReportUserParams{UserID: "tutor1", ConversationID: c.ID, MessageID: own.ID, Reason: "спам", Block: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if report.ReportedID != "student1" || len(repo.reports) != 1 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].EventType != events.UserReported {
+		t.Errorf("expected UserReported event, got %+v", publisher.events)
+	}
+
+	if _, err := u.SendMessage(ctx, usecase.SendDirectMessageParams{ConversationID: c.ID, UserID: "student1", Body: "Еще"}); !errors.Is(err, models.ErrUserBlocked) {
+		t.Errorf("expected ErrUserBlocked after report with block, got %v", err)
+	}
+}
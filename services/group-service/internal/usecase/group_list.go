@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"group_service/internal/models"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultGroupsPageSize = 50
+	maxGroupsPageSize     = 100
+)
+
+// ListGroups возвращает страницу групп репетитора, ученика или, без этих фильтров, всех групп
+// вызывающего: где он владелец, персонал или ученик. Группы чужого ученика видит только опекун,
+// без участников, но вместе с архивными: в них остаются дедлайны и оценки ученика.
+// Пустой nextPageToken - последняя страница.
+func (u *GroupsUsecase) ListGroups(ctx context.Context, callerID string, q models.GroupQuery, pageToken string, pageSize int) ([]*models.Group, string, error) {
+	q.Search = strings.TrimSpace(q.Search)
+	if utf8.RuneCountInString(q.Search) > maxSearchQueryLength {
+		return nil, "", fmt.Errorf("%w: search is longer than %d characters", models.ErrInvalidGroupQuery, maxSearchQueryLength)
+	}
+	if q.SortBy == "" {
+		q.SortBy = models.GroupSortCreatedAt
+	}
+	if !q.SortBy.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown sort field %q", models.ErrInvalidGroupQuery, q.SortBy)
+	}
+	archiveDefault := q.Archived == ""
+	if archiveDefault {
+		q.Archived = models.ArchiveFilterActive
+	}
+	if !q.Archived.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown archive filter %q", models.ErrInvalidGroupQuery, q.Archived)
+	}
+	if pageToken != "" {
+		cursor, err := decodeGroupCursor(pageToken, q)
+		if err != nil {
+			return nil, "", err
+		}
+		q.After = cursor
+	}
+
+	switch {
+	case q.TutorID != "":
+		// проверка репетитора нужна только для создания групп: группы непроверенного
+		// или отклоненного репетитора остаются видны
+		users, err := u.userClient.ResolveUsers(ctx, []string{q.TutorID}, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to resolve tutor: %w", err)
+		}
+		if len(users) == 0 || !users[0].IsTutor {
+			return nil, "", models.ErrTutorIsNotValid
+		}
+
+	case q.StudentID != "":
+		// внутренние вызовы сервисов идут без вызывающего
+		if callerID != "" && callerID != q.StudentID {
+			ok, err := u.userClient.CheckGuardianAccess(ctx, callerID, q.StudentID)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to check guardian access: %w", err)
+			}
+			if !ok {
+				return nil, "", models.ErrGuardianAccessDenied
+			}
+			q.IncludeMembers = false
+			if archiveDefault {
+				q.Archived = models.ArchiveFilterAll
+			}
+		}
+
+	default:
+		if callerID == "" {
+			return nil, "", fmt.Errorf("%w: tutor_id or student_id is required", models.ErrInvalidGroupQuery)
+		}
+		q.UserID = callerID
+	}
+
+	// лишняя запись показывает, есть ли следующая страница
+	pageSize = groupsPageSize(pageSize)
+	q.Limit = pageSize + 1
+	groups, err := u.groupsRepo.ListGroups(ctx, q)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	nextPageToken := ""
+	if len(groups) > pageSize {
+		groups = groups[:pageSize]
+		nextPageToken = encodeGroupCursor(groupCursor(groups[pageSize-1], q))
+	}
+
+	return groups, nextPageToken, nil
+}
+
+func groupsPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultGroupsPageSize
+	}
+	if pageSize > maxGroupsPageSize {
+		return maxGroupsPageSize
+	}
+	return pageSize
+}
+
+func groupCursor(g *models.Group, q models.GroupQuery) models.GroupCursor {
+	c := models.GroupCursor{SortBy: q.SortBy, Desc: q.Desc, ID: g.ID}
+	switch q.SortBy {
+	case models.GroupSortName:
+		c.Name = g.Name
+	case models.GroupSortMemberCount:
+		c.MemberCount = g.MemberCount
+	default:
+		c.CreatedAt = g.CreatedAt
+	}
+	return c
+}
+
+func encodeGroupCursor(c models.GroupCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeGroupCursor проверяет, что токен выдан для того же порядка сортировки
+func decodeGroupCursor(token string, q models.GroupQuery) (*models.GroupCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid page_token", models.ErrInvalidGroupQuery)
+	}
+
+	var c models.GroupCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, fmt.Errorf("%w: invalid page_token", models.ErrInvalidGroupQuery)
+	}
+	if c.SortBy != q.SortBy || c.Desc != q.Desc {
+		return nil, fmt.Errorf("%w: page_token was issued for another sort order", models.ErrInvalidGroupQuery)
+	}
+
+	return &c, nil
+}
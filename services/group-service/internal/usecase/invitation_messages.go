@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"group_service/internal/models"
+	"log"
+	"strings"
+	"time"
+)
+
+// invitationMessages - тексты письма с приглашением, %s подставляются по порядку
+type invitationMessages struct {
+	Subject    string // название группы
+	Greeting   string // имя получателя
+	Invited    string // название группы
+	Link       string // ссылка на вступление
+	Code       string // код приглашения
+	ExpiresAt  string // срок действия с часовым поясом
+	DateLayout string
+}
+
+var invitationTexts = map[string]invitationMessages{
+	"ru": {
+		Subject:    "Приглашение в группу %s",
+		Greeting:   "Здравствуйте, %s!\n\n",
+		Invited:    "Вас пригласили в группу «%s».\n\n",
+		Link:       "Чтобы вступить, перейдите по ссылке:\n%s\n\n",
+		Code:       "Код приглашения: %s\n",
+		ExpiresAt:  "Приглашение действует до %s. Если у вас еще нет аккаунта, зарегистрируйтесь и введите код.",
+		DateLayout: "02.01.2006 15:04",
+	},
+	"en": {
+		Subject:    "Invitation to the group %s",
+		Greeting:   "Hello, %s!\n\n",
+		Invited:    "You have been invited to the group \"%s\".\n\n",
+		Link:       "To join, follow the link:\n%s\n\n",
+		Code:       "Invitation code: %s\n",
+		ExpiresAt:  "The invitation is valid until %s. If you do not have an account yet, sign up and enter the code.",
+		DateLayout: "Jan 2, 2006 15:04",
+	},
+}
+
+// invitationTextsFor выбирает тексты по основному языку локали: en-US -> en
+func invitationTextsFor(locale string) invitationMessages {
+	lang, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	if texts, ok := invitationTexts[lang]; ok {
+		return texts
+	}
+	return invitationTexts[models.DefaultLocale]
+}
+
+// invitationRecipient возвращает язык и часовой пояс адресата, если адрес уже зарегистрирован.
+// Незарегистрированный адрес или недоступный user-service - значения по умолчанию.
+func (u *InvitationsUsecase) invitationRecipient(ctx context.Context, email string) (string, *time.Location) {
+	locale, timezone := models.DefaultLocale, models.DefaultTimezone
+
+	users, err := u.userClient.ResolveUsers(ctx, nil, []string{email})
+	if err != nil {
+		log.Printf("failed to resolve invitation recipient preferences, using defaults: %v", err)
+	}
+	for _, user := range users {
+		if !strings.EqualFold(user.Email, email) {
+			continue
+		}
+		if user.Locale != "" {
+			locale = user.Locale
+		}
+		if user.Timezone != "" {
+			timezone = user.Timezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return locale, loc
+}
+
+func (u *InvitationsUsecase) invitationMail(group *models.Group, inv *models.GroupInvitation, recipientName, locale string, loc *time.Location) (string, string) {
+	texts := invitationTextsFor(locale)
+
+	var b strings.Builder
+	if recipientName != "" {
+		fmt.Fprintf(&b, texts.Greeting, recipientName)
+	}
+	fmt.Fprintf(&b, texts.Invited, group.Name)
+	if link := u.JoinLink(inv.Code); link != "" {
+		fmt.Fprintf(&b, texts.Link, link)
+	}
+	fmt.Fprintf(&b, texts.Code, inv.Code)
+	// пояс указывается явно, иначе время непонятно получателю в другом поясе
+	expiresAt := fmt.Sprintf("%s (%s)", inv.ExpiresAt.In(loc).Format(texts.DateLayout), loc)
+	fmt.Fprintf(&b, texts.ExpiresAt, expiresAt)
+
+	return fmt.Sprintf(texts.Subject, group.Name), b.String()
+}
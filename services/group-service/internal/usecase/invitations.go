@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultInvitationTTL = 7 * 24 * time.Hour
+	maxInvitationTTL     = 90 * 24 * time.Hour
+
+	invitationCodeLength = 10
+	// без похожих символов 0/O и 1/I, код диктуют и вводят вручную
+	invitationCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+	joinCodePlaceholder = "{code}"
+)
+
+type InvitationsRepo interface {
+	CreateInvitation(ctx context.Context, inv *models.GroupInvitation) error
+	GetInvitationByCode(ctx context.Context, code string) (*models.GroupInvitation, error)
+	ListInvitations(ctx context.Context, groupID string) ([]*models.GroupInvitation, error)
+	RevokeInvitation(ctx context.Context, groupID, invitationID string, now time.Time) error
+	RedeemInvitation(ctx context.Context, inv *models.GroupInvitation, studentID string, now time.Time) error
+}
+
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+type CreateInvitationParams struct {
+	GroupID   string
+	UserID    string
+	MaxUses   int
+	ExpiresAt *time.Time
+	Email     string
+	// имя получателя для обращения в письме, необязательно
+	RecipientName string
+}
+
+type InvitationsUsecase struct {
+	invitationsRepo InvitationsRepo
+	groupsRepo      GroupsRepo
+	userClient      UserClient
+	outbox          Outbox
+	topic           string
+	mailer          Mailer
+	joinURL         string
+	now             func() time.Time
+}
+
+// NewInvitationsUsecase - joinURL содержит плейсхолдер {code}, пустой joinURL отключает ссылки
+func NewInvitationsUsecase(invitationsRepo InvitationsRepo, groupsRepo GroupsRepo, userClient UserClient, outbox Outbox, topic string, mailer Mailer, joinURL string) *InvitationsUsecase {
+	return &InvitationsUsecase{
+		invitationsRepo: invitationsRepo,
+		groupsRepo:      groupsRepo,
+		userClient:      userClient,
+		outbox:          outbox,
+		topic:           topic,
+		mailer:          mailer,
+		joinURL:         joinURL,
+		now:             time.Now,
+	}
+}
+
+// JoinLink возвращает ссылку на вступление по коду
+func (u *InvitationsUsecase) JoinLink(code string) string {
+	if u.joinURL == "" {
+		return ""
+	}
+	return strings.ReplaceAll(u.joinURL, joinCodePlaceholder, code)
+}
+
+func (u *InvitationsUsecase) CreateInvitation(ctx context.Context, p CreateInvitationParams) (*models.GroupInvitation, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, p.GroupID, p.UserID, models.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	expiresAt := now.Add(defaultInvitationTTL)
+	if p.ExpiresAt != nil {
+		expiresAt = *p.ExpiresAt
+	}
+	if !expiresAt.After(now) || expiresAt.Sub(now) > maxInvitationTTL {
+		return nil, fmt.Errorf("%w: expires_at must be in the future and within %d days", models.ErrInvalidInvitation, int(maxInvitationTTL.Hours()/24))
+	}
+	if p.MaxUses < 0 {
+		return nil, fmt.Errorf("%w: max_uses cannot be negative", models.ErrInvalidInvitation)
+	}
+
+	email := strings.TrimSpace(p.Email)
+	maxUses := p.MaxUses
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return nil, fmt.Errorf("%w: invalid email", models.ErrInvalidInvitation)
+		}
+		maxUses = 1
+	}
+
+	code, err := newInvitationCode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation code: %w", err)
+	}
+
+	inv := &models.GroupInvitation{
+		ID:        uuid.New().String(),
+		GroupID:   group.ID,
+		Code:      code,
+		Email:     email,
+		CreatedBy: p.UserID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := u.invitationsRepo.CreateInvitation(ctx, inv); err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	// приглашение уже создано, при ошибке отправки репетитор может передать код сам
+	if email != "" {
+		locale, loc := u.invitationRecipient(ctx, email)
+		subject, body := u.invitationMail(group, inv, strings.TrimSpace(p.RecipientName), locale, loc)
+		if err := u.mailer.Send(ctx, email, subject, body); err != nil {
+			log.Printf("failed to send invitation %s: %v", inv.ID, err)
+		}
+	}
+
+	return inv, nil
+}
+
+func (u *InvitationsUsecase) ListInvitations(ctx context.Context, groupID, userID string) ([]*models.GroupInvitation, error) {
+	if _, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	invitations, err := u.invitationsRepo.ListInvitations(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (u *InvitationsUsecase) RevokeInvitation(ctx context.Context, groupID, userID, invitationID string) error {
+	if _, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+		return err
+	}
+
+	if err := u.invitationsRepo.RevokeInvitation(ctx, groupID, invitationID, u.now()); err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	return nil
+}
+
+// JoinGroup добавляет пользователя в группу по коду приглашения.
+// Повторное вступление возвращает группу и не расходует приглашение.
+// Если группа заполнена, приглашение расходуется, ученик встает в очередь и возвращается true.
+func (u *InvitationsUsecase) JoinGroup(ctx context.Context, userID, code string) (*models.Group, bool, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	inv, err := u.invitationsRepo.GetInvitationByCode(ctx, code)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	group, err := u.groupsRepo.GetGroup(ctx, inv.GroupID, false)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get group: %w", err)
+	}
+	if group.Archived() {
+		return nil, false, models.ErrGroupArchived
+	}
+	role, err := roleInGroup(ctx, u.groupsRepo, group, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if role != "" {
+		return nil, false, models.ErrOwnerCannotJoin
+	}
+	if err := ensureStudent(ctx, u.userClient, userID); err != nil {
+		return nil, false, err
+	}
+
+	now := u.now()
+	if err := inv.CheckUsable(now); err != nil {
+		return nil, false, err
+	}
+
+	waitlisted := false
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		err := u.invitationsRepo.RedeemInvitation(ctx, inv, userID, now)
+		switch {
+		case errors.Is(err, models.ErrAlreadyMember):
+			return nil
+		case errors.Is(err, models.ErrWaitlisted):
+			// группа заполнена: приглашение использовано, ученик стоит в очереди
+			waitlisted = true
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to join group: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.addMembers(events.MembersAdded, group, []string{userID}, events.MembersSourceInvitation, "")
+		return batch.save(ctx, u.outbox, u.topic, group.ID)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return group, waitlisted, nil
+}
+
+func newInvitationCode() (string, error) {
+	b := make([]byte, invitationCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	// алфавит из 32 символов, остаток от деления не смещает распределение
+	for i := range b {
+		b[i] = invitationCodeAlphabet[int(b[i])%len(invitationCodeAlphabet)]
+	}
+	return string(b), nil
+}
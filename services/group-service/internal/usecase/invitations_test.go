@@ -0,0 +1,304 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockInvitationsRepo struct {
+	invitations map[string]*models.GroupInvitation
+	members     map[string]bool
+	full        bool // группа заполнена, вступившие встают в очередь
+	waitlist    []string
+}
+
+func newMockInvitationsRepo() *mockInvitationsRepo {
+	return &mockInvitationsRepo{
+		invitations: make(map[string]*models.GroupInvitation),
+		members:     make(map[string]bool),
+	}
+}
+
+func (m *mockInvitationsRepo) CreateInvitation(ctx context.Context, inv *models.GroupInvitation) error {
+	m.invitations[inv.Code] = inv
+	return nil
+}
+
+func (m *mockInvitationsRepo) GetInvitationByCode(ctx context.Context, code string) (*models.GroupInvitation, error) {
+	inv, ok := m.invitations[code]
+	if !ok {
+		return nil, models.ErrInvitationNotFound
+	}
+	return inv, nil
+}
+
+func (m *mockInvitationsRepo) ListInvitations(ctx context.Context, groupID string) ([]*models.GroupInvitation, error) {
+	var result []*models.GroupInvitation
+	for _, inv := range m.invitations {
+		if inv.GroupID == groupID {
+			result = append(result, inv)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockInvitationsRepo) RevokeInvitation(ctx context.Context, groupID, invitationID string, now time.Time) error {
+	for _, inv := range m.invitations {
+		if inv.ID == invitationID && inv.GroupID == groupID && inv.RevokedAt == nil {
+			inv.RevokedAt = &now
+			return nil
+		}
+	}
+	return models.ErrInvitationNotFound
+}
+
+func (m *mockInvitationsRepo) RedeemInvitation(ctx context.Context, inv *models.GroupInvitation, studentID string, now time.Time) error {
+	if err := inv.CheckUsable(now); err != nil {
+		return models.ErrInvitationExhausted
+	}
+	key := inv.GroupID + "/" + studentID
+	if m.members[key] {
+		return models.ErrAlreadyMember
+	}
+	inv.UseCount++
+	if m.full {
+		m.waitlist = append(m.waitlist, studentID)
+		return models.ErrWaitlisted
+	}
+	m.members[key] = true
+	return nil
+}
+
+type mockMailer struct {
+	to      []string
+	subject []string
+	body    []string
+}
+
+func (m *mockMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.to = append(m.to, to)
+	m.subject = append(m.subject, subject)
+	m.body = append(m.body, body)
+	return nil
+}
+
+// groupRepoFor возвращает mockRepo, который всегда отдает группу
+func groupRepoFor(group *models.Group) *mockRepo {
+	return &mockRepo{getGroupFirstResult: group, getGroupSecondResult: group}
+}
+
+func newTestInvitationsUsecase() (*usecase.InvitationsUsecase, *mockInvitationsRepo, *mockMailer) {
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"}
+	invRepo := newMockInvitationsRepo()
+	mailer := &mockMailer{}
+	user := &mockUserClient{users: []*models.UserInfo{
+		{ID: "student1", Email: "student1@example.com", IsStudent: true},
+		{ID: "parent1", Email: "parent1@example.com"},
+		{ID: "student2", Email: "john@example.com", IsStudent: true, Locale: "en-GB", Timezone: "Asia/Tokyo"},
+	}}
+	u := usecase.NewInvitationsUsecase(invRepo, groupRepoFor(group), user, &mockOutbox{}, "group-events", mailer, "https://tutors.local/join?code={code}")
+	return u, invRepo, mailer
+}
+
+func TestCreateInvitation_Code(t *testing.T) {
+	ctx := context.Background()
+	u, _, mailer := newTestInvitationsUsecase()
+
+	inv, err := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", MaxUses: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.Code) != 10 || inv.Code != strings.ToUpper(inv.Code) {
+		t.Errorf("unexpected code %q", inv.Code)
+	}
+	if inv.MaxUses != 30 {
+		t.Errorf("expected max uses 30, got %d", inv.MaxUses)
+	}
+	if d := time.Until(inv.ExpiresAt); d < 6*24*time.Hour || d > 7*24*time.Hour {
+		t.Errorf("expected default expiry in 7 days, got %v", inv.ExpiresAt)
+	}
+	if u.JoinLink(inv.Code) != "https://tutors.local/join?code="+inv.Code {
+		t.Errorf("unexpected link %s", u.JoinLink(inv.Code))
+	}
+	if len(mailer.to) != 0 {
+		t.Error("expected no emails for code invitation")
+	}
+}
+
+func TestCreateInvitation_EmailIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	u, _, mailer := newTestInvitationsUsecase()
+
+	inv, err := u.CreateInvitation(ctx, usecase.CreateInvitationParams{
+		GroupID: "group1",
+		UserID:  "tutor1",
+		MaxUses: 10,
+		Email:   " new.student@example.com ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.MaxUses != 1 || inv.Email != "new.student@example.com" {
+		t.Errorf("expected single-use invitation for new.student@example.com, got %+v", inv)
+	}
+	if len(mailer.to) != 1 || mailer.to[0] != "new.student@example.com" {
+		t.Fatalf("expected invitation email, got %v", mailer.to)
+	}
+	if !strings.Contains(mailer.body[0], inv.Code) || !strings.Contains(mailer.body[0], u.JoinLink(inv.Code)) {
+		t.Error("expected email to contain code and link")
+	}
+	// адрес не зарегистрирован: язык и пояс по умолчанию, пояс указан явно
+	if mailer.subject[0] != "Приглашение в группу Math 10A" || !strings.Contains(mailer.body[0], inv.ExpiresAt.UTC().Format("02.01.2006 15:04")+" (UTC)") {
+		t.Errorf("expected default locale and UTC expiry, got %q: %s", mailer.subject[0], mailer.body[0])
+	}
+}
+
+func TestCreateInvitation_EmailInRecipientLocale(t *testing.T) {
+	ctx := context.Background()
+	u, _, mailer := newTestInvitationsUsecase()
+
+	inv, err := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", Email: "John@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	if mailer.subject[0] != "Invitation to the group Math 10A" {
+		t.Errorf("expected english subject, got %q", mailer.subject[0])
+	}
+	if !strings.Contains(mailer.body[0], inv.ExpiresAt.In(tokyo).Format("Jan 2, 2006 15:04")+" (Asia/Tokyo)") {
+		t.Errorf("expected expiry in recipient timezone, got %s", mailer.body[0])
+	}
+}
+
+func TestCreateInvitation_Validation(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+	tooFar := time.Now().Add(365 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		params  usecase.CreateInvitationParams
+		wantErr error
+	}{
+		{"not owner", usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor2"}, models.ErrTutorIsNotValid},
+		{"expired", usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", ExpiresAt: &past}, models.ErrInvalidInvitation},
+		{"too long", usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", ExpiresAt: &tooFar}, models.ErrInvalidInvitation},
+		{"negative uses", usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", MaxUses: -1}, models.ErrInvalidInvitation},
+		{"bad email", usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", Email: "not-an-email"}, models.ErrInvalidInvitation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, invRepo, _ := newTestInvitationsUsecase()
+			if _, err := u.CreateInvitation(ctx, tt.params); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(invRepo.invitations) != 0 {
+				t.Error("expected no invitation to be created")
+			}
+		})
+	}
+}
+
+func TestJoinGroup_Success(t *testing.T) {
+	ctx := context.Background()
+	u, invRepo, _ := newTestInvitationsUsecase()
+	inv, _ := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", MaxUses: 2})
+
+	group, waitlisted, err := u.JoinGroup(ctx, "student1", " "+strings.ToLower(inv.Code)+" ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.ID != "group1" || waitlisted || !invRepo.members["group1/student1"] {
+		t.Error("expected student to join group1")
+	}
+
+	// повторное вступление не расходует приглашение
+	if _, _, err := u.JoinGroup(ctx, "student1", inv.Code); err != nil {
+		t.Fatalf("unexpected error on repeated join: %v", err)
+	}
+	if inv.UseCount != 1 {
+		t.Errorf("expected use count 1, got %d", inv.UseCount)
+	}
+}
+
+func TestJoinGroup_Waitlisted(t *testing.T) {
+	ctx := context.Background()
+	u, invRepo, _ := newTestInvitationsUsecase()
+	inv, _ := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", MaxUses: 2})
+	invRepo.full = true
+
+	group, waitlisted, err := u.JoinGroup(ctx, "student1", inv.Code)
+	if err != nil {
+		t.Fatalf("expected waitlisted join to succeed, got %v", err)
+	}
+	if group.ID != "group1" || !waitlisted {
+		t.Errorf("expected student to be waitlisted in group1, got %v", waitlisted)
+	}
+	if len(invRepo.waitlist) != 1 || invRepo.members["group1/student1"] {
+		t.Error("expected student on the waitlist, not in the group")
+	}
+}
+
+func TestJoinGroup_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		prepare func(inv *models.GroupInvitation)
+		userID  string
+		code    string
+		wantErr error
+	}{
+		{"unknown code", nil, "student1", "UNKNOWN", models.ErrInvitationNotFound},
+		{"owner", nil, "tutor1", "", models.ErrOwnerCannotJoin},
+		{"not a student", nil, "parent1", "", models.ErrNotStudent},
+		{"unknown user", nil, "ghost", "", models.ErrNotStudent},
+		{"expired", func(inv *models.GroupInvitation) { inv.ExpiresAt = time.Now().Add(-time.Minute) }, "student1", "", models.ErrInvitationExpired},
+		{"revoked", func(inv *models.GroupInvitation) { now := time.Now(); inv.RevokedAt = &now }, "student1", "", models.ErrInvitationRevoked},
+		{"exhausted", func(inv *models.GroupInvitation) { inv.UseCount = inv.MaxUses }, "student1", "", models.ErrInvitationExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, invRepo, _ := newTestInvitationsUsecase()
+			inv, _ := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1", MaxUses: 1})
+			if tt.prepare != nil {
+				tt.prepare(inv)
+			}
+			code := tt.code
+			if code == "" {
+				code = inv.Code
+			}
+
+			if _, _, err := u.JoinGroup(ctx, tt.userID, code); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(invRepo.members) != 0 {
+				t.Error("expected no members to be added")
+			}
+		})
+	}
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	ctx := context.Background()
+	u, _, _ := newTestInvitationsUsecase()
+	inv, _ := u.CreateInvitation(ctx, usecase.CreateInvitationParams{GroupID: "group1", UserID: "tutor1"})
+
+	if err := u.RevokeInvitation(ctx, "group1", "tutor2", inv.ID); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Fatalf("expected ErrTutorIsNotValid, got %v", err)
+	}
+	if err := u.RevokeInvitation(ctx, "group1", "tutor1", inv.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := u.JoinGroup(ctx, "student1", inv.Code); !errors.Is(err, models.ErrInvitationRevoked) {
+		t.Errorf("expected ErrInvitationRevoked, got %v", err)
+	}
+}
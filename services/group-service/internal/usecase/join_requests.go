@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxJoinRequestTextLength = 500
+
+type JoinRequestsRepo interface {
+	// CreateJoinRequest и DecideJoinRequest возвращают true, если ученик добавлен в группу
+	CreateJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error)
+	GetJoinRequest(ctx context.Context, groupID, requestID string) (*models.JoinRequest, error)
+	ListJoinRequests(ctx context.Context, groupID string, status models.JoinRequestStatus) ([]*models.JoinRequest, error)
+	DecideJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error)
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, topic string, key string, value any) error
+}
+
+type JoinRequestsUsecase struct {
+	joinRequestsRepo JoinRequestsRepo
+	groupsRepo       GroupsRepo
+	userClient       UserClient
+	outbox           Outbox
+	topic            string
+	now              func() time.Time
+}
+
+func NewJoinRequestsUsecase(joinRequestsRepo JoinRequestsRepo, groupsRepo GroupsRepo, userClient UserClient, outbox Outbox, topic string) *JoinRequestsUsecase {
+	return &JoinRequestsUsecase{
+		joinRequestsRepo: joinRequestsRepo,
+		groupsRepo:       groupsRepo,
+		userClient:       userClient,
+		outbox:           outbox,
+		topic:            topic,
+		now:              time.Now,
+	}
+}
+
+// RequestToJoin создает заявку на вступление. В открытую группу ученик добавляется сразу,
+// заявка сохраняется одобренной.
+func (u *JoinRequestsUsecase) RequestToJoin(ctx context.Context, groupID, studentID, message string) (*models.JoinRequest, error) {
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > maxJoinRequestTextLength {
+		return nil, fmt.Errorf("%w: message is longer than %d characters", models.ErrInvalidJoinRequest, maxJoinRequestTextLength)
+	}
+
+	group, err := u.groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+	if group.Archived() {
+		return nil, models.ErrGroupArchived
+	}
+	role, err := roleInGroup(ctx, u.groupsRepo, group, studentID)
+	if err != nil {
+		return nil, err
+	}
+	if role != "" {
+		return nil, models.ErrOwnerCannotJoin
+	}
+	if group.JoinPolicy != models.JoinPolicyOpen && group.JoinPolicy != models.JoinPolicyRequest {
+		return nil, models.ErrJoinByRequestDisabled
+	}
+
+	member, err := u.groupsRepo.IsMember(ctx, groupID, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member {
+		return nil, models.ErrAlreadyMember
+	}
+	if err := ensureStudent(ctx, u.userClient, studentID); err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	req := &models.JoinRequest{
+		ID:        uuid.New().String(),
+		GroupID:   groupID,
+		StudentID: studentID,
+		Message:   message,
+		Status:    models.JoinRequestPending,
+		CreatedAt: now,
+	}
+	if group.JoinPolicy == models.JoinPolicyOpen {
+		req.Status = models.JoinRequestApproved
+		req.DecidedAt = &now
+	}
+
+	if err := u.saveJoin(ctx, group, req, u.joinRequestsRepo.CreateJoinRequest); err != nil {
+		if errors.Is(err, models.ErrJoinRequestExists) || errors.Is(err, models.ErrAlreadyMember) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create join request: %w", err)
+	}
+
+	return req, nil
+}
+
+// ListJoinRequests - заявки группы для репетитора, по умолчанию ожидающие решения
+func (u *JoinRequestsUsecase) ListJoinRequests(ctx context.Context, groupID, userID string, status models.JoinRequestStatus) ([]*models.JoinRequest, error) {
+	if _, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	if status == "" {
+		status = models.JoinRequestPending
+	}
+
+	requests, err := u.joinRequestsRepo.ListJoinRequests(ctx, groupID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list join requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+func (u *JoinRequestsUsecase) ApproveJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.JoinRequest, error) {
+	return u.decide(ctx, groupID, userID, requestID, models.JoinRequestApproved, "")
+}
+
+func (u *JoinRequestsUsecase) RejectJoinRequest(ctx context.Context, groupID, userID, requestID, reason string) (*models.JoinRequest, error) {
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxJoinRequestTextLength {
+		return nil, fmt.Errorf("%w: reason is longer than %d characters", models.ErrInvalidJoinRequest, maxJoinRequestTextLength)
+	}
+	return u.decide(ctx, groupID, userID, requestID, models.JoinRequestRejected, reason)
+}
+
+func (u *JoinRequestsUsecase) decide(ctx context.Context, groupID, userID, requestID string, status models.JoinRequestStatus, reason string) (*models.JoinRequest, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := u.joinRequestsRepo.GetJoinRequest(ctx, groupID, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get join request: %w", err)
+	}
+	if req.Status != models.JoinRequestPending {
+		return nil, models.ErrJoinRequestDecided
+	}
+
+	now := u.now()
+	req.Status = status
+	req.DecidedBy = userID
+	req.DecidedAt = &now
+	req.RejectReason = reason
+
+	if err := u.saveJoin(ctx, group, req, u.joinRequestsRepo.DecideJoinRequest); err != nil {
+		if errors.Is(err, models.ErrJoinRequestDecided) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to decide join request: %w", err)
+	}
+
+	return req, nil
+}
+
+// saveJoin сохраняет заявку или решение по ней, вступление ученика и уведомление о заявке
+// попадают в outbox в той же транзакции. Если группа заполнена, заявка сохраняется одобренной,
+// а ученик встает в очередь.
+func (u *JoinRequestsUsecase) saveJoin(ctx context.Context, group *models.Group, req *models.JoinRequest,
+	save func(ctx context.Context, req *models.JoinRequest) (bool, error)) error {
+	return u.outbox.InTx(ctx, func(ctx context.Context) error {
+		added, err := save(ctx, req)
+		switch {
+		case errors.Is(err, models.ErrWaitlisted):
+			req.Waitlisted = true
+		case err != nil:
+			return err
+		}
+
+		var batch outboxBatch
+		// без added ученик уже состоит в группе, например вступил по приглашению
+		if added {
+			batch.addMembers(events.MembersAdded, group, []string{req.StudentID}, events.MembersSourceJoinRequest, req.DecidedBy)
+		}
+		batch.add(joinRequestEventType(req.Status), events.JoinRequestPayload{
+			RequestID:    req.ID,
+			GroupID:      group.ID,
+			GroupName:    group.Name,
+			TutorID:      group.TutorID,
+			StudentID:    req.StudentID,
+			Message:      req.Message,
+			RejectReason: req.RejectReason,
+			AutoApproved: req.Status == models.JoinRequestApproved && req.DecidedBy == "",
+			Waitlisted:   req.Waitlisted,
+		})
+		return batch.save(ctx, u.outbox, u.topic, group.ID)
+	})
+}
+
+func joinRequestEventType(status models.JoinRequestStatus) string {
+	switch status {
+	case models.JoinRequestApproved:
+		return events.JoinRequestApproved
+	case models.JoinRequestRejected:
+		return events.JoinRequestRejected
+	default:
+		return events.JoinRequestCreated
+	}
+}
@@ -0,0 +1,290 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockJoinRequestsRepo struct {
+	requests map[string]*models.JoinRequest
+	members  map[string]bool
+	full     bool // группа заполнена, одобренные встают в очередь
+}
+
+func newMockJoinRequestsRepo() *mockJoinRequestsRepo {
+	return &mockJoinRequestsRepo{
+		requests: make(map[string]*models.JoinRequest),
+		members:  make(map[string]bool),
+	}
+}
+
+func (m *mockJoinRequestsRepo) CreateJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error) {
+	for _, r := range m.requests {
+		if r.GroupID == req.GroupID && r.StudentID == req.StudentID && r.Status == models.JoinRequestPending {
+			return false, models.ErrJoinRequestExists
+		}
+	}
+	copied := *req
+	m.requests[req.ID] = &copied
+	return m.addMember(req)
+}
+
+// addMember добавляет ученика одобренной заявки, false - если он уже в группе
+func (m *mockJoinRequestsRepo) addMember(req *models.JoinRequest) (bool, error) {
+	key := req.GroupID + "/" + req.StudentID
+	if req.Status != models.JoinRequestApproved || m.members[key] {
+		return false, nil
+	}
+	if m.full {
+		return false, models.ErrWaitlisted
+	}
+	m.members[key] = true
+	return true, nil
+}
+
+func (m *mockJoinRequestsRepo) GetJoinRequest(ctx context.Context, groupID, requestID string) (*models.JoinRequest, error) {
+	r, ok := m.requests[requestID]
+	if !ok || r.GroupID != groupID {
+		return nil, models.ErrJoinRequestNotFound
+	}
+	copied := *r
+	return &copied, nil
+}
+
+func (m *mockJoinRequestsRepo) ListJoinRequests(ctx context.Context, groupID string, status models.JoinRequestStatus) ([]*models.JoinRequest, error) {
+	var result []*models.JoinRequest
+	for _, r := range m.requests {
+		if r.GroupID == groupID && r.Status == status {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockJoinRequestsRepo) DecideJoinRequest(ctx context.Context, req *models.JoinRequest) (bool, error) {
+	stored, ok := m.requests[req.ID]
+	if !ok || stored.Status != models.JoinRequestPending {
+		return false, models.ErrJoinRequestDecided
+	}
+	*stored = *req
+	return m.addMember(req)
+}
+
+type mockPublisher struct {
+	events []events.Envelope
+	keys   []string
+}
+
+func (m *mockPublisher) Publish(ctx context.Context, topic, key string, value any) error {
+	m.events = append(m.events, value.(events.Envelope))
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func newTestJoinRequestsUsecase(policy models.JoinPolicy) (*usecase.JoinRequestsUsecase, *mockRepo, *mockJoinRequestsRepo, *mockOutbox) {
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", JoinPolicy: policy}
+	groups := groupRepoFor(group)
+	repo := newMockJoinRequestsRepo()
+	outbox := &mockOutbox{}
+	return usecase.NewJoinRequestsUsecase(repo, groups, &mockUserClient{}, outbox, "group-events"), groups, repo, outbox
+}
+
+func TestRequestToJoin_RequestPolicy(t *testing.T) {
+	ctx := context.Background()
+	u, _, repo, outbox := newTestJoinRequestsUsecase(models.JoinPolicyRequest)
+
+	req, err := u.RequestToJoin(ctx, "group1", "student1", "  Хочу готовиться к ЕГЭ  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Status != models.JoinRequestPending || req.Message != "Хочу готовиться к ЕГЭ" {
+		t.Errorf("unexpected request %+v", req)
+	}
+	if repo.members["group1/student1"] {
+		t.Error("expected student not to be added before approval")
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.JoinRequestCreated || outbox.keys[0] != "group1" {
+		t.Fatalf("expected JoinRequestCreated keyed by group, got %+v", outbox.events)
+	}
+
+	if _, err := u.RequestToJoin(ctx, "group1", "student1", ""); !errors.Is(err, models.ErrJoinRequestExists) {
+		t.Errorf("expected ErrJoinRequestExists, got %v", err)
+	}
+}
+
+func TestRequestToJoin_OpenPolicyJoinsImmediately(t *testing.T) {
+	ctx := context.Background()
+	u, _, repo, outbox := newTestJoinRequestsUsecase(models.JoinPolicyOpen)
+
+	req, err := u.RequestToJoin(ctx, "group1", "student1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Status != models.JoinRequestApproved || req.DecidedAt == nil {
+		t.Errorf("expected auto-approved request, got %+v", req)
+	}
+	if !repo.members["group1/student1"] {
+		t.Error("expected student to be added")
+	}
+	approved := outbox.ofType(events.JoinRequestApproved)
+	if len(approved) != 1 {
+		t.Fatalf("expected JoinRequestApproved, got %v", outbox.types())
+	}
+	if !strings.Contains(string(approved[0].Payload), `"auto_approved":true`) {
+		t.Errorf("expected auto_approved in payload, got %s", approved[0].Payload)
+	}
+}
+
+func TestRequestToJoin_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		policy    models.JoinPolicy
+		studentID string
+		message   string
+		isMember  bool
+		wantErr   error
+	}{
+		{"invite only", models.JoinPolicyInviteOnly, "student1", "", false, models.ErrJoinByRequestDisabled},
+		{"owner", models.JoinPolicyRequest, "tutor1", "", false, models.ErrOwnerCannotJoin},
+		{"already member", models.JoinPolicyRequest, "student1", "", true, models.ErrAlreadyMember},
+		{"long message", models.JoinPolicyRequest, "student1", strings.Repeat("я", 501), false, models.ErrInvalidJoinRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, groups, repo, outbox := newTestJoinRequestsUsecase(tt.policy)
+			groups.isMember = tt.isMember
+
+			if _, err := u.RequestToJoin(ctx, "group1", tt.studentID, tt.message); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(repo.requests) != 0 || len(outbox.events) != 0 {
+				t.Error("expected no request and no events")
+			}
+		})
+	}
+}
+
+func TestRequestToJoin_NotStudent(t *testing.T) {
+	ctx := context.Background()
+	group := &models.Group{ID: "group1", TutorID: "tutor1", JoinPolicy: models.JoinPolicyOpen}
+	repo := newMockJoinRequestsRepo()
+	users := &mockUserClient{users: []*models.UserInfo{{ID: "tutor2", IsTutor: true}}}
+	u := usecase.NewJoinRequestsUsecase(repo, groupRepoFor(group), users, &mockOutbox{}, "group-events")
+
+	if _, err := u.RequestToJoin(ctx, "group1", "tutor2", ""); !errors.Is(err, models.ErrNotStudent) {
+		t.Fatalf("expected ErrNotStudent, got %v", err)
+	}
+	if len(repo.requests) != 0 || len(repo.members) != 0 {
+		t.Error("expected no request and no member")
+	}
+}
+
+func TestApproveJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	u, _, repo, outbox := newTestJoinRequestsUsecase(models.JoinPolicyRequest)
+	req, _ := u.RequestToJoin(ctx, "group1", "student1", "")
+
+	if _, err := u.ApproveJoinRequest(ctx, "group1", "tutor2", req.ID); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Fatalf("expected ErrTutorIsNotValid, got %v", err)
+	}
+
+	approved, err := u.ApproveJoinRequest(ctx, "group1", "tutor1", req.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if approved.Status != models.JoinRequestApproved || approved.DecidedBy != "tutor1" {
+		t.Errorf("unexpected request %+v", approved)
+	}
+	if !repo.members["group1/student1"] {
+		t.Error("expected student to be added")
+	}
+	if last := outbox.events[len(outbox.events)-1]; last.EventType != events.JoinRequestApproved {
+		t.Errorf("expected JoinRequestApproved, got %s", last.EventType)
+	}
+
+	if _, err := u.RejectJoinRequest(ctx, "group1", "tutor1", req.ID, ""); !errors.Is(err, models.ErrJoinRequestDecided) {
+		t.Errorf("expected ErrJoinRequestDecided, got %v", err)
+	}
+}
+
+func TestApproveJoinRequest_Waitlisted(t *testing.T) {
+	ctx := context.Background()
+	u, _, repo, outbox := newTestJoinRequestsUsecase(models.JoinPolicyRequest)
+	req, _ := u.RequestToJoin(ctx, "group1", "student1", "")
+	repo.full = true
+
+	approved, err := u.ApproveJoinRequest(ctx, "group1", "tutor1", req.ID)
+	if err != nil {
+		t.Fatalf("expected approval to succeed, got %v", err)
+	}
+	if approved.Status != models.JoinRequestApproved || !approved.Waitlisted {
+		t.Errorf("expected approved waitlisted request, got %+v", approved)
+	}
+	if repo.members["group1/student1"] {
+		t.Error("expected student to wait for a free place")
+	}
+	last := outbox.events[len(outbox.events)-1]
+	if last.EventType != events.JoinRequestApproved || !strings.Contains(string(last.Payload), `"waitlisted":true`) {
+		t.Errorf("expected JoinRequestApproved with waitlisted, got %s %s", last.EventType, last.Payload)
+	}
+}
+
+func TestRejectJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	u, _, repo, outbox := newTestJoinRequestsUsecase(models.JoinPolicyRequest)
+	req, _ := u.RequestToJoin(ctx, "group1", "student1", "")
+
+	rejected, err := u.RejectJoinRequest(ctx, "group1", "tutor1", req.ID, "Группа заполнена")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rejected.Status != models.JoinRequestRejected || rejected.RejectReason != "Группа заполнена" {
+		t.Errorf("unexpected request %+v", rejected)
+	}
+	if repo.members["group1/student1"] {
+		t.Error("expected student not to be added")
+	}
+	last := outbox.events[len(outbox.events)-1]
+	if last.EventType != events.JoinRequestRejected || !strings.Contains(string(last.Payload), "Группа заполнена") {
+		t.Errorf("expected JoinRequestRejected with reason, got %s %s", last.EventType, last.Payload)
+	}
+
+	pending, err := u.ListJoinRequests(ctx, "group1", "tutor1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("expected no pending requests, got %d", len(pending))
+	}
+
+	// после отказа ученик может подать новую заявку
+	if _, err := u.RequestToJoin(ctx, "group1", "student1", ""); err != nil {
+		t.Errorf("expected new request to be accepted, got %v", err)
+	}
+}
+
+func TestCreateGroup_JoinPolicy(t *testing.T) {
+	ctx := context.Background()
+	u := usecase.NewGroupsUsecase(&mockRepo{}, &mockOutbox{}, &mockUserClient{validateResult: true}, "group-events")
+
+	group, err := u.CreateGroup(ctx, "tutor1", "Math", "", "", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.JoinPolicy != models.JoinPolicyInviteOnly {
+		t.Errorf("expected invite_only by default, got %s", group.JoinPolicy)
+	}
+
+	if _, err := u.CreateGroup(ctx, "tutor1", "Math", "", "anyone", 0); !errors.Is(err, models.ErrInvalidJoinPolicy) {
+		t.Errorf("expected ErrInvalidJoinPolicy, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"strings"
+)
+
+// maxMembersPerRequest - сколько учеников можно добавить за один запрос,
+// столько же ID и email user-service разрешает за один вызов ResolveUsers
+const maxMembersPerRequest = 500
+
+// AddGroupMembers добавляет учеников по ID или email, сверх лимита группы ученики встают в очередь.
+// Каждый элемент проверяется в user-service: пользователь должен существовать и быть учеником.
+// Возвращает результат по каждому элементу запроса в порядке запроса, без повторов.
+func (u *GroupsUsecase) AddGroupMembers(ctx context.Context, groupId, userId string, inputs []string) ([]*models.AddMemberResult, error) {
+	inputs, err := normalizeMemberInputs(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := authorizeChange(ctx, u.groupsRepo, groupId, userId, models.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := u.resolveStudents(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs := make([]string, 0, len(results))
+	seen := make(map[string]bool, len(results))
+	for _, r := range results {
+		// ID и email одного ученика в запросе добавляют его один раз
+		if r.Status == "" && !seen[r.StudentID] {
+			seen[r.StudentID] = true
+			studentIDs = append(studentIDs, r.StudentID)
+		}
+	}
+
+	var added, waitlisted []string
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if added, waitlisted, err = u.groupsRepo.AddMembers(ctx, groupId, studentIDs); err != nil {
+			return fmt.Errorf("failed to add members: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.addMembers(events.MembersAdded, group, added, events.MembersSourceManual, userId)
+		return batch.save(ctx, u.outbox, u.topic, groupId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]models.AddMemberStatus, len(added)+len(waitlisted))
+	for _, id := range added {
+		statuses[id] = models.AddMemberAdded
+	}
+	for _, id := range waitlisted {
+		statuses[id] = models.AddMemberWaitlisted
+	}
+	for _, r := range results {
+		if r.Status != "" {
+			continue
+		}
+		if status, ok := statuses[r.StudentID]; ok {
+			r.Status = status
+		} else {
+			r.Status = models.AddMemberAlreadyMember
+		}
+	}
+
+	return results, nil
+}
+
+// ListGroupMembers возвращает состав группы. Имена и аватары из user-service добавляются
+// только по запросу и только персоналу и ученикам группы, без профилей список доступен
+// внутренним сервисам. Если user-service недоступен, список возвращается без профилей.
+func (u *GroupsUsecase) ListGroupMembers(ctx context.Context, groupId, userId string, includeProfiles bool) ([]*models.GroupMember, error) {
+	if includeProfiles {
+		if _, _, err := authorizeRead(ctx, u.groupsRepo, groupId, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := u.groupsRepo.GetGroupMembers(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group members: %w", err)
+	}
+
+	if includeProfiles {
+		if err := u.fillProfiles(ctx, members); err != nil {
+			log.Printf("failed to load member profiles for group %s: %v", groupId, err)
+		}
+	}
+
+	return members, nil
+}
+
+func (u *GroupsUsecase) fillProfiles(ctx context.Context, members []*models.GroupMember) error {
+	profiles := make(map[string]*models.UserInfo, len(members))
+	for start := 0; start < len(members); start += maxMembersPerRequest {
+		batch := members[start:min(start+maxMembersPerRequest, len(members))]
+		ids := make([]string, len(batch))
+		for i, m := range batch {
+			ids[i] = m.StudentID
+		}
+
+		users, err := u.userClient.ResolveUsers(ctx, ids, nil)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			profiles[user.ID] = user
+		}
+	}
+
+	for _, m := range members {
+		if p, ok := profiles[m.StudentID]; ok {
+			m.Name, m.Surname, m.AvatarURL = p.Name, p.Surname, p.AvatarURL
+		}
+	}
+	return nil
+}
+
+// normalizeMemberInputs убирает пробелы, пустые строки и повторы, email сравниваются без учета регистра
+func normalizeMemberInputs(inputs []string) ([]string, error) {
+	result := make([]string, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+	for _, in := range inputs {
+		in = strings.TrimSpace(in)
+		if isEmail(in) {
+			in = strings.ToLower(in)
+		}
+		if in == "" || seen[in] {
+			continue
+		}
+		seen[in] = true
+		result = append(result, in)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: at least one student id or email is required", models.ErrInvalidMembers)
+	}
+	if len(result) > maxMembersPerRequest {
+		return nil, fmt.Errorf("%w: at most %d students per request", models.ErrInvalidMembers, maxMembersPerRequest)
+	}
+	return result, nil
+}
+
+// ensureStudent - вступить в группу самому может только ученик
+func ensureStudent(ctx context.Context, userClient UserClient, userID string) error {
+	users, err := userClient.ResolveUsers(ctx, []string{userID}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to resolve user: %w", err)
+	}
+	for _, user := range users {
+		if user.ID == userID && user.IsStudent {
+			return nil
+		}
+	}
+	return models.ErrNotStudent
+}
+
+func isEmail(s string) bool {
+	return strings.Contains(s, "@")
+}
+
+// resolveStudents находит пользователей по ID и email. Для найденных учеников статус
+// остается пустым, его выставляет вызывающий после добавления.
+func (u *GroupsUsecase) resolveStudents(ctx context.Context, inputs []string) ([]*models.AddMemberResult, error) {
+	var ids, emails []string
+	for _, in := range inputs {
+		if isEmail(in) {
+			emails = append(emails, in)
+		} else {
+			ids = append(ids, in)
+		}
+	}
+
+	users, err := u.userClient.ResolveUsers(ctx, ids, emails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve users: %w", err)
+	}
+
+	byKey := make(map[string]*models.UserInfo, 2*len(users))
+	for _, user := range users {
+		byKey[user.ID] = user
+		byKey[strings.ToLower(user.Email)] = user
+	}
+
+	results := make([]*models.AddMemberResult, len(inputs))
+	for i, in := range inputs {
+		r := &models.AddMemberResult{Input: in}
+		switch user, ok := byKey[in]; {
+		case !ok:
+			r.Status = models.AddMemberNotFound
+		case !user.IsStudent:
+			r.StudentID, r.Status = user.ID, models.AddMemberNotAStudent
+		default:
+			r.StudentID = user.ID
+		}
+		results[i] = r
+	}
+	return results, nil
+}
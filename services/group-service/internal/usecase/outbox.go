@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"time"
+)
+
+// outboxBatchSize - сколько событий отправляется за один проход
+const outboxBatchSize = 100
+
+// Outbox сохраняет события в одной транзакции с изменениями, в kafka их отправляет OutboxRelay.
+// Так событие не теряется при сбое отправки и не уходит, если изменение откатилось.
+type Outbox interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AddOutboxEvents(ctx context.Context, topic, key string, envelopes ...events.Envelope) error
+}
+
+type OutboxRepo interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	ClaimOutboxMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error)
+	DeleteOutboxMessages(ctx context.Context, ids []int64) error
+}
+
+// outboxBatch собирает события одной операции, ключ события - ID группы
+type outboxBatch struct {
+	envelopes []events.Envelope
+	err       error
+}
+
+func (b *outboxBatch) add(eventType string, payload any) {
+	if b.err != nil {
+		return
+	}
+	event, err := events.NewEnvelope(eventType, payload)
+	if err != nil {
+		b.err = fmt.Errorf("failed to build %s event: %w", eventType, err)
+		return
+	}
+	b.envelopes = append(b.envelopes, event)
+}
+
+// addSnapshot добавляет GroupCreated или GroupUpdated с текущим составом группы
+func (b *outboxBatch) addSnapshot(eventType string, group *models.Group, members []*models.GroupMember, actorID string) {
+	memberIDs := make([]string, len(members))
+	for i, m := range members {
+		memberIDs[i] = m.StudentID
+	}
+
+	termID := ""
+	if group.Term != nil {
+		termID = group.Term.ID
+	}
+
+	b.add(eventType, events.GroupSnapshotPayload{
+		GroupID:     group.ID,
+		TutorID:     group.TutorID,
+		Name:        group.Name,
+		Description: group.Description,
+		JoinPolicy:  string(group.JoinPolicy),
+		MaxMembers:  group.MaxMembers,
+		TermID:      termID,
+		MemberIDs:   memberIDs,
+		ActorID:     actorID,
+		CreatedAt:   group.CreatedAt,
+		ArchivedAt:  group.ArchivedAt,
+	})
+}
+
+// addMembers добавляет MembersAdded или MembersRemoved, пустой список пропускается
+func (b *outboxBatch) addMembers(eventType string, group *models.Group, studentIDs []string, source, actorID string) {
+	if len(studentIDs) == 0 {
+		return
+	}
+
+	b.add(eventType, events.MembersPayload{
+		GroupID:    group.ID,
+		TutorID:    group.TutorID,
+		StudentIDs: studentIDs,
+		Source:     source,
+		ActorID:    actorID,
+	})
+}
+
+func (b *outboxBatch) save(ctx context.Context, outbox Outbox, topic, groupID string) error {
+	if b.err != nil {
+		return b.err
+	}
+	if err := outbox.AddOutboxEvents(ctx, topic, groupID, b.envelopes...); err != nil {
+		return fmt.Errorf("failed to save events: %w", err)
+	}
+	return nil
+}
+
+// OutboxRelay отправляет сохраненные события в kafka в порядке записи
+type OutboxRelay struct {
+	repo      OutboxRepo
+	publisher EventPublisher
+	batchSize int
+}
+
+func NewOutboxRelay(repo OutboxRepo, publisher EventPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		repo:      repo,
+		publisher: publisher,
+		batchSize: outboxBatchSize,
+	}
+}
+
+// PublishPending отправляет одну пачку событий и возвращает число отправленных.
+// При ошибке отправки уже отправленные удаляются, остальные уйдут на следующем проходе:
+// доставка не реже одного раза, повтор события потребители отличают по event_id.
+func (r *OutboxRelay) PublishPending(ctx context.Context) (int, error) {
+	sent := 0
+	var publishErr error
+	err := r.repo.InTx(ctx, func(ctx context.Context) error {
+		messages, err := r.repo.ClaimOutboxMessages(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(messages))
+		for _, m := range messages {
+			if publishErr = r.publisher.Publish(ctx, m.Topic, m.Key, m.Event); publishErr != nil {
+				publishErr = fmt.Errorf("failed to publish %s event %s: %w", m.Event.EventType, m.Event.EventID, publishErr)
+				break
+			}
+			ids = append(ids, m.ID)
+		}
+
+		if err := r.repo.DeleteOutboxMessages(ctx, ids); err != nil {
+			return err
+		}
+		sent = len(ids)
+		// отправленные удаляются и при ошибке отправки, поэтому транзакция фиксируется
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, publishErr
+}
+
+// Start запускает отправку событий, interval <= 0 отключает ее
+func (r *OutboxRelay) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// полная пачка - вероятно, есть еще события, отправляем без ожидания
+				for {
+					sent, err := r.PublishPending(ctx)
+					if err != nil {
+						log.Printf("outbox relay: %v", err)
+						break
+					}
+					if sent < r.batchSize {
+						break
+					}
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,249 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+// mockOutbox сохраняет события только при успешном завершении транзакции
+type mockOutbox struct {
+	events []events.Envelope
+	keys   []string
+}
+
+func (m *mockOutbox) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := len(m.events)
+	if err := fn(ctx); err != nil {
+		m.events, m.keys = m.events[:saved], m.keys[:saved]
+		return err
+	}
+	return nil
+}
+
+func (m *mockOutbox) AddOutboxEvents(ctx context.Context, topic, key string, envelopes ...events.Envelope) error {
+	for _, e := range envelopes {
+		m.events = append(m.events, e)
+		m.keys = append(m.keys, key)
+	}
+	return nil
+}
+
+func (m *mockOutbox) types() []string {
+	result := make([]string, len(m.events))
+	for i, e := range m.events {
+		result[i] = e.EventType
+	}
+	return result
+}
+
+func (m *mockOutbox) ofType(eventType string) []events.Envelope {
+	var result []events.Envelope
+	for _, e := range m.events {
+		if e.EventType == eventType {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func decodeMembers(t *testing.T, e events.Envelope) events.MembersPayload {
+	t.Helper()
+	var payload events.MembersPayload
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	return payload
+}
+
+func TestGroupEvents_CreateAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	outbox := &mockOutbox{}
+	repo := &mockRepo{}
+	u := usecase.NewGroupsUsecase(repo, outbox, &mockUserClient{validateResult: true}, "group-events")
+
+	group, err := u.CreateGroup(ctx, "tutor1", "Math 10A", "Algebra", models.JoinPolicyOpen, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupCreated || outbox.keys[0] != group.ID {
+		t.Fatalf("expected GroupCreated keyed by group, got %v", outbox.types())
+	}
+	var created events.GroupSnapshotPayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &created); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if created.TutorID != "tutor1" || created.MaxMembers != 2 || created.MemberIDs == nil || len(created.MemberIDs) != 0 {
+		t.Errorf("unexpected payload: %+v", created)
+	}
+
+	// при увеличении лимита ученик из очереди становится участником
+	repo.getGroupFirstResult, repo.getGroupSecondResult = group, group
+	repo.promoted = []string{"student3"}
+	repo.getGroupMembersFunc = func(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
+		return []*models.GroupMember{{StudentID: "student1"}, {StudentID: "student3"}}, nil
+	}
+	maxMembers := 3
+	if _, err := u.UpdateGroup(ctx, group.ID, "tutor1", nil, nil, nil, &maxMembers); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := outbox.types(); len(got) != 4 || got[1] != events.MembersAdded || got[2] != events.GroupWaitlistPromoted || got[3] != events.GroupUpdated {
+		t.Fatalf("expected MembersAdded, GroupWaitlistPromoted and GroupUpdated, got %v", got)
+	}
+	if added := decodeMembers(t, outbox.events[1]); added.Source != events.MembersSourceWaitlist || added.StudentIDs[0] != "student3" {
+		t.Errorf("unexpected payload: %+v", added)
+	}
+	var updated events.GroupSnapshotPayload
+	if err := json.Unmarshal(outbox.events[3].Payload, &updated); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if len(updated.MemberIDs) != 2 || updated.ActorID != "tutor1" {
+		t.Errorf("unexpected payload: %+v", updated)
+	}
+}
+
+func TestGroupEvents_Members(t *testing.T) {
+	ctx := context.Background()
+	outbox := &mockOutbox{}
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1"})
+	repo.addMembersCount = 1
+	u := usecase.NewGroupsUsecase(repo, outbox, &mockUserClient{}, "group-events")
+
+	// второй ученик встал в очередь и в событие не попадает
+	repo.addMembersWaitlisted = 1
+	if _, err := u.AddGroupMembers(ctx, "group1", "tutor1", []string{"student1", "student2"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.MembersAdded {
+		t.Fatalf("expected MembersAdded, got %v", outbox.types())
+	}
+	if added := decodeMembers(t, outbox.events[0]); len(added.StudentIDs) != 1 || added.StudentIDs[0] != "student1" ||
+		added.Source != events.MembersSourceManual || added.ActorID != "tutor1" {
+		t.Errorf("unexpected payload: %+v", added)
+	}
+
+	repo.removeMembersCount = 1
+	repo.promoted = []string{"student2"}
+	if _, _, err := u.RemoveGroupMembers(ctx, "group1", "tutor1", []string{"student1"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := outbox.types(); len(got) != 4 || got[1] != events.MembersRemoved || got[2] != events.MembersAdded || got[3] != events.GroupWaitlistPromoted {
+		t.Fatalf("expected MembersRemoved, MembersAdded and GroupWaitlistPromoted, got %v", got)
+	}
+
+	// изменение не сохранилось - событий нет
+	repo.addMembersErr = errors.New("db down")
+	if _, err := u.AddGroupMembers(ctx, "group1", "tutor1", []string{"student4"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(outbox.events) != 4 {
+		t.Errorf("expected no events for failed change, got %v", outbox.types())
+	}
+}
+
+func TestGroupEvents_JoinRequest(t *testing.T) {
+	ctx := context.Background()
+	outbox := &mockOutbox{}
+	group := &models.Group{ID: "group1", TutorID: "tutor1", JoinPolicy: models.JoinPolicyOpen}
+	u := usecase.NewJoinRequestsUsecase(newMockJoinRequestsRepo(), groupRepoFor(group), &mockUserClient{}, outbox, "group-events")
+
+	if _, err := u.RequestToJoin(ctx, "group1", "student1", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// уведомление о заявке сохраняется в той же транзакции, что и вступление
+	if len(outbox.events) != 2 || outbox.events[0].EventType != events.MembersAdded || outbox.events[1].EventType != events.JoinRequestApproved {
+		t.Fatalf("expected MembersAdded and JoinRequestApproved, got %v", outbox.types())
+	}
+	if added := decodeMembers(t, outbox.events[0]); added.Source != events.MembersSourceJoinRequest || added.StudentIDs[0] != "student1" {
+		t.Errorf("unexpected payload: %+v", added)
+	}
+}
+
+func TestGroupEvents_JoinRequestAlreadyMember(t *testing.T) {
+	ctx := context.Background()
+	outbox := &mockOutbox{}
+	group := &models.Group{ID: "group1", TutorID: "tutor1", JoinPolicy: models.JoinPolicyRequest}
+	repo := newMockJoinRequestsRepo()
+	u := usecase.NewJoinRequestsUsecase(repo, groupRepoFor(group), &mockUserClient{}, outbox, "group-events")
+
+	req, err := u.RequestToJoin(ctx, "group1", "student1", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// ученика добавили по приглашению, пока заявка ждала решения
+	repo.members["group1/student1"] = true
+
+	if _, err := u.ApproveJoinRequest(ctx, "group1", "tutor1", req.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.ofType(events.MembersAdded)) != 0 || len(outbox.ofType(events.JoinRequestApproved)) != 1 {
+		t.Errorf("expected only JoinRequestApproved for existing member, got %v", outbox.types())
+	}
+}
+
+type mockOutboxRepo struct {
+	messages []*events.OutboxMessage
+	deleted  []int64
+}
+
+func (m *mockOutboxRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *mockOutboxRepo) ClaimOutboxMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error) {
+	return m.messages[:min(limit, len(m.messages))], nil
+}
+
+func (m *mockOutboxRepo) DeleteOutboxMessages(ctx context.Context, ids []int64) error {
+	m.deleted = append(m.deleted, ids...)
+	return nil
+}
+
+type flakyPublisher struct {
+	failOn string // event_id, на котором отправка падает
+	sent   []string
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, topic, key string, value any) error {
+	event := value.(events.Envelope)
+	if event.EventID == p.failOn {
+		return errors.New("broker unavailable")
+	}
+	p.sent = append(p.sent, event.EventID)
+	return nil
+}
+
+func TestOutboxRelay_PublishPending(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockOutboxRepo{}
+	for i, id := range []string{"e1", "e2", "e3"} {
+		repo.messages = append(repo.messages, &events.OutboxMessage{
+			ID: int64(i + 1), Topic: "group-events", Key: "group1", Event: events.Envelope{EventID: id},
+		})
+	}
+	publisher := &flakyPublisher{failOn: "e2"}
+	relay := usecase.NewOutboxRelay(repo, publisher)
+
+	// после сбоя отправка останавливается, чтобы не нарушить порядок событий группы
+	sent, err := relay.PublishPending(ctx)
+	if err == nil || sent != 1 {
+		t.Fatalf("expected 1 sent and error, got %d (%v)", sent, err)
+	}
+	if len(repo.deleted) != 1 || repo.deleted[0] != 1 || len(publisher.sent) != 1 {
+		t.Errorf("expected only first message deleted, got %v", repo.deleted)
+	}
+
+	repo.messages = repo.messages[1:]
+	publisher.failOn = ""
+	if sent, err := relay.PublishPending(ctx); err != nil || sent != 2 {
+		t.Fatalf("expected 2 sent, got %d (%v)", sent, err)
+	}
+	if publisher.sent[1] != "e2" || publisher.sent[2] != "e3" {
+		t.Errorf("expected order to be kept, got %v", publisher.sent)
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"time"
+)
+
+const ownershipTransferTTL = 7 * 24 * time.Hour
+
+type OwnershipRepo interface {
+	SaveOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer) error
+	GetOwnershipTransfer(ctx context.Context, groupID string) (*models.OwnershipTransfer, error)
+	DeleteOwnershipTransfer(ctx context.Context, groupID string) error
+	CompleteOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer, now time.Time) error
+}
+
+type OwnershipUsecase struct {
+	ownershipRepo OwnershipRepo
+	groupsRepo    GroupsRepo
+	userClient    UserClient
+	outbox        Outbox
+	topic         string
+	now           func() time.Time
+}
+
+func NewOwnershipUsecase(ownershipRepo OwnershipRepo, groupsRepo GroupsRepo, userClient UserClient, outbox Outbox, topic string) *OwnershipUsecase {
+	return &OwnershipUsecase{
+		ownershipRepo: ownershipRepo,
+		groupsRepo:    groupsRepo,
+		userClient:    userClient,
+		outbox:        outbox,
+		topic:         topic,
+		now:           time.Now,
+	}
+}
+
+// TransferOwnership предлагает группу другому репетитору. Владелец меняется только после
+// согласия кандидата, новое предложение заменяет предыдущее.
+func (u *OwnershipUsecase) TransferOwnership(ctx context.Context, groupID, userID, newOwnerID string, keepAsCoTutor bool) (*models.OwnershipTransfer, error) {
+	group, err := u.groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+	if group.TutorID != userID {
+		return nil, models.ErrTutorIsNotValid
+	}
+	if group.Archived() {
+		return nil, models.ErrGroupArchived
+	}
+	if newOwnerID == "" || newOwnerID == userID {
+		return nil, fmt.Errorf("%w: new owner must be another user", models.ErrInvalidTransfer)
+	}
+
+	member, err := u.groupsRepo.IsMember(ctx, groupID, newOwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member {
+		return nil, fmt.Errorf("%w: new owner is a student of this group", models.ErrInvalidTransfer)
+	}
+
+	ok, err := u.userClient.ValidateTutor(ctx, newOwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate tutor: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: new owner must have a tutor profile", models.ErrInvalidTransfer)
+	}
+
+	now := u.now()
+	transfer := &models.OwnershipTransfer{
+		GroupID:       groupID,
+		FromUserID:    userID,
+		ToUserID:      newOwnerID,
+		KeepAsCoTutor: keepAsCoTutor,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(ownershipTransferTTL),
+	}
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.ownershipRepo.SaveOwnershipTransfer(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to save ownership transfer: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.add(events.GroupOwnershipTransferRequested, ownershipPayload(group, transfer))
+		return batch.save(ctx, u.outbox, u.topic, groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// GetTransfer доступна владельцу и кандидату
+func (u *OwnershipUsecase) GetTransfer(ctx context.Context, groupID, userID string) (*models.OwnershipTransfer, error) {
+	transfer, err := u.ownershipRepo.GetOwnershipTransfer(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get ownership transfer: %w", err)
+	}
+	if transfer.FromUserID != userID && transfer.ToUserID != userID {
+		return nil, models.ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+// AcceptTransfer - согласие кандидата, после него он владелец группы
+func (u *OwnershipUsecase) AcceptTransfer(ctx context.Context, groupID, userID string) (*models.Group, error) {
+	transfer, err := u.GetTransfer(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserID != userID {
+		return nil, models.ErrTransferNotFound
+	}
+
+	now := u.now()
+	if !now.Before(transfer.ExpiresAt) {
+		return nil, models.ErrTransferExpired
+	}
+
+	// смена владельца и события о ней сохраняются в одной транзакции
+	var group *models.Group
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.ownershipRepo.CompleteOwnershipTransfer(ctx, transfer, now); err != nil {
+			if errors.Is(err, models.ErrTransferNotFound) {
+				return err
+			}
+			return fmt.Errorf("failed to complete ownership transfer: %w", err)
+		}
+
+		if group, err = u.groupsRepo.GetGroup(ctx, groupID, false); err != nil {
+			return fmt.Errorf("failed to get group: %w", err)
+		}
+		members, err := u.groupsRepo.GetGroupMembers(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to get group members: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.add(events.GroupOwnershipTransferred, ownershipPayload(group, transfer))
+		batch.addSnapshot(events.GroupUpdated, group, members, userID)
+		return batch.save(ctx, u.outbox, u.topic, groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// CancelTransfer - отмена владельцем или отказ кандидата
+func (u *OwnershipUsecase) CancelTransfer(ctx context.Context, groupID, userID string) error {
+	if _, err := u.GetTransfer(ctx, groupID, userID); err != nil {
+		return err
+	}
+
+	if err := u.ownershipRepo.DeleteOwnershipTransfer(ctx, groupID); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete ownership transfer: %w", err)
+	}
+
+	return nil
+}
+
+func ownershipPayload(group *models.Group, t *models.OwnershipTransfer) events.OwnershipTransferPayload {
+	return events.OwnershipTransferPayload{
+		GroupID:         group.ID,
+		GroupName:       group.Name,
+		PreviousOwnerID: t.FromUserID,
+		NewOwnerID:      t.ToUserID,
+	}
+}
@@ -0,0 +1,199 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+// mockOwnershipRepo меняет владельца группы в общем с mockRepo объекте
+type mockOwnershipRepo struct {
+	group     *models.Group
+	staff     map[string]models.StaffRole
+	transfers map[string]*models.OwnershipTransfer
+}
+
+func (m *mockOwnershipRepo) SaveOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer) error {
+	copied := *t
+	m.transfers[t.GroupID] = &copied
+	return nil
+}
+
+func (m *mockOwnershipRepo) GetOwnershipTransfer(ctx context.Context, groupID string) (*models.OwnershipTransfer, error) {
+	t, ok := m.transfers[groupID]
+	if !ok {
+		return nil, models.ErrTransferNotFound
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *mockOwnershipRepo) DeleteOwnershipTransfer(ctx context.Context, groupID string) error {
+	if _, ok := m.transfers[groupID]; !ok {
+		return models.ErrTransferNotFound
+	}
+	delete(m.transfers, groupID)
+	return nil
+}
+
+func (m *mockOwnershipRepo) CompleteOwnershipTransfer(ctx context.Context, t *models.OwnershipTransfer, now time.Time) error {
+	if _, ok := m.transfers[t.GroupID]; !ok || m.group.TutorID != t.FromUserID {
+		return models.ErrTransferNotFound
+	}
+	delete(m.transfers, t.GroupID)
+	m.group.TutorID = t.ToUserID
+	delete(m.staff, t.ToUserID)
+	if t.KeepAsCoTutor {
+		m.staff[t.FromUserID] = models.StaffRoleCoTutor
+	}
+	return nil
+}
+
+func newTestOwnershipUsecase() (*usecase.OwnershipUsecase, *mockOwnershipRepo, *mockRepo, *mockUserClient, *mockOutbox) {
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"}
+	groups := groupRepoFor(group)
+	groups.staff = map[string]models.StaffRole{"tutor2": models.StaffRoleCoTutor}
+	repo := &mockOwnershipRepo{group: group, staff: groups.staff, transfers: make(map[string]*models.OwnershipTransfer)}
+	userClient := &mockUserClient{validateResult: true}
+	outbox := &mockOutbox{}
+	u := usecase.NewOwnershipUsecase(repo, groups, userClient, outbox, "group-events")
+	return u, repo, groups, userClient, outbox
+}
+
+func TestTransferOwnership_Accepted(t *testing.T) {
+	ctx := context.Background()
+	u, repo, groups, _, outbox := newTestOwnershipUsecase()
+
+	transfer, err := u.TransferOwnership(ctx, "group1", "tutor1", "tutor2", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !transfer.ExpiresAt.After(transfer.CreatedAt) {
+		t.Errorf("expected expiration after creation, got %+v", transfer)
+	}
+	if repo.group.TutorID != "tutor1" {
+		t.Fatal("expected owner not to change before acceptance")
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupOwnershipTransferRequested {
+		t.Fatalf("expected requested event in outbox, got %v", outbox.types())
+	}
+	if outbox.keys[0] != "group1" {
+		t.Errorf("expected event keyed by group, got %s", outbox.keys[0])
+	}
+
+	// принять может только кандидат
+	if _, err := u.AcceptTransfer(ctx, "group1", "tutor1"); !errors.Is(err, models.ErrTransferNotFound) {
+		t.Fatalf("expected ErrTransferNotFound for owner, got %v", err)
+	}
+
+	group, err := u.AcceptTransfer(ctx, "group1", "tutor2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.TutorID != "tutor2" {
+		t.Errorf("expected new owner tutor2, got %s", group.TutorID)
+	}
+	if groups.staff["tutor1"] != models.StaffRoleCoTutor {
+		t.Error("expected previous owner to stay as co-tutor")
+	}
+	if _, ok := groups.staff["tutor2"]; ok {
+		t.Error("expected new owner to leave staff list")
+	}
+
+	// смена владельца уходит через outbox вместе со снимком группы
+	if len(outbox.events) != 3 ||
+		outbox.events[1].EventType != events.GroupOwnershipTransferred ||
+		outbox.events[2].EventType != events.GroupUpdated {
+		t.Fatalf("expected requested, transferred and updated events, got %v", outbox.types())
+	}
+	if outbox.keys[1] != "group1" {
+		t.Errorf("expected events keyed by group, got %s", outbox.keys[1])
+	}
+	var snapshot events.GroupSnapshotPayload
+	if err := json.Unmarshal(outbox.events[2].Payload, &snapshot); err != nil {
+		t.Fatalf("failed to decode snapshot: %v", err)
+	}
+	if snapshot.TutorID != "tutor2" {
+		t.Errorf("expected snapshot with new owner, got %+v", snapshot)
+	}
+}
+
+func TestTransferOwnership_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		userID     string
+		newOwnerID string
+		isMember   bool
+		isTutor    bool
+		wantErr    error
+	}{
+		{"not owner", "tutor2", "tutor3", false, true, models.ErrTutorIsNotValid},
+		{"to self", "tutor1", "tutor1", false, true, models.ErrInvalidTransfer},
+		{"not a tutor", "tutor1", "user1", false, false, models.ErrInvalidTransfer},
+		{"group student", "tutor1", "student1", true, true, models.ErrInvalidTransfer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, repo, groups, userClient, outbox := newTestOwnershipUsecase()
+			groups.isMember = tt.isMember
+			userClient.validateResult = tt.isTutor
+
+			if _, err := u.TransferOwnership(ctx, "group1", tt.userID, tt.newOwnerID, false); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(repo.transfers) != 0 || len(outbox.events) != 0 {
+				t.Error("expected no transfer and no events")
+			}
+		})
+	}
+}
+
+func TestAcceptTransfer_Expired(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _, _, _ := newTestOwnershipUsecase()
+
+	if _, err := u.TransferOwnership(ctx, "group1", "tutor1", "tutor3", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.transfers["group1"].ExpiresAt = time.Now().Add(-time.Minute)
+
+	if _, err := u.AcceptTransfer(ctx, "group1", "tutor3"); !errors.Is(err, models.ErrTransferExpired) {
+		t.Fatalf("expected ErrTransferExpired, got %v", err)
+	}
+	if repo.group.TutorID != "tutor1" {
+		t.Error("expected owner not to change")
+	}
+}
+
+func TestCancelTransfer(t *testing.T) {
+	ctx := context.Background()
+	u, repo, _, _, _ := newTestOwnershipUsecase()
+
+	if _, err := u.TransferOwnership(ctx, "group1", "tutor1", "tutor3", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := u.CancelTransfer(ctx, "group1", "stranger"); !errors.Is(err, models.ErrTransferNotFound) {
+		t.Fatalf("expected ErrTransferNotFound for stranger, got %v", err)
+	}
+
+	// кандидат отказывается
+	if err := u.CancelTransfer(ctx, "group1", "tutor3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.transfers) != 0 {
+		t.Error("expected transfer to be deleted")
+	}
+	if _, err := u.AcceptTransfer(ctx, "group1", "tutor3"); !errors.Is(err, models.ErrTransferNotFound) {
+		t.Errorf("expected ErrTransferNotFound after cancel, got %v", err)
+	}
+}
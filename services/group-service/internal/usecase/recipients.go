@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+)
+
+// recipients возвращает язык и часовой пояс получателей уведомления в порядке ids.
+// Уведомление не откладывается из-за user-service: без профиля получатель
+// остается со значениями по умолчанию.
+func recipients(ctx context.Context, userClient UserClient, ids []string) []events.Recipient {
+	result := make([]events.Recipient, len(ids))
+	for i, id := range ids {
+		result[i] = events.Recipient{UserID: id, Locale: models.DefaultLocale, Timezone: models.DefaultTimezone}
+	}
+
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+	for start := 0; start < len(ids); start += maxMembersPerRequest {
+		users, err := userClient.ResolveUsers(ctx, ids[start:min(start+maxMembersPerRequest, len(ids))], nil)
+		if err != nil {
+			log.Printf("failed to resolve recipient preferences, using defaults: %v", err)
+			return result
+		}
+		for _, user := range users {
+			i, ok := index[user.ID]
+			if !ok {
+				continue
+			}
+			if user.Locale != "" {
+				result[i].Locale = user.Locale
+			}
+			if user.Timezone != "" {
+				result[i].Timezone = user.Timezone
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,488 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"io"
+	"log"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ограничение размера CSV, 500 строк с запасом помещаются
+	maxRosterSize = 1 << 20
+	// импорт, который обрабатывается дольше, считается брошенным и забирается снова
+	rosterImportStaleAfter = 10 * time.Minute
+)
+
+type RosterImportsRepo interface {
+	CreateRosterImport(ctx context.Context, imp *models.RosterImport) error
+	GetRosterImport(ctx context.Context, groupID, importID string) (*models.RosterImport, error)
+	ClaimRosterImport(ctx context.Context, now, staleBefore time.Time) (*models.RosterImport, error)
+	UpdateRosterRows(ctx context.Context, imp *models.RosterImport) error
+	FinishRosterImport(ctx context.Context, imp *models.RosterImport) error
+}
+
+// Inviter создает приглашения по email, его реализует InvitationsUsecase
+type Inviter interface {
+	CreateInvitation(ctx context.Context, p CreateInvitationParams) (*models.GroupInvitation, error)
+}
+
+// RosterUsecase импортирует список учеников из CSV: зарегистрированные ученики добавляются
+// в группу, остальным отправляется приглашение по email. Импорт обрабатывается в фоне.
+type RosterUsecase struct {
+	rosterRepo      RosterImportsRepo
+	groupsRepo      GroupsRepo
+	invitationsRepo InvitationsRepo
+	inviter         Inviter
+	outbox          Outbox
+	userClient      UserClient
+	topic           string
+	now             func() time.Time
+}
+
+func NewRosterUsecase(rosterRepo RosterImportsRepo, groupsRepo GroupsRepo, invitationsRepo InvitationsRepo, inviter Inviter, outbox Outbox, userClient UserClient, topic string) *RosterUsecase {
+	return &RosterUsecase{
+		rosterRepo:      rosterRepo,
+		groupsRepo:      groupsRepo,
+		invitationsRepo: invitationsRepo,
+		inviter:         inviter,
+		outbox:          outbox,
+		userClient:      userClient,
+		topic:           topic,
+		now:             time.Now,
+	}
+}
+
+// ImportRoster проверяет CSV и ставит импорт в очередь. При dryRun ничего не меняется и не
+// сохраняется: импорт возвращается сразу со строками и ожидаемым итогом по каждой.
+func (u *RosterUsecase) ImportRoster(ctx context.Context, groupID, userID, content string, dryRun bool) (*models.RosterImport, error) {
+	rows, err := parseRoster(content)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	imp := &models.RosterImport{
+		GroupID:   groupID,
+		CreatedBy: userID,
+		Status:    models.RosterImportPending,
+		Rows:      rows,
+		CreatedAt: now,
+	}
+
+	if dryRun {
+		if err := u.preview(ctx, group, rows); err != nil {
+			return nil, err
+		}
+		imp.Status, imp.StartedAt, imp.FinishedAt = models.RosterImportCompleted, &now, &now
+		return imp, nil
+	}
+
+	imp.ID = uuid.New().String()
+	if err := u.rosterRepo.CreateRosterImport(ctx, imp); err != nil {
+		return nil, fmt.Errorf("failed to create roster import: %w", err)
+	}
+
+	return imp, nil
+}
+
+func (u *RosterUsecase) GetRosterImport(ctx context.Context, groupID, userID, importID string) (*models.RosterImport, error) {
+	if _, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	imp, err := u.rosterRepo.GetRosterImport(ctx, groupID, importID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster import: %w", err)
+	}
+
+	return imp, nil
+}
+
+// GetRosterReport возвращает итоги завершенного импорта в CSV и имя файла
+func (u *RosterUsecase) GetRosterReport(ctx context.Context, groupID, userID, importID string) (string, string, error) {
+	imp, err := u.GetRosterImport(ctx, groupID, userID, importID)
+	if err != nil {
+		return "", "", err
+	}
+	if imp.Status != models.RosterImportCompleted && imp.Status != models.RosterImportFailed {
+		return "", "", models.ErrRosterImportInProgress
+	}
+
+	report, err := rosterReport(imp)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to build roster report: %w", err)
+	}
+
+	return "roster-import-" + imp.ID + ".csv", report, nil
+}
+
+// ProcessNext обрабатывает самый старый ожидающий импорт, false - очередь пуста
+func (u *RosterUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	now := u.now()
+	imp, err := u.rosterRepo.ClaimRosterImport(ctx, now, now.Add(-rosterImportStaleAfter))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim roster import: %w", err)
+	}
+	if imp == nil {
+		return false, nil
+	}
+
+	if err := u.process(ctx, imp); err != nil {
+		// импорт не повторяется: репетитор видит причину и загружает список заново
+		imp.Status, imp.Error = models.RosterImportFailed, err.Error()
+	} else {
+		imp.Status = models.RosterImportCompleted
+	}
+	finishedAt := u.now()
+	imp.FinishedAt = &finishedAt
+
+	if err := u.rosterRepo.FinishRosterImport(ctx, imp); err != nil {
+		return true, fmt.Errorf("failed to finish roster import %s: %w", imp.ID, err)
+	}
+
+	return true, nil
+}
+
+// Start запускает обработку импортов, interval <= 0 отключает ее
+func (u *RosterUsecase) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for {
+					processed, err := u.ProcessNext(ctx)
+					if err != nil {
+						log.Printf("roster import: %v", err)
+						break
+					}
+					if !processed {
+						break
+					}
+				}
+			}
+		}
+	}()
+}
+
+// process выполняет импорт от имени его автора. Строки, которые не удалось обработать,
+// получают статус failed, остальные обрабатываются дальше. Итог строки сохраняется сразу,
+// поэтому импорт, забранный снова после сбоя, не добавляет и не приглашает повторно.
+func (u *RosterUsecase) process(ctx context.Context, imp *models.RosterImport) error {
+	// права автора могли измениться, пока импорт ждал очереди
+	group, err := authorizeChange(ctx, u.groupsRepo, imp.GroupID, imp.CreatedBy, models.PermissionManageMembers)
+	if err != nil {
+		return err
+	}
+
+	toAdd, toInvite, err := u.plan(ctx, group, imp.Rows)
+	if err != nil {
+		return err
+	}
+
+	if err := u.addStudents(ctx, imp, group, toAdd); err != nil {
+		log.Printf("roster import %s: %v", imp.ID, err)
+		for _, row := range toAdd {
+			row.Status, row.Message = models.RosterRowFailed, "failed to add student"
+		}
+		u.saveRows(ctx, imp)
+	}
+
+	for _, row := range toInvite {
+		_, err := u.inviter.CreateInvitation(ctx, CreateInvitationParams{
+			GroupID:       imp.GroupID,
+			UserID:        imp.CreatedBy,
+			Email:         row.Email,
+			RecipientName: strings.TrimSpace(row.Name + " " + row.Surname),
+		})
+		if err != nil {
+			log.Printf("roster import %s: failed to invite line %d: %v", imp.ID, row.Line, err)
+			row.Status, row.Message = models.RosterRowFailed, "failed to create invitation"
+		} else {
+			row.Status = models.RosterRowInvited
+		}
+		u.saveRows(ctx, imp)
+	}
+
+	return nil
+}
+
+// saveRows сохраняет итоги строк до завершения импорта. Ошибка не прерывает импорт:
+// итоги сохранятся при завершении, повторно обработается только строка, начатая до сбоя.
+func (u *RosterUsecase) saveRows(ctx context.Context, imp *models.RosterImport) {
+	if err := u.rosterRepo.UpdateRosterRows(ctx, imp); err != nil {
+		log.Printf("roster import %s: failed to save rows: %v", imp.ID, err)
+	}
+}
+
+// addStudents добавляет учеников и сохраняет итоги их строк в одной транзакции
+func (u *RosterUsecase) addStudents(ctx context.Context, imp *models.RosterImport, group *models.Group, rows []*models.RosterRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	studentIDs := make([]string, len(rows))
+	for i, row := range rows {
+		studentIDs[i] = row.StudentID
+	}
+
+	return u.outbox.InTx(ctx, func(ctx context.Context) error {
+		added, waitlisted, err := u.groupsRepo.AddMembers(ctx, group.ID, studentIDs)
+		if err != nil {
+			return fmt.Errorf("failed to add members: %w", err)
+		}
+
+		statuses := make(map[string]models.RosterRowStatus, len(rows))
+		for _, id := range added {
+			statuses[id] = models.RosterRowAdded
+		}
+		for _, id := range waitlisted {
+			statuses[id] = models.RosterRowWaitlisted
+		}
+		for _, row := range rows {
+			if status, ok := statuses[row.StudentID]; ok {
+				row.Status = status
+			} else {
+				// ученик вступил, пока импорт ждал очереди
+				row.Status = models.RosterRowAlreadyMember
+			}
+		}
+		if err := u.rosterRepo.UpdateRosterRows(ctx, imp); err != nil {
+			return fmt.Errorf("failed to save roster rows: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.addMembers(events.MembersAdded, group, added, events.MembersSourceRoster, imp.CreatedBy)
+		return batch.save(ctx, u.outbox, u.topic, group.ID)
+	})
+}
+
+// preview выставляет строкам ожидаемый итог. Места в группе заполняются по порядку строк,
+// поэтому ожидаемая очередь точна, если состав не изменится до обработки.
+func (u *RosterUsecase) preview(ctx context.Context, group *models.Group, rows []*models.RosterRow) error {
+	toAdd, toInvite, err := u.plan(ctx, group, rows)
+	if err != nil {
+		return err
+	}
+
+	free := -1
+	if group.MaxMembers > 0 {
+		members, err := u.groupsRepo.GetGroupMembers(ctx, group.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get group members: %w", err)
+		}
+		free = max(group.MaxMembers-len(members), 0)
+	}
+
+	for _, row := range toAdd {
+		if free == 0 {
+			row.Status = models.RosterRowWaitlisted
+			continue
+		}
+		row.Status = models.RosterRowAdded
+		if free > 0 {
+			free--
+		}
+	}
+	for _, row := range toInvite {
+		row.Status = models.RosterRowInvited
+	}
+
+	return nil
+}
+
+// plan сопоставляет строки с аккаунтами. Строки без изменений получают итоговый статус,
+// возвращаются ученики для добавления и адреса без аккаунта для приглашения.
+func (u *RosterUsecase) plan(ctx context.Context, group *models.Group, rows []*models.RosterRow) ([]*models.RosterRow, []*models.RosterRow, error) {
+	var emails []string
+	for _, row := range rows {
+		if row.Status == "" {
+			emails = append(emails, row.Email)
+		}
+	}
+	if len(emails) == 0 {
+		return nil, nil, nil
+	}
+
+	users, err := u.userClient.ResolveUsers(ctx, nil, emails)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve users: %w", err)
+	}
+	byEmail := make(map[string]*models.UserInfo, len(users))
+	for _, user := range users {
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+
+	members, err := u.groupsRepo.GetGroupMembers(ctx, group.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get group members: %w", err)
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.StudentID] = true
+	}
+
+	invitations, err := u.invitationsRepo.ListInvitations(ctx, group.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	invited := make(map[string]bool, len(invitations))
+	now := u.now()
+	for _, inv := range invitations {
+		if inv.Email != "" && inv.CheckUsable(now) == nil {
+			invited[strings.ToLower(inv.Email)] = true
+		}
+	}
+
+	var toAdd, toInvite []*models.RosterRow
+	for _, row := range rows {
+		if row.Status != "" {
+			continue
+		}
+
+		user, ok := byEmail[row.Email]
+		switch {
+		case !ok && invited[row.Email]:
+			row.Status = models.RosterRowAlreadyInvited
+		case !ok:
+			toInvite = append(toInvite, row)
+		case !user.IsStudent:
+			row.StudentID, row.Status = user.ID, models.RosterRowNotAStudent
+		case isMember[user.ID]:
+			row.StudentID, row.Status = user.ID, models.RosterRowAlreadyMember
+		default:
+			row.StudentID = user.ID
+			toAdd = append(toAdd, row)
+		}
+	}
+
+	return toAdd, toInvite, nil
+}
+
+// parseRoster читает CSV с заголовком: обязательна колонка email, name и surname необязательны,
+// порядок колонок любой. Разделитель - запятая или точка с запятой, как при сохранении из Excel.
+// Строки с неверным или повторным email помечаются invalid и не обрабатываются.
+func parseRoster(content string) ([]*models.RosterRow, error) {
+	if len(content) > maxRosterSize {
+		return nil, fmt.Errorf("%w: csv must not exceed %d bytes", models.ErrInvalidRoster, maxRosterSize)
+	}
+	content = strings.TrimPrefix(content, "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if firstLine, _, _ := strings.Cut(content, "\n"); strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: csv is empty", models.ErrInvalidRoster)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidRoster, err)
+	}
+	columns := map[string]int{"email": -1, "name": -1, "surname": -1}
+	for i, h := range header {
+		if _, ok := columns[strings.ToLower(strings.TrimSpace(h))]; ok {
+			columns[strings.ToLower(strings.TrimSpace(h))] = i
+		}
+	}
+	if columns["email"] < 0 {
+		return nil, fmt.Errorf("%w: header must contain an email column", models.ErrInvalidRoster)
+	}
+	field := func(record []string, column string) string {
+		if i := columns[column]; i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rows := make([]*models.RosterRow, 0)
+	firstLine := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrInvalidRoster, err)
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &models.RosterRow{
+			Line:    line,
+			Email:   strings.ToLower(field(record, "email")),
+			Name:    field(record, "name"),
+			Surname: field(record, "surname"),
+		}
+		if addr, err := mail.ParseAddress(row.Email); err != nil || addr.Address != row.Email {
+			row.Status, row.Message = models.RosterRowInvalid, "invalid email"
+		} else if prev, ok := firstLine[row.Email]; ok {
+			row.Status, row.Message = models.RosterRowInvalid, "duplicate of line "+strconv.Itoa(prev)
+		} else {
+			firstLine[row.Email] = line
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: csv has no students", models.ErrInvalidRoster)
+	}
+	if len(rows) > maxMembersPerRequest {
+		return nil, fmt.Errorf("%w: at most %d students per import", models.ErrInvalidRoster, maxMembersPerRequest)
+	}
+
+	return rows, nil
+}
+
+// rosterReport - строки импорта с итогами в CSV, строки без итога остались необработанными
+func rosterReport(imp *models.RosterImport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"line", "email", "name", "surname", "student_id", "status", "message"}); err != nil {
+		return "", err
+	}
+	for _, row := range imp.Rows {
+		status := string(row.Status)
+		if status == "" {
+			status = "not_processed"
+		}
+		record := []string{strconv.Itoa(row.Line), row.Email, row.Name, row.Surname, row.StudentID, status, row.Message}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
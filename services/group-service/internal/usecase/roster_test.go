@@ -0,0 +1,292 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockRosterRepo struct {
+	imports  map[string]*models.RosterImport
+	rowSaves int
+}
+
+func (m *mockRosterRepo) CreateRosterImport(ctx context.Context, imp *models.RosterImport) error {
+	m.imports[imp.ID] = imp
+	return nil
+}
+
+func (m *mockRosterRepo) GetRosterImport(ctx context.Context, groupID, importID string) (*models.RosterImport, error) {
+	imp, ok := m.imports[importID]
+	if !ok || imp.GroupID != groupID {
+		return nil, models.ErrRosterImportNotFound
+	}
+	return imp, nil
+}
+
+func (m *mockRosterRepo) ClaimRosterImport(ctx context.Context, now, staleBefore time.Time) (*models.RosterImport, error) {
+	for _, imp := range m.imports {
+		stale := imp.Status == models.RosterImportProcessing && imp.StartedAt.Before(staleBefore)
+		if imp.Status == models.RosterImportPending || stale {
+			imp.Status, imp.StartedAt = models.RosterImportProcessing, &now
+			return imp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockRosterRepo) UpdateRosterRows(ctx context.Context, imp *models.RosterImport) error {
+	m.rowSaves++
+	return nil
+}
+
+func (m *mockRosterRepo) FinishRosterImport(ctx context.Context, imp *models.RosterImport) error {
+	m.imports[imp.ID] = imp
+	return nil
+}
+
+const testRoster = "\ufeffEmail;Name;Surname\n" +
+	"member@example.com;Anna;Petrova\n" +
+	"new1@example.com;Boris;Ivanov\n" +
+	"new2@example.com;Vera;Sidorova\n" +
+	"tutor@example.com;Gleb;Orlov\n" +
+	"\n" +
+	"guest@example.com;Daria;Smirnova\n" +
+	"invited@example.com;;\n" +
+	"not-an-email;Egor;\n" +
+	"NEW1@example.com;Boris;Ivanov\n"
+
+type rosterFixture struct {
+	u       *usecase.RosterUsecase
+	repo    *mockRepo
+	roster  *mockRosterRepo
+	invRepo *mockInvitationsRepo
+	mailer  *mockMailer
+	outbox  *mockOutbox
+}
+
+func newRosterFixture() *rosterFixture {
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", MaxMembers: 2}
+	repo := groupRepoFor(group)
+	repo.getGroupMembersFunc = func(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
+		return []*models.GroupMember{{GroupID: groupID, StudentID: "member"}}, nil
+	}
+	user := &mockUserClient{users: []*models.UserInfo{
+		{ID: "member", Email: "member@example.com", IsStudent: true},
+		{ID: "new1", Email: "new1@example.com", IsStudent: true},
+		{ID: "new2", Email: "new2@example.com", IsStudent: true},
+		{ID: "tutor2", Email: "tutor@example.com"},
+	}}
+
+	invRepo := newMockInvitationsRepo()
+	invRepo.invitations["CODE1"] = &models.GroupInvitation{ID: "inv1", GroupID: "group1", Code: "CODE1",
+		Email: "Invited@example.com", MaxUses: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	mailer := &mockMailer{}
+	outbox := &mockOutbox{}
+	invitations := usecase.NewInvitationsUsecase(invRepo, repo, user, outbox, "group-events", mailer, "")
+	roster := &mockRosterRepo{imports: make(map[string]*models.RosterImport)}
+
+	return &rosterFixture{
+		u:       usecase.NewRosterUsecase(roster, repo, invRepo, invitations, outbox, user, "group-events"),
+		repo:    repo,
+		roster:  roster,
+		invRepo: invRepo,
+		mailer:  mailer,
+		outbox:  outbox,
+	}
+}
+
+func rowStatuses(imp *models.RosterImport) map[int]models.RosterRowStatus {
+	result := make(map[int]models.RosterRowStatus, len(imp.Rows))
+	for _, row := range imp.Rows {
+		result[row.Line] = row.Status
+	}
+	return result
+}
+
+func TestImportRoster_DryRun(t *testing.T) {
+	ctx := context.Background()
+	f := newRosterFixture()
+
+	imp, err := f.u.ImportRoster(ctx, "group1", "tutor1", testRoster, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if imp.ID != "" || imp.Status != models.RosterImportCompleted {
+		t.Errorf("expected unsaved completed preview, got %+v", imp)
+	}
+
+	// пустая строка 6 пропускается, в группе одно свободное место
+	expected := map[int]models.RosterRowStatus{
+		2:  models.RosterRowAlreadyMember,
+		3:  models.RosterRowAdded,
+		4:  models.RosterRowWaitlisted,
+		5:  models.RosterRowNotAStudent,
+		7:  models.RosterRowInvited,
+		8:  models.RosterRowAlreadyInvited,
+		9:  models.RosterRowInvalid,
+		10: models.RosterRowInvalid,
+	}
+	got := rowStatuses(imp)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d rows, got %v", len(expected), got)
+	}
+	for line, status := range expected {
+		if got[line] != status {
+			t.Errorf("line %d: expected %s, got %s", line, status, got[line])
+		}
+	}
+	if imp.Rows[len(imp.Rows)-1].Message != "duplicate of line 3" {
+		t.Errorf("unexpected duplicate message: %q", imp.Rows[len(imp.Rows)-1].Message)
+	}
+
+	if f.repo.addMembersCalled || len(f.mailer.to) != 0 || len(f.roster.imports) != 0 || len(f.outbox.events) != 0 {
+		t.Error("dry run must not change anything")
+	}
+}
+
+func TestImportRoster_Process(t *testing.T) {
+	ctx := context.Background()
+	f := newRosterFixture()
+	f.repo.addMembersCount, f.repo.addMembersWaitlisted = 1, 1
+
+	imp, err := f.u.ImportRoster(ctx, "group1", "tutor1", testRoster, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if imp.ID == "" || imp.Status != models.RosterImportPending {
+		t.Fatalf("expected pending import, got %+v", imp)
+	}
+	if _, _, err := f.u.GetRosterReport(ctx, "group1", "tutor1", imp.ID); !errors.Is(err, models.ErrRosterImportInProgress) {
+		t.Errorf("expected ErrRosterImportInProgress, got %v", err)
+	}
+
+	if processed, err := f.u.ProcessNext(ctx); err != nil || !processed {
+		t.Fatalf("expected import to be processed, got %v (%v)", processed, err)
+	}
+	if processed, _ := f.u.ProcessNext(ctx); processed {
+		t.Error("expected empty queue")
+	}
+
+	imp, err = f.u.GetRosterImport(ctx, "group1", "tutor1", imp.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if imp.Status != models.RosterImportCompleted || imp.FinishedAt == nil {
+		t.Fatalf("expected completed import, got %+v", imp)
+	}
+	summary := imp.Summary()
+	if summary != (models.RosterImportSummary{Added: 1, Waitlisted: 1, Invited: 1, Skipped: 3, Failed: 2}) {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+
+	if !f.repo.addMembersCalled || len(f.repo.addedStudentIDs) != 2 {
+		t.Errorf("expected new1 and new2 to be added, got %v", f.repo.addedStudentIDs)
+	}
+	if added := decodeMembers(t, f.outbox.events[0]); added.Source != events.MembersSourceRoster || added.ActorID != "tutor1" {
+		t.Errorf("unexpected payload: %+v", added)
+	}
+	if len(f.mailer.to) != 1 || f.mailer.to[0] != "guest@example.com" || !strings.HasPrefix(f.mailer.body[0], "Здравствуйте, Daria Smirnova!") {
+		t.Errorf("expected invitation to guest, got %v", f.mailer.to)
+	}
+	// итоги сохраняются после добавления и после каждого приглашения
+	if f.roster.rowSaves != 2 {
+		t.Errorf("expected rows to be saved twice during processing, got %d", f.roster.rowSaves)
+	}
+
+	_, report, err := f.u.GetRosterReport(ctx, "group1", "tutor1", imp.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(report), "\n")
+	if len(lines) != 9 || lines[0] != "line,email,name,surname,student_id,status,message" ||
+		lines[2] != "3,new1@example.com,Boris,Ivanov,new1,added," {
+		t.Errorf("unexpected report:\n%s", report)
+	}
+}
+
+func TestImportRoster_ReclaimSkipsProcessedRows(t *testing.T) {
+	ctx := context.Background()
+	f := newRosterFixture()
+	f.repo.addMembersCount = 1
+
+	imp, err := f.u.ImportRoster(ctx, "group1", "tutor1", testRoster, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// обработчик упал после добавления new1 и приглашения guest
+	startedAt := time.Now().Add(-time.Hour)
+	imp.Status, imp.StartedAt = models.RosterImportProcessing, &startedAt
+	for _, row := range imp.Rows {
+		switch row.Email {
+		case "new1@example.com":
+			row.StudentID, row.Status = "new1", models.RosterRowAdded
+		case "guest@example.com":
+			row.Status = models.RosterRowInvited
+		}
+	}
+
+	if processed, err := f.u.ProcessNext(ctx); err != nil || !processed {
+		t.Fatalf("expected stale import to be reclaimed, got %v (%v)", processed, err)
+	}
+	if imp.Status != models.RosterImportCompleted {
+		t.Fatalf("expected completed import, got %+v", imp)
+	}
+	if len(f.repo.addedStudentIDs) != 1 || f.repo.addedStudentIDs[0] != "new2" {
+		t.Errorf("expected only new2 to be added, got %v", f.repo.addedStudentIDs)
+	}
+	if len(f.mailer.to) != 0 {
+		t.Errorf("expected no repeated invitations, got %v", f.mailer.to)
+	}
+	if got := rowStatuses(imp); got[3] != models.RosterRowAdded || got[7] != models.RosterRowInvited {
+		t.Errorf("expected saved statuses to be kept, got %v", got)
+	}
+}
+
+func TestImportRoster_RejectedAfterPermissionLoss(t *testing.T) {
+	ctx := context.Background()
+	f := newRosterFixture()
+	f.repo.staff = map[string]models.StaffRole{"tutor2": models.StaffRoleCoTutor}
+
+	imp, err := f.u.ImportRoster(ctx, "group1", "tutor2", "email\nnew1@example.com\n", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// соведущего убрали из персонала до обработки
+	f.repo.staff = nil
+	if _, err := f.u.ProcessNext(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if imp.Status != models.RosterImportFailed || imp.Error == "" || f.repo.addMembersCalled {
+		t.Errorf("expected failed import without changes, got %+v", imp)
+	}
+}
+
+func TestImportRoster_InvalidCSV(t *testing.T) {
+	ctx := context.Background()
+	f := newRosterFixture()
+
+	tooMany := "email\n" + strings.Repeat("student@example.com\n", 501)
+	for name, content := range map[string]string{
+		"no email column": "name,surname\nAnna,Petrova\n",
+		"no rows":         "email,name\n\n",
+		"too many rows":   tooMany,
+		"malformed":       "email\n\"unterminated@example.com\n",
+	} {
+		if _, err := f.u.ImportRoster(ctx, "group1", "tutor1", content, false); !errors.Is(err, models.ErrInvalidRoster) {
+			t.Errorf("%s: expected ErrInvalidRoster, got %v", name, err)
+		}
+	}
+
+	if _, err := f.u.ImportRoster(ctx, "group1", "student1", "email\nnew1@example.com\n", true); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected ErrTutorIsNotValid, got %v", err)
+	}
+}
@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/models"
+	"time"
+)
+
+type StaffRepo interface {
+	AddStaff(ctx context.Context, member *models.StaffMember) error
+	UpdateStaffRole(ctx context.Context, groupID, userID string, role models.StaffRole) (*models.StaffMember, error)
+	RemoveStaff(ctx context.Context, groupID, userID string) error
+	ListStaff(ctx context.Context, groupID string) ([]*models.StaffMember, error)
+	HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error)
+}
+
+// roleInGroup возвращает роль пользователя в группе, пустая роль - пользователь не в персонале
+func roleInGroup(ctx context.Context, groupsRepo GroupsRepo, group *models.Group, userID string) (models.StaffRole, error) {
+	if group.TutorID == userID {
+		return models.StaffRoleOwner, nil
+	}
+
+	role, err := groupsRepo.GetStaffRole(ctx, group.ID, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrStaffNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get staff role: %w", err)
+	}
+	return role, nil
+}
+
+// authorize загружает группу и проверяет право пользователя по матрице ролей
+func authorize(ctx context.Context, groupsRepo GroupsRepo, groupID, userID string, perm models.Permission) (*models.Group, error) {
+	group, err := groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	role, err := roleInGroup(ctx, groupsRepo, group, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(perm) {
+		return nil, models.ErrTutorIsNotValid
+	}
+
+	return group, nil
+}
+
+// authorizeChange - authorize для изменений: архивная группа доступна только для чтения
+func authorizeChange(ctx context.Context, groupsRepo GroupsRepo, groupID, userID string, perm models.Permission) (*models.Group, error) {
+	group, err := authorize(ctx, groupsRepo, groupID, userID, perm)
+	if err != nil {
+		return nil, err
+	}
+	if group.Archived() {
+		return nil, models.ErrGroupArchived
+	}
+
+	return group, nil
+}
+
+// authorizeRead пускает к содержимому группы персонал и учеников, роль ученика пустая
+func authorizeRead(ctx context.Context, groupsRepo GroupsRepo, groupID, userID string) (*models.Group, models.StaffRole, error) {
+	group, err := groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get group: %w", err)
+	}
+
+	role, err := roleInGroup(ctx, groupsRepo, group, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if role != "" {
+		return group, role, nil
+	}
+
+	member, err := groupsRepo.IsMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to check membership: %w", err)
+	}
+	if !member {
+		return nil, "", models.ErrNotGroupMember
+	}
+
+	return group, "", nil
+}
+
+type StaffUsecase struct {
+	staffRepo  StaffRepo
+	groupsRepo GroupsRepo
+	userClient UserClient
+	now        func() time.Time
+}
+
+func NewStaffUsecase(staffRepo StaffRepo, groupsRepo GroupsRepo, userClient UserClient) *StaffUsecase {
+	return &StaffUsecase{
+		staffRepo:  staffRepo,
+		groupsRepo: groupsRepo,
+		userClient: userClient,
+		now:        time.Now,
+	}
+}
+
+// AddStaff добавляет соведущего или ассистента. Соведущий должен быть репетитором,
+// ученик группы не может одновременно быть в персонале.
+func (u *StaffUsecase) AddStaff(ctx context.Context, groupID, userID, staffUserID string, role models.StaffRole) (*models.StaffMember, error) {
+	if !role.Valid() {
+		return nil, models.ErrInvalidStaffRole
+	}
+
+	group, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageStaff)
+	if err != nil {
+		return nil, err
+	}
+	if group.TutorID == staffUserID {
+		return nil, models.ErrStaffExists
+	}
+
+	if err := u.checkCandidate(ctx, groupID, staffUserID, role); err != nil {
+		return nil, err
+	}
+
+	member := &models.StaffMember{
+		GroupID:   groupID,
+		UserID:    staffUserID,
+		Role:      role,
+		AddedBy:   userID,
+		CreatedAt: u.now(),
+	}
+	if err := u.staffRepo.AddStaff(ctx, member); err != nil {
+		if errors.Is(err, models.ErrStaffExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to add staff member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (u *StaffUsecase) UpdateStaffRole(ctx context.Context, groupID, userID, staffUserID string, role models.StaffRole) (*models.StaffMember, error) {
+	if !role.Valid() {
+		return nil, models.ErrInvalidStaffRole
+	}
+
+	if _, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageStaff); err != nil {
+		return nil, err
+	}
+
+	if role == models.StaffRoleCoTutor {
+		if err := u.checkCandidate(ctx, groupID, staffUserID, role); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := u.staffRepo.UpdateStaffRole(ctx, groupID, staffUserID, role)
+	if err != nil {
+		if errors.Is(err, models.ErrStaffNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update staff role: %w", err)
+	}
+
+	return member, nil
+}
+
+// RemoveStaff убирает сотрудника из группы, сотрудник может уйти сам
+func (u *StaffUsecase) RemoveStaff(ctx context.Context, groupID, userID, staffUserID string) error {
+	if userID != staffUserID {
+		if _, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageStaff); err != nil {
+			return err
+		}
+	}
+
+	if err := u.staffRepo.RemoveStaff(ctx, groupID, staffUserID); err != nil {
+		if errors.Is(err, models.ErrStaffNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to remove staff member: %w", err)
+	}
+
+	return nil
+}
+
+// ListStaff возвращает персонал группы, владелец идет первым
+func (u *StaffUsecase) ListStaff(ctx context.Context, groupID string) ([]*models.StaffMember, error) {
+	group, err := u.groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	staff, err := u.staffRepo.ListStaff(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staff: %w", err)
+	}
+
+	owner := &models.StaffMember{
+		GroupID:   group.ID,
+		UserID:    group.TutorID,
+		Role:      models.StaffRoleOwner,
+		CreatedAt: group.CreatedAt,
+	}
+	return append([]*models.StaffMember{owner}, staff...), nil
+}
+
+// CheckPermission - проверка права для других сервисов, возвращает и роль пользователя в группе
+func (u *StaffUsecase) CheckPermission(ctx context.Context, groupID, userID string, perm models.Permission) (bool, models.StaffRole, error) {
+	if !perm.Valid() {
+		return false, "", models.ErrInvalidPermission
+	}
+
+	group, err := u.groupsRepo.GetGroup(ctx, groupID, false)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get group: %w", err)
+	}
+
+	role, err := roleInGroup(ctx, u.groupsRepo, group, userID)
+	if err != nil {
+		return false, "", err
+	}
+
+	return role.Can(perm), role, nil
+}
+
+// HasSharedGroup - проверка для отзывов user-service: учился ли ученик у репетитора
+// в какой-либо группе, в том числе архивной или переданной другому владельцу
+func (u *StaffUsecase) HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error) {
+	shared, err := u.staffRepo.HasSharedGroup(ctx, tutorID, studentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check shared group: %w", err)
+	}
+	return shared, nil
+}
+
+func (u *StaffUsecase) checkCandidate(ctx context.Context, groupID, staffUserID string, role models.StaffRole) error {
+	member, err := u.groupsRepo.IsMember(ctx, groupID, staffUserID)
+	if err != nil {
+		return fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member {
+		return models.ErrStaffIsMember
+	}
+
+	if role == models.StaffRoleCoTutor {
+		ok, err := u.userClient.ValidateTutor(ctx, staffUserID)
+		if err != nil {
+			return fmt.Errorf("failed to validate tutor: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("%w: co-tutor must have a tutor profile", models.ErrInvalidStaffRole)
+		}
+	}
+
+	return nil
+}
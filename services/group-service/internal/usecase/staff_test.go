@@ -0,0 +1,290 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+// mockStaffRepo хранит персонал в mockRepo.staff, чтобы проверки прав видели изменения
+type mockStaffRepo struct {
+	groups        *mockRepo
+	members       map[string]bool // текущие участники группы
+	formerMembers map[string]bool // исключенные и вышедшие
+	sharedErr     error
+}
+
+func (m *mockStaffRepo) AddStaff(ctx context.Context, member *models.StaffMember) error {
+	if _, ok := m.groups.staff[member.UserID]; ok {
+		return models.ErrStaffExists
+	}
+	m.groups.staff[member.UserID] = member.Role
+	return nil
+}
+
+func (m *mockStaffRepo) UpdateStaffRole(ctx context.Context, groupID, userID string, role models.StaffRole) (*models.StaffMember, error) {
+	if _, ok := m.groups.staff[userID]; !ok {
+		return nil, models.ErrStaffNotFound
+	}
+	m.groups.staff[userID] = role
+	return &models.StaffMember{GroupID: groupID, UserID: userID, Role: role}, nil
+}
+
+func (m *mockStaffRepo) RemoveStaff(ctx context.Context, groupID, userID string) error {
+	if _, ok := m.groups.staff[userID]; !ok {
+		return models.ErrStaffNotFound
+	}
+	delete(m.groups.staff, userID)
+	return nil
+}
+
+func (m *mockStaffRepo) ListStaff(ctx context.Context, groupID string) ([]*models.StaffMember, error) {
+	var result []*models.StaffMember
+	for userID, role := range m.groups.staff {
+		result = append(result, &models.StaffMember{GroupID: groupID, UserID: userID, Role: role})
+	}
+	return result, nil
+}
+
+func (m *mockStaffRepo) HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error) {
+	if m.sharedErr != nil {
+		return false, m.sharedErr
+	}
+	if !m.members[studentID] && !m.formerMembers[studentID] {
+		return false, nil
+	}
+	_, isStaff := m.groups.staff[tutorID]
+	return m.groups.getGroupFirstResult.TutorID == tutorID || isStaff, nil
+}
+
+func newTestStaffUsecase(staff map[string]models.StaffRole) (*usecase.StaffUsecase, *mockRepo, *mockUserClient) {
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", CreatedAt: time.Now()}
+	groups := groupRepoFor(group)
+	groups.staff = staff
+	if groups.staff == nil {
+		groups.staff = make(map[string]models.StaffRole)
+	}
+	userClient := &mockUserClient{validateResult: true}
+	return usecase.NewStaffUsecase(&mockStaffRepo{groups: groups}, groups, userClient), groups, userClient
+}
+
+func TestStaffRole_Can(t *testing.T) {
+	tests := []struct {
+		role  models.StaffRole
+		perm  models.Permission
+		allow bool
+	}{
+		{models.StaffRoleOwner, models.PermissionDeleteGroup, true},
+		{models.StaffRoleOwner, models.PermissionManageStaff, true},
+		{models.StaffRoleCoTutor, models.PermissionManageTasks, true},
+		{models.StaffRoleCoTutor, models.PermissionManageMembers, true},
+		{models.StaffRoleCoTutor, models.PermissionDeleteGroup, false},
+		{models.StaffRoleCoTutor, models.PermissionManageStaff, false},
+		{models.StaffRoleAssistant, models.PermissionGradeSubmissions, true},
+		{models.StaffRoleAssistant, models.PermissionManageTasks, false},
+		{"", models.PermissionGradeSubmissions, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Can(tt.perm); got != tt.allow {
+			t.Errorf("%q.Can(%q) = %v, want %v", tt.role, tt.perm, got, tt.allow)
+		}
+	}
+}
+
+func TestAddStaff(t *testing.T) {
+	ctx := context.Background()
+	u, groups, userClient := newTestStaffUsecase(nil)
+
+	member, err := u.AddStaff(ctx, "group1", "tutor1", "tutor2", models.StaffRoleCoTutor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.Role != models.StaffRoleCoTutor || member.AddedBy != "tutor1" {
+		t.Errorf("unexpected member %+v", member)
+	}
+	if !userClient.validateCalled {
+		t.Error("expected co-tutor to be validated as tutor")
+	}
+	if groups.staff["tutor2"] != models.StaffRoleCoTutor {
+		t.Error("expected co-tutor to be saved")
+	}
+
+	if _, err := u.AddStaff(ctx, "group1", "tutor1", "tutor2", models.StaffRoleAssistant); !errors.Is(err, models.ErrStaffExists) {
+		t.Errorf("expected ErrStaffExists, got %v", err)
+	}
+
+	// соведущий не управляет персоналом
+	if _, err := u.AddStaff(ctx, "group1", "tutor2", "assistant1", models.StaffRoleAssistant); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected ErrTutorIsNotValid, got %v", err)
+	}
+}
+
+func TestAddStaff_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		staffUser string
+		role      models.StaffRole
+		isMember  bool
+		isTutor   bool
+		wantErr   error
+	}{
+		{"owner role", "tutor2", models.StaffRoleOwner, false, true, models.ErrInvalidStaffRole},
+		{"owner itself", "tutor1", models.StaffRoleAssistant, false, true, models.ErrStaffExists},
+		{"group student", "student1", models.StaffRoleAssistant, true, false, models.ErrStaffIsMember},
+		{"co-tutor without tutor profile", "user1", models.StaffRoleCoTutor, false, false, models.ErrInvalidStaffRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, groups, userClient := newTestStaffUsecase(nil)
+			groups.isMember = tt.isMember
+			userClient.validateResult = tt.isTutor
+
+			if _, err := u.AddStaff(ctx, "group1", "tutor1", tt.staffUser, tt.role); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(groups.staff) != 0 {
+				t.Error("expected staff to stay empty")
+			}
+		})
+	}
+}
+
+func TestRemoveStaff(t *testing.T) {
+	ctx := context.Background()
+	u, groups, _ := newTestStaffUsecase(map[string]models.StaffRole{
+		"tutor2":     models.StaffRoleCoTutor,
+		"assistant1": models.StaffRoleAssistant,
+	})
+
+	if err := u.RemoveStaff(ctx, "group1", "tutor2", "assistant1"); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Fatalf("expected ErrTutorIsNotValid, got %v", err)
+	}
+
+	// сотрудник может уйти сам
+	if err := u.RemoveStaff(ctx, "group1", "assistant1", "assistant1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := u.RemoveStaff(ctx, "group1", "tutor1", "tutor2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(groups.staff) != 0 {
+		t.Errorf("expected staff to be empty, got %v", groups.staff)
+	}
+
+	if err := u.RemoveStaff(ctx, "group1", "tutor1", "tutor2"); !errors.Is(err, models.ErrStaffNotFound) {
+		t.Errorf("expected ErrStaffNotFound, got %v", err)
+	}
+}
+
+func TestListStaff_OwnerFirst(t *testing.T) {
+	u, _, _ := newTestStaffUsecase(map[string]models.StaffRole{"assistant1": models.StaffRoleAssistant})
+
+	staff, err := u.ListStaff(context.Background(), "group1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(staff) != 2 || staff[0].UserID != "tutor1" || staff[0].Role != models.StaffRoleOwner {
+		t.Fatalf("expected owner first, got %+v", staff)
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	ctx := context.Background()
+	u, _, _ := newTestStaffUsecase(map[string]models.StaffRole{"assistant1": models.StaffRoleAssistant})
+
+	tests := []struct {
+		userID   string
+		perm     models.Permission
+		allowed  bool
+		wantRole models.StaffRole
+	}{
+		{"tutor1", models.PermissionManageTasks, true, models.StaffRoleOwner},
+		{"assistant1", models.PermissionGradeSubmissions, true, models.StaffRoleAssistant},
+		{"assistant1", models.PermissionManageTasks, false, models.StaffRoleAssistant},
+		{"student1", models.PermissionGradeSubmissions, false, ""},
+	}
+
+	for _, tt := range tests {
+		allowed, role, err := u.CheckPermission(ctx, "group1", tt.userID, tt.perm)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if allowed != tt.allowed || role != tt.wantRole {
+			t.Errorf("CheckPermission(%s, %s) = %v, %q, want %v, %q", tt.userID, tt.perm, allowed, role, tt.allowed, tt.wantRole)
+		}
+	}
+
+	if _, _, err := u.CheckPermission(ctx, "group1", "tutor1", ""); !errors.Is(err, models.ErrInvalidPermission) {
+		t.Errorf("expected ErrInvalidPermission, got %v", err)
+	}
+}
+
+func TestCoTutorManagesGroup(t *testing.T) {
+	ctx := context.Background()
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"}
+	repo := groupRepoFor(group)
+	repo.staff = map[string]models.StaffRole{"tutor2": models.StaffRoleCoTutor, "assistant1": models.StaffRoleAssistant}
+	repo.addMembersCount = 1
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{validateResult: true}, "group-events")
+
+	if _, err := u.AddGroupMembers(ctx, "group1", "tutor2", []string{"student1"}); err != nil {
+		t.Errorf("expected co-tutor to add members, got %v", err)
+	}
+	archive := usecase.NewArchiveUsecase(repo, &mockOutbox{}, "group-events", 0)
+	if err := archive.DeleteGroup(ctx, "group1", "tutor2"); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected co-tutor not to delete group, got %v", err)
+	}
+	if _, err := u.AddGroupMembers(ctx, "group1", "assistant1", []string{"student1"}); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected assistant not to add members, got %v", err)
+	}
+}
+
+func TestHasSharedGroup(t *testing.T) {
+	ctx := context.Background()
+	groups := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"})
+	groups.staff = map[string]models.StaffRole{"tutor2": models.StaffRoleCoTutor}
+	repo := &mockStaffRepo{
+		groups:        groups,
+		members:       map[string]bool{"student1": true},
+		formerMembers: map[string]bool{"student2": true},
+	}
+	u := usecase.NewStaffUsecase(repo, groups, &mockUserClient{})
+
+	tests := []struct {
+		name      string
+		tutorID   string
+		studentID string
+		shared    bool
+	}{
+		{"current member", "tutor1", "student1", true},
+		{"former member", "tutor1", "student2", true},
+		{"former member of co-tutor", "tutor2", "student2", true},
+		{"non-member", "tutor1", "student3", false},
+		{"another tutor", "tutor3", "student1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shared, err := u.HasSharedGroup(ctx, tt.tutorID, tt.studentID)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if shared != tt.shared {
+				t.Errorf("expected shared=%v, got %v", tt.shared, shared)
+			}
+		})
+	}
+
+	repo.sharedErr = errors.New("db down")
+	if _, err := u.HasSharedGroup(ctx, "tutor1", "student1"); err == nil {
+		t.Error("expected error")
+	}
+}
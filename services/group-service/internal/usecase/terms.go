@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxTermNameLength = 255
+	// сколько групп закончившихся периодов архивируется за один проход расписания
+	termArchiveBatch = 100
+)
+
+type TermsRepo interface {
+	CreateTerm(ctx context.Context, t *models.Term) error
+	GetTerm(ctx context.Context, id string) (*models.Term, error)
+	ListTerms(ctx context.Context, ownerID string) ([]*models.Term, error)
+	UpdateTerm(ctx context.Context, t *models.Term) error
+	DeleteTerm(ctx context.Context, id string) error
+	SetGroupTerm(ctx context.Context, groupID, termID string) error
+	ListEndedTermGroups(ctx context.Context, now time.Time, limit int) ([]*models.Group, error)
+}
+
+type UpdateTermParams struct {
+	TermID   string
+	UserID   string
+	Name     *string
+	StartsAt *time.Time
+	EndsAt   *time.Time
+}
+
+// TermsUsecase - учебные периоды репетитора. Период видит и меняет только владелец,
+// группы закончившегося периода архивируются по расписанию так же, как вручную.
+type TermsUsecase struct {
+	termsRepo  TermsRepo
+	groupsRepo GroupsRepo
+	outbox     Outbox
+	userClient UserClient
+	topic      string
+	now        func() time.Time
+}
+
+func NewTermsUsecase(termsRepo TermsRepo, groupsRepo GroupsRepo, outbox Outbox, userClient UserClient, topic string) *TermsUsecase {
+	return &TermsUsecase{
+		termsRepo:  termsRepo,
+		groupsRepo: groupsRepo,
+		outbox:     outbox,
+		userClient: userClient,
+		topic:      topic,
+		now:        time.Now,
+	}
+}
+
+func (u *TermsUsecase) CreateTerm(ctx context.Context, userID, name string, startsAt, endsAt time.Time) (*models.Term, error) {
+	name, err := validateTerm(name, startsAt, endsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := u.userClient.ValidateTutor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate tutor: %w", err)
+	}
+	if !ok {
+		return nil, models.ErrTutorIsNotValid
+	}
+
+	term := &models.Term{
+		ID:        uuid.New().String(),
+		OwnerID:   userID,
+		Name:      name,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		CreatedAt: u.now(),
+	}
+	if err := u.termsRepo.CreateTerm(ctx, term); err != nil {
+		return nil, fmt.Errorf("failed to create term: %w", err)
+	}
+
+	return term, nil
+}
+
+func (u *TermsUsecase) ListTerms(ctx context.Context, userID string) ([]*models.Term, error) {
+	terms, err := u.termsRepo.ListTerms(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	return terms, nil
+}
+
+func (u *TermsUsecase) GetTerm(ctx context.Context, termID, userID string) (*models.Term, error) {
+	return u.ownTerm(ctx, termID, userID)
+}
+
+// UpdateTerm меняет название и границы периода. Если период закончился раньше,
+// его группы архивирует ближайший проход расписания.
+func (u *TermsUsecase) UpdateTerm(ctx context.Context, p UpdateTermParams) (*models.Term, error) {
+	term, err := u.ownTerm(ctx, p.TermID, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Name != nil {
+		term.Name = *p.Name
+	}
+	if p.StartsAt != nil {
+		term.StartsAt = *p.StartsAt
+	}
+	if p.EndsAt != nil {
+		term.EndsAt = *p.EndsAt
+	}
+	if term.Name, err = validateTerm(term.Name, term.StartsAt, term.EndsAt); err != nil {
+		return nil, err
+	}
+
+	if err := u.termsRepo.UpdateTerm(ctx, term); err != nil {
+		if errors.Is(err, models.ErrTermNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update term: %w", err)
+	}
+
+	return term, nil
+}
+
+// DeleteTerm удаляет период без активных групп, архивные группы теряют привязку
+func (u *TermsUsecase) DeleteTerm(ctx context.Context, termID, userID string) error {
+	term, err := u.ownTerm(ctx, termID, userID)
+	if err != nil {
+		return err
+	}
+	if term.GroupCount > 0 {
+		return fmt.Errorf("%w: detach or archive %d groups first", models.ErrTermInUse, term.GroupCount)
+	}
+
+	if err := u.termsRepo.DeleteTerm(ctx, termID); err != nil {
+		if errors.Is(err, models.ErrTermNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete term: %w", err)
+	}
+
+	return nil
+}
+
+// SetGroupTerm привязывает группу к периоду владельца группы, пустой termID снимает привязку.
+// К закончившемуся периоду группу привязать нельзя.
+func (u *TermsUsecase) SetGroupTerm(ctx context.Context, groupID, userID, termID string) (*models.Group, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionUpdateGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if termID != "" {
+		term, err := u.termsRepo.GetTerm(ctx, termID)
+		if err != nil {
+			if errors.Is(err, models.ErrTermNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to get term: %w", err)
+		}
+		if term.OwnerID != group.TutorID {
+			return nil, fmt.Errorf("%w: term belongs to another tutor", models.ErrInvalidTerm)
+		}
+		if term.Ended(u.now()) {
+			return nil, fmt.Errorf("%w: term has already ended", models.ErrInvalidTerm)
+		}
+	}
+
+	// потребители снимков группы узнают о смене периода из GroupUpdated
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.termsRepo.SetGroupTerm(ctx, groupID, termID); err != nil {
+			return fmt.Errorf("failed to set group term: %w", err)
+		}
+
+		group, err = u.groupsRepo.GetGroup(ctx, groupID, false)
+		if err != nil {
+			return fmt.Errorf("failed to get updated group: %w", err)
+		}
+		members, err := u.groupsRepo.GetGroupMembers(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to get group members: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.addSnapshot(events.GroupUpdated, group, members, userID)
+		return batch.save(ctx, u.outbox, u.topic, groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ArchiveEndedTerms архивирует активные группы закончившихся периодов.
+// Группу, которую уже архивировали вручную или другой экземпляр сервиса, пропускает.
+func (u *TermsUsecase) ArchiveEndedTerms(ctx context.Context) error {
+	now := u.now()
+	groups, err := u.termsRepo.ListEndedTermGroups(ctx, now, termArchiveBatch)
+	if err != nil {
+		return fmt.Errorf("failed to list ended term groups: %w", err)
+	}
+
+	for _, group := range groups {
+		err := u.outbox.InTx(ctx, func(ctx context.Context) error {
+			if err := u.groupsRepo.ArchiveGroup(ctx, group.ID, now); err != nil {
+				if errors.Is(err, models.ErrGroupArchived) {
+					return nil
+				}
+				return fmt.Errorf("failed to archive group: %w", err)
+			}
+			group.ArchivedAt = &now
+
+			return saveGroupState(ctx, u.outbox, u.topic, events.GroupArchived, group, "")
+		})
+		if err != nil {
+			log.Printf("failed to archive group %s at the end of term %s: %v", group.ID, group.Term.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// StartScheduler запускает архивацию групп закончившихся периодов, interval <= 0 отключает расписание
+func (u *TermsUsecase) StartScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := u.ArchiveEndedTerms(ctx); err != nil {
+					log.Printf("term scheduler: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ownTerm - чужой период выглядит как несуществующий
+func (u *TermsUsecase) ownTerm(ctx context.Context, termID, userID string) (*models.Term, error) {
+	term, err := u.termsRepo.GetTerm(ctx, termID)
+	if err != nil {
+		if errors.Is(err, models.ErrTermNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get term: %w", err)
+	}
+	if term.OwnerID != userID {
+		return nil, models.ErrTermNotFound
+	}
+	return term, nil
+}
+
+func validateTerm(name string, startsAt, endsAt time.Time) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", models.ErrInvalidTerm)
+	}
+	if utf8.RuneCountInString(name) > maxTermNameLength {
+		return "", fmt.Errorf("%w: name is longer than %d characters", models.ErrInvalidTerm, maxTermNameLength)
+	}
+	if startsAt.IsZero() || endsAt.IsZero() {
+		return "", fmt.Errorf("%w: starts_at and ends_at are required", models.ErrInvalidTerm)
+	}
+	if !startsAt.Before(endsAt) {
+		return "", fmt.Errorf("%w: starts_at must be before ends_at", models.ErrInvalidTerm)
+	}
+	return name, nil
+}
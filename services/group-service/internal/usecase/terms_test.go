@@ -0,0 +1,276 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+type mockTermsRepo struct {
+	terms       map[string]*models.Term
+	groupTerms  map[string]string // groupID -> termID
+	endedGroups []*models.Group
+	updated     bool
+	deleted     bool
+}
+
+func newMockTermsRepo(terms ...*models.Term) *mockTermsRepo {
+	m := &mockTermsRepo{terms: map[string]*models.Term{}, groupTerms: map[string]string{}}
+	for _, t := range terms {
+		m.terms[t.ID] = t
+	}
+	return m
+}
+
+func (m *mockTermsRepo) CreateTerm(ctx context.Context, t *models.Term) error {
+	m.terms[t.ID] = t
+	return nil
+}
+
+func (m *mockTermsRepo) GetTerm(ctx context.Context, id string) (*models.Term, error) {
+	t, ok := m.terms[id]
+	if !ok {
+		return nil, models.ErrTermNotFound
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *mockTermsRepo) ListTerms(ctx context.Context, ownerID string) ([]*models.Term, error) {
+	var result []*models.Term
+	for _, t := range m.terms {
+		if t.OwnerID == ownerID {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTermsRepo) UpdateTerm(ctx context.Context, t *models.Term) error {
+	m.updated = true
+	m.terms[t.ID] = t
+	return nil
+}
+
+func (m *mockTermsRepo) DeleteTerm(ctx context.Context, id string) error {
+	m.deleted = true
+	delete(m.terms, id)
+	return nil
+}
+
+func (m *mockTermsRepo) SetGroupTerm(ctx context.Context, groupID, termID string) error {
+	m.groupTerms[groupID] = termID
+	return nil
+}
+
+func (m *mockTermsRepo) ListEndedTermGroups(ctx context.Context, now time.Time, limit int) ([]*models.Group, error) {
+	return m.endedGroups, nil
+}
+
+func newTestTermsUsecase(terms *mockTermsRepo, group *models.Group) (*usecase.TermsUsecase, *mockRepo, *mockOutbox) {
+	repo := groupRepoFor(group)
+	outbox := &mockOutbox{}
+	return usecase.NewTermsUsecase(terms, repo, outbox, &mockUserClient{validateResult: true}, "group-events"), repo, outbox
+}
+
+func TestCreateTerm_Validation(t *testing.T) {
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		termName string
+		startsAt time.Time
+		endsAt   time.Time
+		wantErr  error
+	}{
+		{"valid", "  Fall 2026 ", start, end, nil},
+		{"empty name", "  ", start, end, models.ErrInvalidTerm},
+		{"missing dates", "Fall 2026", time.Time{}, end, models.ErrInvalidTerm},
+		{"ends before start", "Fall 2026", end, start, models.ErrInvalidTerm},
+		{"zero length", "Fall 2026", start, start, models.ErrInvalidTerm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms := newMockTermsRepo()
+			u, _, _ := newTestTermsUsecase(terms, nil)
+
+			term, err := u.CreateTerm(context.Background(), "tutor1", tt.termName, tt.startsAt, tt.endsAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				if len(terms.terms) != 0 {
+					t.Error("invalid term must not be saved")
+				}
+				return
+			}
+			if term.Name != "Fall 2026" || term.OwnerID != "tutor1" {
+				t.Errorf("unexpected term: %+v", term)
+			}
+		})
+	}
+}
+
+func TestUpdateTerm(t *testing.T) {
+	ctx := context.Background()
+	term := &models.Term{ID: "term1", OwnerID: "tutor1", Name: "Fall 2026",
+		StartsAt: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), EndsAt: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)}
+	terms := newMockTermsRepo(term)
+	u, _, _ := newTestTermsUsecase(terms, nil)
+
+	// чужой период не виден
+	name := "Spring 2027"
+	if _, err := u.UpdateTerm(ctx, usecase.UpdateTermParams{TermID: "term1", UserID: "tutor2", Name: &name}); !errors.Is(err, models.ErrTermNotFound) {
+		t.Errorf("expected ErrTermNotFound for another tutor, got %v", err)
+	}
+
+	// новое окончание раньше начала
+	endsAt := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := u.UpdateTerm(ctx, usecase.UpdateTermParams{TermID: "term1", UserID: "tutor1", EndsAt: &endsAt}); !errors.Is(err, models.ErrInvalidTerm) {
+		t.Errorf("expected ErrInvalidTerm, got %v", err)
+	}
+	if terms.updated {
+		t.Error("invalid update must not be saved")
+	}
+
+	endsAt = time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	updated, err := u.UpdateTerm(ctx, usecase.UpdateTermParams{TermID: "term1", UserID: "tutor1", EndsAt: &endsAt})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !updated.EndsAt.Equal(endsAt) || updated.Name != "Fall 2026" {
+		t.Errorf("unexpected term: %+v", updated)
+	}
+}
+
+func TestDeleteTerm_InUse(t *testing.T) {
+	ctx := context.Background()
+	terms := newMockTermsRepo(&models.Term{ID: "term1", OwnerID: "tutor1", GroupCount: 2})
+	u, _, _ := newTestTermsUsecase(terms, nil)
+
+	if err := u.DeleteTerm(ctx, "term1", "tutor1"); !errors.Is(err, models.ErrTermInUse) {
+		t.Fatalf("expected ErrTermInUse, got %v", err)
+	}
+	if terms.deleted {
+		t.Error("term with active groups must not be deleted")
+	}
+
+	terms.terms["term1"].GroupCount = 0
+	if err := u.DeleteTerm(ctx, "term1", "tutor1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !terms.deleted {
+		t.Error("expected term to be deleted")
+	}
+}
+
+func TestSetGroupTerm(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	terms := newMockTermsRepo(
+		&models.Term{ID: "current", OwnerID: "tutor1", StartsAt: now.AddDate(0, -1, 0), EndsAt: now.AddDate(0, 1, 0)},
+		&models.Term{ID: "ended", OwnerID: "tutor1", StartsAt: now.AddDate(0, -3, 0), EndsAt: now.AddDate(0, -1, 0)},
+		&models.Term{ID: "foreign", OwnerID: "tutor2", StartsAt: now.AddDate(0, -1, 0), EndsAt: now.AddDate(0, 1, 0)},
+	)
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A"}
+
+	tests := []struct {
+		name    string
+		userID  string
+		termID  string
+		wantErr error
+	}{
+		{"attach current term", "tutor1", "current", nil},
+		{"detach term", "tutor1", "", nil},
+		{"ended term", "tutor1", "ended", models.ErrInvalidTerm},
+		{"term of another tutor", "tutor1", "foreign", models.ErrInvalidTerm},
+		{"unknown term", "tutor1", "missing", models.ErrTermNotFound},
+		{"not group staff", "tutor2", "current", models.ErrTutorIsNotValid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms.groupTerms = map[string]string{}
+			u, _, outbox := newTestTermsUsecase(terms, group)
+
+			_, err := u.SetGroupTerm(ctx, "group1", tt.userID, tt.termID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			termID, called := terms.groupTerms["group1"]
+			if called != (tt.wantErr == nil) || (called && termID != tt.termID) {
+				t.Errorf("unexpected group term change: called=%v term=%q", called, termID)
+			}
+			if updated := len(outbox.ofType(events.GroupUpdated)); updated != len(outbox.events) || (updated == 1) != called {
+				t.Errorf("expected GroupUpdated only on term change, got %v", outbox.types())
+			}
+		})
+	}
+}
+
+func TestSetGroupTerm_GroupUpdated(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	term := &models.Term{ID: "current", OwnerID: "tutor1", StartsAt: now.AddDate(0, -1, 0), EndsAt: now.AddDate(0, 1, 0)}
+	u, repo, outbox := newTestTermsUsecase(newMockTermsRepo(term), &models.Group{ID: "group1", TutorID: "tutor1"})
+	repo.getGroupSecondResult = &models.Group{ID: "group1", TutorID: "tutor1", Term: term}
+
+	if _, err := u.SetGroupTerm(ctx, "group1", "tutor1", "current"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 1 || outbox.keys[0] != "group1" {
+		t.Fatalf("expected GroupUpdated keyed by group, got %v", outbox.types())
+	}
+	var payload events.GroupSnapshotPayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.TermID != "current" || payload.ActorID != "tutor1" {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestArchiveEndedTerms(t *testing.T) {
+	ctx := context.Background()
+	term := &models.Term{ID: "term1", OwnerID: "tutor1", EndsAt: time.Now().Add(-time.Hour)}
+	group := &models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", Term: term}
+	terms := newMockTermsRepo(term)
+	terms.endedGroups = []*models.Group{group}
+
+	u, repo, outbox := newTestTermsUsecase(terms, group)
+	if err := u.ArchiveEndedTerms(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.archiveGroupCalled || !group.Archived() {
+		t.Fatal("expected group to be archived")
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != events.GroupArchived {
+		t.Fatalf("expected GroupArchived event, got %+v", outbox.events)
+	}
+	var payload events.GroupPayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.GroupID != "group1" || payload.ActorID != "" || payload.ArchivedAt == nil {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+
+	// группу уже архивировали между выборкой и архивацией
+	group.ArchivedAt = nil
+	u, repo, outbox = newTestTermsUsecase(terms, group)
+	repo.archiveGroupErr = models.ErrGroupArchived
+	if err := u.ArchiveEndedTerms(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(outbox.events) != 0 {
+		t.Errorf("expected no events for already archived group, got %+v", outbox.events)
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"group_service/internal/events"
 	"group_service/internal/models"
 	"time"
 
@@ -11,33 +12,59 @@ import (
 
 type GroupsRepo interface {
 	CreateGroup(ctx context.Context, group *models.Group) error
-	ListTutorGroups(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error)
-	ListStudentGroups(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error)
+	ListGroups(ctx context.Context, q models.GroupQuery) ([]*models.Group, error)
 	GetGroup(ctx context.Context, id string, includeMembers bool) (*models.Group, error)
-	UpdateGroup(ctx context.Context, id string, name, desc *string) error
+	UpdateGroup(ctx context.Context, id string, name, desc *string, policy *models.JoinPolicy, maxMembers *int) error
+	ArchiveGroup(ctx context.Context, id string, archivedAt time.Time) error
+	RestoreGroup(ctx context.Context, id string) error
 	DeleteGroup(ctx context.Context, id string) error
-	AddMembers(ctx context.Context, groupID string, studentIDs []string) (int, error)
-	RemoveMembers(ctx context.Context, groupID string, studentIDs []string) (int, error)
+	AddMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error)
+	RemoveMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error)
+	PromoteWaitlist(ctx context.Context, groupID string) ([]string, error)
+	ListWaitlist(ctx context.Context, groupID string) ([]*models.WaitlistEntry, error)
+	RemoveFromWaitlist(ctx context.Context, groupID, studentID string) error
 	GetGroupMembers(ctx context.Context, groupID string) ([]*models.GroupMember, error)
+	IsMember(ctx context.Context, groupID, studentID string) (bool, error)
+	GetStaffRole(ctx context.Context, groupID, userID string) (models.StaffRole, error)
 }
 
 type UserClient interface {
 	ValidateTutor(ctx context.Context, tutorId string) (bool, error)
+	CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error)
+	ResolveUsers(ctx context.Context, ids, emails []string) ([]*models.UserInfo, error)
 }
 
+// GroupsUsecase публикует изменения групп и их состава через outbox, чтобы потребители
+// вели локальную копию состава. Уведомления о переводе из очереди сохраняются туда же.
 type GroupsUsecase struct {
 	groupsRepo GroupsRepo
+	outbox     Outbox
 	userClient UserClient
+	topic      string
 }
 
-func NewGroupsUsecase(groupsRepo GroupsRepo, userClient UserClient) *GroupsUsecase {
+func NewGroupsUsecase(groupsRepo GroupsRepo, outbox Outbox, userClient UserClient, topic string) *GroupsUsecase {
 	return &GroupsUsecase{
 		groupsRepo: groupsRepo,
+		outbox:     outbox,
 		userClient: userClient,
+		topic:      topic,
 	}
 }
 
-func (u *GroupsUsecase) CreateGroup(ctx context.Context, tutorID, name, desc string) (*models.Group, error) {
+// CreateGroup создает группу, без политики вступления группа доступна только по приглашению.
+// maxMembers = 0 - без ограничения числа участников.
+func (u *GroupsUsecase) CreateGroup(ctx context.Context, tutorID, name, desc string, policy models.JoinPolicy, maxMembers int) (*models.Group, error) {
+	if maxMembers < 0 {
+		return nil, models.ErrInvalidCapacity
+	}
+	if policy == "" {
+		policy = models.JoinPolicyInviteOnly
+	}
+	if !policy.Valid() {
+		return nil, models.ErrInvalidJoinPolicy
+	}
+
 	ok, err := u.userClient.ValidateTutor(ctx, tutorID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate tutor: %w", err)
@@ -51,43 +78,26 @@ func (u *GroupsUsecase) CreateGroup(ctx context.Context, tutorID, name, desc str
 		TutorID:     tutorID,
 		Name:        name,
 		Description: desc,
+		JoinPolicy:  policy,
+		MaxMembers:  maxMembers,
 		CreatedAt:   time.Now(),
 		Members:     []*models.GroupMember{},
 	}
 
-	if err := u.groupsRepo.CreateGroup(ctx, group); err != nil {
-		return nil, fmt.Errorf("failed to create group: %w", err)
-	}
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.groupsRepo.CreateGroup(ctx, group); err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
+		}
 
-	return group, nil
-}
-
-// ListGroupsByTutor возвращает группы репетитора
-func (u *GroupsUsecase) ListGroupsByTutor(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error) {
-	ok, err := u.userClient.ValidateTutor(ctx, tutorID)
+		var batch outboxBatch
+		batch.addSnapshot(events.GroupCreated, group, group.Members, tutorID)
+		return batch.save(ctx, u.outbox, u.topic, group.ID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate tutor: %w", err)
-	}
-	if !ok {
-		return nil, models.ErrTutorIsNotValid
+		return nil, err
 	}
 
-	groups, err := u.groupsRepo.ListTutorGroups(ctx, tutorID, includeMembers)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list tutor groups: %w", err)
-	}
-
-	return groups, nil
-}
-
-// ListGroupsByStudent возвращает группы студента
-func (u *GroupsUsecase) ListGroupsByStudent(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error) {
-	groups, err := u.groupsRepo.ListStudentGroups(ctx, studentID, includeMembers)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list student groups: %w", err)
-	}
-
-	return groups, nil
+	return group, nil
 }
 
 func (u *GroupsUsecase) GetGroup(ctx context.Context, id string, includeMembers bool) (*models.Group, error) {
@@ -99,82 +109,78 @@ func (u *GroupsUsecase) GetGroup(ctx context.Context, id string, includeMembers
 	return group, nil
 }
 
-func (u *GroupsUsecase) UpdateGroup(ctx context.Context, groupId, userId string, name, desc *string) (*models.Group, error) {
-	group, err := u.groupsRepo.GetGroup(ctx, groupId, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get group: %w", err)
-	}
-	if group.TutorID != userId {
-		return nil, models.ErrTutorIsNotValid
-	}
-
-	if err := u.groupsRepo.UpdateGroup(ctx, groupId, name, desc); err != nil {
-		return nil, fmt.Errorf("failed to update group: %w", err)
-	}
-
-	updatedGroup, err := u.groupsRepo.GetGroup(ctx, groupId, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get updated group: %w", err)
-	}
-
-	return updatedGroup, nil
-}
-
-func (u *GroupsUsecase) DeleteGroup(ctx context.Context, groupId, userId string) error {
-	group, err := u.groupsRepo.GetGroup(ctx, groupId, false)
-	if err != nil {
-		return fmt.Errorf("failed to get group: %w", err)
+// UpdateGroup изменяет группу. Уменьшение лимита не исключает участников,
+// при увеличении освободившиеся места занимают ученики из очереди.
+func (u *GroupsUsecase) UpdateGroup(ctx context.Context, groupId, userId string, name, desc *string, policy *models.JoinPolicy, maxMembers *int) (*models.Group, error) {
+	if policy != nil && !policy.Valid() {
+		return nil, models.ErrInvalidJoinPolicy
 	}
-	if group.TutorID != userId {
-		return models.ErrTutorIsNotValid
+	if maxMembers != nil && *maxMembers < 0 {
+		return nil, models.ErrInvalidCapacity
 	}
 
-	if err := u.groupsRepo.DeleteGroup(ctx, groupId); err != nil {
-		return fmt.Errorf("failed to delete group: %w", err)
-	}
-
-	return nil
-}
-
-func (u *GroupsUsecase) AddGroupMembers(ctx context.Context, groupId, userId string, studentIDs []string) (int, error) {
-	group, err := u.groupsRepo.GetGroup(ctx, groupId, false)
+	_, err := authorizeChange(ctx, u.groupsRepo, groupId, userId, models.PermissionUpdateGroup)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get group: %w", err)
-	}
-	if group.TutorID != userId {
-		return 0, models.ErrTutorIsNotValid
-	}
-
-	addedCount, err := u.groupsRepo.AddMembers(ctx, groupId, studentIDs)
+		return nil, err
+	}
+
+	var promoted []string
+	var updatedGroup *models.Group
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if err := u.groupsRepo.UpdateGroup(ctx, groupId, name, desc, policy, maxMembers); err != nil {
+			return fmt.Errorf("failed to update group: %w", err)
+		}
+
+		if maxMembers != nil {
+			if promoted, err = u.groupsRepo.PromoteWaitlist(ctx, groupId); err != nil {
+				return fmt.Errorf("failed to promote waitlist: %w", err)
+			}
+		}
+
+		if updatedGroup, err = u.groupsRepo.GetGroup(ctx, groupId, false); err != nil {
+			return fmt.Errorf("failed to get updated group: %w", err)
+		}
+		members, err := u.groupsRepo.GetGroupMembers(ctx, groupId)
+		if err != nil {
+			return fmt.Errorf("failed to get group members: %w", err)
+		}
+
+		var batch outboxBatch
+		batch.addMembers(events.MembersAdded, updatedGroup, promoted, events.MembersSourceWaitlist, "")
+		batch.addPromoted(updatedGroup, promoted)
+		batch.addSnapshot(events.GroupUpdated, updatedGroup, members, userId)
+		return batch.save(ctx, u.outbox, u.topic, groupId)
+	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to add members: %w", err)
+		return nil, err
 	}
 
-	return addedCount, nil
+	return updatedGroup, nil
 }
 
-func (u *GroupsUsecase) RemoveGroupMembers(ctx context.Context, groupId, userId string, studentIDs []string) (int, error) {
-	group, err := u.groupsRepo.GetGroup(ctx, groupId, false)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get group: %w", err)
-	}
-	if group.TutorID != userId {
-		return 0, models.ErrTutorIsNotValid
-	}
-
-	removedCount, err := u.groupsRepo.RemoveMembers(ctx, groupId, studentIDs)
+// RemoveGroupMembers исключает учеников, освободившиеся места занимают ученики из очереди.
+// Возвращает число исключенных и ID переведенных из очереди.
+func (u *GroupsUsecase) RemoveGroupMembers(ctx context.Context, groupId, userId string, studentIDs []string) (int, []string, error) {
+	group, err := authorizeChange(ctx, u.groupsRepo, groupId, userId, models.PermissionManageMembers)
 	if err != nil {
-		return 0, fmt.Errorf("failed to remove members: %w", err)
+		return 0, nil, err
 	}
 
-	return removedCount, nil
-}
+	var removed, promoted []string
+	err = u.outbox.InTx(ctx, func(ctx context.Context) error {
+		if removed, promoted, err = u.groupsRepo.RemoveMembers(ctx, groupId, studentIDs); err != nil {
+			return fmt.Errorf("failed to remove members: %w", err)
+		}
 
-func (u *GroupsUsecase) ListGroupMembers(ctx context.Context, groupId string) ([]*models.GroupMember, error) {
-	members, err := u.groupsRepo.GetGroupMembers(ctx, groupId)
+		var batch outboxBatch
+		batch.addMembers(events.MembersRemoved, group, removed, events.MembersSourceManual, userId)
+		batch.addMembers(events.MembersAdded, group, promoted, events.MembersSourceWaitlist, "")
+		batch.addPromoted(group, promoted)
+		return batch.save(ctx, u.outbox, u.topic, groupId)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list group members: %w", err)
+		return 0, nil, err
 	}
 
-	return members, nil
+	return len(removed), promoted, nil
 }
@@ -3,7 +3,11 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"group_service/internal/models"
 	"group_service/internal/usecase"
@@ -14,6 +18,8 @@ type mockRepo struct {
 	getGroupCalled      bool
 	updateGroupCalled   bool
 	deleteGroupCalled   bool
+	archiveGroupCalled  bool
+	restoreGroupCalled  bool
 	addMembersCalled    bool
 	removeMembersCalled bool
 
@@ -22,18 +28,27 @@ type mockRepo struct {
 	getGroupErr          error
 	updateGroupErr       error
 	deleteGroupErr       error
+	archiveGroupErr      error
 	addMembersCount      int
 	addMembersErr        error
 	removeMembersCount   int
 	removeMembersErr     error
+	addMembersWaitlisted int      // сколько учеников после добавленных встают в очередь
+	promoted             []string // ID переведенных из очереди при удалении и PromoteWaitlist
+	promoteCalled        bool
+	updatedMaxMembers    *int
+	waitlist             []*models.WaitlistEntry
+	isMember             bool
+	staff                map[string]models.StaffRole // userID -> роль соведущего или ассистента
+	listGroupsQuery      models.GroupQuery           // последний запрос списка групп
+	addedStudentIDs      []string                    // последний запрос AddMembers
 
 	// Счётчик вызовов GetGroup
 	getGroupCallCount int
 
 	// Поля для переопределения методов
-	getGroupMembersFunc   func(ctx context.Context, groupID string) ([]*models.GroupMember, error)
-	listTutorGroupsFunc   func(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error)
-	listStudentGroupsFunc func(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error)
+	getGroupMembersFunc func(ctx context.Context, groupID string) ([]*models.GroupMember, error)
+	listGroupsFunc      func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error)
 }
 
 func (m *mockRepo) CreateGroup(ctx context.Context, group *models.Group) error {
@@ -50,8 +65,9 @@ func (m *mockRepo) GetGroup(ctx context.Context, id string, includeMembers bool)
 	return m.getGroupSecondResult, m.getGroupErr
 }
 
-func (m *mockRepo) UpdateGroup(ctx context.Context, id string, name, desc *string) error {
+func (m *mockRepo) UpdateGroup(ctx context.Context, id string, name, desc *string, policy *models.JoinPolicy, maxMembers *int) error {
 	m.updateGroupCalled = true
+	m.updatedMaxMembers = maxMembers
 	return m.updateGroupErr
 }
 
@@ -60,30 +76,67 @@ func (m *mockRepo) DeleteGroup(ctx context.Context, id string) error {
 	return m.deleteGroupErr
 }
 
-func (m *mockRepo) AddMembers(ctx context.Context, groupID string, studentIDs []string) (int, error) {
+func (m *mockRepo) AddMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error) {
 	m.addMembersCalled = true
-	return m.addMembersCount, m.addMembersErr
+	m.addedStudentIDs = studentIDs
+	added := min(m.addMembersCount, len(studentIDs))
+	return studentIDs[:added], studentIDs[added:min(added+m.addMembersWaitlisted, len(studentIDs))], m.addMembersErr
 }
 
-func (m *mockRepo) RemoveMembers(ctx context.Context, groupID string, studentIDs []string) (int, error) {
+func (m *mockRepo) RemoveMembers(ctx context.Context, groupID string, studentIDs []string) ([]string, []string, error) {
 	m.removeMembersCalled = true
-	return m.removeMembersCount, m.removeMembersErr
+	return studentIDs[:min(m.removeMembersCount, len(studentIDs))], m.promoted, m.removeMembersErr
 }
 
-func (m *mockRepo) ListTutorGroups(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error) {
-	if m.listTutorGroupsFunc != nil {
-		return m.listTutorGroupsFunc(ctx, tutorID, includeMembers)
+func (m *mockRepo) PromoteWaitlist(ctx context.Context, groupID string) ([]string, error) {
+	m.promoteCalled = true
+	return m.promoted, nil
+}
+
+func (m *mockRepo) ListWaitlist(ctx context.Context, groupID string) ([]*models.WaitlistEntry, error) {
+	return m.waitlist, nil
+}
+
+func (m *mockRepo) RemoveFromWaitlist(ctx context.Context, groupID, studentID string) error {
+	for i, e := range m.waitlist {
+		if e.StudentID == studentID {
+			m.waitlist = append(m.waitlist[:i], m.waitlist[i+1:]...)
+			return nil
+		}
 	}
-	return nil, nil
+	return models.ErrNotOnWaitlist
+}
+
+func (m *mockRepo) ArchiveGroup(ctx context.Context, id string, archivedAt time.Time) error {
+	m.archiveGroupCalled = true
+	return m.archiveGroupErr
 }
 
-func (m *mockRepo) ListStudentGroups(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error) {
-	if m.listStudentGroupsFunc != nil {
-		return m.listStudentGroupsFunc(ctx, studentID, includeMembers)
+func (m *mockRepo) RestoreGroup(ctx context.Context, id string) error {
+	m.restoreGroupCalled = true
+	return nil
+}
+
+func (m *mockRepo) ListGroups(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+	m.listGroupsQuery = q
+	if m.listGroupsFunc != nil {
+		return m.listGroupsFunc(ctx, q)
 	}
 	return nil, nil
 }
 
+func (m *mockRepo) IsMember(ctx context.Context, groupID, studentID string) (bool, error) {
+	return m.isMember, nil
+}
+
+func (m *mockRepo) GetStaffRole(ctx context.Context, groupID, userID string) (models.StaffRole, error) {
+	role, ok := m.staff[userID]
+	if !ok {
+		return "", models.ErrStaffNotFound
+	}
+	return role, nil
+}
+
 func (m *mockRepo) GetGroupMembers(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
 	if m.getGroupMembersFunc != nil {
 		return m.getGroupMembersFunc(ctx, groupID)
@@ -95,6 +148,12 @@ type mockUserClient struct {
 	validateCalled bool
 	validateResult bool
 	validateErr    error
+
+	guardianResult bool
+	guardianErr    error
+
+	users      []*models.UserInfo // nil - любой ID считается учеником
+	resolveErr error
 }
 
 func (m *mockUserClient) ValidateTutor(ctx context.Context, tutorId string) (bool, error) {
@@ -102,14 +161,40 @@ func (m *mockUserClient) ValidateTutor(ctx context.Context, tutorId string) (boo
 	return m.validateResult, m.validateErr
 }
 
+func (m *mockUserClient) CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error) {
+	return m.guardianResult, m.guardianErr
+}
+
+func (m *mockUserClient) ResolveUsers(ctx context.Context, ids, emails []string) ([]*models.UserInfo, error) {
+	if m.resolveErr != nil {
+		return nil, m.resolveErr
+	}
+	if m.users == nil {
+		result := make([]*models.UserInfo, len(ids))
+		for i, id := range ids {
+			result[i] = &models.UserInfo{ID: id, IsStudent: true}
+		}
+		return result, nil
+	}
+
+	var result []*models.UserInfo
+	for _, u := range m.users {
+		// user-service ищет email без учета регистра
+		if slices.Contains(ids, u.ID) || slices.ContainsFunc(emails, func(e string) bool { return strings.EqualFold(e, u.Email) }) {
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
 func TestCreateGroup_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockRepo{}
 	user := &mockUserClient{validateResult: true}
-	u := usecase.NewGroupsUsecase(repo, user)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
 
 	tutorID := "tutor123"
-	group, err := u.CreateGroup(ctx, tutorID, "Math 10A", "Algebra")
+	group, err := u.CreateGroup(ctx, tutorID, "Math 10A", "Algebra", "", 0)
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -132,9 +217,9 @@ func TestCreateGroup_NotTutor(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockRepo{}
 	user := &mockUserClient{validateResult: false}
-	u := usecase.NewGroupsUsecase(repo, user)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
 
-	_, err := u.CreateGroup(ctx, "tutor456", "Test", "")
+	_, err := u.CreateGroup(ctx, "tutor456", "Test", "", "", 0)
 
 	if err == nil {
 		t.Error("expected error")
@@ -164,10 +249,10 @@ func TestUpdateGroup_Success(t *testing.T) {
 			Name:    "New Name",
 		},
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
 	newName := "New Name"
-	updated, err := u.UpdateGroup(ctx, groupID, tutorID, &newName, nil)
+	updated, err := u.UpdateGroup(ctx, groupID, tutorID, &newName, nil, nil, nil)
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -192,9 +277,9 @@ func TestUpdateGroup_NotOwner(t *testing.T) {
 		},
 	}
 	wrongUser := "tutor456"
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
-	_, err := u.UpdateGroup(ctx, repo.getGroupFirstResult.ID, wrongUser, nil, nil)
+	_, err := u.UpdateGroup(ctx, repo.getGroupFirstResult.ID, wrongUser, nil, nil, nil, nil)
 
 	if err == nil {
 		t.Error("expected error")
@@ -214,11 +299,12 @@ func TestDeleteGroup_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockRepo{
 		getGroupFirstResult: &models.Group{
-			ID:      "group123",
-			TutorID: "tutor123",
+			ID:         "group123",
+			TutorID:    "tutor123",
+			ArchivedAt: ptrTime(time.Now().Add(-31 * 24 * time.Hour)),
 		},
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewArchiveUsecase(repo, &mockOutbox{}, "group-events", 30*24*time.Hour)
 
 	err := u.DeleteGroup(ctx, repo.getGroupFirstResult.ID, repo.getGroupFirstResult.TutorID)
 
@@ -239,18 +325,18 @@ func TestAddGroupMembers_Success(t *testing.T) {
 		},
 		addMembersCount: 2,
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{}, "group-events")
 
 	student1 := "student1"
 	student2 := "student2"
 
-	count, err := u.AddGroupMembers(ctx, repo.getGroupFirstResult.ID, repo.getGroupFirstResult.TutorID, []string{student1, student2})
+	results, err := u.AddGroupMembers(ctx, repo.getGroupFirstResult.ID, repo.getGroupFirstResult.TutorID, []string{student1, student2})
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
-	if count != 2 {
-		t.Errorf("wrong count: got %d, want 2", count)
+	if len(results) != 2 || results[0].Status != models.AddMemberAdded || results[1].Status != models.AddMemberAdded {
+		t.Errorf("expected both students added, got %+v", results)
 	}
 	if !repo.addMembersCalled {
 		t.Error("AddMembers not called")
@@ -266,9 +352,9 @@ func TestAddGroupMembers_NotOwner(t *testing.T) {
 		},
 	}
 	wrongUser := "tutor456"
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{}, "group-events")
 
-	count, err := u.AddGroupMembers(ctx, repo.getGroupFirstResult.ID, wrongUser, []string{"student1"})
+	results, err := u.AddGroupMembers(ctx, repo.getGroupFirstResult.ID, wrongUser, []string{"student1"})
 
 	if err == nil {
 		t.Error("expected error")
@@ -276,14 +362,79 @@ func TestAddGroupMembers_NotOwner(t *testing.T) {
 	if !errors.Is(err, models.ErrTutorIsNotValid) {
 		t.Errorf("wrong error: %v", err)
 	}
-	if count != 0 {
-		t.Errorf("expected count 0, got %d", count)
+	if results != nil {
+		t.Errorf("expected no results, got %+v", results)
 	}
 	if repo.addMembersCalled {
 		t.Error("AddMembers called when not owner")
 	}
 }
 
+func TestAddGroupMembers_ValidatesStudents(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", MaxMembers: 2})
+	repo.addMembersCount, repo.addMembersWaitlisted = 1, 1
+	user := &mockUserClient{users: []*models.UserInfo{
+		{ID: "student1", Email: "one@example.com", IsStudent: true},
+		{ID: "student2", Email: "two@example.com", IsStudent: true},
+		{ID: "student3", Email: "three@example.com", IsStudent: true},
+		{ID: "tutor2", Email: "tutor@example.com"},
+	}}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
+
+	results, err := u.AddGroupMembers(ctx, "group1", "tutor1", []string{
+		" student1 ", "TWO@example.com", "student1", "three@example.com", "tutor2", "ghost@example.com", "",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// повторы и пустые строки отбрасываются, email приводится к нижнему регистру
+	expected := []models.AddMemberResult{
+		{Input: "student1", StudentID: "student1", Status: models.AddMemberAdded},
+		{Input: "two@example.com", StudentID: "student2", Status: models.AddMemberWaitlisted},
+		{Input: "three@example.com", StudentID: "student3", Status: models.AddMemberAlreadyMember},
+		{Input: "tutor2", StudentID: "tutor2", Status: models.AddMemberNotAStudent},
+		{Input: "ghost@example.com", Status: models.AddMemberNotFound},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), results)
+	}
+	for i, r := range results {
+		if *r != expected[i] {
+			t.Errorf("result %d: expected %+v, got %+v", i, expected[i], *r)
+		}
+	}
+	if !slices.Equal(repo.addedStudentIDs, []string{"student1", "student2", "student3"}) {
+		t.Errorf("expected only students to be added, got %v", repo.addedStudentIDs)
+	}
+}
+
+func TestAddGroupMembers_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1"})
+	user := &mockUserClient{}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
+
+	tooMany := make([]string, 501)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("student%d", i)
+	}
+	for _, inputs := range [][]string{{" ", ""}, tooMany} {
+		if _, err := u.AddGroupMembers(ctx, "group1", "tutor1", inputs); !errors.Is(err, models.ErrInvalidMembers) {
+			t.Errorf("expected ErrInvalidMembers for %d inputs, got %v", len(inputs), err)
+		}
+	}
+
+	user.resolveErr = errors.New("user service unavailable")
+	if _, err := u.AddGroupMembers(ctx, "group1", "tutor1", []string{"student1"}); err == nil {
+		t.Error("expected error when user service is unavailable")
+	}
+	if repo.addMembersCalled {
+		t.Error("AddMembers called for invalid input")
+	}
+}
+
 func TestRemoveGroupMembers_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockRepo{
@@ -293,9 +444,9 @@ func TestRemoveGroupMembers_Success(t *testing.T) {
 		},
 		removeMembersCount: 1,
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
-	count, err := u.RemoveGroupMembers(ctx, repo.getGroupFirstResult.ID, repo.getGroupFirstResult.TutorID, []string{"student1"})
+	count, _, err := u.RemoveGroupMembers(ctx, repo.getGroupFirstResult.ID, repo.getGroupFirstResult.TutorID, []string{"student1"})
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -319,7 +470,7 @@ func TestGetGroup_Success(t *testing.T) {
 	repo := &mockRepo{
 		getGroupFirstResult: expectedGroup,
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
 	group, err := u.GetGroup(ctx, expectedGroup.ID, false)
 
@@ -350,9 +501,9 @@ func TestListGroupMembers_Success(t *testing.T) {
 		},
 	}
 
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
-	members, err := u.ListGroupMembers(ctx, "group123")
+	members, err := u.ListGroupMembers(ctx, "group123", "", false)
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -362,13 +513,44 @@ func TestListGroupMembers_Success(t *testing.T) {
 	}
 }
 
+func TestListGroupMembers_IncludeProfiles(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1"})
+	repo.getGroupMembersFunc = func(ctx context.Context, groupID string) ([]*models.GroupMember, error) {
+		return []*models.GroupMember{{GroupID: groupID, StudentID: "student1"}, {GroupID: groupID, StudentID: "student2"}}, nil
+	}
+	user := &mockUserClient{users: []*models.UserInfo{
+		{ID: "student1", Name: "Anna", Surname: "Petrova", AvatarURL: "https://cdn.example.com/1.png", IsStudent: true},
+	}}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
+
+	members, err := u.ListGroupMembers(ctx, "group1", "tutor1", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if members[0].Name != "Anna" || members[0].AvatarURL != "https://cdn.example.com/1.png" || members[1].Name != "" {
+		t.Errorf("unexpected profiles: %+v, %+v", members[0], members[1])
+	}
+
+	if _, err := u.ListGroupMembers(ctx, "group1", "stranger", true); !errors.Is(err, models.ErrNotGroupMember) {
+		t.Errorf("expected ErrNotGroupMember, got %v", err)
+	}
+
+	// без user-service список возвращается без профилей
+	user.resolveErr = errors.New("user service unavailable")
+	members, err = u.ListGroupMembers(ctx, "group1", "tutor1", true)
+	if err != nil || len(members) != 2 || members[0].Name != "" {
+		t.Errorf("expected members without profiles, got %+v (%v)", members, err)
+	}
+}
+
 func TestCreateGroup_UserClientError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockRepo{}
 	user := &mockUserClient{validateErr: errors.New("user service error")}
-	u := usecase.NewGroupsUsecase(repo, user)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
 
-	_, err := u.CreateGroup(ctx, "tutor123", "Test", "")
+	_, err := u.CreateGroup(ctx, "tutor123", "Test", "", "", 0)
 
 	if err == nil {
 		t.Error("expected error")
@@ -389,17 +571,18 @@ func TestListGroupsByTutor_Success(t *testing.T) {
 	}
 
 	repo := &mockRepo{
-		listTutorGroupsFunc: func(ctx context.Context, tutorID string, includeMembers bool) ([]*models.Group, error) {
-			if tutorID == "tutor123" {
+		listGroupsFunc: func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+			if q.TutorID == "tutor123" {
 				return expectedGroups, nil
 			}
 			return nil, nil
 		},
 	}
-	user := &mockUserClient{validateResult: true}
-	u := usecase.NewGroupsUsecase(repo, user)
+	// репетитор еще не прошел проверку: ValidateTutor вернул бы false
+	user := &mockUserClient{users: []*models.UserInfo{{ID: "tutor123", IsTutor: true}}}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
 
-	groups, err := u.ListGroupsByTutor(ctx, "tutor123", false)
+	groups, _, err := u.ListGroups(ctx, "", models.GroupQuery{TutorID: "tutor123"}, "", 0)
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -407,8 +590,13 @@ func TestListGroupsByTutor_Success(t *testing.T) {
 	if len(groups) != 2 {
 		t.Errorf("wrong number of groups: got %d, want 2", len(groups))
 	}
-	if !user.validateCalled {
-		t.Error("ValidateTutor not called")
+	if user.validateCalled {
+		t.Error("listing groups must not depend on tutor verification")
+	}
+
+	// не репетитор
+	if _, _, err := u.ListGroups(ctx, "", models.GroupQuery{TutorID: "student1"}, "", 0); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected ErrTutorIsNotValid, got %v", err)
 	}
 }
 
@@ -419,16 +607,16 @@ func TestListGroupsByStudent_Success(t *testing.T) {
 	}
 
 	repo := &mockRepo{
-		listStudentGroupsFunc: func(ctx context.Context, studentID string, includeMembers bool) ([]*models.Group, error) {
-			if studentID == "student123" {
+		listGroupsFunc: func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+			if q.StudentID == "student123" {
 				return expectedGroups, nil
 			}
 			return nil, nil
 		},
 	}
-	u := usecase.NewGroupsUsecase(repo, nil)
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
 
-	groups, err := u.ListGroupsByStudent(ctx, "student123", false)
+	groups, _, err := u.ListGroups(ctx, "student123", models.GroupQuery{StudentID: "student123"}, "", 0)
 
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -437,3 +625,136 @@ func TestListGroupsByStudent_Success(t *testing.T) {
 		t.Errorf("wrong number of groups: got %d, want 1", len(groups))
 	}
 }
+
+func TestListGroupsByStudentForGuardian_Success(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockRepo{
+		listGroupsFunc: func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+			return []*models.Group{{ID: "group1", TutorID: "tutor123", Name: "Group 1"}}, nil
+		},
+	}
+	user := &mockUserClient{guardianResult: true}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
+
+	groups, _, err := u.ListGroups(ctx, "parent123", models.GroupQuery{StudentID: "student123", IncludeMembers: true, Archived: models.ArchiveFilterAll}, "", 0)
+
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if len(groups) != 1 {
+		t.Errorf("wrong number of groups: got %d, want 1", len(groups))
+	}
+	if repo.listGroupsQuery.IncludeMembers {
+		t.Error("guardian must not see group members")
+	}
+	if repo.listGroupsQuery.Archived != models.ArchiveFilterAll {
+		t.Error("guardian must see archived groups with the student's grades")
+	}
+
+	// без фильтра опекун тоже видит архивные группы, явный фильтр сохраняется
+	if _, _, err := u.ListGroups(ctx, "parent123", models.GroupQuery{StudentID: "student123"}, "", 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.listGroupsQuery.Archived != models.ArchiveFilterAll {
+		t.Errorf("expected all groups by default, got %q", repo.listGroupsQuery.Archived)
+	}
+	if _, _, err := u.ListGroups(ctx, "parent123", models.GroupQuery{StudentID: "student123", Archived: models.ArchiveFilterActive}, "", 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.listGroupsQuery.Archived != models.ArchiveFilterActive {
+		t.Errorf("expected explicit filter to be kept, got %q", repo.listGroupsQuery.Archived)
+	}
+}
+
+func TestListGroupsByStudentForGuardian_NotGuardian(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockRepo{}
+	user := &mockUserClient{guardianResult: false}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, user, "group-events")
+
+	_, _, err := u.ListGroups(ctx, "stranger", models.GroupQuery{StudentID: "student123"}, "", 0)
+
+	if !errors.Is(err, models.ErrGuardianAccessDenied) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func TestListGroups_Pagination(t *testing.T) {
+	ctx := context.Background()
+	all := make([]*models.Group, 5)
+	for i := range all {
+		all[i] = &models.Group{ID: fmt.Sprintf("group%d", i), Name: fmt.Sprintf("Group %d", i), MemberCount: 10 - i}
+	}
+
+	repo := &mockRepo{
+		listGroupsFunc: func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+			start := 0
+			if q.After != nil {
+				start = slices.IndexFunc(all, func(g *models.Group) bool { return g.ID == q.After.ID }) + 1
+			}
+			return all[start:min(start+q.Limit, len(all))], nil
+		},
+	}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{}, "group-events")
+
+	query := models.GroupQuery{Search: "  Group ", SortBy: models.GroupSortMemberCount, Desc: true}
+	var pages [][]*models.Group
+	pageToken := ""
+	for {
+		groups, next, err := u.ListGroups(ctx, "user1", query, pageToken, 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		pages = append(pages, groups)
+		if next == "" {
+			break
+		}
+		pageToken = next
+	}
+
+	if len(pages) != 3 || len(pages[0]) != 2 || len(pages[2]) != 1 || pages[2][0].ID != "group4" {
+		t.Errorf("unexpected pages: %v", pages)
+	}
+	q := repo.listGroupsQuery
+	if q.UserID != "user1" || q.TutorID != "" || q.StudentID != "" {
+		t.Errorf("expected groups of the caller, got %+v", q)
+	}
+	if q.Search != "Group" || q.Archived != models.ArchiveFilterActive || q.Limit != 3 {
+		t.Errorf("unexpected query: %+v", q)
+	}
+	if q.After == nil || q.After.ID != "group3" || q.After.MemberCount != 7 {
+		t.Errorf("unexpected cursor: %+v", q.After)
+	}
+}
+
+func TestListGroups_InvalidQuery(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockRepo{
+		listGroupsFunc: func(ctx context.Context, q models.GroupQuery) ([]*models.Group, error) {
+			return []*models.Group{{ID: "group1"}, {ID: "group2"}}, nil
+		},
+	}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, &mockUserClient{validateResult: true}, "group-events")
+
+	_, byName, err := u.ListGroups(ctx, "tutor1", models.GroupQuery{SortBy: models.GroupSortName}, "", 1)
+	if err != nil || byName == "" {
+		t.Fatalf("expected next page token, got %q (%v)", byName, err)
+	}
+
+	for name, tc := range map[string]struct {
+		callerID  string
+		query     models.GroupQuery
+		pageToken string
+	}{
+		"no filter and caller": {query: models.GroupQuery{}},
+		"long search":          {callerID: "tutor1", query: models.GroupQuery{Search: strings.Repeat("a", 201)}},
+		"unknown sort":         {callerID: "tutor1", query: models.GroupQuery{SortBy: "rating"}},
+		"unknown archive":      {callerID: "tutor1", query: models.GroupQuery{Archived: "deleted"}},
+		"malformed token":      {callerID: "tutor1", pageToken: "not a token"},
+		"token of other sort":  {callerID: "tutor1", query: models.GroupQuery{SortBy: models.GroupSortCreatedAt}, pageToken: byName},
+	} {
+		if _, _, err := u.ListGroups(ctx, tc.callerID, tc.query, tc.pageToken, 0); !errors.Is(err, models.ErrInvalidGroupQuery) {
+			t.Errorf("%s: expected ErrInvalidGroupQuery, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"group_service/internal/events"
+	"group_service/internal/models"
+)
+
+// ListWaitlist - очередь заполненной группы в порядке постановки
+func (u *GroupsUsecase) ListWaitlist(ctx context.Context, groupID, userID string) ([]*models.WaitlistEntry, error) {
+	if _, err := authorize(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.groupsRepo.ListWaitlist(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist: %w", err)
+	}
+
+	return entries, nil
+}
+
+// RemoveFromWaitlist убирает ученика из очереди. Ученик может покинуть очередь сам,
+// остальных убирает персонал группы с правом управления участниками.
+func (u *GroupsUsecase) RemoveFromWaitlist(ctx context.Context, groupID, userID, studentID string) error {
+	if userID != studentID {
+		if _, err := authorizeChange(ctx, u.groupsRepo, groupID, userID, models.PermissionManageMembers); err != nil {
+			return err
+		}
+	}
+
+	if err := u.groupsRepo.RemoveFromWaitlist(ctx, groupID, studentID); err != nil {
+		if errors.Is(err, models.ErrNotOnWaitlist) {
+			return err
+		}
+		return fmt.Errorf("failed to remove from waitlist: %w", err)
+	}
+
+	return nil
+}
+
+// addPromoted добавляет уведомления о переводе учеников из очереди, они сохраняются вместе с переводом
+func (b *outboxBatch) addPromoted(group *models.Group, studentIDs []string) {
+	for _, studentID := range studentIDs {
+		b.add(events.GroupWaitlistPromoted, events.WaitlistPromotedPayload{
+			GroupID:   group.ID,
+			GroupName: group.Name,
+			TutorID:   group.TutorID,
+			StudentID: studentID,
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"group_service/internal/events"
+	"group_service/internal/models"
+	"group_service/internal/usecase"
+)
+
+func TestCreateGroup_Capacity(t *testing.T) {
+	ctx := context.Background()
+	u := usecase.NewGroupsUsecase(&mockRepo{}, &mockOutbox{}, &mockUserClient{validateResult: true}, "group-events")
+
+	group, err := u.CreateGroup(ctx, "tutor1", "Math", "", "", 12)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if group.MaxMembers != 12 {
+		t.Errorf("expected max members 12, got %d", group.MaxMembers)
+	}
+
+	if _, err := u.CreateGroup(ctx, "tutor1", "Math", "", "", -1); !errors.Is(err, models.ErrInvalidCapacity) {
+		t.Errorf("expected ErrInvalidCapacity, got %v", err)
+	}
+}
+
+func TestRemoveGroupMembers_PromotesWaitlist(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", Name: "Math 10A", MaxMembers: 2})
+	repo.removeMembersCount = 1
+	repo.promoted = []string{"student3"}
+	outbox := &mockOutbox{}
+	u := usecase.NewGroupsUsecase(repo, outbox, nil, "group-events")
+
+	removed, promoted, err := u.RemoveGroupMembers(ctx, "group1", "tutor1", []string{"student1"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if removed != 1 || len(promoted) != 1 || promoted[0] != "student3" {
+		t.Errorf("unexpected result: removed %d, promoted %v", removed, promoted)
+	}
+
+	// уведомление сохраняется в outbox вместе с переводом
+	promotedEvents := outbox.ofType(events.GroupWaitlistPromoted)
+	if len(promotedEvents) != 1 || outbox.keys[0] != "group1" {
+		t.Fatalf("expected GroupWaitlistPromoted event, got %v", outbox.types())
+	}
+	var payload events.WaitlistPromotedPayload
+	if err := json.Unmarshal(promotedEvents[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.GroupID != "group1" || payload.StudentID != "student3" || payload.TutorID != "tutor1" {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestUpdateGroup_Capacity(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", MaxMembers: 2})
+	repo.promoted = []string{"student3", "student4"}
+	outbox := &mockOutbox{}
+	u := usecase.NewGroupsUsecase(repo, outbox, nil, "group-events")
+
+	invalid := -5
+	if _, err := u.UpdateGroup(ctx, "group1", "tutor1", nil, nil, nil, &invalid); !errors.Is(err, models.ErrInvalidCapacity) {
+		t.Fatalf("expected ErrInvalidCapacity, got %v", err)
+	}
+
+	// без изменения лимита очередь не трогаем
+	name := "Math 11A"
+	if _, err := u.UpdateGroup(ctx, "group1", "tutor1", &name, nil, nil, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.promoteCalled {
+		t.Error("PromoteWaitlist must not be called without capacity change")
+	}
+
+	maxMembers := 4
+	if _, err := u.UpdateGroup(ctx, "group1", "tutor1", nil, nil, nil, &maxMembers); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.updatedMaxMembers == nil || *repo.updatedMaxMembers != 4 {
+		t.Errorf("expected max members 4 to be saved, got %v", repo.updatedMaxMembers)
+	}
+	if promotedEvents := outbox.ofType(events.GroupWaitlistPromoted); !repo.promoteCalled || len(promotedEvents) != 2 {
+		t.Errorf("expected waitlist promotion with 2 events, got %v", outbox.types())
+	}
+}
+
+func TestRemoveFromWaitlist(t *testing.T) {
+	ctx := context.Background()
+	repo := groupRepoFor(&models.Group{ID: "group1", TutorID: "tutor1", MaxMembers: 1})
+	repo.waitlist = []*models.WaitlistEntry{
+		{GroupID: "group1", StudentID: "student2", Position: 1},
+		{GroupID: "group1", StudentID: "student3", Position: 2},
+	}
+	u := usecase.NewGroupsUsecase(repo, &mockOutbox{}, nil, "group-events")
+
+	if _, err := u.ListWaitlist(ctx, "group1", "student2"); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected student not to see waitlist, got %v", err)
+	}
+	entries, err := u.ListWaitlist(ctx, "group1", "tutor1")
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d (%v)", len(entries), err)
+	}
+
+	if err := u.RemoveFromWaitlist(ctx, "group1", "student2", "student3"); !errors.Is(err, models.ErrTutorIsNotValid) {
+		t.Errorf("expected student not to remove another student, got %v", err)
+	}
+	if err := u.RemoveFromWaitlist(ctx, "group1", "student2", "student2"); err != nil {
+		t.Errorf("expected student to leave waitlist, got %v", err)
+	}
+	if err := u.RemoveFromWaitlist(ctx, "group1", "tutor1", "student3"); err != nil {
+		t.Errorf("expected tutor to remove student from waitlist, got %v", err)
+	}
+	if err := u.RemoveFromWaitlist(ctx, "group1", "tutor1", "student3"); !errors.Is(err, models.ErrNotOnWaitlist) {
+		t.Errorf("expected ErrNotOnWaitlist, got %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE group_invitations (
+    id VARCHAR(255) PRIMARY KEY,
+    group_id VARCHAR(255) NOT NULL,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    email VARCHAR(255),
+    created_by VARCHAR(255) NOT NULL,
+    max_uses INT NOT NULL DEFAULT 0,
+    use_count INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_invitations_group_id ON group_invitations(group_id);
@@ -0,0 +1,19 @@
+-- существующие группы остаются закрытыми: в них попадают только по приглашению
+ALTER TABLE student_groups ADD COLUMN join_policy VARCHAR(16) NOT NULL DEFAULT 'invite_only';
+
+CREATE TABLE group_join_requests (
+    id VARCHAR(255) PRIMARY KEY,
+    group_id VARCHAR(255) NOT NULL,
+    student_id VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    decided_by VARCHAR(255),
+    decided_at TIMESTAMP,
+    reject_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+-- одна ожидающая заявка ученика на группу
+CREATE UNIQUE INDEX idx_group_join_requests_pending ON group_join_requests(group_id, student_id) WHERE status = 'pending';
+CREATE INDEX idx_group_join_requests_group_id ON group_join_requests(group_id, status, created_at);
@@ -0,0 +1,12 @@
+-- владелец группы остается в student_groups.tutor_id, здесь только соведущие и ассистенты
+CREATE TABLE group_staff (
+    group_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    added_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id),
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_staff_user_id ON group_staff(user_id);
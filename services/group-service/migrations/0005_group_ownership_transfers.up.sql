@@ -0,0 +1,12 @@
+-- одна активная передача владения на группу, новая заменяет предыдущую
+CREATE TABLE group_ownership_transfers (
+    group_id VARCHAR(255) PRIMARY KEY,
+    from_user_id VARCHAR(255) NOT NULL,
+    to_user_id VARCHAR(255) NOT NULL,
+    keep_as_co_tutor BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_ownership_transfers_to_user_id ON group_ownership_transfers(to_user_id);
@@ -0,0 +1,2 @@
+-- архивная группа доступна только для чтения, удалить ее можно после срока хранения
+ALTER TABLE student_groups ADD COLUMN archived_at TIMESTAMP;
@@ -0,0 +1,14 @@
+-- 0 - без ограничения числа участников
+ALTER TABLE student_groups ADD COLUMN max_members INT NOT NULL DEFAULT 0;
+
+-- очередь на места в заполненной группе, порядок по seq
+CREATE TABLE group_waitlist (
+    group_id VARCHAR(255) NOT NULL,
+    student_id VARCHAR(255) NOT NULL,
+    seq BIGSERIAL NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, student_id),
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_waitlist_group_seq ON group_waitlist(group_id, seq);
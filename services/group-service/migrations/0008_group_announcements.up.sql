@@ -0,0 +1,25 @@
+CREATE TABLE group_announcements (
+    id VARCHAR(255) PRIMARY KEY,
+    group_id VARCHAR(255) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    -- с этого момента объявление видно ученикам
+    publish_at TIMESTAMP NOT NULL,
+    -- заполняется при публикации, отложенные публикует планировщик
+    published_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_announcements_group_id ON group_announcements(group_id, pinned, publish_at);
+CREATE INDEX idx_group_announcements_scheduled ON group_announcements(publish_at) WHERE published_at IS NULL;
+
+CREATE TABLE group_announcement_reads (
+    announcement_id VARCHAR(255) NOT NULL,
+    student_id VARCHAR(255) NOT NULL,
+    read_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (announcement_id, student_id),
+    FOREIGN KEY (announcement_id) REFERENCES group_announcements(id) ON DELETE CASCADE
+);
@@ -0,0 +1,14 @@
+CREATE TABLE group_messages (
+    id VARCHAR(255) PRIMARY KEY,
+    group_id VARCHAR(255) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    edited_at TIMESTAMP,
+    -- удаленное сообщение остается в истории без текста
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+-- история читается страницами от новых к старым
+CREATE INDEX idx_group_messages_group_created ON group_messages(group_id, created_at DESC, id DESC);
@@ -0,0 +1,61 @@
+-- личная переписка двух пользователей, пара хранится упорядоченной: user1_id < user2_id
+CREATE TABLE direct_conversations (
+    id VARCHAR(255) PRIMARY KEY,
+    user1_id VARCHAR(255) NOT NULL,
+    user2_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    last_message_at TIMESTAMP,
+    -- до какого момента участник прочитал переписку
+    user1_read_at TIMESTAMP,
+    user2_read_at TIMESTAMP,
+    UNIQUE (user1_id, user2_id),
+    CHECK (user1_id < user2_id)
+);
+
+CREATE INDEX idx_direct_conversations_user2_id ON direct_conversations(user2_id);
+
+CREATE TABLE direct_messages (
+    id VARCHAR(255) PRIMARY KEY,
+    conversation_id VARCHAR(255) NOT NULL,
+    sender_id VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', body)) STORED,
+    FOREIGN KEY (conversation_id) REFERENCES direct_conversations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_direct_messages_conversation_created ON direct_messages(conversation_id, created_at DESC, id DESC);
+CREATE INDEX idx_direct_messages_search ON direct_messages USING GIN (search_vector);
+
+-- файлы загружаются отдельно, сообщение хранит ссылки на них
+CREATE TABLE direct_message_attachments (
+    message_id VARCHAR(255) NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (message_id, position),
+    FOREIGN KEY (message_id) REFERENCES direct_messages(id) ON DELETE CASCADE
+);
+
+CREATE TABLE user_blocks (
+    blocker_id VARCHAR(255) NOT NULL,
+    blocked_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked_id ON user_blocks(blocked_id);
+
+CREATE TABLE user_reports (
+    id VARCHAR(255) PRIMARY KEY,
+    reporter_id VARCHAR(255) NOT NULL,
+    reported_id VARCHAR(255) NOT NULL,
+    conversation_id VARCHAR(255),
+    message_id VARCHAR(255),
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_user_reports_reported_id ON user_reports(reported_id);
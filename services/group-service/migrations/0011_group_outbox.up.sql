@@ -0,0 +1,9 @@
+-- события о группах и их составе пишутся в одной транзакции с изменениями,
+-- фоновая задача отправляет их в kafka в порядке id и удаляет отправленные
+CREATE TABLE group_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    event_key VARCHAR(255) NOT NULL,
+    event JSONB NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
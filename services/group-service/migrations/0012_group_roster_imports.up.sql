@@ -0,0 +1,17 @@
+-- импорт списка учеников из CSV: строки и их итоги хранятся в rows,
+-- фоновая задача забирает импорты в статусе pending
+CREATE TABLE group_roster_imports (
+    id VARCHAR(255) PRIMARY KEY,
+    group_id VARCHAR(255) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    rows JSONB NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_roster_imports_group_id ON group_roster_imports(group_id, created_at);
+CREATE INDEX idx_group_roster_imports_queue ON group_roster_imports(created_at) WHERE status IN ('pending', 'processing');
@@ -0,0 +1,29 @@
+-- число участников хранится в группе для сортировки списка, его ведет триггер на group_members
+ALTER TABLE student_groups ADD COLUMN member_count INT NOT NULL DEFAULT 0;
+
+UPDATE student_groups sg SET member_count = (SELECT COUNT(*) FROM group_members gm WHERE gm.group_id = sg.id);
+
+CREATE FUNCTION group_members_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE student_groups SET member_count = member_count + 1 WHERE id = NEW.group_id;
+    ELSE
+        UPDATE student_groups SET member_count = member_count - 1 WHERE id = OLD.group_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_group_members_count
+    AFTER INSERT OR DELETE ON group_members
+    FOR EACH ROW EXECUTE FUNCTION group_members_count();
+
+-- поиск по подстроке названия
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX idx_student_groups_name_trgm ON student_groups USING GIN (name gin_trgm_ops);
+
+-- keyset-пагинация групп репетитора по каждому полю сортировки, заменяет индекс по tutor_id
+CREATE INDEX idx_student_groups_tutor_created ON student_groups(tutor_id, created_at, id);
+CREATE INDEX idx_student_groups_tutor_name ON student_groups(tutor_id, name, id);
+CREATE INDEX idx_student_groups_tutor_member_count ON student_groups(tutor_id, member_count, id);
+DROP INDEX idx_student_groups_tutor_id;
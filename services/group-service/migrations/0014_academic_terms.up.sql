@@ -0,0 +1,17 @@
+-- учебный период репетитора: группы периода архивируются после его окончания
+CREATE TABLE academic_terms (
+    id VARCHAR(255) PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX idx_academic_terms_owner_starts ON academic_terms(owner_id, starts_at);
+
+-- удалить можно только период без активных групп, архивные группы теряют привязку
+ALTER TABLE student_groups ADD COLUMN term_id VARCHAR(255) REFERENCES academic_terms(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_student_groups_term_id ON student_groups(term_id) WHERE archived_at IS NULL;
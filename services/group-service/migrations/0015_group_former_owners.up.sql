@@ -0,0 +1,10 @@
+-- прежние владельцы групп после передачи владения, ученики группы могут оставить им отзыв
+CREATE TABLE group_former_owners (
+    group_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id),
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_former_owners_user_id ON group_former_owners(user_id);
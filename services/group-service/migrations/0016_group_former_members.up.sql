@@ -0,0 +1,10 @@
+-- бывшие участники групп: ученик, исключенный или вышедший из группы, может оставить отзыв ее репетитору
+CREATE TABLE group_former_members (
+    group_id VARCHAR(255) NOT NULL,
+    student_id VARCHAR(255) NOT NULL,
+    left_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, student_id),
+    FOREIGN KEY (group_id) REFERENCES student_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_former_members_student_id ON group_former_members(student_id);
@@ -22,7 +22,8 @@ type Cache struct {
 	ttl    time.Duration
 }
 
-func NewCache(cfg Config, prefix string, ttl time.Duration) (*Cache, error) {
+// NewClient подключается к redis и проверяет соединение
+func NewClient(cfg Config) (*redis.Client, error) {
 	client := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%s", cfg.Host, cfg.Port),
 		Password: cfg.Password,
@@ -33,9 +34,19 @@ func NewCache(cfg Config, prefix string, ttl time.Duration) (*Cache, error) {
 	defer cancel()
 
 	if _, err := client.Ping(ctx).Result(); err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to connect to redis: %w", err)
 	}
 
+	return client, nil
+}
+
+func NewCache(cfg Config, prefix string, ttl time.Duration) (*Cache, error) {
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Cache{
 		client: client,
 		prefix: prefix,
@@ -0,0 +1,7 @@
+package kafka
+
+type KafkaConfig struct {
+	Brokers string `env:"KAFKA_BROKERS" env-default:"kafka:9092"`
+	// топик уведомлений о группах
+	GroupEventsTopic string `env:"GROUP_EVENTS_TOPIC" env-default:"group-events"`
+}
@@ -0,0 +1,67 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type MailerConfig struct {
+	SMTPAddr     string `env:"SMTP_ADDR" env-default:""`
+	SMTPFrom     string `env:"SMTP_FROM" env-default:"no-reply@tutors.local"`
+	SMTPUser     string `env:"SMTP_USER" env-default:""`
+	SMTPPassword string `env:"SMTP_PASSWORD" env-default:""`
+}
+
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// NewMailer возвращает SMTP-отправителя, без SMTP_ADDR письма только пишутся в лог
+func NewMailer(cfg MailerConfig) Mailer {
+	if cfg.SMTPAddr == "" {
+		return logMailer{}
+	}
+	return &smtpMailer{cfg: cfg}
+}
+
+type smtpMailer struct {
+	cfg MailerConfig
+}
+
+func (m *smtpMailer) Send(ctx context.Context, to, subject, body string) error {
+	var auth smtp.Auth
+	if m.cfg.SMTPUser != "" {
+		host, _, err := net.SplitHostPort(m.cfg.SMTPAddr)
+		if err != nil {
+			return fmt.Errorf("invalid smtp address: %w", err)
+		}
+		auth = smtp.PlainAuth("", m.cfg.SMTPUser, m.cfg.SMTPPassword, host)
+	}
+
+	msg := strings.Join([]string{
+		"From: " + m.cfg.SMTPFrom,
+		"To: " + to,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	if err := smtp.SendMail(m.cfg.SMTPAddr, auth, m.cfg.SMTPFrom, []string{to}, []byte(msg)); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", to, err)
+	}
+	return nil
+}
+
+type logMailer struct{}
+
+func (logMailer) Send(ctx context.Context, to, subject, body string) error {
+	log.Printf("[MAIL] to: %s, subject: %s\n%s", to, subject, body)
+	return nil
+}
@@ -1,23 +1,33 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	// база часовых поясов для образов без tzdata
+	_ "time/tzdata"
 
 	"task_service/internal/config"
 	"task_service/internal/repository"
 	"task_service/internal/service"
 	"task_service/internal/transport"
 	client "task_service/pkg/groupClient"
+	"task_service/pkg/kafka"
 	"task_service/pkg/pool"
+	userClient "task_service/pkg/userClient"
+
+	kafkago "github.com/segmentio/kafka-go"
 )
 
 type app struct {
 	server      *transport.Server
 	repo        *repository.Repository
 	groupClient *client.GroupClient
+	userClient  *userClient.UserClient
+	consumer    *kafka.Consumer
+	cancel      context.CancelFunc
 }
 
 func main() {
@@ -40,7 +50,12 @@ func main() {
 		log.Printf("failed to create group client: %v", err) // заменить на фатал
 	}
 
-	service := service.NewService(repo, client)
+	users, err := userClient.NewUserClient(cfg.UserServiceAddr)
+	if err != nil {
+		log.Fatalf("failed to create user client: %v", err)
+	}
+
+	service := service.NewService(repo, client, users)
 
 	log.Printf("starting server on port %s...", cfg.ServerPort)
 	server, err := transport.NewServer(cfg.ServerPort, service)
@@ -49,9 +64,18 @@ func main() {
 	}
 
 	app.groupClient = client
+	app.userClient = users
 	app.repo = repo
 	app.server = server
 
+	// передача группы меняет владельца ее заданий
+	consumerCtx, cancel := context.WithCancel(context.Background())
+	app.cancel = cancel
+	app.consumer = kafka.NewConsumer([]string{cfg.Brokers}, cfg.GroupEventsTopic, cfg.GroupID)
+	go app.consumer.Run(consumerCtx, func(ctx context.Context, msg kafkago.Message) error {
+		return service.HandleGroupEvent(ctx, msg.Value)
+	})
+
 	app.server.Start()
 
 	app.WaitForGracefulShutdown()
@@ -66,12 +90,23 @@ func (a *app) WaitForGracefulShutdown() {
 	log.Printf("shutting down server...")
 	a.server.Stop()
 
+	log.Printf("stopping group events consumer...")
+	a.cancel()
+	if err := a.consumer.Close(); err != nil {
+		log.Printf("failed to close group events consumer: %v", err)
+	}
+
 	log.Printf("closing group_service connection...")
 	err := a.groupClient.Close()
 	if err != nil {
 		log.Printf("failed to close group_service connection: %v", err)
 	}
 
+	log.Printf("closing user_service connection...")
+	if err := a.userClient.Close(); err != nil {
+		log.Printf("failed to close user_service connection: %v", err)
+	}
+
 	log.Printf("closing database connection...")
 	a.repo.Close()
 
@@ -2,14 +2,17 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"task_service/pkg/kafka"
 	"task_service/pkg/pool"
 )
 
 type Config struct {
 	pool.PostgresCfg
+	kafka.KafkaConfig
 
 	ServerPort       string `env:"TASK_PORT" env-default:"50051"`
 	GroupServiceAddr string `env:"GROUP_SERV_ADDR" env-default:"localhost:50051"`
+	UserServiceAddr  string `env:"USER_SERV_ADDR" env-default:"localhost:50051"`
 }
 
 func NewConfig() (*Config, error) {
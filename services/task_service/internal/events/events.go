@@ -0,0 +1,39 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	GroupOwnershipTransferred = "GroupOwnershipTransferred"
+	GroupArchived             = "GroupArchived"
+	GroupRestored             = "GroupRestored"
+	GroupDeleted              = "GroupDeleted"
+)
+
+// Envelope - общий формат событий сервисов платформы
+type Envelope struct {
+	EventType  string          `json:"event_type"`
+	EventID    string          `json:"event_id"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// OwnershipTransferPayload - группа перешла к новому владельцу
+type OwnershipTransferPayload struct {
+	GroupID         string `json:"group_id"`
+	GroupName       string `json:"group_name"`
+	PreviousOwnerID string `json:"previous_owner_id"`
+	NewOwnerID      string `json:"new_owner_id"`
+}
+
+// GroupPayload - смена состояния группы: архивация, восстановление, удаление
+type GroupPayload struct {
+	GroupID    string     `json:"group_id"`
+	GroupName  string     `json:"group_name"`
+	TutorID    string     `json:"tutor_id"`
+	ActorID    string     `json:"actor_id"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
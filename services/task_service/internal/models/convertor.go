@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/task"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -27,7 +29,18 @@ func CreateTaskFromProto(proto *pb.CreateTaskRequest) *AssignedTask {
 	return task
 }
 
-func TaskToProto(task *AssignedTask) *pb.AssignedTask {
+// LocalDeadline форматирует дедлайн в часовом поясе пользователя (RFC 3339 со смещением)
+func LocalDeadline(deadline time.Time, loc *time.Location) string {
+	if deadline.IsZero() {
+		return ""
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return deadline.In(loc).Format(time.RFC3339)
+}
+
+func TaskToProto(task *AssignedTask, loc *time.Location) *pb.AssignedTask {
 	if task == nil {
 		return &pb.AssignedTask{}
 	}
@@ -45,6 +58,7 @@ func TaskToProto(task *AssignedTask) *pb.AssignedTask {
 
 	if !task.Deadline.IsZero() {
 		protoTask.Deadline = timestamppb.New(task.Deadline)
+		protoTask.DeadlineLocal = LocalDeadline(task.Deadline, loc)
 	}
 
 	return protoTask
@@ -74,29 +88,29 @@ func UpdateTaskFromProto(proto *pb.UpdateTaskRequest) *UpdateTaskRequest {
 	return req
 }
 
-func UpdateTaskToProto(task *AssignedTask) *pb.UpdateTaskResponse {
+func UpdateTaskToProto(task *AssignedTask, loc *time.Location) *pb.UpdateTaskResponse {
 	if task == nil {
 		return &pb.UpdateTaskResponse{}
 	}
 
 	return &pb.UpdateTaskResponse{
-		Task: TaskToProto(task),
+		Task: TaskToProto(task, loc),
 	}
 }
 
-func TasksListToProto(tasks []*AssignedTaskShort) []*pb.AssignedTaskShort {
+func TasksListToProto(tasks []*AssignedTaskShort, loc *time.Location) []*pb.AssignedTaskShort {
 	if tasks == nil {
 		return []*pb.AssignedTaskShort{}
 	}
 
 	proto := make([]*pb.AssignedTaskShort, len(tasks))
 	for i, task := range tasks {
-		proto[i] = ShortTaskToProto(task)
+		proto[i] = ShortTaskToProto(task, loc)
 	}
 	return proto
 }
 
-func ShortTaskToProto(task *AssignedTaskShort) *pb.AssignedTaskShort {
+func ShortTaskToProto(task *AssignedTaskShort, loc *time.Location) *pb.AssignedTaskShort {
 	if task == nil {
 		return &pb.AssignedTaskShort{}
 	}
@@ -111,6 +125,7 @@ func ShortTaskToProto(task *AssignedTaskShort) *pb.AssignedTaskShort {
 
 	if !task.Deadline.IsZero() {
 		protoTask.Deadline = timestamppb.New(task.Deadline)
+		protoTask.DeadlineLocal = LocalDeadline(task.Deadline, loc)
 	}
 
 	return protoTask
@@ -234,3 +249,14 @@ func submissionStatusToProto(status SubmissionStatus) pb.SubmittedTaskStatus {
 		return pb.SubmittedTaskStatus_UNSPECIFIED
 	}
 }
+
+func StudentTasksToProto(tasks []*StudentTaskProgress, loc *time.Location) []*pb.StudentTaskProgress {
+	result := make([]*pb.StudentTaskProgress, len(tasks))
+	for i, task := range tasks {
+		result[i] = &pb.StudentTaskProgress{Task: ShortTaskToProto(&task.Task, loc)}
+		if task.Submission != nil {
+			result[i].Submission = SubmissionShortToProto(task.Submission)
+		}
+	}
+	return result
+}
@@ -46,6 +46,12 @@ type SubmittedTaskShort struct {
 	SubmittedAt time.Time
 }
 
+// StudentTaskProgress - задание группы ученика вместе с его решением, если оно есть
+type StudentTaskProgress struct {
+	Task       AssignedTaskShort
+	Submission *SubmittedTaskShort
+}
+
 type SubmissionGrade struct {
 	SubmissionId string
 	TutorId      string
@@ -21,3 +21,10 @@ type SubmissionFilter struct {
 	Offset int32
 	Limit  int32
 }
+
+type StudentTaskFilter struct {
+	StudentID string
+	GroupIDs  []string
+	Offset    int32
+	Limit     int32
+}
@@ -27,3 +27,10 @@ type GetSubmissionsParams struct {
 	Limit  int32
 	Status *SubmissionStatus
 }
+
+type GetStudentTasksParams struct {
+	ViewerID  string
+	StudentID string
+	Offset    int32
+	Limit     int32
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -153,10 +154,7 @@ func (r *Repository) SoftDeleteTask(ctx context.Context, userID, taskID string)
 
 	deleteTaskQuery, deleteTaskArgs, err := r.builder.
 		Delete("assigned_tasks").
-		Where(squirrel.And{
-			squirrel.Eq{"id": taskID},
-			squirrel.Eq{"tutor_id": userID},
-		}).
+		Where(squirrel.Eq{"id": taskID}).
 		ToSql()
 
 	if err != nil {
@@ -180,6 +178,140 @@ func (r *Repository) SoftDeleteTask(ctx context.Context, userID, taskID string)
 	return nil
 }
 
+// ReassignGroupTasks переводит задания группы на нового владельца после передачи группы
+func (r *Repository) ReassignGroupTasks(ctx context.Context, groupID, fromTutorID, toTutorID string) (int64, error) {
+	query, args, err := r.builder.
+		Update("assigned_tasks").
+		Set("tutor_id", toTutorID).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"group_id": groupID, "tutor_id": fromTutorID}).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("execute query: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// SetGroupArchived отмечает группу архивной, повторное событие не меняет дату архивации
+func (r *Repository) SetGroupArchived(ctx context.Context, groupID string, archivedAt time.Time) error {
+	query, args, err := r.builder.
+		Insert("archived_groups").
+		Columns("group_id", "archived_at").
+		Values(groupID, archivedAt).
+		Suffix("ON CONFLICT (group_id) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UnsetGroupArchived(ctx context.Context, groupID string) error {
+	query, args, err := r.builder.
+		Delete("archived_groups").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) IsGroupArchived(ctx context.Context, groupID string) (bool, error) {
+	query, args, err := r.builder.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From("archived_groups").
+		Where(squirrel.Eq{"group_id": groupID}).
+		Suffix(")").
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	var archived bool
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&archived); err != nil {
+		return false, fmt.Errorf("execute query: %w", err)
+	}
+
+	return archived, nil
+}
+
+// DeleteGroupTasks удаляет задания и работы окончательно удаленной группы
+func (r *Repository) DeleteGroupTasks(ctx context.Context, groupID string) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	deleteSubmissionsQuery, deleteSubmissionsArgs, err := r.builder.
+		Delete("submitted_tasks").
+		Where(squirrel.Expr("task_id IN (SELECT id FROM assigned_tasks WHERE group_id = ?)", groupID)).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("build delete submissions query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteSubmissionsQuery, deleteSubmissionsArgs...); err != nil {
+		return 0, fmt.Errorf("delete submissions: %w", err)
+	}
+
+	deleteTasksQuery, deleteTasksArgs, err := r.builder.
+		Delete("assigned_tasks").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("build delete tasks query: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, deleteTasksQuery, deleteTasksArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("delete tasks: %w", err)
+	}
+
+	deleteArchiveQuery, deleteArchiveArgs, err := r.builder.
+		Delete("archived_groups").
+		Where(squirrel.Eq{"group_id": groupID}).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("build delete archive query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteArchiveQuery, deleteArchiveArgs...); err != nil {
+		return 0, fmt.Errorf("delete archive mark: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
 func (r *Repository) GetTasks(ctx context.Context, filter models.TaskFilter) ([]*models.AssignedTaskShort, int32, error) {
 	baseQuery := r.builder.
 		Select("id", "group_id", "tutor_id", "title", "deadline", "task_status").
@@ -491,6 +623,83 @@ func (r *Repository) GetSubmissions(ctx context.Context, filter models.Submissio
 	return submissions, total, nil
 }
 
+func (r *Repository) GetStudentTasks(ctx context.Context, filter models.StudentTaskFilter) ([]*models.StudentTaskProgress, int32, error) {
+	if len(filter.GroupIDs) == 0 {
+		return nil, 0, nil
+	}
+
+	countSQL, countArgs, err := r.builder.
+		Select("COUNT(*)").
+		From("assigned_tasks").
+		Where(squirrel.Eq{"group_id": filter.GroupIDs}).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build count query: %w", err)
+	}
+
+	var total int32
+	err = r.pool.QueryRow(ctx, countSQL, countArgs...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count tasks: %w", err)
+	}
+
+	query, args, err := r.builder.
+		Select("t.id", "t.group_id", "t.tutor_id", "t.title", "t.deadline", "t.task_status",
+			"s.id", "s.score", "s.status", "s.created_at").
+		From("assigned_tasks t").
+		LeftJoin("submitted_tasks s ON s.task_id = t.id AND s.student_id = ?", filter.StudentID).
+		Where(squirrel.Eq{"t.group_id": filter.GroupIDs}).
+		OrderBy("t.deadline DESC").
+		Offset(uint64(filter.Offset)).
+		Limit(uint64(filter.Limit)).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*models.StudentTaskProgress
+	for rows.Next() {
+		var (
+			task             models.StudentTaskProgress
+			submissionID     *string
+			score            *int32
+			submissionStatus *string
+			submittedAt      *time.Time
+		)
+		err := rows.Scan(
+			&task.Task.ID, &task.Task.GroupID, &task.Task.TutorID,
+			&task.Task.Title, &task.Task.Deadline, &task.Task.Status,
+			&submissionID, &score, &submissionStatus, &submittedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scan row: %w", err)
+		}
+
+		if submissionID != nil {
+			task.Submission = &models.SubmittedTaskShort{
+				ID:          *submissionID,
+				TaskID:      task.Task.ID,
+				StudentID:   filter.StudentID,
+				Score:       score,
+				Status:      models.SubmissionStatus(*submissionStatus),
+				SubmittedAt: *submittedAt,
+			}
+		}
+		tasks = append(tasks, &task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return tasks, total, nil
+}
+
 func (r *Repository) MarkExpiredTasks(ctx context.Context) error {
 	query, args, err := r.builder.Select("1").
 		From("mark_expired_tasks()").
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"task_service/internal/events"
+)
+
+// HandleGroupEvent обрабатывает события group-service. Битые и неизвестные события пропускаются,
+// ошибка возвращается только для повторяемых сбоев.
+func (s *Service) HandleGroupEvent(ctx context.Context, value []byte) error {
+	var env events.Envelope
+	if err := json.Unmarshal(value, &env); err != nil {
+		log.Printf("[EVENTS] skip malformed group event: %v", err)
+		return nil
+	}
+
+	if env.Version > 1 {
+		log.Printf("[EVENTS] skip %s event %s: unsupported version %d", env.EventType, env.EventID, env.Version)
+		return nil
+	}
+
+	switch env.EventType {
+	case events.GroupOwnershipTransferred:
+		return s.handleOwnershipTransferred(ctx, env)
+	case events.GroupArchived, events.GroupRestored, events.GroupDeleted:
+		return s.handleGroupStateChanged(ctx, env)
+	}
+
+	return nil
+}
+
+func (s *Service) handleOwnershipTransferred(ctx context.Context, env events.Envelope) error {
+	var payload events.OwnershipTransferPayload
+	if err := json.Unmarshal(env.Payload, &payload); err != nil ||
+		payload.GroupID == "" || payload.PreviousOwnerID == "" || payload.NewOwnerID == "" {
+		log.Printf("[EVENTS] skip malformed %s event %s", env.EventType, env.EventID)
+		return nil
+	}
+
+	// повтор события ничего не меняет: заданий прежнего владельца уже нет
+	updated, err := s.repo.ReassignGroupTasks(ctx, payload.GroupID, payload.PreviousOwnerID, payload.NewOwnerID)
+	if err != nil {
+		return fmt.Errorf("reassign tasks of group %s: %w", payload.GroupID, err)
+	}
+
+	log.Printf("[SUCCESS] Group %s tasks reassigned: %d, from: %s, to: %s",
+		payload.GroupID, updated, payload.PreviousOwnerID, payload.NewOwnerID)
+	return nil
+}
+
+// handleGroupStateChanged замораживает задания архивной группы и удаляет задания удаленной.
+// События одной группы приходят по порядку: ключ сообщения - group_id.
+func (s *Service) handleGroupStateChanged(ctx context.Context, env events.Envelope) error {
+	var payload events.GroupPayload
+	if err := json.Unmarshal(env.Payload, &payload); err != nil || payload.GroupID == "" ||
+		(env.EventType == events.GroupArchived && payload.ArchivedAt == nil) {
+		log.Printf("[EVENTS] skip malformed %s event %s", env.EventType, env.EventID)
+		return nil
+	}
+
+	switch env.EventType {
+	case events.GroupArchived:
+		if err := s.repo.SetGroupArchived(ctx, payload.GroupID, *payload.ArchivedAt); err != nil {
+			return fmt.Errorf("archive group %s: %w", payload.GroupID, err)
+		}
+		log.Printf("[SUCCESS] Group %s archived, tasks frozen", payload.GroupID)
+
+	case events.GroupRestored:
+		if err := s.repo.UnsetGroupArchived(ctx, payload.GroupID); err != nil {
+			return fmt.Errorf("restore group %s: %w", payload.GroupID, err)
+		}
+		log.Printf("[SUCCESS] Group %s restored, tasks unfrozen", payload.GroupID)
+
+	case events.GroupDeleted:
+		deleted, err := s.repo.DeleteGroupTasks(ctx, payload.GroupID)
+		if err != nil {
+			return fmt.Errorf("delete tasks of group %s: %w", payload.GroupID, err)
+		}
+		log.Printf("[SUCCESS] Group %s deleted, tasks removed: %d", payload.GroupID, deleted)
+	}
+
+	return nil
+}
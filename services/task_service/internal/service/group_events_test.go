@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"task_service/internal/events"
+	"task_service/internal/models"
+)
+
+func groupEvent(t *testing.T, eventType string, version int, payload any) []byte {
+	t.Helper()
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("failed to marshal payload: %v", err)
+	}
+	value, err := json.Marshal(events.Envelope{
+		EventType: eventType,
+		EventID:   "event-1",
+		Version:   version,
+		Payload:   raw,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal envelope: %v", err)
+	}
+	return value
+}
+
+func TestService_HandleGroupEvent_OwnershipTransferred(t *testing.T) {
+	svc, repo, _ := newTestService()
+	ctx := context.Background()
+
+	deadline := time.Now().Add(24 * time.Hour)
+	repo.tasks["task-1"] = &models.AssignedTask{ID: "task-1", GroupId: "group-1", TutorId: "tutor-1", Deadline: deadline}
+	repo.tasks["task-2"] = &models.AssignedTask{ID: "task-2", GroupId: "group-1", TutorId: "tutor-3", Deadline: deadline}
+	repo.tasks["task-3"] = &models.AssignedTask{ID: "task-3", GroupId: "group-2", TutorId: "tutor-1", Deadline: deadline}
+
+	value := groupEvent(t, events.GroupOwnershipTransferred, 1, events.OwnershipTransferPayload{
+		GroupID:         "group-1",
+		PreviousOwnerID: "tutor-1",
+		NewOwnerID:      "tutor-2",
+	})
+	if err := svc.HandleGroupEvent(ctx, value); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if repo.tasks["task-1"].TutorId != "tutor-2" {
+		t.Errorf("expected task-1 to move to tutor-2, got %s", repo.tasks["task-1"].TutorId)
+	}
+	// задания соведущих и других групп не меняются
+	if repo.tasks["task-2"].TutorId != "tutor-3" || repo.tasks["task-3"].TutorId != "tutor-1" {
+		t.Error("expected other tasks to keep their tutor")
+	}
+
+	// повторная доставка безопасна
+	if err := svc.HandleGroupEvent(ctx, value); err != nil {
+		t.Fatalf("expected no error on redelivery, got %v", err)
+	}
+}
+
+func TestService_HandleGroupEvent_Skipped(t *testing.T) {
+	tests := []struct {
+		name  string
+		value func(t *testing.T) []byte
+	}{
+		{"not json", func(t *testing.T) []byte { return []byte("not json") }},
+		{"other event", func(t *testing.T) []byte {
+			return groupEvent(t, "JoinRequestCreated", 1, map[string]string{"group_id": "group-1"})
+		}},
+		{"unsupported version", func(t *testing.T) []byte {
+			return groupEvent(t, events.GroupOwnershipTransferred, 2, events.OwnershipTransferPayload{
+				GroupID: "group-1", PreviousOwnerID: "tutor-1", NewOwnerID: "tutor-2",
+			})
+		}},
+		{"missing new owner", func(t *testing.T) []byte {
+			return groupEvent(t, events.GroupOwnershipTransferred, 1, events.OwnershipTransferPayload{
+				GroupID: "group-1", PreviousOwnerID: "tutor-1",
+			})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _ := newTestService()
+			repo.tasks["task-1"] = &models.AssignedTask{ID: "task-1", GroupId: "group-1", TutorId: "tutor-1"}
+
+			if err := svc.HandleGroupEvent(context.Background(), tt.value(t)); err != nil {
+				t.Fatalf("expected event to be skipped, got %v", err)
+			}
+			if repo.tasks["task-1"].TutorId != "tutor-1" {
+				t.Error("expected task to keep its tutor")
+			}
+		})
+	}
+}
+
+func TestService_HandleGroupEvent_RepositoryErrorRetried(t *testing.T) {
+	svc, repo, _ := newTestService()
+	repo.updateErr = errors.New("connection refused")
+
+	value := groupEvent(t, events.GroupOwnershipTransferred, 1, events.OwnershipTransferPayload{
+		GroupID: "group-1", PreviousOwnerID: "tutor-1", NewOwnerID: "tutor-2",
+	})
+	if err := svc.HandleGroupEvent(context.Background(), value); err == nil {
+		t.Fatal("expected error to be returned for retry")
+	}
+}
+
+func TestService_HandleGroupEvent_ArchiveLifecycle(t *testing.T) {
+	svc, repo, _ := newTestService()
+	ctx := context.Background()
+
+	archivedAt := time.Now().Add(-time.Hour).UTC()
+	repo.tasks["task-1"] = &models.AssignedTask{ID: "task-1", GroupId: "group-1", TutorId: "tutor-1"}
+	repo.tasks["task-2"] = &models.AssignedTask{ID: "task-2", GroupId: "group-2", TutorId: "tutor-1"}
+
+	archived := groupEvent(t, events.GroupArchived, 1, events.GroupPayload{GroupID: "group-1", ArchivedAt: &archivedAt})
+	for i := 0; i < 2; i++ {
+		if err := svc.HandleGroupEvent(ctx, archived); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if got, ok := repo.archived["group-1"]; !ok || !got.Equal(archivedAt) {
+		t.Fatalf("expected group-1 archived at %v, got %v", archivedAt, got)
+	}
+
+	if err := svc.HandleGroupEvent(ctx, groupEvent(t, events.GroupRestored, 1, events.GroupPayload{GroupID: "group-1"})); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := repo.archived["group-1"]; ok {
+		t.Fatal("expected group-1 to be restored")
+	}
+
+	if err := svc.HandleGroupEvent(ctx, groupEvent(t, events.GroupDeleted, 1, events.GroupPayload{GroupID: "group-1"})); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := repo.tasks["task-1"]; ok {
+		t.Error("expected tasks of deleted group to be removed")
+	}
+	if _, ok := repo.tasks["task-2"]; !ok {
+		t.Error("expected tasks of other groups to stay")
+	}
+}
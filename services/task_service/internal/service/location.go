@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const locationCacheTTL = 5 * time.Minute
+
+type cachedLocation struct {
+	loc       *time.Location
+	expiresAt time.Time
+}
+
+// locationCache хранит часовые пояса пользователей, чтобы не ходить в user_service на каждый запрос
+type locationCache struct {
+	mu    sync.RWMutex
+	items map[string]cachedLocation
+}
+
+func newLocationCache() *locationCache {
+	return &locationCache{items: make(map[string]cachedLocation)}
+}
+
+func (c *locationCache) get(userID string) (*time.Location, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, ok := c.items[userID]
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, false
+	}
+	return item.loc, true
+}
+
+func (c *locationCache) set(userID string, loc *time.Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[userID] = cachedLocation{loc: loc, expiresAt: time.Now().Add(locationCacheTTL)}
+}
+
+// GetUserLocation возвращает часовой пояс пользователя из профиля.
+// При любой ошибке используется UTC: локализация дедлайна не должна ломать ответ.
+func (s *Service) GetUserLocation(ctx context.Context, userID string) *time.Location {
+	if userID == "" {
+		return time.UTC
+	}
+	if loc, ok := s.locations.get(userID); ok {
+		return loc
+	}
+
+	tz, err := s.userClient.GetUserTimezone(ctx, userID)
+	if err != nil {
+		log.Printf("[USER_SERVICE] GetUserTimezone failed: %v, userID: %s", err, userID)
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "" {
+		log.Printf("[LOCATION] unknown timezone %q for userID: %s", tz, userID)
+		loc = time.UTC
+	}
+
+	s.locations.set(userID, loc)
+	return loc
+}
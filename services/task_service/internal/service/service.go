@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -18,6 +19,13 @@ type Repository interface {
 	SoftDeleteTask(ctx context.Context, userID, taskID string) error
 	GetTaskByID(ctx context.Context, taskID string) (*models.AssignedTask, error)
 	GetTasks(ctx context.Context, filter models.TaskFilter) ([]*models.AssignedTaskShort, int32, error)
+	ReassignGroupTasks(ctx context.Context, groupID, fromTutorID, toTutorID string) (int64, error)
+	DeleteGroupTasks(ctx context.Context, groupID string) (int64, error)
+
+	// Archived groups
+	SetGroupArchived(ctx context.Context, groupID string, archivedAt time.Time) error
+	UnsetGroupArchived(ctx context.Context, groupID string) error
+	IsGroupArchived(ctx context.Context, groupID string) (bool, error)
 
 	// Submission operations
 	CreateSubmission(ctx context.Context, submission models.SubmittedTask) (*models.SubmittedTask, error)
@@ -27,6 +35,7 @@ type Repository interface {
 	ResetGrade(ctx context.Context, userID, submissionID string) error
 	GetSubmissionByID(ctx context.Context, submissionID string) (*models.SubmittedTask, error)
 	GetSubmissions(ctx context.Context, filter models.SubmissionFilter) ([]*models.SubmittedTaskShort, int32, error)
+	GetStudentTasks(ctx context.Context, filter models.StudentTaskFilter) ([]*models.StudentTaskProgress, int32, error)
 
 	// Utility
 	MarkExpiredTasks(ctx context.Context) error
@@ -35,18 +44,29 @@ type Repository interface {
 type GroupClient interface {
 	GetGroupInfo(ctx context.Context, groupID string) (*pb.Group, error)
 	GetGroupMembers(ctx context.Context, groupID string) ([]*pb.GroupMember, error)
+	ListStudentGroups(ctx context.Context, studentID string) ([]*pb.Group, error)
+	CheckPermission(ctx context.Context, groupID, userID string, perm pb.Permission) (bool, error)
+}
+
+type UserClient interface {
+	CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error)
+	GetUserTimezone(ctx context.Context, userID string) (string, error)
 }
 
 type Service struct {
 	repo        Repository
 	groupClient GroupClient
+	userClient  UserClient
+	locations   *locationCache
 	// logger      *log.Logger
 }
 
-func NewService(repo Repository, client GroupClient) *Service {
+func NewService(repo Repository, client GroupClient, userClient UserClient) *Service {
 	return &Service{
 		repo:        repo,
 		groupClient: client,
+		userClient:  userClient,
+		locations:   newLocationCache(),
 	}
 }
 
@@ -75,6 +95,71 @@ func (s *Service) checkExpiredTasks(ctx context.Context) {
 	}
 }
 
+// hasGroupPermission - права в группе определяет group-service по ролям персонала
+func (s *Service) hasGroupPermission(ctx context.Context, groupID, userID string, perm pb.Permission) (bool, *models.Error) {
+	allowed, err := s.groupClient.CheckPermission(ctx, groupID, userID, perm)
+	if err != nil {
+		log.Printf("[GROUP_SERVICE] CheckPermission failed: %v, groupID: %s, userID: %s", err, groupID, userID)
+		return false, &models.Error{
+			Code:    codes.Internal,
+			Message: "group_service error",
+		}
+	}
+
+	return allowed, nil
+}
+
+// checkGroupActive - задания архивной группы заморожены, состояние группы приходит событиями group-service
+func (s *Service) checkGroupActive(ctx context.Context, groupID string) *models.Error {
+	archived, err := s.repo.IsGroupArchived(ctx, groupID)
+	if err != nil {
+		log.Printf("[REPOSITORY] IsGroupArchived failed: %v, groupID: %s", err, groupID)
+		return &models.Error{
+			Code:    codes.Internal,
+			Message: "failed to check group state",
+		}
+	}
+
+	if archived {
+		log.Printf("[VALIDATION] Group is archived: %s", groupID)
+		return &models.Error{
+			Code:    codes.FailedPrecondition,
+			Message: "group is archived",
+		}
+	}
+
+	return nil
+}
+
+// checkDeadlineInTerm - дедлайн задания группы с учебным периодом должен попадать в период
+func (s *Service) checkDeadlineInTerm(ctx context.Context, groupID string, deadline time.Time) *models.Error {
+	group, err := s.groupClient.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		log.Printf("[GROUP_SERVICE] GetGroupInfo failed: %v, groupID: %s", err, groupID)
+		return &models.Error{
+			Code:    codes.Internal,
+			Message: "group_service error",
+		}
+	}
+
+	term := group.GetTerm()
+	if term == nil {
+		return nil
+	}
+
+	startsAt, endsAt := term.GetStartsAt().AsTime(), term.GetEndsAt().AsTime()
+	if deadline.Before(startsAt) || deadline.After(endsAt) {
+		log.Printf("[VALIDATION] Deadline %v is outside term %s of group %s", deadline, term.GetId(), groupID)
+		return &models.Error{
+			Code: codes.InvalidArgument,
+			Message: fmt.Sprintf("deadline must be within the group term (%s - %s)",
+				startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) CreateTask(ctx context.Context, task models.AssignedTask) (*models.AssignedTask, *models.Error) {
 	if task.Deadline.Before(time.Now()) {
 		log.Printf("[VALIDATION] CreateTask: deadline in the past, taskID: %s", task.ID)
@@ -92,24 +177,28 @@ func (s *Service) CreateTask(ctx context.Context, task models.AssignedTask) (*mo
 		}
 	}
 
-	group, err := s.groupClient.GetGroupInfo(ctx, task.GroupId)
-	if err != nil {
-		log.Printf("[GROUP_SERVICE] GetGroupInfo failed: %v, groupID: %s", err, task.GroupId)
-		return nil, &models.Error{
-			Code:    codes.Internal,
-			Message: "group_service error",
-		}
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return nil, groupErr
 	}
 
-	if group.TutorId != task.TutorId {
-		log.Printf("[VALIDATION] CreateTask: tutor mismatch, taskTutor: %s, groupTutor: %s",
-			task.TutorId, group.TutorId)
+	allowed, permErr := s.hasGroupPermission(ctx, task.GroupId, task.TutorId, pb.Permission_PERMISSION_MANAGE_TASKS)
+	if permErr != nil {
+		return nil, permErr
+	}
+
+	if !allowed {
+		log.Printf("[VALIDATION] CreateTask: user %s cannot manage tasks in group %s",
+			task.TutorId, task.GroupId)
 		return nil, &models.Error{
 			Code:    codes.FailedPrecondition,
 			Message: "user is not tutor in this group",
 		}
 	}
 
+	if termErr := s.checkDeadlineInTerm(ctx, task.GroupId, task.Deadline); termErr != nil {
+		return nil, termErr
+	}
+
 	task.Status = models.TaskStatusActive
 	task.CreatedAt = time.Now()
 
@@ -160,12 +249,21 @@ func (s *Service) UpdateTask(ctx context.Context, req models.UpdateTaskRequest)
 		}
 	}
 
-	if req.TutorID != currentTask.TutorId {
-		log.Printf("[PERMISSION] UpdateTask denied: reqTutor=%s, taskTutor=%s",
-			req.TutorID, currentTask.TutorId)
+	if groupErr := s.checkGroupActive(ctx, currentTask.GroupId); groupErr != nil {
+		return nil, groupErr
+	}
+
+	allowed, permErr := s.hasGroupPermission(ctx, currentTask.GroupId, req.TutorID, pb.Permission_PERMISSION_MANAGE_TASKS)
+	if permErr != nil {
+		return nil, permErr
+	}
+
+	if !allowed {
+		log.Printf("[PERMISSION] UpdateTask denied: user=%s, group=%s",
+			req.TutorID, currentTask.GroupId)
 		return nil, &models.Error{
 			Code:    codes.PermissionDenied,
-			Message: "only task creator can update it",
+			Message: "only group tutors can update tasks",
 		}
 	}
 
@@ -177,6 +275,12 @@ func (s *Service) UpdateTask(ctx context.Context, req models.UpdateTaskRequest)
 		}
 	}
 
+	if req.Deadline != nil {
+		if termErr := s.checkDeadlineInTerm(ctx, currentTask.GroupId, *req.Deadline); termErr != nil {
+			return nil, termErr
+		}
+	}
+
 	updatedTask, err := s.repo.UpdateTask(ctx, req)
 	if err != nil {
 		log.Printf("[REPOSITORY] UpdateTask failed: %v, taskID: %s", err, req.TaskID)
@@ -208,11 +312,20 @@ func (s *Service) DeleteTask(ctx context.Context, userID, taskID string) *models
 		}
 	}
 
-	if task.TutorId != userID {
-		log.Printf("[PERMISSION] DeleteTask denied: user=%s, taskTutor=%s", userID, task.TutorId)
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return groupErr
+	}
+
+	allowed, permErr := s.hasGroupPermission(ctx, task.GroupId, userID, pb.Permission_PERMISSION_MANAGE_TASKS)
+	if permErr != nil {
+		return permErr
+	}
+
+	if !allowed {
+		log.Printf("[PERMISSION] DeleteTask denied: user=%s, group=%s", userID, task.GroupId)
 		return &models.Error{
 			Code:    codes.PermissionDenied,
-			Message: "only task creator can delete it",
+			Message: "only group tutors can delete tasks",
 		}
 	}
 
@@ -347,6 +460,10 @@ func (s *Service) CreateSubmission(ctx context.Context, submission models.Submit
 		}
 	}
 
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return nil, groupErr
+	}
+
 	members, err := s.groupClient.GetGroupMembers(ctx, task.GroupId)
 	if err != nil {
 		log.Printf("[GROUP_SERVICE] GetGroupMembers failed: %v, groupID: %s", err, task.GroupId)
@@ -444,6 +561,10 @@ func (s *Service) UpdateSubmission(ctx context.Context, req models.UpdateSubmiss
 		}
 	}
 
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return nil, groupErr
+	}
+
 	if time.Now().After(task.Deadline) {
 		log.Printf("[VALIDATION] Cannot update after deadline: %s, deadline: %v",
 			req.SubmussionID, task.Deadline)
@@ -509,6 +630,19 @@ func (s *Service) DeleteSubmission(ctx context.Context, userID, submissionID str
 		}
 	}
 
+	task, err := s.repo.GetTaskByID(ctx, submission.TaskID)
+	if err != nil {
+		log.Printf("[REPOSITORY] GetTaskByID failed: %v, taskID: %s", err, submission.TaskID)
+		return &models.Error{
+			Code:    codes.Internal,
+			Message: "task not found",
+		}
+	}
+
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return groupErr
+	}
+
 	if err := s.repo.DeleteSubmission(ctx, userID, submissionID); err != nil {
 		log.Printf("[REPOSITORY] DeleteSubmission failed: %v, submissionID: %s, userID: %s",
 			err, submissionID, userID)
@@ -557,12 +691,21 @@ func (s *Service) GradeSubmission(ctx context.Context, grade models.SubmissionGr
 		}
 	}
 
-	if task.TutorId != grade.TutorId {
-		log.Printf("[PERMISSION] GradeSubmission denied: gradeTutor=%s, taskTutor=%s",
-			grade.TutorId, task.TutorId)
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return nil, groupErr
+	}
+
+	allowed, permErr := s.hasGroupPermission(ctx, task.GroupId, grade.TutorId, pb.Permission_PERMISSION_GRADE_SUBMISSIONS)
+	if permErr != nil {
+		return nil, permErr
+	}
+
+	if !allowed {
+		log.Printf("[PERMISSION] GradeSubmission denied: user=%s, group=%s",
+			grade.TutorId, task.GroupId)
 		return nil, &models.Error{
 			Code:    codes.PermissionDenied,
-			Message: "only task tutor can grade submissions",
+			Message: "only group tutors and assistants can grade submissions",
 		}
 	}
 
@@ -617,11 +760,20 @@ func (s *Service) ResetGrade(ctx context.Context, userID, submissionID string) *
 		}
 	}
 
-	if task.TutorId != userID {
-		log.Printf("[PERMISSION] ResetGrade denied: user=%s, taskTutor=%s", userID, task.TutorId)
+	if groupErr := s.checkGroupActive(ctx, task.GroupId); groupErr != nil {
+		return groupErr
+	}
+
+	allowed, permErr := s.hasGroupPermission(ctx, task.GroupId, userID, pb.Permission_PERMISSION_GRADE_SUBMISSIONS)
+	if permErr != nil {
+		return permErr
+	}
+
+	if !allowed {
+		log.Printf("[PERMISSION] ResetGrade denied: user=%s, group=%s", userID, task.GroupId)
 		return &models.Error{
 			Code:    codes.PermissionDenied,
-			Message: "only task creator can reset grade",
+			Message: "only group tutors and assistants can reset grade",
 		}
 	}
 
@@ -688,3 +840,59 @@ func (s *Service) GetTaskSubmissions(ctx context.Context, params models.GetSubmi
 		params.TaskID, len(submissions), total)
 	return submissions, total, nil
 }
+
+// GetStudentTasks - дедлайны и оценки ученика, доступно самому ученику и его опекунам
+func (s *Service) GetStudentTasks(ctx context.Context, params models.GetStudentTasksParams) ([]*models.StudentTaskProgress, int32, *models.Error) {
+	if params.ViewerID != params.StudentID {
+		ok, err := s.userClient.CheckGuardianAccess(ctx, params.ViewerID, params.StudentID)
+		if err != nil {
+			log.Printf("[USER_SERVICE] CheckGuardianAccess failed: %v, viewer: %s, student: %s",
+				err, params.ViewerID, params.StudentID)
+			return nil, 0, &models.Error{
+				Code:    codes.Internal,
+				Message: "user_service error",
+			}
+		}
+
+		if !ok {
+			log.Printf("[PERMISSION] GetStudentTasks denied: viewer=%s, student=%s",
+				params.ViewerID, params.StudentID)
+			return nil, 0, &models.Error{
+				Code:    codes.PermissionDenied,
+				Message: "only student or linked guardian can view student tasks",
+			}
+		}
+	}
+
+	groups, err := s.groupClient.ListStudentGroups(ctx, params.StudentID)
+	if err != nil {
+		log.Printf("[GROUP_SERVICE] ListStudentGroups failed: %v, studentID: %s", err, params.StudentID)
+		return nil, 0, &models.Error{
+			Code:    codes.Internal,
+			Message: "group_service error",
+		}
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.Id)
+	}
+
+	tasks, total, err := s.repo.GetStudentTasks(ctx, models.StudentTaskFilter{
+		StudentID: params.StudentID,
+		GroupIDs:  groupIDs,
+		Offset:    params.Offset,
+		Limit:     params.Limit,
+	})
+	if err != nil {
+		log.Printf("[REPOSITORY] GetStudentTasks failed: %v, studentID: %s", err, params.StudentID)
+		return nil, 0, &models.Error{
+			Code:    codes.Internal,
+			Message: "failed to get student tasks",
+		}
+	}
+
+	log.Printf("[INFO] GetStudentTasks: student=%s, viewer=%s, found=%d, total=%d",
+		params.StudentID, params.ViewerID, len(tasks), total)
+	return tasks, total, nil
+}
@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
 	"task_service/internal/models"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type mockRepository struct {
@@ -17,12 +19,14 @@ type mockRepository struct {
 	getErr      error
 	updateErr   error
 	deleteErr   error
+	archived    map[string]time.Time
 }
 
 func newMockRepository() *mockRepository {
 	return &mockRepository{
 		tasks:       make(map[string]*models.AssignedTask),
 		submissions: make(map[string]*models.SubmittedTask),
+		archived:    make(map[string]time.Time),
 	}
 }
 
@@ -67,6 +71,63 @@ func (m *mockRepository) SoftDeleteTask(ctx context.Context, userID, taskID stri
 	return nil
 }
 
+func (m *mockRepository) DeleteGroupTasks(ctx context.Context, groupID string) (int64, error) {
+	if m.deleteErr != nil {
+		return 0, m.deleteErr
+	}
+	var deleted int64
+	for id, task := range m.tasks {
+		if task.GroupId == groupID {
+			delete(m.tasks, id)
+			deleted++
+		}
+	}
+	for id, sub := range m.submissions {
+		if _, ok := m.tasks[sub.TaskID]; !ok {
+			delete(m.submissions, id)
+		}
+	}
+	delete(m.archived, groupID)
+	return deleted, nil
+}
+
+func (m *mockRepository) SetGroupArchived(ctx context.Context, groupID string, archivedAt time.Time) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	if _, ok := m.archived[groupID]; !ok {
+		m.archived[groupID] = archivedAt
+	}
+	return nil
+}
+
+func (m *mockRepository) UnsetGroupArchived(ctx context.Context, groupID string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	delete(m.archived, groupID)
+	return nil
+}
+
+func (m *mockRepository) IsGroupArchived(ctx context.Context, groupID string) (bool, error) {
+	_, ok := m.archived[groupID]
+	return ok, nil
+}
+
+func (m *mockRepository) ReassignGroupTasks(ctx context.Context, groupID, fromTutorID, toTutorID string) (int64, error) {
+	if m.updateErr != nil {
+		return 0, m.updateErr
+	}
+	var updated int64
+	for _, task := range m.tasks {
+		if task.GroupId == groupID && task.TutorId == fromTutorID {
+			task.TutorId = toTutorID
+			updated++
+		}
+	}
+	return updated, nil
+}
+
 func (m *mockRepository) GetTaskByID(ctx context.Context, taskID string) (*models.AssignedTask, error) {
 	if m.getErr != nil {
 		return nil, m.getErr
@@ -182,6 +243,41 @@ func (m *mockRepository) GetSubmissions(ctx context.Context, filter models.Submi
 	return result, int32(len(result)), nil
 }
 
+func (m *mockRepository) GetStudentTasks(ctx context.Context, filter models.StudentTaskFilter) ([]*models.StudentTaskProgress, int32, error) {
+	inGroups := make(map[string]bool, len(filter.GroupIDs))
+	for _, id := range filter.GroupIDs {
+		inGroups[id] = true
+	}
+
+	var result []*models.StudentTaskProgress
+	for _, task := range m.tasks {
+		if !inGroups[task.GroupId] {
+			continue
+		}
+		progress := &models.StudentTaskProgress{Task: models.AssignedTaskShort{
+			ID:       task.ID,
+			GroupID:  task.GroupId,
+			TutorID:  task.TutorId,
+			Title:    task.Title,
+			Deadline: task.Deadline,
+			Status:   task.Status,
+		}}
+		for _, sub := range m.submissions {
+			if sub.TaskID == task.ID && sub.StudentID == filter.StudentID {
+				progress.Submission = &models.SubmittedTaskShort{
+					ID:        sub.ID,
+					TaskID:    sub.TaskID,
+					StudentID: sub.StudentID,
+					Score:     sub.Score,
+					Status:    sub.Status,
+				}
+			}
+		}
+		result = append(result, progress)
+	}
+	return result, int32(len(result)), nil
+}
+
 func (m *mockRepository) MarkExpiredTasks(ctx context.Context) error {
 	now := time.Now()
 	for _, task := range m.tasks {
@@ -195,6 +291,7 @@ func (m *mockRepository) MarkExpiredTasks(ctx context.Context) error {
 type mockGroupClient struct {
 	groups  map[string]*pb.Group
 	members map[string][]*pb.GroupMember
+	staff   map[string]pb.StaffRole // userID -> роль соведущего или ассистента
 	getErr  error
 }
 
@@ -202,9 +299,44 @@ func newMockGroupClient() *mockGroupClient {
 	return &mockGroupClient{
 		groups:  make(map[string]*pb.Group),
 		members: make(map[string][]*pb.GroupMember),
+		staff:   make(map[string]pb.StaffRole),
 	}
 }
 
+func (m *mockGroupClient) ListStudentGroups(ctx context.Context, studentID string) ([]*pb.Group, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	var result []*pb.Group
+	for groupID, members := range m.members {
+		for _, member := range members {
+			if member.StudentId == studentID {
+				result = append(result, m.groups[groupID])
+			}
+		}
+	}
+	return result, nil
+}
+
+type mockUserClient struct {
+	guardians     map[string]string // guardianID -> studentID
+	timezones     map[string]string
+	timezoneCalls int
+}
+
+func (m *mockUserClient) CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error) {
+	return m.guardians[guardianID] == studentID, nil
+}
+
+func (m *mockUserClient) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	m.timezoneCalls++
+	tz, ok := m.timezones[userID]
+	if !ok {
+		return "", models.ErrNotFound
+	}
+	return tz, nil
+}
+
 func (m *mockGroupClient) GetGroupInfo(ctx context.Context, groupID string) (*pb.Group, error) {
 	if m.getErr != nil {
 		return nil, m.getErr
@@ -227,10 +359,31 @@ func (m *mockGroupClient) GetGroupMembers(ctx context.Context, groupID string) (
 	return members, nil
 }
 
+// CheckPermission повторяет матрицу ролей group-service
+func (m *mockGroupClient) CheckPermission(ctx context.Context, groupID, userID string, perm pb.Permission) (bool, error) {
+	if m.getErr != nil {
+		return false, m.getErr
+	}
+	group, exists := m.groups[groupID]
+	if !exists {
+		return false, models.ErrNotFound
+	}
+	if group.TutorId == userID {
+		return true, nil
+	}
+	switch m.staff[userID] {
+	case pb.StaffRole_STAFF_ROLE_CO_TUTOR:
+		return perm != pb.Permission_PERMISSION_DELETE_GROUP && perm != pb.Permission_PERMISSION_MANAGE_STAFF, nil
+	case pb.StaffRole_STAFF_ROLE_ASSISTANT:
+		return perm == pb.Permission_PERMISSION_GRADE_SUBMISSIONS, nil
+	}
+	return false, nil
+}
+
 func newTestService() (*Service, *mockRepository, *mockGroupClient) {
 	repo := newMockRepository()
 	groupClient := newMockGroupClient()
-	svc := NewService(repo, groupClient)
+	svc := NewService(repo, groupClient, &mockUserClient{guardians: map[string]string{"parent-1": "student-1"}})
 	return svc, repo, groupClient
 }
 
@@ -407,8 +560,9 @@ func TestService_GetTask_NotFound(t *testing.T) {
 }
 
 func TestService_UpdateTask_Success(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -442,8 +596,9 @@ func TestService_UpdateTask_Success(t *testing.T) {
 }
 
 func TestService_UpdateTask_NotOwner(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -512,8 +667,9 @@ func TestService_UpdateTask_ExpiredTask(t *testing.T) {
 }
 
 func TestService_DeleteTask_Success(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -534,8 +690,9 @@ func TestService_DeleteTask_Success(t *testing.T) {
 }
 
 func TestService_DeleteTask_NotOwner(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -705,8 +862,9 @@ func TestService_CreateSubmission_StudentNotInGroup(t *testing.T) {
 }
 
 func TestService_GradeSubmission_Success(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -754,8 +912,9 @@ func TestService_GradeSubmission_Success(t *testing.T) {
 }
 
 func TestService_GradeSubmission_NotTutor(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -797,9 +956,189 @@ func TestService_GradeSubmission_NotTutor(t *testing.T) {
 	}
 }
 
+func TestService_StaffPermissions(t *testing.T) {
+	svc, repo, groupClient := newTestService()
+	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
+	groupClient.staff["tutor-2"] = pb.StaffRole_STAFF_ROLE_CO_TUTOR
+	groupClient.staff["assistant-1"] = pb.StaffRole_STAFF_ROLE_ASSISTANT
+
+	repo.tasks["task-1"] = &models.AssignedTask{
+		ID:       "task-1",
+		GroupId:  "group-1",
+		TutorId:  "tutor-1",
+		Title:    "Test Task",
+		MaxScore: 100,
+		Deadline: time.Now().Add(24 * time.Hour),
+		Status:   models.TaskStatusActive,
+	}
+	repo.submissions["submission-1"] = &models.SubmittedTask{
+		ID:        "submission-1",
+		TaskID:    "task-1",
+		StudentID: "student-1",
+		Status:    models.SubmissionStatusPending,
+	}
+
+	// соведущий редактирует задание владельца группы
+	newTitle := "Updated by co-tutor"
+	if _, err := svc.UpdateTask(ctx, models.UpdateTaskRequest{TutorID: "tutor-2", TaskID: "task-1", Title: &newTitle}); err != nil {
+		t.Fatalf("expected co-tutor to update task, got %v", err)
+	}
+
+	// ассистент проверяет работы, но не редактирует задания
+	if _, err := svc.UpdateTask(ctx, models.UpdateTaskRequest{TutorID: "assistant-1", TaskID: "task-1", Title: &newTitle}); err == nil || err.Code != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for assistant update, got %v", err)
+	}
+
+	score := int32(90)
+	if _, err := svc.GradeSubmission(ctx, models.SubmissionGrade{SubmissionId: "submission-1", TutorId: "assistant-1", Score: &score}); err != nil {
+		t.Fatalf("expected assistant to grade submission, got %v", err)
+	}
+
+	if err := svc.DeleteTask(ctx, "assistant-1", "task-1"); err == nil || err.Code != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for assistant delete, got %v", err)
+	}
+}
+
+func TestService_ArchivedGroupFrozen(t *testing.T) {
+	svc, repo, groupClient := newTestService()
+	ctx := context.Background()
+
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
+	groupClient.members["group-1"] = []*pb.GroupMember{{StudentId: "student-1"}}
+	repo.tasks["task-1"] = &models.AssignedTask{
+		ID:       "task-1",
+		GroupId:  "group-1",
+		TutorId:  "tutor-1",
+		MaxScore: 100,
+		Deadline: time.Now().Add(24 * time.Hour),
+		Status:   models.TaskStatusActive,
+	}
+	repo.archived["group-1"] = time.Now()
+
+	_, err := svc.CreateTask(ctx, models.AssignedTask{
+		ID:       "task-2",
+		GroupId:  "group-1",
+		TutorId:  "tutor-1",
+		MaxScore: 100,
+		Deadline: time.Now().Add(24 * time.Hour),
+	})
+	if err == nil || err.Code != codes.FailedPrecondition {
+		t.Errorf("expected CreateTask FailedPrecondition, got %v", err)
+	}
+
+	title := "New title"
+	_, err = svc.UpdateTask(ctx, models.UpdateTaskRequest{TaskID: "task-1", TutorID: "tutor-1", Title: &title})
+	if err == nil || err.Code != codes.FailedPrecondition {
+		t.Errorf("expected UpdateTask FailedPrecondition, got %v", err)
+	}
+
+	_, err = svc.CreateSubmission(ctx, models.SubmittedTask{ID: "submission-1", TaskID: "task-1", StudentID: "student-1"})
+	if err == nil || err.Code != codes.FailedPrecondition {
+		t.Errorf("expected CreateSubmission FailedPrecondition, got %v", err)
+	}
+
+	// чтение заданий архивной группы доступно
+	if _, err := svc.GetTask(ctx, "task-1"); err != nil {
+		t.Errorf("expected GetTask to succeed, got %v", err)
+	}
+}
+
+func TestService_DeadlineWithinGroupTerm(t *testing.T) {
+	svc, repo, groupClient := newTestService()
+	ctx := context.Background()
+
+	now := time.Now()
+	groupClient.groups["group-1"] = &pb.Group{
+		Id:      "group-1",
+		TutorId: "tutor-1",
+		Term: &pb.Term{
+			Id:       "term-1",
+			StartsAt: timestamppb.New(now.Add(-24 * time.Hour)),
+			EndsAt:   timestamppb.New(now.Add(7 * 24 * time.Hour)),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+		wantErr  bool
+	}{
+		{"inside term", now.Add(24 * time.Hour), false},
+		{"after term end", now.Add(30 * 24 * time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateTask(ctx, models.AssignedTask{
+				ID:       "task-" + tt.name,
+				GroupId:  "group-1",
+				TutorId:  "tutor-1",
+				MaxScore: 100,
+				Deadline: tt.deadline,
+			})
+			if tt.wantErr {
+				if err == nil || err.Code != codes.InvalidArgument {
+					t.Errorf("expected InvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	repo.tasks["task-1"] = &models.AssignedTask{
+		ID:       "task-1",
+		GroupId:  "group-1",
+		TutorId:  "tutor-1",
+		MaxScore: 100,
+		Deadline: now.Add(24 * time.Hour),
+		Status:   models.TaskStatusActive,
+	}
+
+	// перенос дедлайна за конец периода
+	deadline := now.Add(30 * 24 * time.Hour)
+	_, err := svc.UpdateTask(ctx, models.UpdateTaskRequest{TaskID: "task-1", TutorID: "tutor-1", Deadline: &deadline})
+	if err == nil || err.Code != codes.InvalidArgument {
+		t.Errorf("expected UpdateTask InvalidArgument, got %v", err)
+	}
+
+	// без переноса дедлайна период не проверяется
+	title := "New title"
+	if _, err := svc.UpdateTask(ctx, models.UpdateTaskRequest{TaskID: "task-1", TutorID: "tutor-1", Title: &title}); err != nil {
+		t.Errorf("expected UpdateTask without deadline to succeed, got %v", err)
+	}
+}
+
+func TestService_GroupServiceUnavailable(t *testing.T) {
+	svc, repo, groupClient := newTestService()
+	ctx := context.Background()
+	groupClient.getErr = errors.New("connection refused")
+
+	repo.tasks["task-1"] = &models.AssignedTask{
+		ID:       "task-1",
+		GroupId:  "group-1",
+		TutorId:  "tutor-1",
+		Deadline: time.Now().Add(24 * time.Hour),
+		Status:   models.TaskStatusActive,
+	}
+
+	// без ответа group-service доступ не выдается
+	err := svc.DeleteTask(ctx, "tutor-1", "task-1")
+	if err == nil || err.Code != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+	if _, ok := repo.tasks["task-1"]; !ok {
+		t.Error("expected task not to be deleted")
+	}
+}
+
 func TestService_GradeSubmission_ScoreExceedsMax(t *testing.T) {
-	svc, repo, _ := newTestService()
+	svc, repo, groupClient := newTestService()
 	ctx := context.Background()
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
 
 	task := &models.AssignedTask{
 		ID:       "task-1",
@@ -883,3 +1222,86 @@ func TestService_GetTaskSubmission_NotFound(t *testing.T) {
 		t.Error("expected nil submission")
 	}
 }
+
+func TestService_GetStudentTasks_Guardian(t *testing.T) {
+	svc, repo, groupClient := newTestService()
+	ctx := context.Background()
+
+	groupClient.groups["group-1"] = &pb.Group{Id: "group-1", TutorId: "tutor-1"}
+	groupClient.members["group-1"] = []*pb.GroupMember{{GroupId: "group-1", StudentId: "student-1"}}
+	repo.tasks["task-1"] = &models.AssignedTask{ID: "task-1", GroupId: "group-1", TutorId: "tutor-1", Title: "Task", Deadline: time.Now().Add(time.Hour)}
+	repo.tasks["task-2"] = &models.AssignedTask{ID: "task-2", GroupId: "group-2", TutorId: "tutor-2", Title: "Other", Deadline: time.Now().Add(time.Hour)}
+	score := int32(8)
+	repo.submissions["sub-1"] = &models.SubmittedTask{ID: "sub-1", TaskID: "task-1", StudentID: "student-1", Score: &score, Status: models.SubmissionStatusVerified}
+
+	tasks, total, err := svc.GetStudentTasks(ctx, models.GetStudentTasksParams{ViewerID: "parent-1", StudentID: "student-1", Limit: 10})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total != 1 || len(tasks) != 1 {
+		t.Fatalf("expected 1 task, got %d", len(tasks))
+	}
+	if tasks[0].Submission == nil || *tasks[0].Submission.Score != 8 {
+		t.Error("expected graded submission in progress")
+	}
+}
+
+func TestService_GetStudentTasks_NotGuardian(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	_, _, err := svc.GetStudentTasks(ctx, models.GetStudentTasksParams{ViewerID: "stranger", StudentID: "student-1", Limit: 10})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != codes.PermissionDenied {
+		t.Errorf("expected code PermissionDenied, got %v", err.Code)
+	}
+}
+
+func TestService_GetUserLocation(t *testing.T) {
+	svc, _, _ := newTestService()
+	userClient := svc.userClient.(*mockUserClient)
+	userClient.timezones = map[string]string{
+		"tutor-1":   "Asia/Yekaterinburg",
+		"student-1": "Mars/Olympus",
+	}
+	ctx := context.Background()
+
+	loc := svc.GetUserLocation(ctx, "tutor-1")
+	if loc.String() != "Asia/Yekaterinburg" {
+		t.Errorf("expected Asia/Yekaterinburg, got %s", loc)
+	}
+
+	// повторный запрос берется из кэша
+	svc.GetUserLocation(ctx, "tutor-1")
+	if userClient.timezoneCalls != 1 {
+		t.Errorf("expected 1 call to user service, got %d", userClient.timezoneCalls)
+	}
+
+	for _, userID := range []string{"", "student-1", "unknown"} {
+		if loc := svc.GetUserLocation(ctx, userID); loc != time.UTC {
+			t.Errorf("expected UTC fallback for %q, got %s", userID, loc)
+		}
+	}
+}
+
+func TestLocalDeadline(t *testing.T) {
+	deadline := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	if got := models.LocalDeadline(deadline, loc); got != "2026-03-01T23:00:00+03:00" {
+		t.Errorf("unexpected local deadline %s", got)
+	}
+	if got := models.LocalDeadline(deadline, nil); got != "2026-03-01T20:00:00Z" {
+		t.Errorf("unexpected UTC deadline %s", got)
+	}
+	if got := models.LocalDeadline(time.Time{}, loc); got != "" {
+		t.Errorf("expected empty string for zero deadline, got %s", got)
+	}
+}
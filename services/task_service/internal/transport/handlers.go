@@ -34,7 +34,7 @@ func (s *Server) CreateTask(ctx context.Context, request *pb.CreateTaskRequest)
 		return nil, status.Error(err.Code, err.Message)
 	}
 
-	resp := models.TaskToProto(task)
+	resp := models.TaskToProto(task, s.viewerLocation(ctx, request.GetTutorId()))
 	return &pb.CreateTaskResponse{Task: resp}, nil
 }
 
@@ -52,7 +52,7 @@ func (s *Server) UpdateTask(ctx context.Context, request *pb.UpdateTaskRequest)
 		return nil, status.Error(err.Code, err.Message)
 	}
 
-	return models.UpdateTaskToProto(resp), nil
+	return models.UpdateTaskToProto(resp, s.viewerLocation(ctx, request.GetTutorId())), nil
 }
 
 func (s *Server) DeleteTask(ctx context.Context, request *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
@@ -82,7 +82,7 @@ func (s *Server) GetTask(ctx context.Context, request *pb.GetTaskRequest) (*pb.G
 		return nil, status.Error(err.Code, err.Message)
 	}
 
-	resp := models.TaskToProto(task)
+	resp := models.TaskToProto(task, s.viewerLocation(ctx, ""))
 	return &pb.GetTaskResponse{Task: resp}, nil
 }
 
@@ -102,7 +102,7 @@ func (s *Server) GetGroupTasks(ctx context.Context, request *pb.GetGroupTasksReq
 		return nil, status.Error(err.Code, err.Message)
 	}
 
-	tasksProto := models.TasksListToProto(tasks)
+	tasksProto := models.TasksListToProto(tasks, s.viewerLocation(ctx, ""))
 	resp := &pb.GetGroupTasksResponse{
 		Tasks:  tasksProto,
 		Offset: params.Offset,
@@ -129,7 +129,7 @@ func (s *Server) GetCreatedByMeTasks(ctx context.Context, request *pb.GetCreated
 		return nil, status.Error(err.Code, err.Message)
 	}
 
-	tasksProto := models.TasksListToProto(tasks)
+	tasksProto := models.TasksListToProto(tasks, s.viewerLocation(ctx, params.UserID))
 	resp := &pb.GetCreatedByMeTasksResponse{
 		Tasks:  tasksProto,
 		Offset: params.Offset,
@@ -270,3 +270,36 @@ func (s *Server) GetTaskSubmissions(ctx context.Context, request *pb.GetTaskSubm
 
 	return resp, nil
 }
+
+// просмотр ученика (ученик или опекун), личность берется из метаданных gateway
+func (s *Server) GetStudentTasks(ctx context.Context, request *pb.GetStudentTasksRequest) (*pb.GetStudentTasksResponse, error) {
+	if request.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is not set")
+	}
+
+	viewerID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := models.GetStudentTasksParams{
+		ViewerID:  viewerID,
+		StudentID: request.GetStudentId(),
+		Offset:    request.GetOffset(),
+		Limit:     request.GetLimit(),
+	}
+
+	tasks, total, e := s.service.GetStudentTasks(ctx, params)
+	if e != nil {
+		return nil, status.Error(e.Code, e.Message)
+	}
+
+	resp := &pb.GetStudentTasksResponse{
+		Tasks:  models.StudentTasksToProto(tasks, s.service.GetUserLocation(ctx, viewerID)),
+		Offset: params.Offset,
+		Limit:  params.Limit,
+		Total:  total,
+	}
+
+	return resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/task"
 
@@ -29,6 +30,12 @@ type TaskService interface {
 	ResetGrade(ctx context.Context, userID, gradeID string) *models.Error
 	GetTaskSubmission(ctx context.Context, submissionID string) (*models.SubmittedTask, *models.Error)
 	GetTaskSubmissions(ctx context.Context, params models.GetSubmissionsParams) ([]*models.SubmittedTaskShort, int32, *models.Error)
+
+	// Guardian / student views
+	GetStudentTasks(ctx context.Context, params models.GetStudentTasksParams) ([]*models.StudentTaskProgress, int32, *models.Error)
+
+	// Localization
+	GetUserLocation(ctx context.Context, userID string) *time.Location
 }
 
 type Server struct {
@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func getUserIDFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "no metadata in request")
+	}
+
+	values := md.Get("x-user-id")
+	if len(values) == 0 || values[0] == "" {
+		return "", status.Error(codes.Unauthenticated, "user id not provided by gateway")
+	}
+
+	return values[0], nil
+}
+
+// viewerLocation определяет часовой пояс того, кто смотрит ответ:
+// пользователь из метаданных gateway, иначе fallbackID из тела запроса
+func (s *Server) viewerLocation(ctx context.Context, fallbackID string) *time.Location {
+	viewerID := fallbackID
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-user-id"); len(values) > 0 && values[0] != "" {
+			viewerID = values[0]
+		}
+	}
+
+	return s.service.GetUserLocation(ctx, viewerID)
+}
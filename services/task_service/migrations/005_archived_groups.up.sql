@@ -0,0 +1,5 @@
+-- архивные группы из событий group-service: их задания заморожены
+CREATE TABLE IF NOT EXISTS archived_groups (
+    group_id VARCHAR(255) PRIMARY KEY,
+    archived_at TIMESTAMPTZ NOT NULL
+);
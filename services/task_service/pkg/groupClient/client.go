@@ -94,6 +94,51 @@ func (c *GroupClient) GetGroupMembers(ctx context.Context, groupID string) ([]*p
 	return resp.GetMembers(), nil
 }
 
+func (c *GroupClient) ListStudentGroups(ctx context.Context, studentID string) ([]*pb.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// список групп отдается страницами, собираем все. Архивные группы нужны:
+	// в них остаются дедлайны и оценки ученика
+	var groups []*pb.Group
+	req := &pb.ListGroupsRequest{
+		Filter:        &pb.ListGroupsRequest_StudentId{StudentId: studentID},
+		PageSize:      100,
+		ArchiveFilter: pb.ArchiveFilter_ARCHIVE_FILTER_ALL,
+	}
+	for {
+		resp, err := c.client.ListGroups(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, resp.GetGroups()...)
+		if resp.GetNextPageToken() == "" {
+			return groups, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// CheckPermission проверяет право пользователя по ролям персонала группы
+func (c *GroupClient) CheckPermission(ctx context.Context, groupID, userID string, perm pb.Permission) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &pb.CheckPermissionRequest{
+		GroupId:    groupID,
+		UserId:     userID,
+		Permission: perm,
+	}
+
+	resp, err := c.client.CheckPermission(ctx, req)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.GetAllowed(), nil
+}
+
 func (c *GroupClient) Close() error {
 	return c.conn.Close()
 }
@@ -0,0 +1,8 @@
+package kafka
+
+type KafkaConfig struct {
+	Brokers string `env:"KAFKA_BROKERS" env-default:"kafka:9092"`
+	// топик событий group-service
+	GroupEventsTopic string `env:"GROUP_EVENTS_TOPIC" env-default:"group-events"`
+	GroupID          string `env:"KAFKA_GROUP_ID" env-default:"task-service"`
+}
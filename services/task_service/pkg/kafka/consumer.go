@@ -0,0 +1,70 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	initialRetryDelay = time.Second
+	maxRetryDelay     = time.Minute
+)
+
+type Handler func(ctx context.Context, msg kafka.Message) error
+
+type Consumer struct {
+	reader *kafka.Reader
+}
+
+func NewConsumer(brokers []string, topic, groupID string) *Consumer {
+	return &Consumer{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: groupID,
+		}),
+	}
+}
+
+// Run читает сообщения до отмены контекста. Смещение фиксируется после успешной обработки,
+// ошибку обработчика повторяем с паузой, не пропуская сообщение: порядок событий группы важен.
+func (c *Consumer) Run(ctx context.Context, handle Handler) {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("[KAFKA] fetch message failed: %v", err)
+			continue
+		}
+
+		delay := initialRetryDelay
+		for {
+			err := handle(ctx, msg)
+			if err == nil {
+				break
+			}
+			log.Printf("[KAFKA] handle message failed: %v, topic: %s, offset: %d, retry in %s",
+				err, msg.Topic, msg.Offset, delay)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(delay*2, maxRetryDelay)
+		}
+
+		if err := c.reader.CommitMessages(ctx, msg); err != nil && ctx.Err() == nil {
+			log.Printf("[KAFKA] commit failed: %v, topic: %s, offset: %d", err, msg.Topic, msg.Offset)
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	return c.reader.Close()
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"task_service/pkg/resilience"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type UserClient struct {
+	conn    *grpc.ClientConn
+	client  pb.UserServiceClient
+	timeout time.Duration
+}
+
+func NewUserClient(address string) (*UserClient, error) {
+	cbConfig := resilience.CircuitBreakerConfig{
+		Name:             "user-service",
+		FailureThreshold: 5,
+		SuccessThreshold: 2,
+		Timeout:          30 * time.Second,
+		OnStateChange:    resilience.LoggingStateChangeCallback,
+	}
+
+	retryConfig := resilience.RetryConfig{
+		MaxAttempts:     3,
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     2 * time.Second,
+		Multiplier:      2.0,
+		Jitter:          0.1,
+	}
+
+	resilienceInterceptor := resilience.NewResilienceInterceptor(cbConfig, retryConfig, 10*time.Second)
+
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(resilienceInterceptor.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %v", err)
+	}
+
+	conn.Connect()
+
+	return &UserClient{
+		conn:    conn,
+		client:  pb.NewUserServiceClient(conn),
+		timeout: 5 * time.Second,
+	}, nil
+}
+
+func (c *UserClient) CheckGuardianAccess(ctx context.Context, guardianID, studentID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.CheckGuardianAccess(ctx, &pb.CheckGuardianAccessRequest{
+		GuardianId: guardianID,
+		StudentId:  studentID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.GetHasAccess(), nil
+}
+
+func (c *UserClient) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.GetUserPreferences(ctx, &pb.GetUserPreferencesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetTimezone(), nil
+}
+
+func (c *UserClient) Close() error {
+	return c.conn.Close()
+}
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	// база часовых поясов для образов без tzdata
+	_ "time/tzdata"
+	"user-service/internal/clients/auth"
+	"user-service/internal/clients/group"
 	"user-service/internal/config"
 	kfk "user-service/pkg/kafka"
 	"user-service/internal/transport"
@@ -15,7 +20,7 @@ import (
 
 	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
 
-	"github.com/segmentio/kafka-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 )
 
@@ -41,40 +46,59 @@ func main() {
 	defer pgDB.DB.Close()
 
 
+	groupClient, err := group.NewClient(cfg.GroupServiceAddr)
+	if err != nil {
+		panic(fmt.Errorf("failed to create group client: %w", err))
+	}
+	defer groupClient.Close()
+
+	authClient, err := auth.NewClient(cfg.AuthServiceAddr)
+	if err != nil {
+		panic(fmt.Errorf("failed to create auth client: %w", err))
+	}
+	defer authClient.Close()
+
 	producer := kfk.NewProducer([]string{cfg.KafkaConfig.Brokers}, cfg.KafkaConfig.Topic)
-	apiServer := transport.NewApiServer(pgDB.DB, producer)
+	apiServer := transport.NewApiServer(pgDB.DB, producer, groupClient, authClient, cfg)
 
-	topics := []string{
-		cfg.Topic,
-		fmt.Sprintf("%s.retry.1", cfg.Topic),
-		fmt.Sprintf("%s.retry.2", cfg.Topic),
-		fmt.Sprintf("%s.retry.3", cfg.Topic),
+	tiers := map[string]string{
+		"main":    cfg.Topic,
+		"retry.1": fmt.Sprintf("%s.retry.1", cfg.Topic),
+		"retry.2": fmt.Sprintf("%s.retry.2", cfg.Topic),
+		"retry.3": fmt.Sprintf("%s.retry.3", cfg.Topic),
 	}
 
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	for _, topic := range topics {
-		reader := kfk.NewConsumer([]string{cfg.KafkaConfig.Brokers}, topic, cfg.GroupID)
+	// задержка retry-топиков выдерживается по заголовку x-not-before без блокировки чтения
+	for tier, topic := range tiers {
+		source := kfk.NewGroupSource([]string{cfg.KafkaConfig.Brokers}, topic, cfg.GroupID)
+		go kfk.NewDelayedConsumer(source, tier, apiServer.EventHandler.Handle).Run(ctx)
+	}
 
-		go func(r *kafka.Reader) {
-			for {
-				msg, err := r.FetchMessage(ctx)
-				if err != nil {
-					continue
-				}
+	// сообщения из DLQ сохраняются в БД для просмотра и повторной отправки через DLQAdminService
+	dlqSource := kfk.NewGroupSource([]string{cfg.KafkaConfig.Brokers}, fmt.Sprintf("%s.dlq", cfg.Topic), cfg.GroupID)
+	go kfk.NewDelayedConsumer(dlqSource, "dlq", apiServer.DLQService.Index).Run(ctx)
 
+	// отправка событий изменения профилей из outbox
+	go apiServer.OutboxRelay.Run(ctx, cfg.OutboxPollInterval)
 
-				err = apiServer.EventHandler.Handle(ctx, msg)
-				if err == nil {
-					_ = r.CommitMessages(ctx, msg)
-				}
-			}
-		}(reader)
-	}
+	// сверка профилей с auth-service на случай потерянных UserRegistered/UserDeleted
+	go apiServer.Reconciler.Start(ctx, cfg.ReconcileInterval)
+
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		if err := http.ListenAndServe(":"+cfg.MetricsPort, mux); err != nil {
+			log.Printf("metrics server stopped: %v", err)
+		}
+	}()
 
 
 	grpcServer := grpc.NewServer()
 	pb.RegisterUserServiceServer(grpcServer, apiServer)
+	pb.RegisterDLQAdminServiceServer(grpcServer, transport.NewDLQAdminServer(apiServer.DLQService))
 
 	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 	if err != nil {
@@ -94,6 +118,7 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
+	cancel()
 	grpcServer.GracefulStop()
 	log.Println("server shut down")
 
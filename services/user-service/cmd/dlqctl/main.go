@@ -0,0 +1,203 @@
+// dlqctl - консольный клиент DLQAdminService.
+//
+//	dlqctl [-addr host:port] [-admin id] list [-status pending|replayed|discarded|all] [-limit N] [-offset N]
+//	dlqctl show <id>
+//	dlqctl replay [-all] [id...]
+//	dlqctl discard -note "причина" [-all] [id...]
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var statuses = map[string]pb.DLQMessageStatus{
+	"all":       pb.DLQMessageStatus_DLQ_MESSAGE_STATUS_UNSPECIFIED,
+	"pending":   pb.DLQMessageStatus_DLQ_PENDING,
+	"replayed":  pb.DLQMessageStatus_DLQ_REPLAYED,
+	"discarded": pb.DLQMessageStatus_DLQ_DISCARDED,
+}
+
+func main() {
+	addr := flag.String("addr", envOr("USER_SERVICE_ADDRESS", "localhost:50051"), "user-service gRPC address")
+	admin := flag.String("admin", os.Getenv("DLQ_ADMIN_ID"), "admin user id, must be listed in ADMIN_USER_IDS")
+	timeout := flag.Duration("timeout", 30*time.Second, "request timeout")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	if *admin == "" {
+		fail(fmt.Errorf("admin id is required: use -admin or DLQ_ADMIN_ID"))
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fail(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", *admin)
+
+	client := pb.NewDLQAdminServiceClient(conn)
+	args := flag.Args()[1:]
+
+	switch flag.Arg(0) {
+	case "list":
+		err = list(ctx, client, args)
+	case "show":
+		err = show(ctx, client, args)
+	case "replay":
+		err = replay(ctx, client, args)
+	case "discard":
+		err = discard(ctx, client, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+func list(ctx context.Context, client pb.DLQAdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	status := fs.String("status", "pending", "pending, replayed, discarded or all")
+	limit := fs.Int("limit", 50, "page size")
+	offset := fs.Int("offset", 0, "page offset")
+	_ = fs.Parse(args)
+
+	st, ok := statuses[strings.ToLower(*status)]
+	if !ok {
+		return fmt.Errorf("unknown status %q", *status)
+	}
+
+	resp, err := client.ListDLQMessages(ctx, &pb.ListDLQMessagesRequest{
+		Status: st,
+		Limit:  int32(*limit),
+		Offset: int32(*offset),
+	})
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCREATED\tSTATUS\tTOPIC\tRETRIES\tERROR")
+	for _, m := range resp.Messages {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			m.Id,
+			m.CreatedAt.AsTime().Local().Format(time.DateTime),
+			strings.TrimPrefix(m.Status.String(), "DLQ_"),
+			m.OriginalTopic,
+			m.RetryCount,
+			m.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nshown %d of %d\n", len(resp.Messages), resp.Total)
+	return nil
+}
+
+func show(ctx context.Context, client pb.DLQAdminServiceClient, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: dlqctl show <id>")
+	}
+
+	resp, err := client.GetDLQMessage(ctx, &pb.GetDLQMessageRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+	m := resp.Message
+
+	fmt.Printf("id:             %s\n", m.Id)
+	fmt.Printf("status:         %s\n", strings.TrimPrefix(m.Status.String(), "DLQ_"))
+	fmt.Printf("dlq position:   %s[%d]@%d\n", m.Topic, m.Partition, m.Offset)
+	fmt.Printf("original topic: %s\n", m.OriginalTopic)
+	fmt.Printf("key:            %s\n", m.Key)
+	fmt.Printf("retries:        %d\n", m.RetryCount)
+	fmt.Printf("error:          %s\n", m.Error)
+	fmt.Printf("created at:     %s\n", m.CreatedAt.AsTime().Local().Format(time.DateTime))
+	if m.ResolvedAt != nil {
+		fmt.Printf("resolved:       %s by %s\n", m.ResolvedAt.AsTime().Local().Format(time.DateTime), m.ResolvedBy)
+		fmt.Printf("note:           %s\n", m.Note)
+	}
+
+	fmt.Println("payload:")
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, m.Payload, "", "  ") == nil {
+		fmt.Println(pretty.String())
+	} else {
+		fmt.Printf("%q\n", m.Payload)
+	}
+	return nil
+}
+
+func replay(ctx context.Context, client pb.DLQAdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	all := fs.Bool("all", false, "replay all pending messages")
+	_ = fs.Parse(args)
+
+	resp, err := client.ReplayDLQMessages(ctx, &pb.ReplayDLQMessagesRequest{Ids: fs.Args(), All: *all})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("replayed %d message(s)\n", resp.Replayed)
+	return nil
+}
+
+func discard(ctx context.Context, client pb.DLQAdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("discard", flag.ExitOnError)
+	all := fs.Bool("all", false, "discard all pending messages")
+	note := fs.String("note", "", "reason, stored for audit (required)")
+	_ = fs.Parse(args)
+
+	resp, err := client.DiscardDLQMessages(ctx, &pb.DiscardDLQMessagesRequest{Ids: fs.Args(), All: *all, Note: *note})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("discarded %d message(s)\n", resp.Discarded)
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: dlqctl [-addr host:port] [-admin id] <command> [args]
+
+commands:
+  list [-status pending|replayed|discarded|all] [-limit N] [-offset N]
+  show <id>
+  replay [-all] [id...]
+  discard -note "reason" [-all] [id...]`)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "dlqctl:", err)
+	os.Exit(1)
+}
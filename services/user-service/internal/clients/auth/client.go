@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"user-service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.AuthServiceClient
+	timeout time.Duration
+}
+
+func NewClient(address string, opts ...grpc.DialOption) (*Client, error) {
+	defaultOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	conn, err := grpc.NewClient(address, append(defaultOpts, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	return &Client{
+		conn:    conn,
+		service: pb.NewAuthServiceClient(conn),
+		timeout: 10 * time.Second,
+	}, nil
+}
+
+// ListUsers возвращает страницу всех пользователей auth-service, активных и неактивных.
+// Пагинация по курсору, поэтому новые пользователи не сдвигают страницы.
+func (c *Client) ListUsers(ctx context.Context, pageToken string, limit int32) ([]models.AuthUser, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListUsers(ctx, &pb.ListUsersRequest{
+		Limit:         limit,
+		Status:        pb.UserStatusFilter_USER_STATUS_ALL,
+		SortBy:        pb.UserSortField_USER_SORT_CREATED_AT,
+		SortDirection: pb.SortDirection_SORT_ASC,
+		PageToken:     pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	users := make([]models.AuthUser, 0, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		users = append(users, models.AuthUser{
+			ID:        u.GetId(),
+			Email:     u.GetEmail(),
+			IsActive:  u.GetIsActive(),
+			CreatedAt: u.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return users, resp.GetNextPageToken(), nil
+}
+
+// UserExists проверяет пользователя напрямую, без постраничного списка
+func (c *Client) UserExists(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.service.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,55 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/group"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	service pb.GroupsServiceClient
+	timeout time.Duration
+}
+
+func NewClient(address string, opts ...grpc.DialOption) (*Client, error) {
+	defaultOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	conn, err := grpc.NewClient(address, append(defaultOpts, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	return &Client{
+		conn:    conn,
+		service: pb.NewGroupsServiceClient(conn),
+		timeout: 5 * time.Second,
+	}, nil
+}
+
+// HasSharedGroup проверяет, учился ли ученик у репетитора в какой-либо группе:
+// архивной, где репетитор в персонале или был владельцем до передачи
+func (c *Client) HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.HasSharedGroup(ctx, &pb.HasSharedGroupRequest{
+		TutorId:   tutorID,
+		StudentId: studentID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.GetShared(), nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
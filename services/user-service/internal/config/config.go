@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 	"user-service/pkg/kafka"
 	"user-service/pkg/postgres"
 
@@ -11,6 +12,24 @@ import (
 type Config struct {
 	GRPCPort    string `env:"GRPC_PORT" env-default:":50051"`
 	MigrationPath string `env:"MIGRATION_PATH" env-default:":file://migrations"`
+	GroupServiceAddr string `env:"GROUP_SERVICE_ADDRESS" env-default:"group-go:50051"`
+	AuthServiceAddr  string `env:"AUTH_SERVICE_ADDRESS" env-default:"auth-go:50051"`
+	MetricsPort string `env:"METRICS_PORT" env-default:"9100"`
+
+	// события изменения профилей
+	UserEventsTopic    string        `env:"USER_EVENTS_TOPIC" env-default:"user-events"`
+	OutboxPollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" env-default:"1s"`
+
+	AdminUserIDs      []string `env:"ADMIN_USER_IDS" env-separator:","`
+	ReviewBannedWords []string `env:"REVIEW_BANNED_WORDS" env-separator:","`
+	// хосты хранилища сервиса загрузок для ссылок на документы проверки
+	UploadHosts []string `env:"UPLOAD_HOSTS" env-separator:","`
+
+	// сверка профилей с auth-service
+	ReconcileInterval      time.Duration `env:"RECONCILE_INTERVAL" env-default:"1h"`
+	ReconcileGrace         time.Duration `env:"RECONCILE_GRACE" env-default:"15m"`
+	ReconcileRepair        bool          `env:"RECONCILE_REPAIR" env-default:"true"`
+	ReconcileDeleteOrphans bool          `env:"RECONCILE_DELETE_ORPHANS" env-default:"false"`
 
 	postgres.PGConfig
 	kafka.KafkaConfig
@@ -0,0 +1,8 @@
+package events
+
+// EmailChangedPayload - схема EmailChanged версии 1, публикуется после подтверждения нового адреса
+type EmailChangedPayload struct {
+	UserID   string `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
@@ -0,0 +1,29 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	UserRegistered = "UserRegistered"
+	UserDeleted    = "UserDeleted"
+	EmailChanged   = "EmailChanged"
+)
+
+// Envelope - общая оболочка события, payload разбирается обработчиком по event_type и version
+type Envelope struct {
+	EventType  string          `json:"event_type"`
+	EventID    string          `json:"event_id"`
+	Version    int             `json:"version,omitempty"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// SchemaVersion возвращает версию схемы payload, события без версии считаются первой
+func (e Envelope) SchemaVersion() int {
+	if e.Version <= 0 {
+		return 1
+	}
+	return e.Version
+}
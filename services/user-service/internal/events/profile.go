@@ -0,0 +1,46 @@
+package events
+
+// события изменения профилей, публикуются user-service в USER_EVENTS_TOPIC через outbox
+const (
+	UserProfileUpdated    = "UserProfileUpdated"
+	UserProfileDeleted    = "UserProfileDeleted"
+	TutorProfileCreated   = "TutorProfileCreated"
+	TutorProfileDeleted   = "TutorProfileDeleted"
+	StudentProfileCreated = "StudentProfileCreated"
+	StudentProfileDeleted = "StudentProfileDeleted"
+)
+
+// UserProfilePayload - полный снимок профиля, схема UserProfileUpdated версии 1.
+// Отправляется и при создании профиля, потребитель делает upsert.
+type UserProfilePayload struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	Surname   string `json:"surname"`
+	Telegram  string `json:"telegram"`
+	Timezone  string `json:"timezone"`
+	Locale    string `json:"locale"`
+	AvatarURL string `json:"avatar_url"`
+	IsTutor   bool   `json:"is_tutor"`
+	IsStudent bool   `json:"is_student"`
+}
+
+// TutorProfilePayload - схема TutorProfileCreated версии 1
+type TutorProfilePayload struct {
+	UserID          string `json:"user_id"`
+	Bio             string `json:"bio"`
+	Specialization  string `json:"specialization"`
+	ExperienceYears int32  `json:"experience_years"`
+}
+
+// StudentProfilePayload - схема StudentProfileCreated версии 1
+type StudentProfilePayload struct {
+	UserID string `json:"user_id"`
+	Grade  string `json:"grade"`
+	Bio    string `json:"bio"`
+}
+
+// ProfileDeletedPayload - схема UserProfileDeleted, TutorProfileDeleted и StudentProfileDeleted версии 1
+type ProfileDeletedPayload struct {
+	UserID string `json:"user_id"`
+}
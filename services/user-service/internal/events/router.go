@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownEvent       = errors.New("unknown event type")
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+	ErrMalformedPayload   = errors.New("malformed event payload")
+)
+
+type HandlerFunc func(ctx context.Context, env Envelope) error
+
+// Router выбирает обработчик по event_type и версии схемы payload
+type Router struct {
+	handlers map[string]map[int]HandlerFunc
+}
+
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string]map[int]HandlerFunc)}
+}
+
+func (r *Router) Register(eventType string, version int, handler HandlerFunc) {
+	versions, ok := r.handlers[eventType]
+	if !ok {
+		versions = make(map[int]HandlerFunc)
+		r.handlers[eventType] = versions
+	}
+	if _, exists := versions[version]; exists {
+		panic(fmt.Sprintf("handler for %s v%d already registered", eventType, version))
+	}
+	versions[version] = handler
+}
+
+// On регистрирует типизированный обработчик: payload разбирается в T до вызова fn
+func On[T any](r *Router, eventType string, version int, fn func(ctx context.Context, env Envelope, payload T) error) {
+	r.Register(eventType, version, func(ctx context.Context, env Envelope) error {
+		var payload T
+		if err := json.Unmarshal(env.Payload, &payload); err != nil {
+			return fmt.Errorf("%w: %s v%d: %v", ErrMalformedPayload, eventType, version, err)
+		}
+		return fn(ctx, env, payload)
+	})
+}
+
+func (r *Router) Dispatch(ctx context.Context, env Envelope) error {
+	versions, ok := r.handlers[env.EventType]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownEvent, env.EventType)
+	}
+
+	handler, ok := versions[env.SchemaVersion()]
+	if !ok {
+		return fmt.Errorf("%w: %s v%d", ErrUnsupportedVersion, env.EventType, env.SchemaVersion())
+	}
+
+	return handler(ctx, env)
+}
+
+// IsPermanent сообщает, что повтор обработки не поможет и событие нужно отправить в DLQ
+func IsPermanent(err error) bool {
+	return errors.Is(err, ErrUnknownEvent) ||
+		errors.Is(err, ErrUnsupportedVersion) ||
+		errors.Is(err, ErrMalformedPayload)
+}
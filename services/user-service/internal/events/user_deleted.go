@@ -0,0 +1,6 @@
+package events
+
+// UserDeletedPayload - схема UserDeleted версии 1
+type UserDeletedPayload struct {
+	UserID string `json:"user_id"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+type DLQMessageStatus string
+
+const (
+	DLQPending   DLQMessageStatus = "PENDING"
+	DLQReplayed  DLQMessageStatus = "REPLAYED"
+	DLQDiscarded DLQMessageStatus = "DISCARDED"
+)
+
+// DLQMessage - сообщение из <topic>.dlq, сохраненное для просмотра и повторной отправки
+type DLQMessage struct {
+	ID            string            `json:"id" db:"id"`
+	Topic         string            `json:"topic" db:"topic"`
+	Partition     int               `json:"partition" db:"kafka_partition"`
+	Offset        int64             `json:"offset" db:"kafka_offset"`
+	Key           string            `json:"key" db:"message_key"`
+	Payload       []byte            `json:"payload" db:"payload"`
+	Headers       map[string]string `json:"headers" db:"headers"`
+	OriginalTopic string            `json:"original_topic" db:"original_topic"`
+	Error         string            `json:"error" db:"error"`
+	RetryCount    int               `json:"retry_count" db:"retry_count"`
+	Status        DLQMessageStatus  `json:"status" db:"status"`
+	Note          string            `json:"note" db:"note"`
+	ResolvedBy    string            `json:"resolved_by" db:"resolved_by"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+	ResolvedAt    *time.Time        `json:"resolved_at" db:"resolved_at"`
+}
+
+type DLQFilter struct {
+	Status DLQMessageStatus
+	Limit  int32
+	Offset int32
+}
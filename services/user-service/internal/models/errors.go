@@ -3,15 +3,23 @@ package models
 type ErrorResponseErrorCode string
 
 const (
-	USERNOTFOUND    ErrorResponseErrorCode = "USER_NOT_FOUND"
-	TUTORNOTFOUND   ErrorResponseErrorCode = "TUTOR_NOT_FOUND"
-	STUDENTNOTFOUND ErrorResponseErrorCode = "STUDENT_NOT_FOUND"
-	USEREXISTS      ErrorResponseErrorCode = "USER_EXISTS"
-	TUTOREXISTS     ErrorResponseErrorCode = "TUTOR_EXISTS"
-	STUDENTEXISTS   ErrorResponseErrorCode = "STUDENT_EXISTS"
-	INTERNALERROR   ErrorResponseErrorCode = "INTERNAL_ERROR"
-	INVALIDINPUT    ErrorResponseErrorCode = "INVALID_INPUT"
-	STATUS_OK       ErrorResponseErrorCode = "STATUS_OK"
+	USERNOTFOUND         ErrorResponseErrorCode = "USER_NOT_FOUND"
+	TUTORNOTFOUND        ErrorResponseErrorCode = "TUTOR_NOT_FOUND"
+	STUDENTNOTFOUND      ErrorResponseErrorCode = "STUDENT_NOT_FOUND"
+	USEREXISTS           ErrorResponseErrorCode = "USER_EXISTS"
+	TUTOREXISTS          ErrorResponseErrorCode = "TUTOR_EXISTS"
+	STUDENTEXISTS        ErrorResponseErrorCode = "STUDENT_EXISTS"
+	REVIEWNOTFOUND       ErrorResponseErrorCode = "REVIEW_NOT_FOUND"
+	REVIEWEXISTS         ErrorResponseErrorCode = "REVIEW_EXISTS"
+	GUARDIANNOTFOUND     ErrorResponseErrorCode = "GUARDIAN_LINK_NOT_FOUND"
+	GUARDIANEXISTS       ErrorResponseErrorCode = "GUARDIAN_LINK_EXISTS"
+	DLQNOTFOUND          ErrorResponseErrorCode = "DLQ_MESSAGE_NOT_FOUND"
+	RECONCILERUNNING     ErrorResponseErrorCode = "RECONCILE_RUNNING"
+	VERIFICATIONCONFLICT ErrorResponseErrorCode = "TUTOR_VERIFICATION_CONFLICT"
+	PERMISSIONDENIED     ErrorResponseErrorCode = "PERMISSION_DENIED"
+	INTERNALERROR        ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDINPUT         ErrorResponseErrorCode = "INVALID_INPUT"
+	STATUS_OK            ErrorResponseErrorCode = "STATUS_OK"
 )
 
 type Error struct {
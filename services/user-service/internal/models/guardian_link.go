@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type GuardianLinkStatus string
+
+const (
+	GuardianPending  GuardianLinkStatus = "PENDING"
+	GuardianAccepted GuardianLinkStatus = "ACCEPTED"
+	GuardianRevoked  GuardianLinkStatus = "REVOKED"
+)
+
+type GuardianLink struct {
+	ID          string             `json:"id" db:"id"`
+	StudentID   string             `json:"student_id" db:"student_id"`
+	GuardianID  string             `json:"guardian_id" db:"guardian_id"`
+	Status      GuardianLinkStatus `json:"status" db:"status"`
+	InvitedAt   time.Time          `json:"invited_at" db:"invited_at"`
+	ConsentedAt *time.Time         `json:"consented_at" db:"consented_at"`
+	RevokedAt   *time.Time         `json:"revoked_at" db:"revoked_at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEvent - событие, записанное в одной транзакции с изменением профиля
+// и ожидающее отправки в Kafka
+type OutboxEvent struct {
+	ID          int64
+	EventID     string
+	EventType   string
+	Version     int
+	Topic       string
+	Key         string
+	Payload     json.RawMessage
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// AuthUser - учетная запись из auth-service
+type AuthUser struct {
+	ID        string
+	Email     string
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+type DriftKind string
+
+const (
+	// DriftMissing - пользователь есть в auth-service, профиля нет
+	DriftMissing DriftKind = "missing"
+	// DriftOrphaned - профиль без пользователя в auth-service
+	DriftOrphaned DriftKind = "orphaned"
+	// DriftEmailMismatch - email профиля отличается от email в auth-service
+	DriftEmailMismatch DriftKind = "email_mismatch"
+)
+
+type DriftRecord struct {
+	Kind         DriftKind
+	UserID       string
+	AuthEmail    string
+	ProfileEmail string
+	Repaired     bool
+	Error        string
+}
+
+type ReconcileReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	DryRun     bool
+	AuthUsers  int
+	Profiles   int
+	Records    []DriftRecord
+}
+
+// Count возвращает число расхождений указанного вида
+func (r *ReconcileReport) Count(kind DriftKind) int {
+	n := 0
+	for _, rec := range r.Records {
+		if rec.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type TutorReview struct {
+	ID           string     `json:"id" db:"id"`
+	TutorID      string     `json:"tutor_id" db:"tutor_id"`
+	StudentID    string     `json:"student_id" db:"student_id"`
+	Rating       int32      `json:"rating" db:"rating"`
+	Text         string     `json:"text" db:"text"`
+	TutorReply   string     `json:"tutor_reply" db:"tutor_reply"`
+	RepliedAt    *time.Time `json:"replied_at" db:"replied_at"`
+	IsHidden     bool       `json:"is_hidden" db:"is_hidden"`
+	HiddenReason string     `json:"hidden_reason" db:"hidden_reason"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type TutorRating struct {
+	Average float64 `json:"average_rating"`
+	Count   int32   `json:"reviews_count"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type TutorVerificationStatus string
+
+const (
+	TutorUnverified          TutorVerificationStatus = "UNVERIFIED"
+	TutorVerificationPending TutorVerificationStatus = "PENDING"
+	TutorVerified            TutorVerificationStatus = "VERIFIED"
+	TutorRejected            TutorVerificationStatus = "REJECTED"
+)
+
+type VerificationDocument struct {
+	ID         string    `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	URL        string    `json:"url" db:"url"`
+	UploadedAt time.Time `json:"uploaded_at" db:"uploaded_at"`
+}
+
+type TutorVerification struct {
+	TutorID     string                  `json:"tutor_id" db:"user_id"`
+	Status      TutorVerificationStatus `json:"status" db:"verification_status"`
+	Reason      string                  `json:"reason" db:"verification_reason"`
+	SubmittedAt *time.Time              `json:"submitted_at" db:"verification_submitted_at"`
+	ReviewedAt  *time.Time              `json:"reviewed_at" db:"verification_reviewed_at"`
+	ReviewedBy  string                  `json:"reviewed_by" db:"verification_reviewed_by"`
+	Documents   []VerificationDocument  `json:"documents" db:"-"`
+}
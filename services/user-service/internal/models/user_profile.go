@@ -8,6 +8,10 @@ type UserProfile struct {
 	Name     string `json:"name" db:"name"`
 	Surname  string `json:"surname" db:"surname"`
 	Telegram string `json:"telegram" db:"telegram"`
+	Timezone string `json:"timezone" db:"timezone"`
+	Locale   string `json:"locale" db:"locale"`
+	// AvatarURL - ссылка на изображение, пустая строка - аватара нет
+	AvatarURL string `json:"avatar_url" db:"avatar_url"`
 	UserType
 	CreatedAt time.Time `json:"createdAt" db:"created_at"`
 }
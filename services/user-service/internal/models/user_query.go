@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+type UserRoleFilter string
+
+const (
+	UserRoleAny     UserRoleFilter = ""
+	UserRoleTutor   UserRoleFilter = "tutor"
+	UserRoleStudent UserRoleFilter = "student"
+)
+
+type ProfileSortField string
+
+const (
+	ProfileSortCreatedAt ProfileSortField = "created_at"
+	ProfileSortEmail     ProfileSortField = "email"
+	// ProfileSortName - фамилия, затем имя
+	ProfileSortName ProfileSortField = "name"
+)
+
+type ProfileQuery struct {
+	// ActiveOnly - устаревший фильтр is_active. Профили не хранят активность аккаунта,
+	// поэтому он отклоняется, а не игнорируется
+	ActiveOnly bool
+	Role       UserRoleFilter
+	// Search - префикс email, имени или фамилии
+	Search string
+	// CreatedFrom включительно, CreatedTo не включительно
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      ProfileSortField
+	Desc        bool
+	PageToken   string
+	Limit       int32
+	Offset      int32
+}
+
+// ProfileCursor - позиция keyset-пагинации: значения полей сортировки и id последней записи страницы
+type ProfileCursor struct {
+	SortBy ProfileSortField `json:"s"`
+	Desc   bool             `json:"d"`
+	Keys   []string         `json:"k"`
+	ID     string           `json:"id"`
+}
+
+type ProfilePage struct {
+	Users         []UserProfile
+	Total         int32
+	NextPageToken string
+}
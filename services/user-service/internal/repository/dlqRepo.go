@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"user-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+const dlqMessageColumns = `id, topic, kafka_partition, kafka_offset, message_key, payload, headers,
+		original_topic, error, retry_count, status, note, resolved_by, created_at, resolved_at`
+
+type dlqRepository struct {
+	db *sql.DB
+}
+
+func NewDLQRepository(db *sql.DB) *dlqRepository {
+	return &dlqRepository{db: db}
+}
+
+func scanDLQMessage(row rowScanner) (*models.DLQMessage, error) {
+	var (
+		msg     models.DLQMessage
+		headers []byte
+	)
+
+	err := row.Scan(
+		&msg.ID,
+		&msg.Topic,
+		&msg.Partition,
+		&msg.Offset,
+		&msg.Key,
+		&msg.Payload,
+		&headers,
+		&msg.OriginalTopic,
+		&msg.Error,
+		&msg.RetryCount,
+		&msg.Status,
+		&msg.Note,
+		&msg.ResolvedBy,
+		&msg.CreatedAt,
+		&msg.ResolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(headers, &msg.Headers); err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+// SaveDLQMessage сохраняет сообщение, повторное чтение того же offset ничего не меняет
+func (r *dlqRepository) SaveDLQMessage(ctx context.Context, msg *models.DLQMessage) error {
+	headers, err := json.Marshal(msg.Headers)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO dlq_messages
+		(topic, kafka_partition, kafka_offset, message_key, payload, headers, original_topic, error, retry_count)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        ON CONFLICT (topic, kafka_partition, kafka_offset) DO NOTHING
+    `
+
+	_, err = r.db.ExecContext(ctx, query,
+		msg.Topic,
+		msg.Partition,
+		msg.Offset,
+		msg.Key,
+		msg.Payload,
+		headers,
+		msg.OriginalTopic,
+		msg.Error,
+		msg.RetryCount)
+	return err
+}
+
+func (r *dlqRepository) GetDLQMessage(ctx context.Context, id string) (*models.DLQMessage, error) {
+	query := `
+        SELECT ` + dlqMessageColumns + `
+        FROM dlq_messages
+        WHERE id = $1
+    `
+
+	msg, err := scanDLQMessage(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrDLQMessageNotFound
+		}
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (r *dlqRepository) ListDLQMessages(ctx context.Context, filter models.DLQFilter) ([]models.DLQMessage, int32, error) {
+	var total int32
+	countQuery := `
+        SELECT COUNT(*)
+        FROM dlq_messages
+        WHERE ($1 = '' OR status = $1)
+    `
+	if err := r.db.QueryRowContext(ctx, countQuery, string(filter.Status)).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+        SELECT ` + dlqMessageColumns + `
+        FROM dlq_messages
+        WHERE ($1 = '' OR status = $1)
+        ORDER BY created_at DESC
+		LIMIT $2
+		OFFSET $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, string(filter.Status), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var messages []models.DLQMessage
+	for rows.Next() {
+		msg, err := scanDLQMessage(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return messages, total, nil
+}
+
+// ListPendingDLQMessages возвращает необработанные сообщения: выбранные по ids или все, если ids пуст
+func (r *dlqRepository) ListPendingDLQMessages(ctx context.Context, ids []string, limit int32) ([]models.DLQMessage, error) {
+	query := `
+        SELECT ` + dlqMessageColumns + `
+        FROM dlq_messages
+        WHERE status = 'PENDING' AND (cardinality($1::text[]) = 0 OR id = ANY($1))
+        ORDER BY created_at
+		LIMIT $2
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []models.DLQMessage
+	for rows.Next() {
+		msg, err := scanDLQMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// ResolveDLQMessage закрывает сообщение с пометкой для аудита.
+// Возвращает false, если сообщение уже обработано или удалено.
+func (r *dlqRepository) ResolveDLQMessage(ctx context.Context, id string, status models.DLQMessageStatus, resolvedBy, note string) (bool, error) {
+	query := `
+        UPDATE dlq_messages
+        SET status = $1, resolved_by = $2, note = $3, resolved_at = NOW()
+        WHERE id = $4 AND status = 'PENDING'
+    `
+
+	res, err := r.db.ExecContext(ctx, query, status, resolvedBy, note, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -3,6 +3,15 @@ package repository
 import "errors"
 
 var (
-	ErrUserNotFound = errors.New("user not found")
-	ErrUserExists   = errors.New("user already exists")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrUserExists     = errors.New("user already exists")
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewExists   = errors.New("review already exists")
+
+	ErrGuardianLinkNotFound = errors.New("guardian link not found")
+	ErrGuardianLinkExists   = errors.New("guardian link already exists")
+
+	ErrDLQMessageNotFound = errors.New("dlq message not found")
+
+	ErrVerificationStateChanged = errors.New("tutor verification status has changed")
 )
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"user-service/internal/models"
+	"user-service/pkg/postgres"
+)
+
+const guardianLinkColumns = `id, student_id, guardian_id, status, invited_at, consented_at, revoked_at`
+
+type guardianRepository struct {
+	db *sql.DB
+}
+
+func NewGuardianRepository(db *sql.DB) *guardianRepository {
+	return &guardianRepository{db: db}
+}
+
+func scanGuardianLink(row rowScanner) (*models.GuardianLink, error) {
+	var link models.GuardianLink
+
+	err := row.Scan(
+		&link.ID,
+		&link.StudentID,
+		&link.GuardianID,
+		&link.Status,
+		&link.InvitedAt,
+		&link.ConsentedAt,
+		&link.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+func (r *guardianRepository) CreateGuardianLink(ctx context.Context, studentID, guardianID string) (*models.GuardianLink, error) {
+	query := `
+        INSERT INTO guardian_links (student_id, guardian_id)
+        VALUES ($1, $2)
+        RETURNING ` + guardianLinkColumns
+
+	link, err := scanGuardianLink(r.db.QueryRowContext(ctx, query, studentID, guardianID))
+	if err != nil {
+		if postgres.IsDuplicateKeyError(err) {
+			return nil, ErrGuardianLinkExists
+		}
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (r *guardianRepository) GetGuardianLinkByID(ctx context.Context, id string) (*models.GuardianLink, error) {
+	query := `
+        SELECT ` + guardianLinkColumns + `
+        FROM guardian_links
+        WHERE id = $1
+    `
+
+	link, err := scanGuardianLink(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrGuardianLinkNotFound
+		}
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// AcceptGuardianLink фиксирует согласие опекуна, принять можно только ожидающее приглашение
+func (r *guardianRepository) AcceptGuardianLink(ctx context.Context, id string) (*models.GuardianLink, error) {
+	query := `
+        UPDATE guardian_links
+        SET status = 'ACCEPTED', consented_at = NOW()
+        WHERE id = $1 AND status = 'PENDING'
+        RETURNING ` + guardianLinkColumns
+
+	link, err := scanGuardianLink(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrGuardianLinkNotFound
+		}
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (r *guardianRepository) RevokeGuardianLink(ctx context.Context, id string) error {
+	query := `
+        UPDATE guardian_links
+        SET status = 'REVOKED', revoked_at = NOW()
+        WHERE id = $1 AND status <> 'REVOKED'
+    `
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrGuardianLinkNotFound
+	}
+
+	return nil
+}
+
+// ListGuardianLinks возвращает действующие связи, где пользователь ученик или опекун
+func (r *guardianRepository) ListGuardianLinks(ctx context.Context, userID string) ([]models.GuardianLink, error) {
+	query := `
+        SELECT ` + guardianLinkColumns + `
+        FROM guardian_links
+        WHERE (student_id = $1 OR guardian_id = $1) AND status <> 'REVOKED'
+        ORDER BY invited_at DESC
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []models.GuardianLink
+	for rows.Next() {
+		link, err := scanGuardianLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (r *guardianRepository) HasAcceptedGuardianLink(ctx context.Context, guardianID, studentID string) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1 FROM guardian_links
+            WHERE guardian_id = $1 AND student_id = $2 AND status = 'ACCEPTED'
+        )
+    `
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, guardianID, studentID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+type processedEventRepository struct {
+	db *sql.DB
+}
+
+func NewProcessedEventRepository(db *sql.DB) *processedEventRepository {
+	return &processedEventRepository{db: db}
+}
+
+// MarkProcessed вставляет отметку в транзакции из контекста. Параллельная вставка того же
+// события ждет фиксации первой и возвращает false, после отката первой - true.
+func (r *processedEventRepository) MarkProcessed(ctx context.Context, eventID, eventType string) (bool, error) {
+	query := `
+        INSERT INTO processed_events (event_id, event_type)
+        VALUES ($1, $2)
+        ON CONFLICT (event_id) DO NOTHING
+    `
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, eventID, eventType)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"user-service/internal/models"
+	"user-service/pkg/postgres"
+)
+
+const reviewColumns = `id, tutor_id, student_id, rating, text, tutor_reply, replied_at,
+		is_hidden, hidden_reason, created_at, updated_at`
+
+type reviewRepository struct {
+	db *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) *reviewRepository {
+	return &reviewRepository{db: db}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReview(row rowScanner) (*models.TutorReview, error) {
+	var (
+		review       models.TutorReview
+		reply        sql.NullString
+		hiddenReason sql.NullString
+	)
+
+	err := row.Scan(
+		&review.ID,
+		&review.TutorID,
+		&review.StudentID,
+		&review.Rating,
+		&review.Text,
+		&reply,
+		&review.RepliedAt,
+		&review.IsHidden,
+		&hiddenReason,
+		&review.CreatedAt,
+		&review.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	review.TutorReply = reply.String
+	review.HiddenReason = hiddenReason.String
+
+	return &review, nil
+}
+
+func (r *reviewRepository) CreateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error) {
+	query := `
+        INSERT INTO tutor_reviews
+		(tutor_id, student_id, rating, text, is_hidden, hidden_reason)
+        VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+		RETURNING ` + reviewColumns
+
+	created, err := scanReview(r.db.QueryRowContext(ctx, query,
+		review.TutorID,
+		review.StudentID,
+		review.Rating,
+		review.Text,
+		review.IsHidden,
+		review.HiddenReason))
+	if err != nil {
+		if postgres.IsDuplicateKeyError(err) {
+			return nil, ErrReviewExists
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *reviewRepository) GetReviewByID(ctx context.Context, id string) (*models.TutorReview, error) {
+	query := `
+        SELECT ` + reviewColumns + `
+        FROM tutor_reviews
+        WHERE id = $1
+    `
+
+	review, err := scanReview(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (r *reviewRepository) UpdateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error) {
+	query := `
+        UPDATE tutor_reviews
+        SET rating = $1, text = $2, is_hidden = $3, hidden_reason = NULLIF($4, ''), updated_at = NOW()
+        WHERE id = $5
+        RETURNING ` + reviewColumns
+
+	updated, err := scanReview(r.db.QueryRowContext(ctx, query,
+		review.Rating,
+		review.Text,
+		review.IsHidden,
+		review.HiddenReason,
+		review.ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *reviewRepository) SetReply(ctx context.Context, id, reply string) (*models.TutorReview, error) {
+	query := `
+        UPDATE tutor_reviews
+        SET tutor_reply = NULLIF($1, ''), replied_at = NOW()
+        WHERE id = $2
+        RETURNING ` + reviewColumns
+
+	updated, err := scanReview(r.db.QueryRowContext(ctx, query, reply, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *reviewRepository) SetHidden(ctx context.Context, id string, hidden bool, reason string) (*models.TutorReview, error) {
+	query := `
+        UPDATE tutor_reviews
+        SET is_hidden = $1, hidden_reason = NULLIF($2, '')
+        WHERE id = $3
+        RETURNING ` + reviewColumns
+
+	updated, err := scanReview(r.db.QueryRowContext(ctx, query, hidden, reason, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *reviewRepository) DeleteReview(ctx context.Context, id string) error {
+	query := `
+        DELETE FROM tutor_reviews
+		WHERE id = $1
+    `
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrReviewNotFound
+	}
+
+	return nil
+}
+
+func (r *reviewRepository) ListTutorReviews(ctx context.Context, tutorID string, limit, offset int32) ([]models.TutorReview, error) {
+	query := `
+        SELECT ` + reviewColumns + `
+        FROM tutor_reviews
+        WHERE tutor_id = $1 AND NOT is_hidden
+        ORDER BY created_at DESC
+		LIMIT $2
+		OFFSET $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, tutorID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []models.TutorReview
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, *review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// GetTutorRating считает агрегированный рейтинг без учета скрытых отзывов
+func (r *reviewRepository) GetTutorRating(ctx context.Context, tutorID string) (*models.TutorRating, error) {
+	query := `
+        SELECT COALESCE(AVG(rating), 0), COUNT(*)
+        FROM tutor_reviews
+        WHERE tutor_id = $1 AND NOT is_hidden
+    `
+
+	var rating models.TutorRating
+	err := r.db.QueryRowContext(ctx, query, tutorID).Scan(&rating.Average, &rating.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rating, nil
+}
@@ -16,7 +16,7 @@ func NewStudentProfileRepository(db *sql.DB) *studentProfileRepository {
 }
 
 func (r *studentProfileRepository) CreateStudentProfile(ctx context.Context, student *models.StudentProfile) (*models.StudentProfile, error) {
-	tx, err := r.db.Begin()
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (r *studentProfileRepository) GetStudentProfileByID(ctx context.Context, id
     `
     
     var student models.StudentProfile
-    err := r.db.QueryRowContext(ctx, query, id).
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).
 		Scan(
 		&student.UserID, 
         &student.Bio,
@@ -102,7 +102,7 @@ func (r *studentProfileRepository) UpdateStudentProfile(ctx context.Context, stu
         RETURNING user_id, bio, grade
     `
 
-    err := r.db.QueryRowContext(ctx, query, student.Bio, student.Grade, student.UserID).
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, student.Bio, student.Grade, student.UserID).
 		Scan(
 		&student.UserID, 
         &student.Bio,
@@ -119,7 +119,7 @@ func (r *studentProfileRepository) UpdateStudentProfile(ctx context.Context, stu
 }
 
 func (r *studentProfileRepository) DeleteStudentProfie(ctx context.Context, id string) error {
-	tx, err := r.db.Begin()
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
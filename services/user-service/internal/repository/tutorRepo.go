@@ -16,7 +16,7 @@ func NewTutorProfileRepository(db *sql.DB) *tutorProfileRepository {
 }
 
 func (r *tutorProfileRepository) CreateTutorProfile(ctx context.Context, tutor *models.TutorProfile) (*models.TutorProfile, error) {
-	tx, err := r.db.Begin()
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (r *tutorProfileRepository) CreateTutorProfile(ctx context.Context, tutor *
         INSERT INTO tutor_profiles
 		(user_id, bio, specialization, experience_years)
         VALUES ($1, $2, $3, $4)
-		RETURNING user_id, bio, specialization, experience_years
+		RETURNING user_id, bio, specialization, experience_years, verification_status
     `
 
 	err = tx.QueryRowContext(ctx, createQuery,
@@ -52,7 +52,8 @@ func (r *tutorProfileRepository) CreateTutorProfile(ctx context.Context, tutor *
 		&tutor.UserID, 
         &tutor.Bio,
 		&tutor.Specialization,
-		&tutor.Experience)
+		&tutor.Experience,
+		&tutor.VerificationStatus)
 	
 
 	if err != nil{
@@ -72,18 +73,19 @@ func (r *tutorProfileRepository) CreateTutorProfile(ctx context.Context, tutor *
 
 func (r *tutorProfileRepository) GetTutorProfileByID(ctx context.Context, id string) (*models.TutorProfile, error) {
     query := `
-        SELECT user_id, bio, specialization, experience_years
+        SELECT user_id, bio, specialization, experience_years, verification_status
         FROM tutor_profiles 
         WHERE user_id = $1
     `
     
     var tutor models.TutorProfile
-    err := r.db.QueryRowContext(ctx, query, id).
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).
 		Scan(
 		&tutor.UserID, 
         &tutor.Bio,
 		&tutor.Specialization,
-		&tutor.Experience)
+		&tutor.Experience,
+		&tutor.VerificationStatus)
     
 	if err != nil{
 		if err == sql.ErrNoRows {
@@ -102,15 +104,16 @@ func (r *tutorProfileRepository) UpdateTutorProfile(ctx context.Context, tutor *
         UPDATE tutor_profiles
         SET bio = $1, specialization = $2, experience_years = $3
         WHERE user_id = $4 
-        RETURNING user_id, bio, specialization, experience_years
+        RETURNING user_id, bio, specialization, experience_years, verification_status
     `
 
-    err := r.db.QueryRowContext(ctx, query, tutor.Bio, tutor.Specialization, tutor.Experience, tutor.UserID).
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, tutor.Bio, tutor.Specialization, tutor.Experience, tutor.UserID).
 		Scan(
 		&tutor.UserID, 
         &tutor.Bio,
 		&tutor.Specialization,
-		&tutor.Experience)
+		&tutor.Experience,
+		&tutor.VerificationStatus)
     
 	if err != nil{
 		if err == sql.ErrNoRows {
@@ -123,7 +126,7 @@ func (r *tutorProfileRepository) UpdateTutorProfile(ctx context.Context, tutor *
 }
 
 func (r *tutorProfileRepository) DeleteTutorProfie(ctx context.Context, id string) error {
-	tx, err := r.db.Begin()
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"user-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+const tutorVerificationColumns = `user_id, verification_status, verification_reason,
+		verification_submitted_at, verification_reviewed_at, verification_reviewed_by`
+
+type tutorVerificationRepository struct {
+	db *sql.DB
+}
+
+func NewTutorVerificationRepository(db *sql.DB) *tutorVerificationRepository {
+	return &tutorVerificationRepository{db: db}
+}
+
+func scanTutorVerification(row rowScanner) (*models.TutorVerification, error) {
+	var v models.TutorVerification
+
+	err := row.Scan(
+		&v.TutorID,
+		&v.Status,
+		&v.Reason,
+		&v.SubmittedAt,
+		&v.ReviewedAt,
+		&v.ReviewedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func (r *tutorVerificationRepository) GetTutorVerification(ctx context.Context, tutorID string) (*models.TutorVerification, error) {
+	query := `
+        SELECT ` + tutorVerificationColumns + `
+        FROM tutor_profiles
+        WHERE user_id = $1
+    `
+
+	v, err := scanTutorVerification(conn(ctx, r.db).QueryRowContext(ctx, query, tutorID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	docs, err := r.listDocuments(ctx, []string{tutorID})
+	if err != nil {
+		return nil, err
+	}
+	v.Documents = docs[tutorID]
+
+	return v, nil
+}
+
+// SubmitTutorVerification переводит заявку в PENDING и заменяет документы.
+// Подать заявку можно только из UNVERIFIED и REJECTED.
+func (r *tutorVerificationRepository) SubmitTutorVerification(ctx context.Context, tutorID string, docs []models.VerificationDocument) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE tutor_profiles
+        SET verification_status = 'PENDING', verification_reason = '', verification_submitted_at = NOW(),
+			verification_reviewed_at = NULL, verification_reviewed_by = ''
+        WHERE user_id = $1 AND verification_status IN ('UNVERIFIED', 'REJECTED')
+    `
+
+	res, err := tx.ExecContext(ctx, query, tutorID)
+	if err != nil {
+		return err
+	}
+	if err := expectAffected(res, ErrVerificationStateChanged); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tutor_verification_documents WHERE tutor_id = $1`, tutorID); err != nil {
+		return err
+	}
+
+	insertQuery := `
+        INSERT INTO tutor_verification_documents (tutor_id, name, url)
+        VALUES ($1, $2, $3)
+    `
+	for _, d := range docs {
+		if _, err := tx.ExecContext(ctx, insertQuery, tutorID, d.Name, d.URL); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReviewTutorVerification сохраняет решение администратора, если статус
+// заявки все еще один из from
+func (r *tutorVerificationRepository) ReviewTutorVerification(ctx context.Context, tutorID string, from []models.TutorVerificationStatus, to models.TutorVerificationStatus, reviewerID, reason string) error {
+	statuses := make([]string, 0, len(from))
+	for _, s := range from {
+		statuses = append(statuses, string(s))
+	}
+
+	query := `
+        UPDATE tutor_profiles
+        SET verification_status = $1, verification_reason = $2,
+			verification_reviewed_at = NOW(), verification_reviewed_by = $3
+        WHERE user_id = $4 AND verification_status = ANY($5)
+    `
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, to, reason, reviewerID, tutorID, pq.Array(statuses))
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res, ErrVerificationStateChanged)
+}
+
+// ListTutorVerifications - очередь заявок, старые заявки первыми
+func (r *tutorVerificationRepository) ListTutorVerifications(ctx context.Context, status models.TutorVerificationStatus, limit, offset int32) ([]models.TutorVerification, int32, error) {
+	var total int32
+	countQuery := `
+        SELECT COUNT(*)
+        FROM tutor_profiles
+        WHERE verification_status = $1
+    `
+	if err := r.db.QueryRowContext(ctx, countQuery, status).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+        SELECT ` + tutorVerificationColumns + `
+        FROM tutor_profiles
+        WHERE verification_status = $1
+        ORDER BY verification_submitted_at NULLS LAST, user_id
+		LIMIT $2
+		OFFSET $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		verifications []models.TutorVerification
+		ids           []string
+	)
+	for rows.Next() {
+		v, err := scanTutorVerification(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		verifications = append(verifications, *v)
+		ids = append(ids, v.TutorID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	docs, err := r.listDocuments(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range verifications {
+		verifications[i].Documents = docs[verifications[i].TutorID]
+	}
+
+	return verifications, total, nil
+}
+
+func (r *tutorVerificationRepository) listDocuments(ctx context.Context, tutorIDs []string) (map[string][]models.VerificationDocument, error) {
+	docs := make(map[string][]models.VerificationDocument, len(tutorIDs))
+	if len(tutorIDs) == 0 {
+		return docs, nil
+	}
+
+	query := `
+        SELECT tutor_id, id, name, url, uploaded_at
+        FROM tutor_verification_documents
+        WHERE tutor_id = ANY($1)
+        ORDER BY uploaded_at, id
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, pq.Array(tutorIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			tutorID string
+			d       models.VerificationDocument
+		)
+		if err := rows.Scan(&tutorID, &d.ID, &d.Name, &d.URL, &d.UploadedAt); err != nil {
+			return nil, err
+		}
+		docs[tutorID] = append(docs[tutorID], d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+func expectAffected(res sql.Result, notAffected error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notAffected
+	}
+	return nil
+}
@@ -3,8 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"user-service/internal/models"
 	"user-service/pkg/postgres"
+
+	"github.com/lib/pq"
 )
 
 type userProfileRepository struct {
@@ -17,13 +21,14 @@ func NewUserProfileRepository(db *sql.DB) *userProfileRepository {
 
 func (r *userProfileRepository) SelectAllUsers(ctx context.Context, limit, offset int32) ([]models.UserProfile, error) {
 	query := `
-        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram
+        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
         FROM user_profiles
+        ORDER BY user_id
 		LIMIT $1
 		OFFSET $2
     `
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +45,10 @@ func (r *userProfileRepository) SelectAllUsers(ctx context.Context, limit, offse
 			&user.IsTutor,
 			&user.IsStudent,
 			&user.CreatedAt,
-			&user.Telegram); err != nil {
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL); err != nil {
 			return nil, err
 		}
 		users = append(users, user)
@@ -54,21 +62,146 @@ func (r *userProfileRepository) SelectAllUsers(ctx context.Context, limit, offse
 
 }
 
+// profileSortColumns - колонки сортировки и приведение значений курсора к их типу
+var profileSortColumns = map[models.ProfileSortField][]struct{ column, cast string }{
+	models.ProfileSortCreatedAt: {{"created_at", "::timestamp"}},
+	models.ProfileSortEmail:     {{"email", ""}},
+	models.ProfileSortName:      {{"surname", ""}, {"name", ""}},
+}
+
+// ListUsers возвращает профили по фильтру и общее число подходящих записей.
+// Если передан after, страница начинается после этой записи, offset не применяется.
+func (r *userProfileRepository) ListUsers(ctx context.Context, q models.ProfileQuery, after *models.ProfileCursor) ([]models.UserProfile, int32, error) {
+	sortCols, ok := profileSortColumns[q.SortBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown sort field %q", q.SortBy)
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	switch q.Role {
+	case models.UserRoleTutor:
+		where = append(where, "is_tutor = true")
+	case models.UserRoleStudent:
+		where = append(where, "is_student = true")
+	}
+	if q.Search != "" {
+		p := arg(postgres.LikePrefix(q.Search))
+		where = append(where, fmt.Sprintf("(email ILIKE %s OR name ILIKE %s OR surname ILIKE %s)", p, p, p))
+	}
+	if q.CreatedFrom != nil {
+		where = append(where, "created_at >= "+arg(postgres.Timestamp(*q.CreatedFrom))+"::timestamp")
+	}
+	if q.CreatedTo != nil {
+		where = append(where, "created_at < "+arg(postgres.Timestamp(*q.CreatedTo))+"::timestamp")
+	}
+
+	var total int32
+	countQuery := "SELECT COUNT(*) FROM user_profiles" + whereClause(where)
+	if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	direction, op := "ASC", ">"
+	if q.Desc {
+		direction, op = "DESC", "<"
+	}
+
+	offset := q.Offset
+	if after != nil {
+		if len(after.Keys) != len(sortCols) {
+			return nil, 0, fmt.Errorf("cursor does not match sort field %q", q.SortBy)
+		}
+		cols := make([]string, 0, len(sortCols)+1)
+		vals := make([]string, 0, len(sortCols)+1)
+		for i, c := range sortCols {
+			cols = append(cols, c.column)
+			vals = append(vals, arg(after.Keys[i])+c.cast)
+		}
+		cols = append(cols, "user_id")
+		vals = append(vals, arg(after.ID))
+		where = append(where, fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ", "), op, strings.Join(vals, ", ")))
+		offset = 0
+	}
+
+	order := make([]string, 0, len(sortCols)+1)
+	for _, c := range sortCols {
+		order = append(order, c.column+" "+direction)
+	}
+	order = append(order, "user_id "+direction)
+
+	query := fmt.Sprintf(`
+        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
+        FROM user_profiles%s
+        ORDER BY %s
+		LIMIT %s
+		OFFSET %s
+    `, whereClause(where), strings.Join(order, ", "), arg(q.Limit), arg(offset))
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []models.UserProfile
+	for rows.Next() {
+		var user models.UserProfile
+		if err := rows.Scan(
+			&user.UserID,
+			&user.Email,
+			&user.Name,
+			&user.Surname,
+			&user.IsTutor,
+			&user.IsStudent,
+			&user.CreatedAt,
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "\n        WHERE " + strings.Join(conditions, " AND ")
+}
+
 func (r *userProfileRepository) CreateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, error) {
 	query := `
         INSERT INTO user_profiles 
-		(user_id, email, name, surname, telegram)
-        VALUES ($1, $2, $3, $4, $5)
+		(user_id, email, name, surname, telegram, timezone, locale)
+        VALUES ($1, $2, $3, $4, $5, COALESCE(NULLIF($6, ''), 'UTC'), COALESCE(NULLIF($7, ''), 'ru'))
 		ON CONFLICT DO NOTHING
-		RETURNING user_id, email, name, surname, is_tutor, is_student, created_at, telegram
+		RETURNING user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
     `
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		user.UserID,
 		user.Email,
 		user.Name,
 		user.Surname,
-		user.Telegram).
+		user.Telegram,
+		user.Timezone,
+		user.Locale).
 		Scan(
 			&user.UserID,
 			&user.Email,
@@ -77,10 +210,14 @@ func (r *userProfileRepository) CreateUser(ctx context.Context, user *models.Use
 			&user.IsTutor,
 			&user.IsStudent,
 			&user.CreatedAt,
-			&user.Telegram)
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL)
 
 	if err != nil {
-		if postgres.IsDuplicateKeyError(err) {
+		// ON CONFLICT DO NOTHING не возвращает строку, если профиль уже есть
+		if postgres.IsDuplicateKeyError(err) || err == sql.ErrNoRows {
 			return nil, ErrUserExists
 		}
 		return nil, err
@@ -91,13 +228,13 @@ func (r *userProfileRepository) CreateUser(ctx context.Context, user *models.Use
 
 func (r *userProfileRepository) GetUserByID(ctx context.Context, id string) (*models.UserProfile, error) {
 	query := `
-        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram
+        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
         FROM user_profiles 
         WHERE user_id = $1
     `
 
 	var user models.UserProfile
-	err := r.db.QueryRowContext(ctx, query, id).
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).
 		Scan(
 			&user.UserID,
 			&user.Email,
@@ -106,7 +243,10 @@ func (r *userProfileRepository) GetUserByID(ctx context.Context, id string) (*mo
 			&user.IsTutor,
 			&user.IsStudent,
 			&user.CreatedAt,
-			&user.Telegram)
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -120,13 +260,13 @@ func (r *userProfileRepository) GetUserByID(ctx context.Context, id string) (*mo
 
 func (r *userProfileRepository) GetUserByEmail(ctx context.Context, email string) (*models.UserProfile, error) {
 	query := `
-        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram
+        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
         FROM user_profiles 
         WHERE email = $1
     `
 
 	var user models.UserProfile
-	err := r.db.QueryRowContext(ctx, query, email).
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, email).
 		Scan(
 			&user.UserID,
 			&user.Email,
@@ -135,7 +275,10 @@ func (r *userProfileRepository) GetUserByEmail(ctx context.Context, email string
 			&user.IsTutor,
 			&user.IsStudent,
 			&user.CreatedAt,
-			&user.Telegram)
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -147,15 +290,64 @@ func (r *userProfileRepository) GetUserByEmail(ctx context.Context, email string
 	return &user, nil
 }
 
+// ResolveUsers возвращает найденные профили по ID и email, email сравнивается без учета регистра
+func (r *userProfileRepository) ResolveUsers(ctx context.Context, ids, emails []string) ([]models.UserProfile, error) {
+	lowered := make([]string, len(emails))
+	for i, e := range emails {
+		lowered[i] = strings.ToLower(e)
+	}
+
+	query := `
+        SELECT user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
+        FROM user_profiles
+        WHERE user_id = ANY($1) OR lower(email) = ANY($2)
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, pq.Array(ids), pq.Array(lowered))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []models.UserProfile
+	for rows.Next() {
+		var user models.UserProfile
+		if err := rows.Scan(
+			&user.UserID,
+			&user.Email,
+			&user.Name,
+			&user.Surname,
+			&user.IsTutor,
+			&user.IsStudent,
+			&user.CreatedAt,
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (r *userProfileRepository) UpdateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, error) {
 	query := `
         UPDATE user_profiles 
-        SET name = $1, surname = $2, telegram = $3
-        WHERE user_id = $4
-        RETURNING user_id, email, name, surname, is_tutor, is_student, created_at, telegram
+        SET name = $1, surname = $2, telegram = $3,
+            timezone = COALESCE(NULLIF($4, ''), timezone),
+            locale = COALESCE(NULLIF($5, ''), locale),
+            avatar_url = COALESCE(NULLIF($7, ''), avatar_url)
+        WHERE user_id = $6
+        RETURNING user_id, email, name, surname, is_tutor, is_student, created_at, telegram, timezone, locale, avatar_url
     `
 
-	err := r.db.QueryRowContext(ctx, query, user.Name, user.Surname, user.Telegram, user.UserID).
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, user.Name, user.Surname, user.Telegram, user.Timezone, user.Locale, user.UserID, user.AvatarURL).
 		Scan(
 			&user.UserID,
 			&user.Email,
@@ -164,7 +356,10 @@ func (r *userProfileRepository) UpdateUser(ctx context.Context, user *models.Use
 			&user.IsTutor,
 			&user.IsStudent,
 			&user.CreatedAt,
-			&user.Telegram)
+			&user.Telegram,
+			&user.Timezone,
+			&user.Locale,
+			&user.AvatarURL)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -176,13 +371,40 @@ func (r *userProfileRepository) UpdateUser(ctx context.Context, user *models.Use
 	return user, nil
 }
 
+// UpdateUserEmail синхронизирует email профиля с auth-service
+func (r *userProfileRepository) UpdateUserEmail(ctx context.Context, id, email string) error {
+	query := `
+        UPDATE user_profiles
+        SET email = $1
+        WHERE user_id = $2
+    `
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, email, id)
+	if err != nil {
+		if postgres.IsDuplicateKeyError(err) {
+			return ErrUserExists
+		}
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
 func (r *userProfileRepository) DeleteUser(ctx context.Context, id string) error {
 	query := `
         DELETE FROM user_profiles
 		WHERE user_id = $1
     `
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -202,7 +424,7 @@ func (r *userProfileRepository) GetUserTypes(ctx context.Context, id string) (*m
     `
 
 	var userType models.UserType
-	err := r.db.QueryRowContext(ctx, query, id).
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).
 		Scan(&userType.IsTutor, &userType.IsStudent)
 
 	if err != nil {
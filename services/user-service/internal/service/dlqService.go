@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+	kfk "user-service/pkg/kafka"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	defaultDLQLimit = 50
+	maxDLQLimit     = 500
+	dlqBatchSize    = 100
+
+	// HeaderReplayedFrom - id записи DLQ, из которой сообщение отправлено повторно
+	HeaderReplayedFrom = "x-replayed-from"
+)
+
+// DLQService сохраняет сообщения из <topic>.dlq и дает администраторам
+// просматривать их, отправлять повторно или отбрасывать с пометкой
+type DLQService struct {
+	repo      DLQRepository
+	publisher EventPublisher
+	topic     string
+	admins    map[string]struct{}
+}
+
+func NewDLQService(repo DLQRepository, publisher EventPublisher, topic string, adminIDs []string) *DLQService {
+	admins := make(map[string]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+
+	return &DLQService{
+		repo:      repo,
+		publisher: publisher,
+		topic:     topic,
+		admins:    admins,
+	}
+}
+
+func (s *DLQService) checkAdmin(userID string) *models.Error {
+	if _, ok := s.admins[userID]; !ok {
+		return &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("dlq administration is available to admins only")}
+	}
+	return nil
+}
+
+// Index сохраняет прочитанное из DLQ сообщение, используется как обработчик консьюмера
+func (s *DLQService) Index(ctx context.Context, msg kafka.Message) error {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+
+	return s.repo.SaveDLQMessage(ctx, &models.DLQMessage{
+		Topic:         msg.Topic,
+		Partition:     msg.Partition,
+		Offset:        msg.Offset,
+		Key:           string(msg.Key),
+		Payload:       msg.Value,
+		Headers:       headers,
+		OriginalTopic: kfk.Header(msg, kfk.HeaderOriginalTopic),
+		Error:         kfk.Header(msg, kfk.HeaderError),
+		RetryCount:    kfk.RetryCount(msg),
+	})
+}
+
+func (s *DLQService) ListMessages(ctx context.Context, userID string, filter models.DLQFilter) ([]models.DLQMessage, int32, *models.Error) {
+	if e := s.checkAdmin(userID); e != nil {
+		return nil, 0, e
+	}
+	if filter.Limit <= 0 || filter.Limit > maxDLQLimit {
+		filter.Limit = defaultDLQLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	messages, total, err := s.repo.ListDLQMessages(ctx, filter)
+	if err != nil {
+		return nil, 0, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return messages, total, nil
+}
+
+func (s *DLQService) GetMessage(ctx context.Context, userID, id string) (*models.DLQMessage, *models.Error) {
+	if e := s.checkAdmin(userID); e != nil {
+		return nil, e
+	}
+
+	msg, err := s.repo.GetDLQMessage(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrDLQMessageNotFound) {
+			return nil, &models.Error{Code: models.DLQNOTFOUND, Message: err}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return msg, nil
+}
+
+// Replay отправляет сообщения в исходный топик без служебных заголовков повторов.
+// Уже обработанные или отброшенные сообщения пропускаются.
+func (s *DLQService) Replay(ctx context.Context, userID string, ids []string, all bool) (int32, *models.Error) {
+	return s.resolve(ctx, userID, ids, all, func(msg models.DLQMessage) (models.DLQMessageStatus, string, error) {
+		return models.DLQReplayed, "", s.publisher.PublishMessage(ctx, replayMessage(msg, s.topic))
+	})
+}
+
+// Discard отбрасывает сообщения, note обязателен и сохраняется для аудита
+func (s *DLQService) Discard(ctx context.Context, userID string, ids []string, all bool, note string) (int32, *models.Error) {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return 0, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("note is required to discard messages")}
+	}
+
+	return s.resolve(ctx, userID, ids, all, func(msg models.DLQMessage) (models.DLQMessageStatus, string, error) {
+		return models.DLQDiscarded, note, nil
+	})
+}
+
+type dlqAction func(msg models.DLQMessage) (models.DLQMessageStatus, string, error)
+
+func (s *DLQService) resolve(ctx context.Context, userID string, ids []string, all bool, action dlqAction) (int32, *models.Error) {
+	if e := s.checkAdmin(userID); e != nil {
+		return 0, e
+	}
+	limit := int32(dlqBatchSize)
+	if all {
+		ids = nil
+	} else if len(ids) == 0 {
+		return 0, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("ids are required unless all is set")}
+	} else {
+		limit = int32(len(ids))
+	}
+
+	var resolved int32
+	for {
+		batch, err := s.repo.ListPendingDLQMessages(ctx, ids, limit)
+		if err != nil {
+			return resolved, &models.Error{Code: models.INTERNALERROR, Message: err}
+		}
+
+		batchResolved := 0
+		for _, msg := range batch {
+			status, note, err := action(msg)
+			if err != nil {
+				return resolved, &models.Error{Code: models.INTERNALERROR, Message: fmt.Errorf("message %s: %w", msg.ID, err)}
+			}
+
+			ok, err := s.repo.ResolveDLQMessage(ctx, msg.ID, status, userID, note)
+			if err != nil {
+				return resolved, &models.Error{Code: models.INTERNALERROR, Message: err}
+			}
+			if ok {
+				resolved++
+				batchResolved++
+				log.Printf("[DLQ] message %s marked %s by %s", msg.ID, status, userID)
+			}
+		}
+
+		// выбранные ids укладываются в одну выборку, для all идем до конца очереди
+		if !all || len(batch) < int(limit) || batchResolved == 0 {
+			return resolved, nil
+		}
+	}
+}
+
+func replayMessage(msg models.DLQMessage, defaultTopic string) kafka.Message {
+	topic := msg.OriginalTopic
+	if topic == "" {
+		topic = defaultTopic
+	}
+
+	var headers []kafka.Header
+	for key, value := range msg.Headers {
+		switch key {
+		case kfk.HeaderRetryCount, kfk.HeaderNotBefore, kfk.HeaderError, kfk.HeaderOriginalTopic:
+			continue
+		}
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	headers = kfk.SetHeader(headers, HeaderReplayedFrom, msg.ID)
+
+	return kafka.Message{
+		Topic:   topic,
+		Key:     []byte(msg.Key),
+		Value:   msg.Payload,
+		Headers: headers,
+	}
+}
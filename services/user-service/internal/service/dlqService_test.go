@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+	kfk "user-service/pkg/kafka"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type mockDLQRepository struct {
+	messages map[string]*models.DLQMessage
+	seq      int
+}
+
+func newMockDLQRepository() *mockDLQRepository {
+	return &mockDLQRepository{messages: make(map[string]*models.DLQMessage)}
+}
+
+func (m *mockDLQRepository) SaveDLQMessage(ctx context.Context, msg *models.DLQMessage) error {
+	for _, existing := range m.messages {
+		if existing.Topic == msg.Topic && existing.Partition == msg.Partition && existing.Offset == msg.Offset {
+			return nil
+		}
+	}
+	m.seq++
+	saved := *msg
+	saved.ID = fmt.Sprintf("dlq-%03d", m.seq)
+	saved.Status = models.DLQPending
+	m.messages[saved.ID] = &saved
+	return nil
+}
+
+func (m *mockDLQRepository) GetDLQMessage(ctx context.Context, id string) (*models.DLQMessage, error) {
+	msg, ok := m.messages[id]
+	if !ok {
+		return nil, repository.ErrDLQMessageNotFound
+	}
+	copied := *msg
+	return &copied, nil
+}
+
+func (m *mockDLQRepository) sorted() []models.DLQMessage {
+	var res []models.DLQMessage
+	for _, msg := range m.messages {
+		res = append(res, *msg)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (m *mockDLQRepository) ListDLQMessages(ctx context.Context, filter models.DLQFilter) ([]models.DLQMessage, int32, error) {
+	var res []models.DLQMessage
+	for _, msg := range m.sorted() {
+		if filter.Status == "" || msg.Status == filter.Status {
+			res = append(res, msg)
+		}
+	}
+	return res, int32(len(res)), nil
+}
+
+func (m *mockDLQRepository) ListPendingDLQMessages(ctx context.Context, ids []string, limit int32) ([]models.DLQMessage, error) {
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var res []models.DLQMessage
+	for _, msg := range m.sorted() {
+		if msg.Status != models.DLQPending || (len(ids) > 0 && !wanted[msg.ID]) {
+			continue
+		}
+		if int32(len(res)) == limit {
+			break
+		}
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+func (m *mockDLQRepository) ResolveDLQMessage(ctx context.Context, id string, status models.DLQMessageStatus, resolvedBy, note string) (bool, error) {
+	msg, ok := m.messages[id]
+	if !ok || msg.Status != models.DLQPending {
+		return false, nil
+	}
+	msg.Status = status
+	msg.ResolvedBy = resolvedBy
+	msg.Note = note
+	return true, nil
+}
+
+func newTestDLQService() (*DLQService, *mockDLQRepository, *mockEventPublisher) {
+	repo := newMockDLQRepository()
+	publisher := &mockEventPublisher{}
+	return NewDLQService(repo, publisher, testTopic, []string{"admin-1"}), repo, publisher
+}
+
+func dlqMessage(offset int64, originalTopic string) kafka.Message {
+	headers := kfk.SetHeader(nil, kfk.HeaderRetryCount, "3")
+	headers = kfk.SetHeader(headers, kfk.HeaderError, "boom")
+	headers = kfk.SetHeader(headers, kfk.HeaderNotBefore, "1700000000000")
+	headers = kfk.SetHeader(headers, "trace-id", "abc")
+	if originalTopic != "" {
+		headers = kfk.SetHeader(headers, kfk.HeaderOriginalTopic, originalTopic)
+	}
+
+	return kafka.Message{
+		Topic:   testTopic + ".dlq",
+		Offset:  offset,
+		Key:     []byte("user-1"),
+		Value:   []byte(`{"event_type":"user.registered"}`),
+		Headers: headers,
+	}
+}
+
+func TestDLQService_IndexStoresFailureDetails(t *testing.T) {
+	svc, repo, _ := newTestDLQService()
+	ctx := context.Background()
+
+	msg := dlqMessage(1, "auth-events.retry.2")
+	if err := svc.Index(ctx, msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// повторное чтение того же offset не создает дубликат
+	if err := svc.Index(ctx, msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	messages, total, e := svc.ListMessages(ctx, "admin-1", models.DLQFilter{Status: models.DLQPending})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if total != 1 || len(repo.messages) != 1 {
+		t.Fatalf("expected 1 stored message, got %d", total)
+	}
+	stored := messages[0]
+	if stored.Error != "boom" || stored.RetryCount != 3 || stored.OriginalTopic != "auth-events.retry.2" {
+		t.Errorf("unexpected failure details: %+v", stored)
+	}
+}
+
+func TestDLQService_NonAdminDenied(t *testing.T) {
+	svc, _, _ := newTestDLQService()
+	ctx := context.Background()
+
+	if _, _, e := svc.ListMessages(ctx, "user-1", models.DLQFilter{}); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on list, got %v", e)
+	}
+	if _, e := svc.Replay(ctx, "user-1", nil, true); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on replay, got %v", e)
+	}
+}
+
+func TestDLQService_GetMessageNotFound(t *testing.T) {
+	svc, _, _ := newTestDLQService()
+
+	_, e := svc.GetMessage(context.Background(), "admin-1", "missing")
+	if e == nil || e.Code != models.DLQNOTFOUND {
+		t.Errorf("expected DLQ not found, got %v", e)
+	}
+}
+
+func TestDLQService_ReplaySelected(t *testing.T) {
+	svc, repo, publisher := newTestDLQService()
+	ctx := context.Background()
+
+	_ = svc.Index(ctx, dlqMessage(1, "auth-events.retry.1"))
+	_ = svc.Index(ctx, dlqMessage(2, ""))
+
+	replayed, e := svc.Replay(ctx, "admin-1", []string{"dlq-001"}, false)
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if replayed != 1 || len(publisher.messages) != 1 {
+		t.Fatalf("expected 1 replayed message, got %d", replayed)
+	}
+
+	sent := publisher.messages[0]
+	if sent.Topic != "auth-events.retry.1" {
+		t.Errorf("expected replay to original topic, got %s", sent.Topic)
+	}
+	for _, key := range []string{kfk.HeaderRetryCount, kfk.HeaderError, kfk.HeaderNotBefore, kfk.HeaderOriginalTopic} {
+		if kfk.Header(sent, key) != "" {
+			t.Errorf("expected header %s to be stripped", key)
+		}
+	}
+	if kfk.Header(sent, "trace-id") != "abc" || kfk.Header(sent, HeaderReplayedFrom) != "dlq-001" {
+		t.Errorf("unexpected headers: %v", sent.Headers)
+	}
+
+	if repo.messages["dlq-001"].Status != models.DLQReplayed || repo.messages["dlq-001"].ResolvedBy != "admin-1" {
+		t.Errorf("expected message to be marked replayed by admin")
+	}
+	if repo.messages["dlq-002"].Status != models.DLQPending {
+		t.Errorf("expected unselected message to stay pending")
+	}
+
+	// повторный replay уже обработанного сообщения ничего не отправляет
+	replayed, _ = svc.Replay(ctx, "admin-1", []string{"dlq-001"}, false)
+	if replayed != 0 || len(publisher.messages) != 1 {
+		t.Errorf("expected resolved message to be skipped")
+	}
+}
+
+func TestDLQService_ReplayAllInBatches(t *testing.T) {
+	svc, _, publisher := newTestDLQService()
+	ctx := context.Background()
+
+	count := dlqBatchSize + 5
+	for i := 0; i < count; i++ {
+		_ = svc.Index(ctx, dlqMessage(int64(i), ""))
+	}
+
+	replayed, e := svc.Replay(ctx, "admin-1", nil, true)
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if int(replayed) != count || len(publisher.messages) != count {
+		t.Errorf("expected %d replayed messages, got %d", count, replayed)
+	}
+	if publisher.messages[0].Topic != testTopic {
+		t.Errorf("expected default topic without original one, got %s", publisher.messages[0].Topic)
+	}
+}
+
+func TestDLQService_DiscardRequiresNote(t *testing.T) {
+	svc, repo, publisher := newTestDLQService()
+	ctx := context.Background()
+
+	_ = svc.Index(ctx, dlqMessage(1, ""))
+
+	if _, e := svc.Discard(ctx, "admin-1", []string{"dlq-001"}, false, "  "); e == nil || e.Code != models.INVALIDINPUT {
+		t.Fatalf("expected invalid input without note, got %v", e)
+	}
+
+	discarded, e := svc.Discard(ctx, "admin-1", []string{"dlq-001"}, false, "payload is broken")
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if discarded != 1 || len(publisher.messages) != 0 {
+		t.Errorf("expected discard without publishing")
+	}
+	if msg := repo.messages["dlq-001"]; msg.Status != models.DLQDiscarded || msg.Note != "payload is broken" {
+		t.Errorf("expected discard note to be stored, got %+v", msg)
+	}
+}
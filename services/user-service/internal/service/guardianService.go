@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+type GuardianService struct {
+	userRepo     UserProfileRepository
+	guardianRepo GuardianRepository
+}
+
+func NewGuardianService(userRepo UserProfileRepository, guardianRepo GuardianRepository) *GuardianService {
+	return &GuardianService{
+		userRepo:     userRepo,
+		guardianRepo: guardianRepo,
+	}
+}
+
+func guardianRepoError(err error) *models.Error {
+	switch {
+	case errors.Is(err, repository.ErrGuardianLinkNotFound):
+		return &models.Error{Code: models.GUARDIANNOTFOUND, Message: err}
+	case errors.Is(err, repository.ErrGuardianLinkExists):
+		return &models.Error{Code: models.GUARDIANEXISTS, Message: err}
+	default:
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+}
+
+// InviteGuardian - ученик приглашает опекуна по email, связь ждет согласия опекуна
+func (s *GuardianService) InviteGuardian(ctx context.Context, studentID, guardianEmail string) (*models.GuardianLink, *models.Error) {
+	if guardianEmail == "" {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("guardian email is required")}
+	}
+
+	types, err := s.userRepo.GetUserTypes(ctx, studentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, &models.Error{Code: models.USERNOTFOUND, Message: err}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if !types.IsStudent {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("only students can invite guardians")}
+	}
+
+	guardian, err := s.userRepo.GetUserByEmail(ctx, guardianEmail)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, &models.Error{Code: models.USERNOTFOUND, Message: fmt.Errorf("guardian must be registered: %w", err)}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if guardian.UserID == studentID {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("cannot invite yourself as a guardian")}
+	}
+
+	link, err := s.guardianRepo.CreateGuardianLink(ctx, studentID, guardian.UserID)
+	if err != nil {
+		return nil, guardianRepoError(err)
+	}
+
+	return link, nil
+}
+
+func (s *GuardianService) AcceptInvitation(ctx context.Context, userID, linkID string, consent bool) (*models.GuardianLink, *models.Error) {
+	if !consent {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("guardian consent is required")}
+	}
+
+	link, err := s.guardianRepo.GetGuardianLinkByID(ctx, linkID)
+	if err != nil {
+		return nil, guardianRepoError(err)
+	}
+	if link.GuardianID != userID {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("invitation was sent to another user")}
+	}
+	if link.Status != models.GuardianPending {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("invitation is already %s", link.Status)}
+	}
+
+	accepted, err := s.guardianRepo.AcceptGuardianLink(ctx, linkID)
+	if err != nil {
+		return nil, guardianRepoError(err)
+	}
+
+	return accepted, nil
+}
+
+// RevokeLink - отозвать связь может как ученик, так и опекун
+func (s *GuardianService) RevokeLink(ctx context.Context, userID, linkID string) *models.Error {
+	link, err := s.guardianRepo.GetGuardianLinkByID(ctx, linkID)
+	if err != nil {
+		return guardianRepoError(err)
+	}
+	if link.StudentID != userID && link.GuardianID != userID {
+		return &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("user is not a party of this link")}
+	}
+
+	if err := s.guardianRepo.RevokeGuardianLink(ctx, linkID); err != nil {
+		return guardianRepoError(err)
+	}
+
+	return nil
+}
+
+func (s *GuardianService) ListLinks(ctx context.Context, userID string) ([]models.GuardianLink, *models.Error) {
+	links, err := s.guardianRepo.ListGuardianLinks(ctx, userID)
+	if err != nil {
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return links, nil
+}
+
+func (s *GuardianService) CheckAccess(ctx context.Context, guardianID, studentID string) (bool, *models.Error) {
+	if guardianID == "" || studentID == "" {
+		return false, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("guardian_id and student_id are required")}
+	}
+
+	ok, err := s.guardianRepo.HasAcceptedGuardianLink(ctx, guardianID, studentID)
+	if err != nil {
+		return false, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+type mockGuardianRepository struct {
+	links  map[string]*models.GuardianLink
+	nextID int
+}
+
+func newMockGuardianRepository() *mockGuardianRepository {
+	return &mockGuardianRepository{links: make(map[string]*models.GuardianLink)}
+}
+
+func (m *mockGuardianRepository) CreateGuardianLink(ctx context.Context, studentID, guardianID string) (*models.GuardianLink, error) {
+	for _, l := range m.links {
+		if l.StudentID == studentID && l.GuardianID == guardianID && l.Status != models.GuardianRevoked {
+			return nil, repository.ErrGuardianLinkExists
+		}
+	}
+	m.nextID++
+	link := &models.GuardianLink{
+		ID:         fmt.Sprintf("link-%d", m.nextID),
+		StudentID:  studentID,
+		GuardianID: guardianID,
+		Status:     models.GuardianPending,
+		InvitedAt:  time.Now(),
+	}
+	m.links[link.ID] = link
+	return link, nil
+}
+
+func (m *mockGuardianRepository) GetGuardianLinkByID(ctx context.Context, id string) (*models.GuardianLink, error) {
+	link, exists := m.links[id]
+	if !exists {
+		return nil, repository.ErrGuardianLinkNotFound
+	}
+	copied := *link
+	return &copied, nil
+}
+
+func (m *mockGuardianRepository) AcceptGuardianLink(ctx context.Context, id string) (*models.GuardianLink, error) {
+	link, exists := m.links[id]
+	if !exists || link.Status != models.GuardianPending {
+		return nil, repository.ErrGuardianLinkNotFound
+	}
+	now := time.Now()
+	link.Status = models.GuardianAccepted
+	link.ConsentedAt = &now
+	return link, nil
+}
+
+func (m *mockGuardianRepository) RevokeGuardianLink(ctx context.Context, id string) error {
+	link, exists := m.links[id]
+	if !exists || link.Status == models.GuardianRevoked {
+		return repository.ErrGuardianLinkNotFound
+	}
+	now := time.Now()
+	link.Status = models.GuardianRevoked
+	link.RevokedAt = &now
+	return nil
+}
+
+func (m *mockGuardianRepository) ListGuardianLinks(ctx context.Context, userID string) ([]models.GuardianLink, error) {
+	var result []models.GuardianLink
+	for _, l := range m.links {
+		if (l.StudentID == userID || l.GuardianID == userID) && l.Status != models.GuardianRevoked {
+			result = append(result, *l)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGuardianRepository) HasAcceptedGuardianLink(ctx context.Context, guardianID, studentID string) (bool, error) {
+	for _, l := range m.links {
+		if l.GuardianID == guardianID && l.StudentID == studentID && l.Status == models.GuardianAccepted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newTestGuardianService() (*GuardianService, *mockGuardianRepository) {
+	userRepo := newMockUserProfileRepository()
+	for _, u := range []*models.UserProfile{
+		{UserID: "student-1", Email: "student@example.com", UserType: models.UserType{IsStudent: true}},
+		{UserID: "parent-1", Email: "parent@example.com"},
+		{UserID: "tutor-1", Email: "tutor@example.com", UserType: models.UserType{IsTutor: true}},
+	} {
+		userRepo.users[u.UserID] = u
+		userRepo.usersByEmail[u.Email] = u
+	}
+
+	guardianRepo := newMockGuardianRepository()
+	return NewGuardianService(userRepo, guardianRepo), guardianRepo
+}
+
+func TestGuardianService_InviteAndAccept(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	link, err := svc.InviteGuardian(ctx, "student-1", "parent@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if link.Status != models.GuardianPending {
+		t.Errorf("expected status %s, got %s", models.GuardianPending, link.Status)
+	}
+
+	if ok, _ := svc.CheckAccess(ctx, "parent-1", "student-1"); ok {
+		t.Error("expected no access before consent")
+	}
+
+	accepted, err := svc.AcceptInvitation(ctx, "parent-1", link.ID, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if accepted.ConsentedAt == nil {
+		t.Error("expected consent time to be recorded")
+	}
+
+	if ok, _ := svc.CheckAccess(ctx, "parent-1", "student-1"); !ok {
+		t.Error("expected access after consent")
+	}
+}
+
+func TestGuardianService_InviteGuardian_NotStudent(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	_, err := svc.InviteGuardian(ctx, "tutor-1", "parent@example.com")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected error code %s, got %s", models.PERMISSIONDENIED, err.Code)
+	}
+}
+
+func TestGuardianService_InviteGuardian_UnknownEmail(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	_, err := svc.InviteGuardian(ctx, "student-1", "nobody@example.com")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.USERNOTFOUND {
+		t.Errorf("expected error code %s, got %s", models.USERNOTFOUND, err.Code)
+	}
+}
+
+func TestGuardianService_AcceptInvitation_WithoutConsent(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	link, _ := svc.InviteGuardian(ctx, "student-1", "parent@example.com")
+
+	_, err := svc.AcceptInvitation(ctx, "parent-1", link.ID, false)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.INVALIDINPUT {
+		t.Errorf("expected error code %s, got %s", models.INVALIDINPUT, err.Code)
+	}
+}
+
+func TestGuardianService_AcceptInvitation_WrongUser(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	link, _ := svc.InviteGuardian(ctx, "student-1", "parent@example.com")
+
+	_, err := svc.AcceptInvitation(ctx, "tutor-1", link.ID, true)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected error code %s, got %s", models.PERMISSIONDENIED, err.Code)
+	}
+}
+
+func TestGuardianService_RevokeLink(t *testing.T) {
+	svc, _ := newTestGuardianService()
+	ctx := context.Background()
+
+	link, _ := svc.InviteGuardian(ctx, "student-1", "parent@example.com")
+	_, _ = svc.AcceptInvitation(ctx, "parent-1", link.ID, true)
+
+	if err := svc.RevokeLink(ctx, "tutor-1", link.ID); err == nil || err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected %s, got %v", models.PERMISSIONDENIED, err)
+	}
+
+	if err := svc.RevokeLink(ctx, "student-1", link.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if ok, _ := svc.CheckAccess(ctx, "parent-1", "student-1"); ok {
+		t.Error("expected access to be revoked")
+	}
+}
@@ -3,105 +3,187 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"user-service/internal/events"
-	kfk "user-service/pkg/kafka"
 	"user-service/internal/models"
+	kfk "user-service/pkg/kafka"
 
 	"github.com/segmentio/kafka-go"
 )
 
+// задержки перед повторной обработкой: <topic>.retry.1, .retry.2, .retry.3
+var retryDelays = []time.Duration{10 * time.Second, time.Minute, 10 * time.Minute}
+
+type EventPublisher interface {
+	PublishMessage(ctx context.Context, msg kafka.Message) error
+}
+
+// ProfileWriter - изменения профилей через UserService, чтобы они попадали в outbox
+type ProfileWriter interface {
+	CreateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, *models.Error)
+	UpdateUserEmail(ctx context.Context, id, email string) *models.Error
+	DeleteUser(ctx context.Context, id string) *models.Error
+}
+
 type KafkaHandler struct {
-	repo     UserProfileRepository
-	producer *kfk.Producer
+	processed ProcessedEventRepository
+	tx        Transactor
+	users     ProfileWriter
+	publisher EventPublisher
+	topic     string
+	router    *events.Router
 }
 
-func NewKafkaHandler(repo UserProfileRepository, producer *kfk.Producer) *KafkaHandler {
-	return &KafkaHandler{repo: repo, producer: producer}
+func NewKafkaHandler(
+	processed ProcessedEventRepository,
+	tx Transactor,
+	users ProfileWriter,
+	publisher EventPublisher,
+	topic string,
+) *KafkaHandler {
+	h := &KafkaHandler{
+		processed: processed,
+		tx:        tx,
+		users:     users,
+		publisher: publisher,
+		topic:     topic,
+		router:    events.NewRouter(),
+	}
+
+	events.On(h.router, events.UserRegistered, 1, h.handleUserRegistered)
+	events.On(h.router, events.UserDeleted, 1, h.handleUserDeleted)
+	events.On(h.router, events.EmailChanged, 1, h.handleEmailChanged)
+
+	return h
 }
 
-func (h *KafkaHandler) Handle(ctx context.Context, msg kafka.Message) error {
+// Router дает зарегистрировать обработчики новых событий без изменения цикла чтения
+func (h *KafkaHandler) Router() *events.Router {
+	return h.router
+}
 
-	var event events.UserRegisteredEvent
+func (h *KafkaHandler) Handle(ctx context.Context, msg kafka.Message) error {
+	var env events.Envelope
+	if err := json.Unmarshal(msg.Value, &env); err != nil || env.EventType == "" {
+		log.Printf("[KAFKA] malformed event in %s at offset %d: %v", msg.Topic, msg.Offset, err)
+		if err == nil {
+			err = errors.New("event_type is empty")
+		}
+		return h.toDLQ(ctx, msg, err)
+	}
 
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
-		h.toDLQ(ctx, msg)
-		return nil
+	// отметка об обработке пишется в одной транзакции с изменениями обработчика:
+	// после сбоя или отката нет ни изменений, ни отметки, и повторная доставка обработает
+	// событие заново, а после фиксации - пропустит его
+	duplicate := false
+	err := h.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if env.EventID != "" {
+			marked, err := h.processed.MarkProcessed(ctx, env.EventID, env.EventType)
+			if err != nil {
+				return fmt.Errorf("mark event as processed: %w", err)
+			}
+			if !marked {
+				duplicate = true
+				return nil
+			}
+		}
+		return h.router.Dispatch(ctx, env)
+	})
+	if err != nil {
+		if events.IsPermanent(err) {
+			log.Printf("[KAFKA] event %s sent to DLQ: %v", env.EventID, err)
+			return h.toDLQ(ctx, msg, err)
+		}
+		log.Printf("[KAFKA] event %s (%s) failed: %v", env.EventID, env.EventType, err)
+		return h.retry(ctx, msg, err)
+	}
+	if duplicate {
+		log.Printf("[KAFKA] skip duplicate event %s (%s)", env.EventID, env.EventType)
 	}
 
-	h.delay(msg.Topic)
+	return nil
+}
 
-	if err := h.handleUserRegistered(ctx, event); err != nil {
-		return h.retry(ctx, event)
+func (h *KafkaHandler) handleUserRegistered(ctx context.Context, env events.Envelope, payload events.UserRegisteredPayload) error {
+	_, e := h.users.CreateUser(ctx, &models.UserProfile{
+		UserID: payload.UserID,
+		Email:  payload.Email,
+	})
+	if e != nil {
+		if e.Code == models.USEREXISTS {
+			return nil
+		}
+		return fmt.Errorf("create user %s: %w", payload.UserID, e.Message)
 	}
+	return nil
+}
 
+func (h *KafkaHandler) handleUserDeleted(ctx context.Context, env events.Envelope, payload events.UserDeletedPayload) error {
+	if e := h.users.DeleteUser(ctx, payload.UserID); e != nil {
+		if e.Code == models.USERNOTFOUND {
+			return nil
+		}
+		return fmt.Errorf("delete user %s: %w", payload.UserID, e.Message)
+	}
 	return nil
 }
 
-func (h *KafkaHandler) handleUserRegistered(
-	ctx context.Context,
-	event events.UserRegisteredEvent,
-) error {
-	_, err := h.repo.CreateUser(ctx, &models.UserProfile{
-		UserID: event.Payload.UserID,
-		Email:  event.Payload.Email,
-	})
-	return err
+// handleEmailChanged переносит подтвержденный в auth-service адрес в профиль.
+// Профиля может не быть, если регистрация еще не обработана, его создаст сверка.
+func (h *KafkaHandler) handleEmailChanged(ctx context.Context, env events.Envelope, payload events.EmailChangedPayload) error {
+	if payload.UserID == "" || payload.NewEmail == "" {
+		return fmt.Errorf("%w: EmailChanged without user_id or new_email", events.ErrMalformedPayload)
+	}
+
+	if e := h.users.UpdateUserEmail(ctx, payload.UserID, payload.NewEmail); e != nil {
+		if e.Code == models.USERNOTFOUND {
+			log.Printf("[KAFKA] skip email change for missing profile %s", payload.UserID)
+			return nil
+		}
+		return fmt.Errorf("update email of user %s: %w", payload.UserID, e.Message)
+	}
+	return nil
 }
 
-func (h *KafkaHandler) retry(ctx context.Context, event events.UserRegisteredEvent) error {
-
-	event.Meta.RetryCount++
-
-	var topic string
-
-	switch event.Meta.RetryCount {
-	case 1:
-		topic = h.producer.Topic + ".retry.1"
-	case 2:
-		topic = h.producer.Topic + ".retry.2"
-	case 3:
-		topic = h.producer.Topic + ".retry.3"
-	default:
-		return h.producer.Publish(
-			ctx,
-			h.producer.Topic + ".dlq",
-			event.Payload.UserID,
-			event,
-		)
+// retry откладывает сообщение в следующий retry-топик. Счетчик попыток и время,
+// раньше которого сообщение не обрабатывается, передаются в заголовках, payload не меняется.
+func (h *KafkaHandler) retry(ctx context.Context, msg kafka.Message, cause error) error {
+	count := kfk.RetryCount(msg) + 1
+	if count > len(retryDelays) {
+		return h.toDLQ(ctx, msg, cause)
 	}
 
-	log.Printf("retry %d for user %s",
-		event.Meta.RetryCount,
-		event.Payload.UserID,
-	)
-
-	return h.producer.Publish(
-		ctx,
-		topic,
-		event.Payload.UserID,
-		event,
-	)
+	headers := h.failureHeaders(msg, cause)
+	headers = kfk.SetHeader(headers, kfk.HeaderRetryCount, strconv.Itoa(count))
+	headers = kfk.SetHeader(headers, kfk.HeaderNotBefore, kfk.FormatNotBefore(time.Now().Add(retryDelays[count-1])))
+
+	log.Printf("retry %d for message %s[%d]@%d", count, msg.Topic, msg.Partition, msg.Offset)
+
+	return h.publisher.PublishMessage(ctx, kafka.Message{
+		Topic:   fmt.Sprintf("%s.retry.%d", h.topic, count),
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
 }
 
-func (h *KafkaHandler) toDLQ(ctx context.Context, msg kafka.Message) {
-	_ = h.producer.Publish(
-		ctx,
-		h.producer.Topic + ".dlq",
-		string(msg.Key),
-		msg.Value,
-	)
+func (h *KafkaHandler) toDLQ(ctx context.Context, msg kafka.Message, cause error) error {
+	headers := kfk.DeleteHeader(h.failureHeaders(msg, cause), kfk.HeaderNotBefore)
+
+	return h.publisher.PublishMessage(ctx, kafka.Message{
+		Topic:   h.topic + ".dlq",
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
 }
 
-func (h *KafkaHandler) delay(topic string) {
-	switch topic {
-	case h.producer.Topic + ".retry.1":
-		time.Sleep(10 * time.Second)
-	case h.producer.Topic + ".retry.1":
-		time.Sleep(1 * time.Minute)
-	case h.producer.Topic + ".retry.1":
-		time.Sleep(10 * time.Minute)
-	}
+func (h *KafkaHandler) failureHeaders(msg kafka.Message, cause error) []kafka.Header {
+	headers := kfk.SetHeader(msg.Headers, kfk.HeaderOriginalTopic, h.topic)
+	return kfk.SetHeader(headers, kfk.HeaderError, cause.Error())
 }
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+	"user-service/internal/events"
+	"user-service/internal/models"
+	kfk "user-service/pkg/kafka"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	testTopic       = "auth-events"
+	testEventsTopic = "user-events"
+)
+
+type mockEventPublisher struct {
+	messages []kafka.Message
+}
+
+func (m *mockEventPublisher) PublishMessage(ctx context.Context, msg kafka.Message) error {
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+type mockProcessedEventRepository struct {
+	processed map[string]string
+	markErr   error
+}
+
+func newMockProcessedEventRepository() *mockProcessedEventRepository {
+	return &mockProcessedEventRepository{processed: make(map[string]string)}
+}
+
+func (m *mockProcessedEventRepository) MarkProcessed(ctx context.Context, eventID, eventType string) (bool, error) {
+	if m.markErr != nil {
+		return false, m.markErr
+	}
+	if _, ok := m.processed[eventID]; ok {
+		return false, nil
+	}
+	m.processed[eventID] = eventType
+	return true, nil
+}
+
+// processedTransactor откатывает отметки об обработке при ошибке, как транзакция в БД
+type processedTransactor struct {
+	processed *mockProcessedEventRepository
+}
+
+func (m *processedTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := make(map[string]string, len(m.processed.processed))
+	for id, eventType := range m.processed.processed {
+		saved[id] = eventType
+	}
+	if err := fn(ctx); err != nil {
+		m.processed.processed = saved
+		return err
+	}
+	return nil
+}
+
+func newTestKafkaHandler() (*KafkaHandler, *mockUserProfileRepository, *mockProcessedEventRepository, *mockEventPublisher) {
+	userSvc, userRepo, _, _ := newTestUserService()
+	processed := newMockProcessedEventRepository()
+	publisher := &mockEventPublisher{}
+	h := NewKafkaHandler(processed, &processedTransactor{processed: processed}, userSvc, publisher, testTopic)
+	return h, userRepo, processed, publisher
+}
+
+func eventMessage(t *testing.T, eventType, eventID string, version int, payload any) kafka.Message {
+	t.Helper()
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("failed to marshal payload: %v", err)
+	}
+	value, err := json.Marshal(events.Envelope{
+		EventType: eventType,
+		EventID:   eventID,
+		Version:   version,
+		Payload:   raw,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal envelope: %v", err)
+	}
+
+	return kafka.Message{Topic: testTopic, Key: []byte("user-1"), Value: value}
+}
+
+func TestKafkaHandler_UserRegistered(t *testing.T) {
+	h, userRepo, processed, publisher := newTestKafkaHandler()
+	msg := eventMessage(t, events.UserRegistered, "event-1", 0,
+		events.UserRegisteredPayload{UserID: "user-1", Email: "user@example.com"})
+
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, ok := userRepo.users["user-1"]; !ok {
+		t.Error("expected user profile to be created")
+	}
+	if processed.processed["event-1"] != events.UserRegistered {
+		t.Error("expected event to be marked as processed")
+	}
+	if len(publisher.messages) != 0 {
+		t.Errorf("expected no published messages, got %d", len(publisher.messages))
+	}
+}
+
+func TestKafkaHandler_DuplicateEventSkipped(t *testing.T) {
+	h, userRepo, processed, _ := newTestKafkaHandler()
+	processed.processed["event-1"] = events.UserRegistered
+	msg := eventMessage(t, events.UserRegistered, "event-1", 1,
+		events.UserRegisteredPayload{UserID: "user-1", Email: "user@example.com"})
+
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, ok := userRepo.users["user-1"]; ok {
+		t.Error("expected duplicate event not to be applied")
+	}
+}
+
+func TestKafkaHandler_UserDeleted(t *testing.T) {
+	h, userRepo, _, _ := newTestKafkaHandler()
+	user := &models.UserProfile{UserID: "user-1", Email: "user@example.com"}
+	userRepo.users[user.UserID] = user
+	userRepo.usersByEmail[user.Email] = user
+
+	msg := eventMessage(t, events.UserDeleted, "event-2", 1, events.UserDeletedPayload{UserID: "user-1"})
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := userRepo.users["user-1"]; ok {
+		t.Error("expected user profile to be deleted")
+	}
+
+	// повторное удаление уже удаленного профиля не считается ошибкой
+	msg = eventMessage(t, events.UserDeleted, "event-3", 1, events.UserDeletedPayload{UserID: "user-1"})
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestKafkaHandler_EmailChanged(t *testing.T) {
+	h, userRepo, _, publisher := newTestKafkaHandler()
+	addProfile(userRepo, "user-1", "old@example.com", time.Now())
+	addProfile(userRepo, "user-2", "taken@example.com", time.Now())
+
+	msg := eventMessage(t, events.EmailChanged, "event-4", 1,
+		events.EmailChangedPayload{UserID: "user-1", OldEmail: "old@example.com", NewEmail: "new@example.com"})
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if userRepo.users["user-1"].Email != "new@example.com" {
+		t.Errorf("expected email to be updated, got %s", userRepo.users["user-1"].Email)
+	}
+
+	// профиль еще не создан - событие не повторяется, расхождение исправит сверка
+	msg = eventMessage(t, events.EmailChanged, "event-5", 1,
+		events.EmailChangedPayload{UserID: "missing", NewEmail: "missing@example.com"})
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(publisher.messages) != 0 {
+		t.Fatalf("expected no retries, got %d messages", len(publisher.messages))
+	}
+
+	msg = eventMessage(t, events.EmailChanged, "event-6", 1, events.EmailChangedPayload{UserID: "user-2"})
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(publisher.messages) != 1 || publisher.messages[0].Topic != testTopic+".dlq" {
+		t.Errorf("expected event without new_email to go to DLQ, got %+v", publisher.messages)
+	}
+}
+
+func TestKafkaHandler_PermanentFailuresGoToDLQ(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  func(t *testing.T) kafka.Message
+	}{
+		{
+			name: "unknown event type",
+			msg: func(t *testing.T) kafka.Message {
+				return eventMessage(t, "SomethingHappened", "event-1", 1, struct{}{})
+			},
+		},
+		{
+			name: "unsupported version",
+			msg: func(t *testing.T) kafka.Message {
+				return eventMessage(t, events.UserRegistered, "event-1", 2, events.UserRegisteredPayload{UserID: "user-1"})
+			},
+		},
+		{
+			name: "malformed payload",
+			msg: func(t *testing.T) kafka.Message {
+				return eventMessage(t, events.UserDeleted, "event-1", 1, map[string]int{"user_id": 1})
+			},
+		},
+		{
+			name: "not json",
+			msg: func(t *testing.T) kafka.Message {
+				return kafka.Message{Topic: testTopic, Value: []byte("not json")}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _, processed, publisher := newTestKafkaHandler()
+
+			if err := h.Handle(context.Background(), tt.msg(t)); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(publisher.messages) != 1 || publisher.messages[0].Topic != testTopic+".dlq" {
+				t.Fatalf("expected one message in DLQ, got %+v", publisher.messages)
+			}
+			if kfk.Header(publisher.messages[0], kfk.HeaderError) == "" {
+				t.Error("expected failure reason in DLQ headers")
+			}
+			if len(processed.processed) != 0 {
+				t.Error("expected event not to be marked as processed")
+			}
+		})
+	}
+}
+
+func TestKafkaHandler_MarkFailureRetried(t *testing.T) {
+	h, userRepo, processed, publisher := newTestKafkaHandler()
+	processed.markErr = errors.New("connection refused")
+	msg := eventMessage(t, events.UserRegistered, "event-1", 1,
+		events.UserRegisteredPayload{UserID: "user-1", Email: "user@example.com"})
+
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(publisher.messages) != 1 {
+		t.Fatalf("expected one published message, got %d", len(publisher.messages))
+	}
+	if publisher.messages[0].Topic != testTopic+".retry.1" {
+		t.Errorf("expected topic %s.retry.1, got %s", testTopic, publisher.messages[0].Topic)
+	}
+	if _, ok := userRepo.users["user-1"]; ok {
+		t.Error("expected event not to be handled without a processed marker")
+	}
+
+	// повторная доставка после сбоя обрабатывает событие один раз
+	processed.markErr = nil
+	publisher.messages = nil
+	for i := 0; i < 2; i++ {
+		if err := h.Handle(context.Background(), msg); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if _, ok := userRepo.users["user-1"]; !ok {
+		t.Error("expected profile to be created on redelivery")
+	}
+	if len(publisher.messages) != 0 {
+		t.Errorf("expected no retries, got %d", len(publisher.messages))
+	}
+	if processed.processed["event-1"] != events.UserRegistered {
+		t.Error("expected event to be marked as processed")
+	}
+}
+
+func TestKafkaHandler_TransientFailureRetried(t *testing.T) {
+	h, userRepo, processed, publisher := newTestKafkaHandler()
+	userRepo.createErr = errors.New("connection refused")
+	msg := eventMessage(t, events.UserRegistered, "event-1", 1,
+		events.UserRegisteredPayload{UserID: "user-1", Email: "user@example.com"})
+
+	if err := h.Handle(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(publisher.messages) != 1 {
+		t.Fatalf("expected one published message, got %d", len(publisher.messages))
+	}
+	retried := publisher.messages[0]
+	if retried.Topic != testTopic+".retry.1" {
+		t.Errorf("expected topic %s.retry.1, got %s", testTopic, retried.Topic)
+	}
+	if kfk.RetryCount(retried) != 1 {
+		t.Errorf("expected retry count 1, got %d", kfk.RetryCount(retried))
+	}
+	if !kfk.NotBefore(retried).After(time.Now()) {
+		t.Error("expected not-before in the future")
+	}
+	if string(retried.Value) != string(msg.Value) {
+		t.Error("expected payload to be forwarded unchanged")
+	}
+	if len(processed.processed) != 0 {
+		t.Error("expected failed event not to be marked as processed")
+	}
+}
+
+func TestKafkaHandler_RetryTiers(t *testing.T) {
+	h, userRepo, _, publisher := newTestKafkaHandler()
+	userRepo.createErr = errors.New("connection refused")
+	msg := eventMessage(t, events.UserRegistered, "event-1", 1,
+		events.UserRegisteredPayload{UserID: "user-1", Email: "user@example.com"})
+
+	for _, want := range []string{".retry.1", ".retry.2", ".retry.3", ".dlq"} {
+		if err := h.Handle(context.Background(), msg); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		msg = publisher.messages[len(publisher.messages)-1]
+		if msg.Topic != testTopic+want {
+			t.Fatalf("expected topic %s%s, got %s", testTopic, want, msg.Topic)
+		}
+	}
+
+	if kfk.Header(msg, kfk.HeaderNotBefore) != "" {
+		t.Error("expected DLQ message without not-before header")
+	}
+	if kfk.Header(msg, kfk.HeaderOriginalTopic) != testTopic {
+		t.Errorf("expected original topic %s, got %s", testTopic, kfk.Header(msg, kfk.HeaderOriginalTopic))
+	}
+}
+
+func TestRouter_RegisterDuplicatePanics(t *testing.T) {
+	router := events.NewRouter()
+	handler := func(ctx context.Context, env events.Envelope) error { return nil }
+	router.Register(events.UserRegistered, 1, handler)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	router.Register(events.UserRegistered, 1, handler)
+}
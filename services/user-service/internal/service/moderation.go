@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"user-service/internal/models"
+)
+
+// ReviewModerator - хук модерации, вызывается перед сохранением отзыва.
+// Возвращает true и причину, если отзыв нужно скрыть.
+type ReviewModerator interface {
+	Moderate(ctx context.Context, review *models.TutorReview) (bool, string)
+}
+
+type keywordModerator struct {
+	words []string
+}
+
+// NewKeywordModerator скрывает отзывы, содержащие запрещенные слова
+func NewKeywordModerator(words []string) *keywordModerator {
+	normalized := make([]string, 0, len(words))
+	for _, w := range words {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w != "" {
+			normalized = append(normalized, w)
+		}
+	}
+	return &keywordModerator{words: normalized}
+}
+
+func (m *keywordModerator) Moderate(ctx context.Context, review *models.TutorReview) (bool, string) {
+	text := strings.ToLower(review.Text)
+	for _, w := range m.words {
+		if strings.Contains(text, w) {
+			return true, "automatic moderation: abusive language"
+		}
+	}
+	return false, ""
+}
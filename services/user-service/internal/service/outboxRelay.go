@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+	"user-service/internal/events"
+	"user-service/internal/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	outboxBatchSize = 100
+	// отправленные события хранятся сутки для разбора инцидентов
+	outboxRetention       = 24 * time.Hour
+	outboxCleanupInterval = time.Hour
+)
+
+var (
+	outboxPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_outbox_published_total",
+		Help: "События outbox, отправленные в Kafka.",
+	})
+
+	outboxPublishFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_outbox_publish_failures_total",
+		Help: "Неудачные попытки отправить пачку событий outbox.",
+	})
+
+	outboxOldestPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "user_outbox_oldest_pending_age_seconds",
+		Help: "Возраст самого старого неотправленного события outbox.",
+	})
+)
+
+type BatchPublisher interface {
+	PublishMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// OutboxRelay отправляет события outbox в Kafka в порядке записи.
+// Доставка at-least-once: потребители отбрасывают повторы по event_id.
+type OutboxRelay struct {
+	tx        Transactor
+	outbox    OutboxRepository
+	publisher BatchPublisher
+}
+
+func NewOutboxRelay(tx Transactor, outbox OutboxRepository, publisher BatchPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		tx:        tx,
+		outbox:    outbox,
+		publisher: publisher,
+	}
+}
+
+// Run опрашивает outbox каждые interval, пока есть события - без паузы
+func (r *OutboxRelay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(outboxCleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		sent, err := r.PublishBatch(ctx)
+		if err != nil {
+			log.Printf("[OUTBOX] failed to publish batch: %v", err)
+		}
+		if err == nil && sent == outboxBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-cleanup.C:
+			deleted, err := r.outbox.DeletePublishedOutboxEvents(ctx, time.Now().Add(-outboxRetention))
+			if err != nil {
+				log.Printf("[OUTBOX] failed to delete published events: %v", err)
+			} else if deleted > 0 {
+				log.Printf("[OUTBOX] deleted %d published events", deleted)
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishBatch отправляет очередную пачку одним запросом к Kafka. При ошибке
+// пачка остается неотправленной целиком, чтобы не нарушить порядок событий.
+func (r *OutboxRelay) PublishBatch(ctx context.Context) (int, error) {
+	sent := 0
+
+	err := r.tx.WithinTx(ctx, func(ctx context.Context) error {
+		batch, err := r.outbox.ListUnpublishedOutboxEvents(ctx, outboxBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			outboxOldestPending.Set(0)
+			return nil
+		}
+		outboxOldestPending.Set(time.Since(batch[0].CreatedAt).Seconds())
+
+		msgs := make([]kafka.Message, 0, len(batch))
+		ids := make([]int64, 0, len(batch))
+		for _, e := range batch {
+			msg, err := outboxMessage(e)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, msg)
+			ids = append(ids, e.ID)
+		}
+
+		if err := r.publisher.PublishMessages(ctx, msgs...); err != nil {
+			outboxPublishFailures.Inc()
+			// фиксируем попытку, транзакция при этом коммитится
+			if markErr := r.outbox.MarkOutboxEventFailed(ctx, batch[0].ID, err.Error()); markErr != nil {
+				return markErr
+			}
+			log.Printf("[OUTBOX] publish failed, %d events pending since %s: %v", len(batch), batch[0].CreatedAt.Format(time.RFC3339), err)
+			return nil
+		}
+
+		if err := r.outbox.MarkOutboxEventsPublished(ctx, ids); err != nil {
+			return err
+		}
+		sent = len(batch)
+		outboxPublished.Add(float64(sent))
+		return nil
+	})
+
+	return sent, err
+}
+
+func outboxMessage(e models.OutboxEvent) (kafka.Message, error) {
+	value, err := json.Marshal(events.Envelope{
+		EventType:  e.EventType,
+		EventID:    e.EventID,
+		Version:    e.Version,
+		OccurredAt: e.CreatedAt,
+		Payload:    e.Payload,
+	})
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Topic: e.Topic,
+		Key:   []byte(e.Key),
+		Value: value,
+	}, nil
+}
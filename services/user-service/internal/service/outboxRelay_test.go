@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"user-service/internal/events"
+	"user-service/internal/models"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type mockBatchPublisher struct {
+	batches [][]kafka.Message
+	err     error
+}
+
+func (m *mockBatchPublisher) PublishMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.batches = append(m.batches, msgs)
+	return nil
+}
+
+func addOutboxEvents(t *testing.T, outbox *mockOutboxRepository, n int) {
+	t.Helper()
+	profileEvents := NewProfileEvents(outbox, testEventsTopic)
+	for i := 0; i < n; i++ {
+		if err := profileEvents.UserProfileDeleted(context.Background(), "user-1"); err != nil {
+			t.Fatalf("failed to add event: %v", err)
+		}
+	}
+}
+
+func TestOutboxRelay_PublishesInOrder(t *testing.T) {
+	outbox := newMockOutboxRepository()
+	publisher := &mockBatchPublisher{}
+	relay := NewOutboxRelay(&mockTransactor{}, outbox, publisher)
+	addOutboxEvents(t, outbox, outboxBatchSize+1)
+
+	sent, err := relay.PublishBatch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sent != outboxBatchSize {
+		t.Fatalf("expected full batch, got %d", sent)
+	}
+
+	sent, _ = relay.PublishBatch(context.Background())
+	if sent != 1 {
+		t.Fatalf("expected remaining event, got %d", sent)
+	}
+	sent, _ = relay.PublishBatch(context.Background())
+	if sent != 0 {
+		t.Fatalf("expected empty outbox, got %d", sent)
+	}
+
+	msg := publisher.batches[0][0]
+	if msg.Topic != testEventsTopic || string(msg.Key) != "user-1" {
+		t.Errorf("unexpected message routing: %s %s", msg.Topic, msg.Key)
+	}
+
+	var env events.Envelope
+	if err := json.Unmarshal(msg.Value, &env); err != nil {
+		t.Fatalf("failed to decode envelope: %v", err)
+	}
+	if env.EventType != events.UserProfileDeleted || env.EventID != "event-1" || env.Version != 1 || env.OccurredAt.IsZero() {
+		t.Errorf("unexpected envelope: %+v", env)
+	}
+	if string(publisher.batches[0][1].Value) == string(msg.Value) {
+		t.Error("expected distinct event ids per message")
+	}
+}
+
+func TestOutboxRelay_FailureKeepsEventsPending(t *testing.T) {
+	outbox := newMockOutboxRepository()
+	publisher := &mockBatchPublisher{err: errors.New("broker unavailable")}
+	relay := NewOutboxRelay(&mockTransactor{}, outbox, publisher)
+	addOutboxEvents(t, outbox, 2)
+
+	sent, err := relay.PublishBatch(context.Background())
+	if err != nil || sent != 0 {
+		t.Fatalf("expected nothing sent without error, got %d, %v", sent, err)
+	}
+	if len(outbox.published) != 0 {
+		t.Error("expected events to stay unpublished")
+	}
+	if outbox.failures[1] != "broker unavailable" {
+		t.Errorf("expected failure to be recorded, got %v", outbox.failures)
+	}
+
+	publisher.err = nil
+	sent, _ = relay.PublishBatch(context.Background())
+	if sent != 2 {
+		t.Errorf("expected pending events to be sent after recovery, got %d", sent)
+	}
+}
+
+func TestOutboxMessage_KeepsPayload(t *testing.T) {
+	payload := json.RawMessage(`{"user_id":"user-1"}`)
+	msg, err := outboxMessage(models.OutboxEvent{EventType: events.TutorProfileDeleted, EventID: "e-1", Version: 1, Topic: "t", Key: "user-1", Payload: payload})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var env events.Envelope
+	if err := json.Unmarshal(msg.Value, &env); err != nil {
+		t.Fatalf("failed to decode envelope: %v", err)
+	}
+	if string(env.Payload) != string(payload) {
+		t.Errorf("expected payload to be passed as is, got %s", env.Payload)
+	}
+}
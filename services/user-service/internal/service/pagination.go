@@ -0,0 +1,50 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"user-service/internal/models"
+	"user-service/pkg/postgres"
+)
+
+const (
+	defaultProfilesLimit = 50
+	maxProfilesLimit     = 500
+)
+
+func encodeProfileCursor(c models.ProfileCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeProfileCursor проверяет, что токен выдан для того же порядка сортировки
+func decodeProfileCursor(token string, q models.ProfileQuery) (*models.ProfileCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+
+	var c models.ProfileCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+	if c.SortBy != q.SortBy || c.Desc != q.Desc || len(c.Keys) != len(profileCursor(models.UserProfile{}, q).Keys) {
+		return nil, fmt.Errorf("page_token was issued for another sort order")
+	}
+
+	return &c, nil
+}
+
+func profileCursor(u models.UserProfile, q models.ProfileQuery) models.ProfileCursor {
+	c := models.ProfileCursor{SortBy: q.SortBy, Desc: q.Desc, ID: u.UserID}
+	switch q.SortBy {
+	case models.ProfileSortEmail:
+		c.Keys = []string{u.Email}
+	case models.ProfileSortName:
+		c.Keys = []string{u.Surname, u.Name}
+	default:
+		c.Keys = []string{postgres.Timestamp(u.CreatedAt)}
+	}
+	return c
+}
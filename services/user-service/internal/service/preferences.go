@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/internal/models"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	maxTimezoneLength = 64
+	// длина колонки user_profiles.locale, BCP 47 допускает и более длинные теги
+	maxLocaleLength = 16
+)
+
+// normalizeTimezone проверяет имя часового пояса по базе IANA.
+// Пустая строка допустима и означает значение по умолчанию или текущее значение.
+func normalizeTimezone(tz string) (string, *models.Error) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" {
+		return "", nil
+	}
+	// "Local" зависит от окружения сервера и не является зоной IANA
+	if tz == "Local" || len(tz) > maxTimezoneLength {
+		return "", &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("unknown timezone %q", tz)}
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return "", &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("unknown timezone %q", tz)}
+	}
+	return tz, nil
+}
+
+// normalizeLocale проверяет тег BCP 47 и приводит его к каноническому виду (en-us -> en-US)
+func normalizeLocale(locale string) (string, *models.Error) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return "", nil
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("invalid locale %q: %w", locale, err)}
+	}
+	if len(tag.String()) > maxLocaleLength {
+		return "", &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("locale %q is longer than %d characters", locale, maxLocaleLength)}
+	}
+	return tag.String(), nil
+}
+
+func normalizePreferences(user *models.UserProfile) *models.Error {
+	tz, e := normalizeTimezone(user.Timezone)
+	if e != nil {
+		return e
+	}
+	locale, e := normalizeLocale(user.Locale)
+	if e != nil {
+		return e
+	}
+	user.Timezone, user.Locale = tz, locale
+	return nil
+}
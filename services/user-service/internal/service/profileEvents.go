@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"user-service/internal/events"
+	"user-service/internal/models"
+)
+
+// ProfileEvents пишет события изменения профилей в outbox. Вызывается внутри
+// транзакции изменения, поэтому событие сохраняется тогда и только тогда, когда
+// сохранено изменение.
+type ProfileEvents struct {
+	outbox OutboxRepository
+	topic  string
+}
+
+func NewProfileEvents(outbox OutboxRepository, topic string) *ProfileEvents {
+	return &ProfileEvents{outbox: outbox, topic: topic}
+}
+
+func (p *ProfileEvents) add(ctx context.Context, eventType, userID string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	// ключ - id пользователя, события одного пользователя попадают в одну партицию по порядку
+	return p.outbox.AddOutboxEvent(ctx, &models.OutboxEvent{
+		EventType: eventType,
+		Version:   1,
+		Topic:     p.topic,
+		Key:       userID,
+		Payload:   raw,
+	})
+}
+
+func (p *ProfileEvents) UserProfileUpdated(ctx context.Context, user *models.UserProfile) error {
+	return p.add(ctx, events.UserProfileUpdated, user.UserID, events.UserProfilePayload{
+		UserID:    user.UserID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Surname:   user.Surname,
+		Telegram:  user.Telegram,
+		Timezone:  user.Timezone,
+		Locale:    user.Locale,
+		AvatarURL: user.AvatarURL,
+		IsTutor:   user.IsTutor,
+		IsStudent: user.IsStudent,
+	})
+}
+
+func (p *ProfileEvents) UserProfileDeleted(ctx context.Context, userID string) error {
+	return p.add(ctx, events.UserProfileDeleted, userID, events.ProfileDeletedPayload{UserID: userID})
+}
+
+func (p *ProfileEvents) TutorProfileCreated(ctx context.Context, tutor *models.TutorProfile) error {
+	return p.add(ctx, events.TutorProfileCreated, tutor.UserID, events.TutorProfilePayload{
+		UserID:          tutor.UserID,
+		Bio:             tutor.Bio,
+		Specialization:  tutor.Specialization,
+		ExperienceYears: tutor.Experience,
+	})
+}
+
+func (p *ProfileEvents) TutorProfileDeleted(ctx context.Context, userID string) error {
+	return p.add(ctx, events.TutorProfileDeleted, userID, events.ProfileDeletedPayload{UserID: userID})
+}
+
+func (p *ProfileEvents) StudentProfileCreated(ctx context.Context, student *models.StudentProfile) error {
+	return p.add(ctx, events.StudentProfileCreated, student.UserID, events.StudentProfilePayload{
+		UserID: student.UserID,
+		Grade:  student.Grade,
+		Bio:    student.Bio,
+	})
+}
+
+func (p *ProfileEvents) StudentProfileDeleted(ctx context.Context, userID string) error {
+	return p.add(ctx, events.StudentProfileDeleted, userID, events.ProfileDeletedPayload{UserID: userID})
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	"user-service/internal/events"
+	"user-service/internal/models"
+)
+
+func TestUserService_PublishesProfileEvents(t *testing.T) {
+	svc, userRepo, _, _, outbox := newTestUserServiceWithOutbox()
+	ctx := context.Background()
+
+	_, e := svc.CreateUser(ctx, &models.UserProfile{UserID: "user-1", Email: "user@example.com"})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	_, e = svc.UpdateUser(ctx, &models.UserProfile{UserID: "user-1", Name: "Ivan"})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+
+	userRepo.users["user-1"].IsTutor = true
+	userRepo.users["user-1"].IsStudent = true
+	if e := svc.DeleteUser(ctx, "user-1"); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+
+	expected := []string{
+		events.UserProfileUpdated,
+		events.UserProfileUpdated,
+		events.TutorProfileDeleted,
+		events.StudentProfileDeleted,
+		events.UserProfileDeleted,
+	}
+	if got := outbox.eventTypes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected events %v, got %v", expected, got)
+	}
+
+	var payload events.UserProfilePayload
+	if err := json.Unmarshal(outbox.events[1].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.Name != "Ivan" || payload.Email != "user@example.com" {
+		t.Errorf("expected full profile snapshot, got %+v", payload)
+	}
+	for _, ev := range outbox.events {
+		if ev.Key != "user-1" || ev.Topic != testEventsTopic || ev.Version != 1 {
+			t.Errorf("unexpected event routing: %+v", ev)
+		}
+	}
+}
+
+func TestUserService_UpdateUserEmailPublishesSnapshot(t *testing.T) {
+	svc, userRepo, _, _, outbox := newTestUserServiceWithOutbox()
+	ctx := context.Background()
+	addProfile(userRepo, "user-1", "old@example.com", time.Now())
+
+	if e := svc.UpdateUserEmail(ctx, "user-1", "new@example.com"); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+
+	var payload events.UserProfilePayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.Email != "new@example.com" {
+		t.Errorf("expected new email in event, got %s", payload.Email)
+	}
+
+	if e := svc.UpdateUserEmail(ctx, "missing", "x@example.com"); e == nil || e.Code != models.USERNOTFOUND {
+		t.Errorf("expected user not found, got %v", e)
+	}
+}
+
+func TestUserService_OutboxFailureFailsChange(t *testing.T) {
+	svc, _, _, _, outbox := newTestUserServiceWithOutbox()
+	outbox.addErr = errors.New("outbox unavailable")
+
+	_, e := svc.CreateUser(context.Background(), &models.UserProfile{UserID: "user-1", Email: "user@example.com"})
+	if e == nil || e.Code != models.INTERNALERROR {
+		t.Errorf("expected internal error when event cannot be stored, got %v", e)
+	}
+}
+
+func TestTutorAndStudentServices_PublishProfileEvents(t *testing.T) {
+	_, userRepo, tutorRepo, studentRepo, outbox := newTestUserServiceWithOutbox()
+	profileEvents := NewProfileEvents(outbox, testEventsTopic)
+	tutorSvc := NewTutorService(userRepo, tutorRepo, newMockReviewRepository(), &mockTransactor{}, profileEvents)
+	studentSvc := NewStudentService(userRepo, studentRepo, &mockTransactor{}, profileEvents)
+	ctx := context.Background()
+
+	addProfile(userRepo, "user-1", "user@example.com", time.Now())
+
+	if _, e := tutorSvc.CreateTutorProfile(ctx, &models.TutorProfile{UserID: "user-1", Specialization: "math", Experience: 5}); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if _, e := studentSvc.CreateStudentProfile(ctx, &models.StudentProfile{UserID: "user-1", Grade: "9"}); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if e := tutorSvc.DeleteTutorProfile(ctx, "user-1"); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if e := studentSvc.DeleteStudentProfile(ctx, "user-1"); e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+
+	expected := []string{
+		events.TutorProfileCreated,
+		events.StudentProfileCreated,
+		events.TutorProfileDeleted,
+		events.StudentProfileDeleted,
+	}
+	if got := outbox.eventTypes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected events %v, got %v", expected, got)
+	}
+
+	var tutor events.TutorProfilePayload
+	if err := json.Unmarshal(outbox.events[0].Payload, &tutor); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if tutor.Specialization != "math" || tutor.ExperienceYears != 5 {
+		t.Errorf("unexpected tutor payload: %+v", tutor)
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+	"user-service/internal/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const reconcilePageSize = 500
+
+// ErrReconcileRunning - предыдущая сверка еще не завершилась
+var ErrReconcileRunning = errors.New("reconciliation is already running")
+
+var (
+	reconcileDrift = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "user_reconcile_drift_records",
+		Help: "Расхождения auth-service и профилей, найденные последней сверкой.",
+	}, []string{"kind"})
+
+	reconcileRepaired = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_reconcile_repaired_total",
+		Help: "Исправленные сверкой расхождения.",
+	}, []string{"kind"})
+
+	reconcileRepairFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_reconcile_repair_failures_total",
+		Help: "Расхождения, которые не удалось исправить.",
+	}, []string{"kind"})
+
+	reconcileRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_reconcile_runs_total",
+		Help: "Запуски сверки по результату.",
+	}, []string{"result"})
+
+	reconcileLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "user_reconcile_last_success_timestamp_seconds",
+		Help: "Время окончания последней успешной сверки.",
+	})
+
+	reconcileDuration = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "user_reconcile_duration_seconds",
+		Help: "Длительность последней сверки.",
+	})
+)
+
+type AuthDirectory interface {
+	ListUsers(ctx context.Context, pageToken string, limit int32) ([]models.AuthUser, string, error)
+	UserExists(ctx context.Context, id string) (bool, error)
+}
+
+type ReconcileOptions struct {
+	// Repair - исправлять расхождения, иначе только отчет
+	Repair bool
+	// DeleteOrphans - удалять профили без пользователя в auth-service
+	DeleteOrphans bool
+	// Grace - записи моложе этого срока не проверяются, их событие может быть еще в retry-топиках
+	Grace time.Duration
+}
+
+// Reconciler сверяет пользователей auth-service с профилями и чинит профили,
+// которые разошлись из-за потерянных событий
+type Reconciler struct {
+	auth    AuthDirectory
+	repo    UserProfileRepository
+	users   ProfileWriter
+	opts    ReconcileOptions
+	admins  map[string]struct{}
+	running atomic.Bool
+	now     func() time.Time
+}
+
+func NewReconciler(auth AuthDirectory, repo UserProfileRepository, users ProfileWriter, opts ReconcileOptions, adminIDs []string) *Reconciler {
+	admins := make(map[string]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+
+	return &Reconciler{
+		auth:   auth,
+		repo:   repo,
+		users:  users,
+		opts:   opts,
+		admins: admins,
+		now:    time.Now,
+	}
+}
+
+// Start запускает сверку по расписанию, interval <= 0 отключает расписание
+func (r *Reconciler) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Run(ctx, false); err != nil {
+				log.Printf("[RECONCILE] scheduled run failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunNow - запуск по запросу администратора
+func (r *Reconciler) RunNow(ctx context.Context, userID string, dryRun bool) (*models.ReconcileReport, *models.Error) {
+	if _, ok := r.admins[userID]; !ok {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("reconciliation is available to admins only")}
+	}
+
+	report, err := r.Run(ctx, dryRun)
+	if err != nil {
+		if errors.Is(err, ErrReconcileRunning) {
+			return nil, &models.Error{Code: models.RECONCILERUNNING, Message: err}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return report, nil
+}
+
+// Run находит пропущенные, осиротевшие профили и профили с другим email.
+// Исправления выполняются только после полного обхода обеих сторон.
+func (r *Reconciler) Run(ctx context.Context, dryRun bool) (*models.ReconcileReport, error) {
+	if !r.running.CompareAndSwap(false, true) {
+		reconcileRuns.WithLabelValues("skipped").Inc()
+		return nil, ErrReconcileRunning
+	}
+	defer r.running.Store(false)
+
+	report := &models.ReconcileReport{
+		StartedAt: r.now(),
+		DryRun:    dryRun || !r.opts.Repair,
+	}
+
+	if err := r.collect(ctx, report); err != nil {
+		reconcileRuns.WithLabelValues("failed").Inc()
+		return nil, err
+	}
+
+	if !report.DryRun {
+		r.repair(ctx, report)
+	}
+
+	report.FinishedAt = r.now()
+	for _, kind := range []models.DriftKind{models.DriftMissing, models.DriftOrphaned, models.DriftEmailMismatch} {
+		reconcileDrift.WithLabelValues(string(kind)).Set(float64(report.Count(kind)))
+	}
+	reconcileRuns.WithLabelValues("success").Inc()
+	reconcileLastSuccess.Set(float64(report.FinishedAt.Unix()))
+	reconcileDuration.Set(report.FinishedAt.Sub(report.StartedAt).Seconds())
+
+	log.Printf("[RECONCILE] auth users: %d, profiles: %d, missing: %d, orphaned: %d, email mismatch: %d, dry run: %t",
+		report.AuthUsers, report.Profiles,
+		report.Count(models.DriftMissing), report.Count(models.DriftOrphaned), report.Count(models.DriftEmailMismatch),
+		report.DryRun)
+
+	return report, nil
+}
+
+func (r *Reconciler) collect(ctx context.Context, report *models.ReconcileReport) error {
+	authUsers, err := r.loadAuthUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("list auth users: %w", err)
+	}
+	report.AuthUsers = len(authUsers)
+
+	cutoff := report.StartedAt.Add(-r.opts.Grace)
+	seen := make(map[string]struct{}, len(authUsers))
+
+	var orphans, mismatches []models.DriftRecord
+	for offset := int32(0); ; offset += reconcilePageSize {
+		profiles, err := r.repo.SelectAllUsers(ctx, reconcilePageSize, offset)
+		if err != nil {
+			return fmt.Errorf("list profiles: %w", err)
+		}
+		report.Profiles += len(profiles)
+
+		for _, p := range profiles {
+			authUser, ok := authUsers[p.UserID]
+			if ok {
+				seen[p.UserID] = struct{}{}
+				if authUser.Email != p.Email {
+					mismatches = append(mismatches, models.DriftRecord{
+						Kind:         models.DriftEmailMismatch,
+						UserID:       p.UserID,
+						AuthEmail:    authUser.Email,
+						ProfileEmail: p.Email,
+					})
+				}
+				continue
+			}
+			if p.CreatedAt.After(cutoff) {
+				continue
+			}
+
+			// постраничный список мог сдвинуться во время обхода, поэтому перепроверяем точечно
+			exists, err := r.auth.UserExists(ctx, p.UserID)
+			if err != nil {
+				return fmt.Errorf("check auth user %s: %w", p.UserID, err)
+			}
+			if !exists {
+				orphans = append(orphans, models.DriftRecord{
+					Kind:         models.DriftOrphaned,
+					UserID:       p.UserID,
+					ProfileEmail: p.Email,
+				})
+			}
+		}
+
+		if len(profiles) < reconcilePageSize {
+			break
+		}
+	}
+
+	var missing []models.DriftRecord
+	for id, u := range authUsers {
+		if _, ok := seen[id]; ok || u.CreatedAt.After(cutoff) {
+			continue
+		}
+		missing = append(missing, models.DriftRecord{
+			Kind:      models.DriftMissing,
+			UserID:    id,
+			AuthEmail: u.Email,
+		})
+	}
+
+	// порядок важен для исправления: удаление сирот и смена email освобождают адреса для новых профилей
+	report.Records = append(append(orphans, mismatches...), missing...)
+	return nil
+}
+
+func (r *Reconciler) loadAuthUsers(ctx context.Context) (map[string]models.AuthUser, error) {
+	users := make(map[string]models.AuthUser)
+
+	token := ""
+	for {
+		page, next, err := r.auth.ListUsers(ctx, token, reconcilePageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range page {
+			users[u.ID] = u
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	return users, nil
+}
+
+func (r *Reconciler) repair(ctx context.Context, report *models.ReconcileReport) {
+	for i := range report.Records {
+		rec := &report.Records[i]
+
+		var err error
+		switch rec.Kind {
+		case models.DriftOrphaned:
+			if !r.opts.DeleteOrphans {
+				continue
+			}
+			err = r.deleteOrphan(ctx, rec.UserID)
+		case models.DriftEmailMismatch:
+			if e := r.users.UpdateUserEmail(ctx, rec.UserID, rec.AuthEmail); e != nil {
+				err = e.Message
+			}
+		case models.DriftMissing:
+			err = r.createMissing(ctx, rec.UserID, rec.AuthEmail)
+		}
+
+		if err != nil {
+			rec.Error = err.Error()
+			reconcileRepairFailures.WithLabelValues(string(rec.Kind)).Inc()
+			log.Printf("[RECONCILE] failed to repair %s profile %s: %v", rec.Kind, rec.UserID, err)
+			continue
+		}
+		rec.Repaired = true
+		reconcileRepaired.WithLabelValues(string(rec.Kind)).Inc()
+	}
+}
+
+func (r *Reconciler) deleteOrphan(ctx context.Context, id string) error {
+	if e := r.users.DeleteUser(ctx, id); e != nil && e.Code != models.USERNOTFOUND {
+		return e.Message
+	}
+	return nil
+}
+
+func (r *Reconciler) createMissing(ctx context.Context, id, email string) error {
+	_, e := r.users.CreateUser(ctx, &models.UserProfile{
+		UserID: id,
+		Email:  email,
+	})
+	if e == nil {
+		return nil
+	}
+	if e.Code != models.USEREXISTS {
+		return e.Message
+	}
+
+	// профиль мог появиться параллельно из события, иначе email занят другим профилем
+	if _, getErr := r.repo.GetUserByID(ctx, id); getErr == nil {
+		return nil
+	}
+	return fmt.Errorf("email %s is used by another profile", email)
+}
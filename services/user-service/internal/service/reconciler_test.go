@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+	"user-service/internal/models"
+)
+
+type mockAuthDirectory struct {
+	users   []models.AuthUser
+	listErr error
+	// hidden - пользователи, которых нет в списке, но которые находит UserExists
+	hidden map[string]bool
+}
+
+func (m *mockAuthDirectory) ListUsers(ctx context.Context, pageToken string, limit int32) ([]models.AuthUser, string, error) {
+	if m.listErr != nil {
+		return nil, "", m.listErr
+	}
+	offset := 0
+	if pageToken != "" {
+		offset, _ = strconv.Atoi(pageToken)
+	}
+	if offset >= len(m.users) {
+		return nil, "", nil
+	}
+	page := m.users[offset:]
+	if int(limit) >= len(page) {
+		return page, "", nil
+	}
+	return page[:limit], strconv.Itoa(offset + int(limit)), nil
+}
+
+func (m *mockAuthDirectory) UserExists(ctx context.Context, id string) (bool, error) {
+	if m.hidden[id] {
+		return true, nil
+	}
+	for _, u := range m.users {
+		if u.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newTestReconciler(opts ReconcileOptions) (*Reconciler, *mockAuthDirectory, *mockUserProfileRepository) {
+	userSvc, userRepo, _, _ := newTestUserService()
+	auth := &mockAuthDirectory{hidden: make(map[string]bool)}
+	return NewReconciler(auth, userRepo, userSvc, opts, []string{"admin-1"}), auth, userRepo
+}
+
+func addProfile(repo *mockUserProfileRepository, id, email string, createdAt time.Time) {
+	user := &models.UserProfile{UserID: id, Email: email, CreatedAt: createdAt}
+	repo.users[id] = user
+	repo.usersByEmail[email] = user
+}
+
+func findRecord(report *models.ReconcileReport, id string) *models.DriftRecord {
+	for i := range report.Records {
+		if report.Records[i].UserID == id {
+			return &report.Records[i]
+		}
+	}
+	return nil
+}
+
+func seedDrift(auth *mockAuthDirectory, repo *mockUserProfileRepository) {
+	old := time.Now().Add(-time.Hour)
+
+	auth.users = []models.AuthUser{
+		{ID: "ok", Email: "ok@example.com", IsActive: true, CreatedAt: old},
+		{ID: "missing", Email: "missing@example.com", IsActive: true, CreatedAt: old},
+		{ID: "inactive", Email: "inactive@example.com", IsActive: false, CreatedAt: old},
+		{ID: "changed", Email: "new@example.com", IsActive: true, CreatedAt: old},
+	}
+	addProfile(repo, "ok", "ok@example.com", old)
+	addProfile(repo, "inactive", "inactive@example.com", old)
+	addProfile(repo, "changed", "old@example.com", old)
+	addProfile(repo, "orphan", "orphan@example.com", old)
+}
+
+func TestReconciler_RepairsDrift(t *testing.T) {
+	r, auth, repo := newTestReconciler(ReconcileOptions{Repair: true, Grace: 15 * time.Minute})
+	seedDrift(auth, repo)
+
+	report, err := r.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.AuthUsers != 4 || report.Profiles != 4 {
+		t.Errorf("expected 4 auth users and 4 profiles, got %d and %d", report.AuthUsers, report.Profiles)
+	}
+	if len(report.Records) != 3 {
+		t.Fatalf("expected 3 drift records, got %+v", report.Records)
+	}
+
+	if rec := findRecord(report, "missing"); rec == nil || rec.Kind != models.DriftMissing || !rec.Repaired {
+		t.Errorf("expected repaired missing profile, got %+v", rec)
+	}
+	if p, ok := repo.users["missing"]; !ok || p.Email != "missing@example.com" {
+		t.Error("expected missing profile to be created")
+	}
+
+	if rec := findRecord(report, "changed"); rec == nil || rec.Kind != models.DriftEmailMismatch || !rec.Repaired {
+		t.Errorf("expected repaired email mismatch, got %+v", rec)
+	}
+	if repo.users["changed"].Email != "new@example.com" {
+		t.Error("expected profile email to follow auth-service")
+	}
+
+	// сироты по умолчанию только попадают в отчет
+	if rec := findRecord(report, "orphan"); rec == nil || rec.Kind != models.DriftOrphaned || rec.Repaired {
+		t.Errorf("expected reported orphan, got %+v", rec)
+	}
+	if _, ok := repo.users["orphan"]; !ok {
+		t.Error("expected orphan profile to be kept")
+	}
+}
+
+func TestReconciler_DryRunChangesNothing(t *testing.T) {
+	r, auth, repo := newTestReconciler(ReconcileOptions{Repair: true, DeleteOrphans: true, Grace: 15 * time.Minute})
+	seedDrift(auth, repo)
+
+	report, err := r.Run(context.Background(), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !report.DryRun || len(report.Records) != 3 {
+		t.Fatalf("expected dry run report with 3 records, got %+v", report)
+	}
+	for _, rec := range report.Records {
+		if rec.Repaired {
+			t.Errorf("expected nothing repaired in dry run, got %+v", rec)
+		}
+	}
+	if _, ok := repo.users["missing"]; ok {
+		t.Error("expected no profile to be created")
+	}
+	if _, ok := repo.users["orphan"]; !ok {
+		t.Error("expected orphan to be kept")
+	}
+}
+
+func TestReconciler_DeletesConfirmedOrphans(t *testing.T) {
+	r, auth, repo := newTestReconciler(ReconcileOptions{Repair: true, DeleteOrphans: true, Grace: 15 * time.Minute})
+	seedDrift(auth, repo)
+	addProfile(repo, "shifted", "shifted@example.com", time.Now().Add(-time.Hour))
+	// пользователь выпал из постраничного списка, но существует
+	auth.hidden["shifted"] = true
+
+	report, err := r.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := repo.users["orphan"]; ok {
+		t.Error("expected orphan profile to be deleted")
+	}
+	if findRecord(report, "shifted") != nil {
+		t.Error("expected existing auth user not to be reported as orphan")
+	}
+	if _, ok := repo.users["shifted"]; !ok {
+		t.Error("expected profile of existing auth user to be kept")
+	}
+}
+
+func TestReconciler_SkipsRecentRecords(t *testing.T) {
+	r, auth, repo := newTestReconciler(ReconcileOptions{Repair: true, Grace: 15 * time.Minute})
+	recent := time.Now().Add(-time.Minute)
+
+	auth.users = []models.AuthUser{{ID: "new-user", Email: "new@example.com", IsActive: true, CreatedAt: recent}}
+	addProfile(repo, "new-profile", "profile@example.com", recent)
+
+	report, err := r.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Records) != 0 {
+		t.Errorf("expected records inside grace period to be skipped, got %+v", report.Records)
+	}
+}
+
+func TestReconciler_PagesThroughAuthUsers(t *testing.T) {
+	r, auth, _ := newTestReconciler(ReconcileOptions{Grace: 15 * time.Minute})
+	old := time.Now().Add(-time.Hour)
+
+	total := reconcilePageSize + 10
+	for i := 0; i < total; i++ {
+		auth.users = append(auth.users, models.AuthUser{
+			ID:        fmt.Sprintf("user-%d", i),
+			Email:     fmt.Sprintf("user-%d@example.com", i),
+			IsActive:  i%2 == 0,
+			CreatedAt: old,
+		})
+	}
+
+	report, err := r.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.AuthUsers != total || report.Count(models.DriftMissing) != total {
+		t.Errorf("expected %d missing profiles, got %d of %d users", total, report.Count(models.DriftMissing), report.AuthUsers)
+	}
+	// RECONCILE_REPAIR выключен - только отчет
+	if !report.DryRun {
+		t.Error("expected report-only run when repair is disabled")
+	}
+}
+
+func TestReconciler_AuthFailureAbortsRun(t *testing.T) {
+	r, auth, repo := newTestReconciler(ReconcileOptions{Repair: true, DeleteOrphans: true})
+	seedDrift(auth, repo)
+	auth.listErr = errors.New("auth unavailable")
+
+	if _, err := r.Run(context.Background(), false); err == nil {
+		t.Fatal("expected error when auth-service is unavailable")
+	}
+	if _, ok := repo.users["orphan"]; !ok {
+		t.Error("expected no profiles to be deleted on failed run")
+	}
+}
+
+func TestReconciler_RunNow(t *testing.T) {
+	r, _, _ := newTestReconciler(ReconcileOptions{})
+	ctx := context.Background()
+
+	if _, e := r.RunNow(ctx, "user-1", false); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied, got %v", e)
+	}
+
+	r.running.Store(true)
+	if _, e := r.RunNow(ctx, "admin-1", false); e == nil || e.Code != models.RECONCILERUNNING {
+		t.Errorf("expected already running error, got %v", e)
+	}
+
+	r.running.Store(false)
+	if _, e := r.RunNow(ctx, "admin-1", true); e != nil {
+		t.Errorf("unexpected error: %v", e.Message)
+	}
+}
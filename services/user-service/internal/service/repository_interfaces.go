@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 	"user-service/internal/models"
 )
 
@@ -11,7 +12,10 @@ type UserProfileRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*models.UserProfile, error)
 	GetUserByID(ctx context.Context, id string) (*models.UserProfile, error)
 	GetUserTypes(ctx context.Context, id string) (*models.UserType, error)
+	ResolveUsers(ctx context.Context, ids, emails []string) ([]models.UserProfile, error)
 	UpdateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, error)
+	UpdateUserEmail(ctx context.Context, id, email string) error
+	ListUsers(ctx context.Context, q models.ProfileQuery, after *models.ProfileCursor) ([]models.UserProfile, int32, error)
 	SelectAllUsers(ctx context.Context, limit, offset int32) ([]models.UserProfile, error)
 }
 
@@ -28,3 +32,55 @@ type StudentProfileRepository interface {
 	GetStudentProfileByID(ctx context.Context, id string) (*models.StudentProfile, error)
 	UpdateStudentProfile(ctx context.Context, student *models.StudentProfile) (*models.StudentProfile, error)
 }
+
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error)
+	DeleteReview(ctx context.Context, id string) error
+	GetReviewByID(ctx context.Context, id string) (*models.TutorReview, error)
+	GetTutorRating(ctx context.Context, tutorID string) (*models.TutorRating, error)
+	ListTutorReviews(ctx context.Context, tutorID string, limit, offset int32) ([]models.TutorReview, error)
+	SetHidden(ctx context.Context, id string, hidden bool, reason string) (*models.TutorReview, error)
+	SetReply(ctx context.Context, id, reply string) (*models.TutorReview, error)
+	UpdateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error)
+}
+
+type TutorVerificationRepository interface {
+	GetTutorVerification(ctx context.Context, tutorID string) (*models.TutorVerification, error)
+	ListTutorVerifications(ctx context.Context, status models.TutorVerificationStatus, limit, offset int32) ([]models.TutorVerification, int32, error)
+	ReviewTutorVerification(ctx context.Context, tutorID string, from []models.TutorVerificationStatus, to models.TutorVerificationStatus, reviewerID, reason string) error
+	SubmitTutorVerification(ctx context.Context, tutorID string, docs []models.VerificationDocument) error
+}
+
+type GuardianRepository interface {
+	AcceptGuardianLink(ctx context.Context, id string) (*models.GuardianLink, error)
+	CreateGuardianLink(ctx context.Context, studentID, guardianID string) (*models.GuardianLink, error)
+	GetGuardianLinkByID(ctx context.Context, id string) (*models.GuardianLink, error)
+	HasAcceptedGuardianLink(ctx context.Context, guardianID, studentID string) (bool, error)
+	ListGuardianLinks(ctx context.Context, userID string) ([]models.GuardianLink, error)
+	RevokeGuardianLink(ctx context.Context, id string) error
+}
+
+type ProcessedEventRepository interface {
+	// MarkProcessed отмечает событие обработанным, false - отметка уже есть
+	MarkProcessed(ctx context.Context, eventID, eventType string) (bool, error)
+}
+
+type DLQRepository interface {
+	SaveDLQMessage(ctx context.Context, msg *models.DLQMessage) error
+	GetDLQMessage(ctx context.Context, id string) (*models.DLQMessage, error)
+	ListDLQMessages(ctx context.Context, filter models.DLQFilter) ([]models.DLQMessage, int32, error)
+	ListPendingDLQMessages(ctx context.Context, ids []string, limit int32) ([]models.DLQMessage, error)
+	ResolveDLQMessage(ctx context.Context, id string, status models.DLQMessageStatus, resolvedBy, note string) (bool, error)
+}
+
+type OutboxRepository interface {
+	AddOutboxEvent(ctx context.Context, event *models.OutboxEvent) error
+	ListUnpublishedOutboxEvents(ctx context.Context, limit int32) ([]models.OutboxEvent, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []int64) error
+	MarkOutboxEventFailed(ctx context.Context, id int64, reason string) error
+	DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
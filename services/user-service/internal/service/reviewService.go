@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+const (
+	minReviewRating     = 1
+	maxReviewRating     = 5
+	maxReviewTextLength = 2000
+
+	defaultReviewsLimit = 20
+	maxReviewsLimit     = 100
+)
+
+type GroupClient interface {
+	HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error)
+}
+
+type ReviewService struct {
+	userRepo    UserProfileRepository
+	reviewRepo  ReviewRepository
+	groupClient GroupClient
+	moderator   ReviewModerator
+	admins      map[string]struct{}
+}
+
+func NewReviewService(
+	userRepo UserProfileRepository,
+	reviewRepo ReviewRepository,
+	groupClient GroupClient,
+	moderator ReviewModerator,
+	adminIDs []string,
+) *ReviewService {
+	admins := make(map[string]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+
+	return &ReviewService{
+		userRepo:    userRepo,
+		reviewRepo:  reviewRepo,
+		groupClient: groupClient,
+		moderator:   moderator,
+		admins:      admins,
+	}
+}
+
+func (s *ReviewService) isAdmin(id string) bool {
+	_, ok := s.admins[id]
+	return ok
+}
+
+func validateReview(review *models.TutorReview) *models.Error {
+	if review.Rating < minReviewRating || review.Rating > maxReviewRating {
+		return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("rating must be between %d and %d", minReviewRating, maxReviewRating)}
+	}
+	if utf8.RuneCountInString(review.Text) > maxReviewTextLength {
+		return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("review text is longer than %d characters", maxReviewTextLength)}
+	}
+	return nil
+}
+
+func reviewRepoError(err error) *models.Error {
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return &models.Error{Code: models.REVIEWNOTFOUND, Message: err}
+	case errors.Is(err, repository.ErrReviewExists):
+		return &models.Error{Code: models.REVIEWEXISTS, Message: err}
+	default:
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+}
+
+func (s *ReviewService) CreateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, *models.Error) {
+	if e := validateReview(review); e != nil {
+		return nil, e
+	}
+	if review.TutorID == review.StudentID {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("cannot review yourself")}
+	}
+
+	tutorTypes, err := s.userRepo.GetUserTypes(ctx, review.TutorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, &models.Error{Code: models.TUTORNOTFOUND, Message: err}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if !tutorTypes.IsTutor {
+		return nil, &models.Error{Code: models.TUTORNOTFOUND, Message: fmt.Errorf("user %s is not a tutor", review.TutorID)}
+	}
+
+	studentTypes, err := s.userRepo.GetUserTypes(ctx, review.StudentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, &models.Error{Code: models.STUDENTNOTFOUND, Message: err}
+		}
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	if !studentTypes.IsStudent {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("only students can review tutors")}
+	}
+
+	shared, err := s.groupClient.HasSharedGroup(ctx, review.TutorID, review.StudentID)
+	if err != nil {
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: fmt.Errorf("failed to check group membership: %w", err)}
+	}
+	if !shared {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("student has no groups with this tutor")}
+	}
+
+	review.IsHidden, review.HiddenReason = s.moderator.Moderate(ctx, review)
+
+	created, err := s.reviewRepo.CreateReview(ctx, review)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+
+	return created, nil
+}
+
+func (s *ReviewService) UpdateReview(ctx context.Context, userID string, review *models.TutorReview) (*models.TutorReview, *models.Error) {
+	if e := validateReview(review); e != nil {
+		return nil, e
+	}
+
+	current, err := s.reviewRepo.GetReviewByID(ctx, review.ID)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+	if current.StudentID != userID {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("only the author can edit a review")}
+	}
+
+	current.Rating = review.Rating
+	current.Text = review.Text
+
+	// скрытый модератором отзыв не становится видимым после редактирования
+	if hidden, reason := s.moderator.Moderate(ctx, current); hidden {
+		current.IsHidden, current.HiddenReason = true, reason
+	}
+
+	updated, err := s.reviewRepo.UpdateReview(ctx, current)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+
+	return updated, nil
+}
+
+func (s *ReviewService) DeleteReview(ctx context.Context, userID, reviewID string) *models.Error {
+	current, err := s.reviewRepo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return reviewRepoError(err)
+	}
+	if current.StudentID != userID && !s.isAdmin(userID) {
+		return &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("only the author can delete a review")}
+	}
+
+	if err := s.reviewRepo.DeleteReview(ctx, reviewID); err != nil {
+		return reviewRepoError(err)
+	}
+
+	return nil
+}
+
+func (s *ReviewService) ReplyToReview(ctx context.Context, userID, reviewID, reply string) (*models.TutorReview, *models.Error) {
+	if utf8.RuneCountInString(reply) > maxReviewTextLength {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("reply is longer than %d characters", maxReviewTextLength)}
+	}
+
+	current, err := s.reviewRepo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+	if current.TutorID != userID {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("only the reviewed tutor can reply")}
+	}
+
+	updated, err := s.reviewRepo.SetReply(ctx, reviewID, reply)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+
+	return updated, nil
+}
+
+func (s *ReviewService) ModerateReview(ctx context.Context, userID, reviewID string, hidden bool, reason string) (*models.TutorReview, *models.Error) {
+	if !s.isAdmin(userID) {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("moderation is available to admins only")}
+	}
+	if !hidden {
+		reason = ""
+	}
+
+	updated, err := s.reviewRepo.SetHidden(ctx, reviewID, hidden, reason)
+	if err != nil {
+		return nil, reviewRepoError(err)
+	}
+
+	return updated, nil
+}
+
+func (s *ReviewService) ListTutorReviews(ctx context.Context, tutorID string, limit, offset int32) ([]models.TutorReview, *models.TutorRating, *models.Error) {
+	if limit <= 0 || limit > maxReviewsLimit {
+		limit = defaultReviewsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	reviews, err := s.reviewRepo.ListTutorReviews(ctx, tutorID, limit, offset)
+	if err != nil {
+		return nil, nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	rating, err := s.reviewRepo.GetTutorRating(ctx, tutorID)
+	if err != nil {
+		return nil, nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return reviews, rating, nil
+}
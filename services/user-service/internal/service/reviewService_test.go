@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+type mockReviewRepository struct {
+	reviews map[string]*models.TutorReview
+	nextID  int
+}
+
+func newMockReviewRepository() *mockReviewRepository {
+	return &mockReviewRepository{reviews: make(map[string]*models.TutorReview)}
+}
+
+func (m *mockReviewRepository) CreateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error) {
+	for _, r := range m.reviews {
+		if r.TutorID == review.TutorID && r.StudentID == review.StudentID {
+			return nil, repository.ErrReviewExists
+		}
+	}
+	m.nextID++
+	created := *review
+	created.ID = fmt.Sprintf("review-%d", m.nextID)
+	created.CreatedAt = time.Now()
+	m.reviews[created.ID] = &created
+	return &created, nil
+}
+
+func (m *mockReviewRepository) GetReviewByID(ctx context.Context, id string) (*models.TutorReview, error) {
+	review, exists := m.reviews[id]
+	if !exists {
+		return nil, repository.ErrReviewNotFound
+	}
+	copied := *review
+	return &copied, nil
+}
+
+func (m *mockReviewRepository) UpdateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, error) {
+	if _, exists := m.reviews[review.ID]; !exists {
+		return nil, repository.ErrReviewNotFound
+	}
+	updated := *review
+	m.reviews[review.ID] = &updated
+	return &updated, nil
+}
+
+func (m *mockReviewRepository) SetReply(ctx context.Context, id, reply string) (*models.TutorReview, error) {
+	review, exists := m.reviews[id]
+	if !exists {
+		return nil, repository.ErrReviewNotFound
+	}
+	now := time.Now()
+	review.TutorReply = reply
+	review.RepliedAt = &now
+	return review, nil
+}
+
+func (m *mockReviewRepository) SetHidden(ctx context.Context, id string, hidden bool, reason string) (*models.TutorReview, error) {
+	review, exists := m.reviews[id]
+	if !exists {
+		return nil, repository.ErrReviewNotFound
+	}
+	review.IsHidden = hidden
+	review.HiddenReason = reason
+	return review, nil
+}
+
+func (m *mockReviewRepository) DeleteReview(ctx context.Context, id string) error {
+	if _, exists := m.reviews[id]; !exists {
+		return repository.ErrReviewNotFound
+	}
+	delete(m.reviews, id)
+	return nil
+}
+
+func (m *mockReviewRepository) ListTutorReviews(ctx context.Context, tutorID string, limit, offset int32) ([]models.TutorReview, error) {
+	var result []models.TutorReview
+	for _, r := range m.reviews {
+		if r.TutorID == tutorID && !r.IsHidden {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockReviewRepository) GetTutorRating(ctx context.Context, tutorID string) (*models.TutorRating, error) {
+	var rating models.TutorRating
+	var sum int32
+	for _, r := range m.reviews {
+		if r.TutorID == tutorID && !r.IsHidden {
+			sum += r.Rating
+			rating.Count++
+		}
+	}
+	if rating.Count > 0 {
+		rating.Average = float64(sum) / float64(rating.Count)
+	}
+	return &rating, nil
+}
+
+type mockGroupClient struct {
+	shared map[string]bool
+	err    error
+}
+
+func (m *mockGroupClient) HasSharedGroup(ctx context.Context, tutorID, studentID string) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	return m.shared[tutorID+":"+studentID], nil
+}
+
+func newTestReviewService() (*ReviewService, *mockReviewRepository, *mockGroupClient) {
+	userRepo := newMockUserProfileRepository()
+	userRepo.users["tutor-1"] = &models.UserProfile{UserID: "tutor-1", UserType: models.UserType{IsTutor: true}}
+	userRepo.users["student-1"] = &models.UserProfile{UserID: "student-1", UserType: models.UserType{IsStudent: true}}
+	userRepo.users["student-2"] = &models.UserProfile{UserID: "student-2", UserType: models.UserType{IsStudent: true}}
+
+	reviewRepo := newMockReviewRepository()
+	groupClient := &mockGroupClient{shared: map[string]bool{"tutor-1:student-1": true}}
+
+	svc := NewReviewService(userRepo, reviewRepo, groupClient, NewKeywordModerator([]string{"badword"}), []string{"admin-1"})
+	return svc, reviewRepo, groupClient
+}
+
+func TestReviewService_CreateReview_Success(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	review, err := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 5, Text: "great"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if review.ID == "" {
+		t.Error("expected review id")
+	}
+	if review.IsHidden {
+		t.Error("expected visible review")
+	}
+}
+
+func TestReviewService_CreateReview_InvalidRating(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	_, err := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 6})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.INVALIDINPUT {
+		t.Errorf("expected error code %s, got %s", models.INVALIDINPUT, err.Code)
+	}
+}
+
+func TestReviewService_CreateReview_NoSharedGroup(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	_, err := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-2", Rating: 4})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected error code %s, got %s", models.PERMISSIONDENIED, err.Code)
+	}
+}
+
+func TestReviewService_CreateReview_Duplicate(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	_, _ = svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 5})
+	_, err := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 3})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.REVIEWEXISTS {
+		t.Errorf("expected error code %s, got %s", models.REVIEWEXISTS, err.Code)
+	}
+}
+
+func TestReviewService_CreateReview_Moderated(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	review, err := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 1, Text: "BadWord!"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !review.IsHidden {
+		t.Error("expected review to be hidden by moderation")
+	}
+
+	_, rating, _ := svc.ListTutorReviews(ctx, "tutor-1", 10, 0)
+	if rating.Count != 0 {
+		t.Errorf("expected hidden review to be excluded from rating, got count %d", rating.Count)
+	}
+}
+
+func TestReviewService_UpdateReview_NotAuthor(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	review, _ := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 5})
+
+	_, err := svc.UpdateReview(ctx, "student-2", &models.TutorReview{ID: review.ID, Rating: 1})
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected error code %s, got %s", models.PERMISSIONDENIED, err.Code)
+	}
+}
+
+func TestReviewService_ReplyToReview_OnlyTutor(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	review, _ := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 4})
+
+	if _, err := svc.ReplyToReview(ctx, "student-1", review.ID, "thanks"); err == nil || err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected %s, got %v", models.PERMISSIONDENIED, err)
+	}
+
+	replied, err := svc.ReplyToReview(ctx, "tutor-1", review.ID, "thanks")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replied.TutorReply != "thanks" || replied.RepliedAt == nil {
+		t.Error("expected reply to be saved")
+	}
+}
+
+func TestReviewService_ModerateReview_AdminOnly(t *testing.T) {
+	svc, _, _ := newTestReviewService()
+	ctx := context.Background()
+
+	review, _ := svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 2})
+
+	if _, err := svc.ModerateReview(ctx, "tutor-1", review.ID, true, "spam"); err == nil || err.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected %s, got %v", models.PERMISSIONDENIED, err)
+	}
+
+	moderated, err := svc.ModerateReview(ctx, "admin-1", review.ID, true, "spam")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !moderated.IsHidden || moderated.HiddenReason != "spam" {
+		t.Error("expected review to be hidden")
+	}
+}
+
+func TestReviewService_ListTutorReviews_Rating(t *testing.T) {
+	svc, _, groupClient := newTestReviewService()
+	ctx := context.Background()
+	groupClient.shared["tutor-1:student-2"] = true
+
+	_, _ = svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-1", Rating: 5})
+	_, _ = svc.CreateReview(ctx, &models.TutorReview{TutorID: "tutor-1", StudentID: "student-2", Rating: 4})
+
+	reviews, rating, err := svc.ListTutorReviews(ctx, "tutor-1", 0, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Errorf("expected 2 reviews, got %d", len(reviews))
+	}
+	if rating.Count != 2 || rating.Average != 4.5 {
+		t.Errorf("expected rating 4.5 from 2 reviews, got %v from %d", rating.Average, rating.Count)
+	}
+}
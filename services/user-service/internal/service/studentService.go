@@ -10,15 +10,21 @@ import (
 type StudentService struct {
 	userRepo    UserProfileRepository
 	studentRepo   StudentProfileRepository
+	tx          Transactor
+	events      *ProfileEvents
 }
 
 func NewStudentService(
 	userRepo UserProfileRepository,
 	studentRepo StudentProfileRepository,
+	tx Transactor,
+	events *ProfileEvents,
 ) *StudentService {
 	return &StudentService{
 		userRepo:    userRepo,
 		studentRepo:   studentRepo,
+		tx:          tx,
+		events:      events,
 	}
 }
 
@@ -33,7 +39,15 @@ func (s *StudentService) CreateStudentProfile(ctx context.Context, student *mode
 		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
 
-	studentProfile, err := s.studentRepo.CreateStudentProfile(ctx, student)
+	var studentProfile *models.StudentProfile
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		studentProfile, err = s.studentRepo.CreateStudentProfile(ctx, student)
+		if err != nil {
+			return err
+		}
+		return s.events.StudentProfileCreated(ctx, studentProfile)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserExists){
 			return nil, &models.Error{Code: models.TUTOREXISTS, Message: err}
@@ -69,7 +83,12 @@ func (s *StudentService) UpdateStudentProfile(ctx context.Context, student *mode
 }
 
 func (s *StudentService) DeleteStudentProfile(ctx context.Context, id string) *models.Error {
-	err := s.studentRepo.DeleteStudentProfie(ctx, id)
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.studentRepo.DeleteStudentProfie(ctx, id); err != nil {
+			return err
+		}
+		return s.events.StudentProfileDeleted(ctx, id)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserNotFound){
 			return &models.Error{Code: models.TUTORNOTFOUND, Message: err}
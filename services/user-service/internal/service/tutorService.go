@@ -10,15 +10,24 @@ import (
 type TutorService struct {
 	userRepo    UserProfileRepository
 	tutorRepo   TutorProfileRepository
+	reviewRepo  ReviewRepository
+	tx          Transactor
+	events      *ProfileEvents
 }
 
 func NewTutorService(
 	userRepo UserProfileRepository,
 	tutorRepo TutorProfileRepository,
+	reviewRepo ReviewRepository,
+	tx Transactor,
+	events *ProfileEvents,
 ) *TutorService {
 	return &TutorService{
 		userRepo:    userRepo,
 		tutorRepo:   tutorRepo,
+		reviewRepo:  reviewRepo,
+		tx:          tx,
+		events:      events,
 	}
 }
 
@@ -32,7 +41,15 @@ func (s *TutorService) CreateTutorProfile(ctx context.Context, tutor *models.Tut
 		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
 
-	tutorProfile, err := s.tutorRepo.CreateTutorProfile(ctx, tutor)
+	var tutorProfile *models.TutorProfile
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		tutorProfile, err = s.tutorRepo.CreateTutorProfile(ctx, tutor)
+		if err != nil {
+			return err
+		}
+		return s.events.TutorProfileCreated(ctx, tutorProfile)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserExists){
 			return nil, &models.Error{Code: models.TUTOREXISTS, Message: err}
@@ -52,6 +69,12 @@ func (s *TutorService) GetTutorProfile(ctx context.Context, id string) (*models.
 		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
 
+	rating, err := s.reviewRepo.GetTutorRating(ctx, id)
+	if err != nil{
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+	tutorProfile.Rating = *rating
+
 	return tutorProfile, nil
 }
 
@@ -68,7 +91,12 @@ func (s *TutorService) UpdateTutorProfile(ctx context.Context, tutor *models.Tut
 }
 
 func (s *TutorService) DeleteTutorProfile(ctx context.Context, id string) *models.Error {
-	err := s.tutorRepo.DeleteTutorProfie(ctx, id)
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.tutorRepo.DeleteTutorProfie(ctx, id); err != nil {
+			return err
+		}
+		return s.events.TutorProfileDeleted(ctx, id)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserNotFound){
 			return &models.Error{Code: models.TUTORNOTFOUND, Message: err}
@@ -79,11 +107,23 @@ func (s *TutorService) DeleteTutorProfile(ctx context.Context, id string) *model
 	return nil 
 }
 
+// ValidateTutor - вести группы могут только репетиторы, прошедшие проверку
 func (s *TutorService) ValidateTutor(ctx context.Context, id string) (bool, *models.Error){
 	types, err := s.userRepo.GetUserTypes(ctx, id)
 	if err != nil{
 		return false, &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
+	if !types.IsTutor {
+		return false, nil
+	}
+
+	tutorProfile, err := s.tutorRepo.GetTutorProfileByID(ctx, id)
+	if err != nil{
+		if errors.Is(err, repository.ErrUserNotFound){
+			return false, nil
+		}
+		return false, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
 
-	return types.IsTutor, nil
+	return tutorProfile.VerificationStatus == models.TutorVerified, nil
 }
\ No newline at end of file
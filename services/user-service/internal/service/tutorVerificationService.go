@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+const (
+	maxVerificationDocuments  = 10
+	defaultVerificationsLimit = 50
+	maxVerificationsLimit     = 200
+)
+
+// TutorVerificationService - проверка репетиторов:
+// UNVERIFIED/REJECTED -> PENDING (заявка с документами) -> VERIFIED или REJECTED (решение администратора).
+// Проверенного репетитора администратор может отклонить позже с указанием причины.
+type TutorVerificationService struct {
+	repo   TutorVerificationRepository
+	admins map[string]struct{}
+	// хосты хранилища сервиса загрузок, ссылки на другие адреса не принимаются
+	uploadHosts map[string]struct{}
+}
+
+func NewTutorVerificationService(repo TutorVerificationRepository, adminIDs, uploadHosts []string) *TutorVerificationService {
+	admins := make(map[string]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+	hosts := make(map[string]struct{}, len(uploadHosts))
+	for _, h := range uploadHosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts[h] = struct{}{}
+		}
+	}
+
+	return &TutorVerificationService{
+		repo:        repo,
+		admins:      admins,
+		uploadHosts: hosts,
+	}
+}
+
+func (s *TutorVerificationService) isAdmin(userID string) bool {
+	_, ok := s.admins[userID]
+	return ok
+}
+
+func verificationRepoError(err error) *models.Error {
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		return &models.Error{Code: models.TUTORNOTFOUND, Message: err}
+	case errors.Is(err, repository.ErrVerificationStateChanged):
+		return &models.Error{Code: models.VERIFICATIONCONFLICT, Message: err}
+	default:
+		return &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+}
+
+// Submit - репетитор отправляет ссылки на документы, загруженные через сервис загрузок.
+// Повторная заявка после отказа заменяет прежние документы.
+func (s *TutorVerificationService) Submit(ctx context.Context, userID, tutorID string, docs []models.VerificationDocument) (*models.TutorVerification, *models.Error) {
+	if userID != tutorID {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("tutors can submit only their own verification")}
+	}
+	if e := s.validateVerificationDocuments(docs); e != nil {
+		return nil, e
+	}
+
+	current, err := s.repo.GetTutorVerification(ctx, tutorID)
+	if err != nil {
+		return nil, verificationRepoError(err)
+	}
+	if current.Status != models.TutorUnverified && current.Status != models.TutorRejected {
+		return nil, &models.Error{Code: models.VERIFICATIONCONFLICT, Message: fmt.Errorf("verification is already %s", current.Status)}
+	}
+
+	if err := s.repo.SubmitTutorVerification(ctx, tutorID, docs); err != nil {
+		return nil, verificationRepoError(err)
+	}
+
+	return s.get(ctx, tutorID)
+}
+
+// Get - заявку видят сам репетитор и администраторы
+func (s *TutorVerificationService) Get(ctx context.Context, userID, tutorID string) (*models.TutorVerification, *models.Error) {
+	if userID != tutorID && !s.isAdmin(userID) {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("verification is available to the tutor and admins only")}
+	}
+
+	return s.get(ctx, tutorID)
+}
+
+// List - очередь заявок для администраторов, по умолчанию ожидающие проверки
+func (s *TutorVerificationService) List(ctx context.Context, userID string, status models.TutorVerificationStatus, limit, offset int32) ([]models.TutorVerification, int32, *models.Error) {
+	if !s.isAdmin(userID) {
+		return nil, 0, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("verification queue is available to admins only")}
+	}
+	if status == "" {
+		status = models.TutorVerificationPending
+	}
+	if limit <= 0 || limit > maxVerificationsLimit {
+		limit = defaultVerificationsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	verifications, total, err := s.repo.ListTutorVerifications(ctx, status, limit, offset)
+	if err != nil {
+		return nil, 0, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return verifications, total, nil
+}
+
+// Review - решение администратора. Одобрить можно только заявку на проверке,
+// отклонить - заявку или уже проверенного репетитора, причина при отказе обязательна.
+func (s *TutorVerificationService) Review(ctx context.Context, userID, tutorID string, approve bool, reason string) (*models.TutorVerification, *models.Error) {
+	if !s.isAdmin(userID) {
+		return nil, &models.Error{Code: models.PERMISSIONDENIED, Message: fmt.Errorf("verification review is available to admins only")}
+	}
+
+	reason = strings.TrimSpace(reason)
+	to := models.TutorVerified
+	from := []models.TutorVerificationStatus{models.TutorVerificationPending}
+	if !approve {
+		if reason == "" {
+			return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("reason is required to reject verification")}
+		}
+		to = models.TutorRejected
+		from = append(from, models.TutorVerified)
+	}
+
+	current, err := s.repo.GetTutorVerification(ctx, tutorID)
+	if err != nil {
+		return nil, verificationRepoError(err)
+	}
+	if !containsStatus(from, current.Status) {
+		return nil, &models.Error{Code: models.VERIFICATIONCONFLICT, Message: fmt.Errorf("cannot move verification from %s to %s", current.Status, to)}
+	}
+
+	if err := s.repo.ReviewTutorVerification(ctx, tutorID, from, to, userID, reason); err != nil {
+		return nil, verificationRepoError(err)
+	}
+
+	return s.get(ctx, tutorID)
+}
+
+func (s *TutorVerificationService) get(ctx context.Context, tutorID string) (*models.TutorVerification, *models.Error) {
+	v, err := s.repo.GetTutorVerification(ctx, tutorID)
+	if err != nil {
+		return nil, verificationRepoError(err)
+	}
+	return v, nil
+}
+
+// validateVerificationDocuments принимает только https-ссылки на хранилище сервиса загрузок:
+// администратор открывает документы, и ссылка на произвольный сайт ведет его туда же
+func (s *TutorVerificationService) validateVerificationDocuments(docs []models.VerificationDocument) *models.Error {
+	if len(docs) == 0 || len(docs) > maxVerificationDocuments {
+		return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("from 1 to %d documents are required", maxVerificationDocuments)}
+	}
+
+	for i := range docs {
+		docs[i].Name = strings.TrimSpace(docs[i].Name)
+		if docs[i].Name == "" {
+			return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("document %d: name is required", i+1)}
+		}
+		u, err := url.Parse(strings.TrimSpace(docs[i].URL))
+		if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil {
+			return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("document %d: url must be an absolute https link", i+1)}
+		}
+		if _, ok := s.uploadHosts[strings.ToLower(u.Hostname())]; !ok {
+			return &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("document %d: url must point to the uploads storage", i+1)}
+		}
+		docs[i].URL = u.String()
+	}
+
+	return nil
+}
+
+func containsStatus(statuses []models.TutorVerificationStatus, status models.TutorVerificationStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
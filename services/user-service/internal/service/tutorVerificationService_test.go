@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+	"user-service/internal/models"
+	"user-service/internal/repository"
+)
+
+type mockTutorVerificationRepository struct {
+	verifications map[string]*models.TutorVerification
+}
+
+func newMockTutorVerificationRepository() *mockTutorVerificationRepository {
+	return &mockTutorVerificationRepository{verifications: make(map[string]*models.TutorVerification)}
+}
+
+func (m *mockTutorVerificationRepository) GetTutorVerification(ctx context.Context, tutorID string) (*models.TutorVerification, error) {
+	v, ok := m.verifications[tutorID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+	copied := *v
+	return &copied, nil
+}
+
+func (m *mockTutorVerificationRepository) ListTutorVerifications(ctx context.Context, status models.TutorVerificationStatus, limit, offset int32) ([]models.TutorVerification, int32, error) {
+	var res []models.TutorVerification
+	for _, v := range m.verifications {
+		if v.Status == status {
+			res = append(res, *v)
+		}
+	}
+	return res, int32(len(res)), nil
+}
+
+func (m *mockTutorVerificationRepository) ReviewTutorVerification(ctx context.Context, tutorID string, from []models.TutorVerificationStatus, to models.TutorVerificationStatus, reviewerID, reason string) error {
+	v, ok := m.verifications[tutorID]
+	if !ok || !containsStatus(from, v.Status) {
+		return repository.ErrVerificationStateChanged
+	}
+	now := time.Now()
+	v.Status = to
+	v.Reason = reason
+	v.ReviewedBy = reviewerID
+	v.ReviewedAt = &now
+	return nil
+}
+
+func (m *mockTutorVerificationRepository) SubmitTutorVerification(ctx context.Context, tutorID string, docs []models.VerificationDocument) error {
+	v, ok := m.verifications[tutorID]
+	if !ok || (v.Status != models.TutorUnverified && v.Status != models.TutorRejected) {
+		return repository.ErrVerificationStateChanged
+	}
+	now := time.Now()
+	v.Status = models.TutorVerificationPending
+	v.Reason = ""
+	v.SubmittedAt = &now
+	v.Documents = docs
+	return nil
+}
+
+func newTestVerificationService() (*TutorVerificationService, *mockTutorVerificationRepository) {
+	repo := newMockTutorVerificationRepository()
+	repo.verifications["tutor-1"] = &models.TutorVerification{TutorID: "tutor-1", Status: models.TutorUnverified}
+	return NewTutorVerificationService(repo, []string{"admin-1"}, []string{"uploads.example.com"}), repo
+}
+
+func testDocuments() []models.VerificationDocument {
+	return []models.VerificationDocument{{Name: "Диплом", URL: "https://uploads.example.com/files/diploma.pdf"}}
+}
+
+func TestTutorVerificationService_SubmitAndApprove(t *testing.T) {
+	svc, _ := newTestVerificationService()
+	ctx := context.Background()
+
+	v, e := svc.Submit(ctx, "tutor-1", "tutor-1", testDocuments())
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if v.Status != models.TutorVerificationPending || len(v.Documents) != 1 {
+		t.Fatalf("expected pending verification with documents, got %+v", v)
+	}
+
+	pending, total, e := svc.List(ctx, "admin-1", "", 0, 0)
+	if e != nil || total != 1 || pending[0].TutorID != "tutor-1" {
+		t.Fatalf("expected tutor in pending queue, got %+v", pending)
+	}
+
+	v, e = svc.Review(ctx, "admin-1", "tutor-1", true, "")
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if v.Status != models.TutorVerified || v.ReviewedBy != "admin-1" {
+		t.Errorf("expected verified by admin, got %+v", v)
+	}
+
+	// повторная заявка проверенного репетитора не нужна
+	if _, e := svc.Submit(ctx, "tutor-1", "tutor-1", testDocuments()); e == nil || e.Code != models.VERIFICATIONCONFLICT {
+		t.Errorf("expected conflict on resubmit, got %v", e)
+	}
+}
+
+func TestTutorVerificationService_RejectRequiresReason(t *testing.T) {
+	svc, _ := newTestVerificationService()
+	ctx := context.Background()
+
+	_, _ = svc.Submit(ctx, "tutor-1", "tutor-1", testDocuments())
+
+	if _, e := svc.Review(ctx, "admin-1", "tutor-1", false, " "); e == nil || e.Code != models.INVALIDINPUT {
+		t.Fatalf("expected invalid input without reason, got %v", e)
+	}
+
+	v, e := svc.Review(ctx, "admin-1", "tutor-1", false, "документ не читается")
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e.Message)
+	}
+	if v.Status != models.TutorRejected || v.Reason != "документ не читается" {
+		t.Errorf("expected rejection with reason, got %+v", v)
+	}
+
+	// после отказа можно подать заявку снова
+	v, e = svc.Submit(ctx, "tutor-1", "tutor-1", testDocuments())
+	if e != nil || v.Status != models.TutorVerificationPending || v.Reason != "" {
+		t.Errorf("expected resubmitted verification, got %+v, %v", v, e)
+	}
+}
+
+func TestTutorVerificationService_InvalidTransitions(t *testing.T) {
+	svc, repo := newTestVerificationService()
+	ctx := context.Background()
+
+	if _, e := svc.Review(ctx, "admin-1", "tutor-1", true, ""); e == nil || e.Code != models.VERIFICATIONCONFLICT {
+		t.Errorf("expected conflict when approving without submission, got %v", e)
+	}
+
+	repo.verifications["tutor-1"].Status = models.TutorVerified
+	v, e := svc.Review(ctx, "admin-1", "tutor-1", false, "жалобы учеников")
+	if e != nil || v.Status != models.TutorRejected {
+		t.Errorf("expected verified tutor to be revoked, got %+v, %v", v, e)
+	}
+
+	if _, e := svc.Review(ctx, "admin-1", "missing", true, ""); e == nil || e.Code != models.TUTORNOTFOUND {
+		t.Errorf("expected tutor not found, got %v", e)
+	}
+}
+
+func TestTutorVerificationService_Permissions(t *testing.T) {
+	svc, _ := newTestVerificationService()
+	ctx := context.Background()
+
+	if _, e := svc.Submit(ctx, "user-2", "tutor-1", testDocuments()); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on foreign submit, got %v", e)
+	}
+	if _, e := svc.Get(ctx, "user-2", "tutor-1"); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on foreign get, got %v", e)
+	}
+	if _, e := svc.Get(ctx, "admin-1", "tutor-1"); e != nil {
+		t.Errorf("expected admin to see verification, got %v", e.Message)
+	}
+	if _, e := svc.Review(ctx, "tutor-1", "tutor-1", true, ""); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on self review, got %v", e)
+	}
+	if _, _, e := svc.List(ctx, "tutor-1", "", 0, 0); e == nil || e.Code != models.PERMISSIONDENIED {
+		t.Errorf("expected permission denied on list, got %v", e)
+	}
+}
+
+func TestTutorVerificationService_ValidatesDocuments(t *testing.T) {
+	svc, _ := newTestVerificationService()
+	ctx := context.Background()
+
+	cases := map[string][]models.VerificationDocument{
+		"no documents":  nil,
+		"empty name":    {{Name: " ", URL: "https://uploads.example.com/a.pdf"}},
+		"relative url":  {{Name: "Диплом", URL: "/files/a.pdf"}},
+		"not http link": {{Name: "Диплом", URL: "ftp://uploads.example.com/a.pdf"}},
+		"plain http":    {{Name: "Диплом", URL: "http://uploads.example.com/a.pdf"}},
+		"foreign host":  {{Name: "Диплом", URL: "https://evil.example.net/a.pdf"}},
+		"userinfo":      {{Name: "Диплом", URL: "https://uploads.example.com@evil.example.net/a.pdf"}},
+	}
+	for name, docs := range cases {
+		if _, e := svc.Submit(ctx, "tutor-1", "tutor-1", docs); e == nil || e.Code != models.INVALIDINPUT {
+			t.Errorf("%s: expected invalid input, got %v", name, e)
+		}
+	}
+}
+
+func TestTutorService_ValidateTutorRequiresVerification(t *testing.T) {
+	userRepo := newMockUserProfileRepository()
+	tutorRepo := newMockTutorProfileRepository()
+	svc := NewTutorService(userRepo, tutorRepo, newMockReviewRepository(), &mockTransactor{}, NewProfileEvents(newMockOutboxRepository(), testEventsTopic))
+	ctx := context.Background()
+
+	userRepo.users["tutor-1"] = &models.UserProfile{UserID: "tutor-1", UserType: models.UserType{IsTutor: true}}
+	tutorRepo.tutors["tutor-1"] = &models.TutorProfile{UserID: "tutor-1", VerificationStatus: models.TutorVerificationPending}
+
+	if ok, e := svc.ValidateTutor(ctx, "tutor-1"); e != nil || ok {
+		t.Errorf("expected unverified tutor to be invalid, got %t, %v", ok, e)
+	}
+
+	tutorRepo.tutors["tutor-1"].VerificationStatus = models.TutorVerified
+	if ok, e := svc.ValidateTutor(ctx, "tutor-1"); e != nil || !ok {
+		t.Errorf("expected verified tutor to be valid, got %t, %v", ok, e)
+	}
+}
@@ -3,31 +3,57 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"user-service/internal/models"
 	"user-service/internal/repository"
 )
 
+const (
+	// сколько ID и email вместе можно разрешить за один запрос
+	maxResolveUsers    = 500
+	maxAvatarURLLength = 2048
+)
+
 type UserService struct {
 	userRepo    UserProfileRepository
 	tutorRepo   TutorProfileRepository
 	studentRepo StudentProfileRepository
+	tx          Transactor
+	events      *ProfileEvents
 }
 
 func NewUserService(
 	userRepo UserProfileRepository,
 	tutorRepo TutorProfileRepository,
 	studentRepo StudentProfileRepository,
+	tx Transactor,
+	events *ProfileEvents,
 ) *UserService {
 	return &UserService{
 		userRepo:    userRepo,
 		tutorRepo:   tutorRepo,
 		studentRepo: studentRepo,
+		tx:          tx,
+		events:      events,
 	}
 }
 
 func (s *UserService) CreateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, *models.Error) {
-	
-	resp_user, err := s.userRepo.CreateUser(ctx, user)
+	if e := normalizePreferences(user); e != nil {
+		return nil, e
+	}
+
+	var resp_user *models.UserProfile
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		resp_user, err = s.userRepo.CreateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		return s.events.UserProfileUpdated(ctx, resp_user)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserExists){
 			return nil, &models.Error{Code: models.USEREXISTS, Message: err}
@@ -63,7 +89,24 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*models
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, *models.Error) {
-	resp_user, err := s.userRepo.UpdateUser(ctx, user)
+	if e := normalizePreferences(user); e != nil {
+		return nil, e
+	}
+	avatarURL, e := normalizeAvatarURL(user.AvatarURL)
+	if e != nil {
+		return nil, e
+	}
+	user.AvatarURL = avatarURL
+
+	var resp_user *models.UserProfile
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		resp_user, err = s.userRepo.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		return s.events.UserProfileUpdated(ctx, resp_user)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserNotFound){
 			return nil, &models.Error{Code: models.USERNOTFOUND, Message: err}
@@ -74,31 +117,95 @@ func (s *UserService) UpdateUser(ctx context.Context, user *models.UserProfile)
 	return resp_user, nil
 }
 
-func (s *UserService) DeleteUser(ctx context.Context, id string) *models.Error {
-	user, err := s.userRepo.GetUserByID(ctx, id)
+// ResolveUsers ищет профили по ID и email, ненайденные просто отсутствуют в ответе
+func (s *UserService) ResolveUsers(ctx context.Context, ids, emails []string) ([]models.UserProfile, *models.Error) {
+	if len(ids)+len(emails) > maxResolveUsers {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("at most %d user ids and emails per request", maxResolveUsers)}
+	}
+	if len(ids)+len(emails) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.userRepo.ResolveUsers(ctx, ids, emails)
+	if err != nil {
+		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
+	}
+
+	return users, nil
+}
+
+// normalizeAvatarURL допускает только абсолютные http(s) ссылки, пустая строка оставляет текущий аватар
+func normalizeAvatarURL(raw string) (string, *models.Error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(raw) > maxAvatarURLLength {
+		return "", &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("avatar_url must be an http(s) link up to %d characters", maxAvatarURLLength)}
+	}
+	return raw, nil
+}
+
+// UpdateUserEmail меняет email профиля вслед за auth-service
+func (s *UserService) UpdateUserEmail(ctx context.Context, id, email string) *models.Error {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.UpdateUserEmail(ctx, id, email); err != nil {
+			return err
+		}
+		user, err := s.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return s.events.UserProfileUpdated(ctx, user)
+	})
 	if err != nil{
 		if errors.Is(err, repository.ErrUserNotFound){
 			return &models.Error{Code: models.USERNOTFOUND, Message: err}
 		}
+		if errors.Is(err, repository.ErrUserExists){
+			return &models.Error{Code: models.USEREXISTS, Message: err}
+		}
 		return &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
 
-	if user.IsTutor {
-		err = s.tutorRepo.DeleteTutorProfie(ctx, id)
-		if err != nil{
-			return &models.Error{Code: models.INTERNALERROR, Message: err}
+	return nil
+}
+
+func (s *UserService) DeleteUser(ctx context.Context, id string) *models.Error {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
 		}
-	}
 
-	if user.IsStudent {
-		err = s.studentRepo.DeleteStudentProfie(ctx, id)
-		if err != nil{
-			return &models.Error{Code: models.INTERNALERROR, Message: err}
+		if user.IsTutor {
+			if err := s.tutorRepo.DeleteTutorProfie(ctx, id); err != nil {
+				return err
+			}
+			if err := s.events.TutorProfileDeleted(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		if user.IsStudent {
+			if err := s.studentRepo.DeleteStudentProfie(ctx, id); err != nil {
+				return err
+			}
+			if err := s.events.StudentProfileDeleted(ctx, id); err != nil {
+				return err
+			}
 		}
-	}
 
-	err = s.userRepo.DeleteUser(ctx, id)
+		if err := s.userRepo.DeleteUser(ctx, id); err != nil {
+			return err
+		}
+		return s.events.UserProfileDeleted(ctx, id)
+	})
 	if err != nil{
+		if errors.Is(err, repository.ErrUserNotFound){
+			return &models.Error{Code: models.USERNOTFOUND, Message: err}
+		}
 		return &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
 
@@ -121,10 +228,45 @@ func (s *UserService) GetUserTypes(ctx context.Context, id string) (*models.User
 
 
 
-func (s *UserService) GetAllUsers(ctx context.Context, limit, offset int32) ([]models.UserProfile, *models.Error) {
-	users, err := s.userRepo.SelectAllUsers(ctx, limit, offset)
+func (s *UserService) GetAllUsers(ctx context.Context, q models.ProfileQuery) (*models.ProfilePage, *models.Error) {
+	if q.ActiveOnly {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("profiles do not carry account status, filter by status with auth ListUsers")}
+	}
+	if q.Limit <= 0 || q.Limit > maxProfilesLimit {
+		q.Limit = defaultProfilesLimit
+	}
+	if q.Offset < 0 {
+		q.Offset = 0
+	}
+	if q.SortBy == "" {
+		q.SortBy = models.ProfileSortCreatedAt
+	}
+	if q.CreatedFrom != nil && q.CreatedTo != nil && !q.CreatedFrom.Before(*q.CreatedTo) {
+		return nil, &models.Error{Code: models.INVALIDINPUT, Message: fmt.Errorf("created_from must be before created_to")}
+	}
+
+	var after *models.ProfileCursor
+	if q.PageToken != "" {
+		cursor, err := decodeProfileCursor(q.PageToken, q)
+		if err != nil {
+			return nil, &models.Error{Code: models.INVALIDINPUT, Message: err}
+		}
+		after = cursor
+	}
+
+	// лишняя запись показывает, есть ли следующая страница
+	limit := q.Limit
+	q.Limit++
+	users, total, err := s.userRepo.ListUsers(ctx, q, after)
 	if err != nil{
 		return nil, &models.Error{Code: models.INTERNALERROR, Message: err}
 	}
-	return users, nil
+
+	page := &models.ProfilePage{Users: users, Total: total}
+	if int32(len(users)) > limit {
+		page.Users = users[:limit]
+		page.NextPageToken = encodeProfileCursor(profileCursor(page.Users[limit-1], q))
+	}
+
+	return page, nil
 }
\ No newline at end of file
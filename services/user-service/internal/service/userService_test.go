@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 	"user-service/internal/models"
@@ -86,6 +89,23 @@ func (m *mockUserProfileRepository) GetUserTypes(ctx context.Context, id string)
 	}, nil
 }
 
+func (m *mockUserProfileRepository) ResolveUsers(ctx context.Context, ids, emails []string) ([]models.UserProfile, error) {
+	var result []models.UserProfile
+	for _, user := range m.users {
+		for _, id := range ids {
+			if user.UserID == id {
+				result = append(result, *user)
+			}
+		}
+		for _, email := range emails {
+			if strings.EqualFold(user.Email, email) {
+				result = append(result, *user)
+			}
+		}
+	}
+	return result, nil
+}
+
 func (m *mockUserProfileRepository) UpdateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, error) {
 	if m.updateErr != nil {
 		return nil, m.updateErr
@@ -103,17 +123,93 @@ func (m *mockUserProfileRepository) UpdateUser(ctx context.Context, user *models
 	if user.Telegram != "" {
 		existing.Telegram = user.Telegram
 	}
+	if user.Timezone != "" {
+		existing.Timezone = user.Timezone
+	}
+	if user.Locale != "" {
+		existing.Locale = user.Locale
+	}
+	if user.AvatarURL != "" {
+		existing.AvatarURL = user.AvatarURL
+	}
 	return existing, nil
 }
 
+func (m *mockUserProfileRepository) UpdateUserEmail(ctx context.Context, id, email string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	user, exists := m.users[id]
+	if !exists {
+		return repository.ErrUserNotFound
+	}
+	delete(m.usersByEmail, user.Email)
+	user.Email = email
+	m.usersByEmail[email] = user
+	return nil
+}
+
 func (m *mockUserProfileRepository) SelectAllUsers(ctx context.Context, limit, offset int32) ([]models.UserProfile, error) {
 	var result []models.UserProfile
 	for _, user := range m.users {
 		result = append(result, *user)
 	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UserID < result[j].UserID })
+	if int(offset) >= len(result) {
+		return nil, nil
+	}
+	result = result[offset:]
+	if limit > 0 && int(limit) < len(result) {
+		result = result[:limit]
+	}
 	return result, nil
 }
 
+func (m *mockUserProfileRepository) ListUsers(ctx context.Context, q models.ProfileQuery, after *models.ProfileCursor) ([]models.UserProfile, int32, error) {
+	search := strings.ToLower(q.Search)
+	var result []models.UserProfile
+	for _, user := range m.users {
+		if q.Role == models.UserRoleTutor && !user.IsTutor || q.Role == models.UserRoleStudent && !user.IsStudent {
+			continue
+		}
+		if search != "" && !strings.HasPrefix(strings.ToLower(user.Email), search) &&
+			!strings.HasPrefix(strings.ToLower(user.Name), search) && !strings.HasPrefix(strings.ToLower(user.Surname), search) {
+			continue
+		}
+		if q.CreatedFrom != nil && user.CreatedAt.Before(*q.CreatedFrom) || q.CreatedTo != nil && !user.CreatedAt.Before(*q.CreatedTo) {
+			continue
+		}
+		result = append(result, *user)
+	}
+	total := int32(len(result))
+
+	// сравнение по (ключи сортировки, id) в направлении сортировки
+	before := func(a, b models.ProfileCursor) bool {
+		ka := strings.Join(a.Keys, "\x00") + "\x00" + a.ID
+		kb := strings.Join(b.Keys, "\x00") + "\x00" + b.ID
+		return ka != kb && (ka < kb) != q.Desc
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return before(profileCursor(result[i], q), profileCursor(result[j], q))
+	})
+
+	offset := int(q.Offset)
+	if after != nil {
+		offset = 0
+		for len(result) > 0 && !before(*after, profileCursor(result[0], q)) {
+			result = result[1:]
+		}
+	}
+	if offset >= len(result) {
+		return nil, total, nil
+	}
+	result = result[offset:]
+	if int(q.Limit) < len(result) {
+		result = result[:q.Limit]
+	}
+	return result, total, nil
+}
+
 type mockTutorProfileRepository struct {
 	tutors    map[string]*models.TutorProfile
 	createErr error
@@ -227,12 +323,91 @@ func (m *mockStudentProfileRepository) UpdateStudentProfile(ctx context.Context,
 	return existing, nil
 }
 
+// mockTransactor выполняет fn без транзакции, откат проверяется в тестах outbox
+type mockTransactor struct {
+	calls int
+}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
+type mockOutboxRepository struct {
+	events    []models.OutboxEvent
+	published map[int64]bool
+	failures  map[int64]string
+	addErr    error
+}
+
+func newMockOutboxRepository() *mockOutboxRepository {
+	return &mockOutboxRepository{
+		published: make(map[int64]bool),
+		failures:  make(map[int64]string),
+	}
+}
+
+func (m *mockOutboxRepository) AddOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	if m.addErr != nil {
+		return m.addErr
+	}
+	event.ID = int64(len(m.events) + 1)
+	event.EventID = fmt.Sprintf("event-%d", event.ID)
+	event.CreatedAt = time.Now()
+	m.events = append(m.events, *event)
+	return nil
+}
+
+func (m *mockOutboxRepository) ListUnpublishedOutboxEvents(ctx context.Context, limit int32) ([]models.OutboxEvent, error) {
+	var res []models.OutboxEvent
+	for _, e := range m.events {
+		if m.published[e.ID] {
+			continue
+		}
+		if int32(len(res)) == limit {
+			break
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
+
+func (m *mockOutboxRepository) MarkOutboxEventsPublished(ctx context.Context, ids []int64) error {
+	for _, id := range ids {
+		m.published[id] = true
+	}
+	return nil
+}
+
+func (m *mockOutboxRepository) MarkOutboxEventFailed(ctx context.Context, id int64, reason string) error {
+	m.failures[id] = reason
+	return nil
+}
+
+func (m *mockOutboxRepository) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockOutboxRepository) eventTypes() []string {
+	var types []string
+	for _, e := range m.events {
+		types = append(types, e.EventType)
+	}
+	return types
+}
+
 func newTestUserService() (*UserService, *mockUserProfileRepository, *mockTutorProfileRepository, *mockStudentProfileRepository) {
+	svc, userRepo, tutorRepo, studentRepo, _ := newTestUserServiceWithOutbox()
+	return svc, userRepo, tutorRepo, studentRepo
+}
+
+func newTestUserServiceWithOutbox() (*UserService, *mockUserProfileRepository, *mockTutorProfileRepository, *mockStudentProfileRepository, *mockOutboxRepository) {
 	userRepo := newMockUserProfileRepository()
 	tutorRepo := newMockTutorProfileRepository()
 	studentRepo := newMockStudentProfileRepository()
-	svc := NewUserService(userRepo, tutorRepo, studentRepo)
-	return svc, userRepo, tutorRepo, studentRepo
+	outbox := newMockOutboxRepository()
+	svc := NewUserService(userRepo, tutorRepo, studentRepo, &mockTransactor{}, NewProfileEvents(outbox, testEventsTopic))
+	return svc, userRepo, tutorRepo, studentRepo, outbox
 }
 
 func TestUserService_CreateUser_Success(t *testing.T) {
@@ -406,6 +581,74 @@ func TestUserService_UpdateUser_Success(t *testing.T) {
 	}
 }
 
+func TestUserService_UpdateUser_TimezoneAndLocale(t *testing.T) {
+	svc, _, _, _ := newTestUserService()
+	ctx := context.Background()
+
+	_, _ = svc.CreateUser(ctx, &models.UserProfile{
+		UserID:   "test-id",
+		Email:    "test@example.com",
+		Timezone: "UTC",
+		Locale:   "ru",
+	})
+
+	result, err := svc.UpdateUser(ctx, &models.UserProfile{
+		UserID:   "test-id",
+		Timezone: "Asia/Novosibirsk",
+		Locale:   "en-us",
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Timezone != "Asia/Novosibirsk" {
+		t.Errorf("expected timezone Asia/Novosibirsk, got %s", result.Timezone)
+	}
+	if result.Locale != "en-US" {
+		t.Errorf("expected canonical locale en-US, got %s", result.Locale)
+	}
+}
+
+func TestUserService_UpdateUser_InvalidPreferences(t *testing.T) {
+	svc, _, _, _ := newTestUserService()
+	ctx := context.Background()
+
+	_, _ = svc.CreateUser(ctx, &models.UserProfile{UserID: "test-id", Email: "test@example.com"})
+
+	for _, update := range []*models.UserProfile{
+		{UserID: "test-id", Timezone: "Moscow/Europe"},
+		{UserID: "test-id", Timezone: "Local"},
+		{UserID: "test-id", Locale: "not a locale"},
+		// корректный тег BCP 47 длиннее колонки locale
+		{UserID: "test-id", Locale: "de-CH-1901-x-phonebk"},
+		{UserID: "test-id", AvatarURL: "javascript:alert(1)"},
+		{UserID: "test-id", AvatarURL: "/avatars/1.png"},
+		{UserID: "test-id", AvatarURL: "https://cdn.example.com/" + strings.Repeat("a", 2048)},
+	} {
+		_, err := svc.UpdateUser(ctx, update)
+		if err == nil {
+			t.Fatalf("expected error for %+v, got nil", update)
+		}
+		if err.Code != models.INVALIDINPUT {
+			t.Errorf("expected error code %s, got %s", models.INVALIDINPUT, err.Code)
+		}
+	}
+}
+
+func TestUserService_CreateUser_InvalidTimezone(t *testing.T) {
+	svc, _, _, _ := newTestUserService()
+
+	_, err := svc.CreateUser(context.Background(), &models.UserProfile{
+		UserID:   "test-id",
+		Email:    "test@example.com",
+		Timezone: "GMT+25",
+	})
+
+	if err == nil || err.Code != models.INVALIDINPUT {
+		t.Fatalf("expected %s error, got %v", models.INVALIDINPUT, err)
+	}
+}
+
 func TestUserService_UpdateUser_NotFound(t *testing.T) {
 	svc, _, _, _ := newTestUserService()
 	ctx := context.Background()
@@ -543,6 +786,36 @@ func TestUserService_GetUserTypes_NotFound(t *testing.T) {
 	}
 }
 
+func TestUserService_ResolveUsers(t *testing.T) {
+	svc, _, _, _ := newTestUserService()
+	ctx := context.Background()
+
+	_, _ = svc.CreateUser(ctx, &models.UserProfile{UserID: "id1", Email: "one@example.com", UserType: models.UserType{IsStudent: true}})
+	_, _ = svc.CreateUser(ctx, &models.UserProfile{UserID: "id2", Email: "two@example.com"})
+	_, _ = svc.UpdateUser(ctx, &models.UserProfile{UserID: "id2", AvatarURL: " https://cdn.example.com/2.png "})
+
+	users, err := svc.ResolveUsers(ctx, []string{"id1", "missing"}, []string{"TWO@example.com"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+	for _, user := range users {
+		if user.UserID == "id1" && !user.IsStudent {
+			t.Error("expected id1 to be a student")
+		}
+		if user.UserID == "id2" && user.AvatarURL != "https://cdn.example.com/2.png" {
+			t.Errorf("expected trimmed avatar url, got %q", user.AvatarURL)
+		}
+	}
+
+	_, err = svc.ResolveUsers(ctx, make([]string, 300), make([]string, 201))
+	if err == nil || err.Code != models.INVALIDINPUT {
+		t.Fatalf("expected %s error, got %v", models.INVALIDINPUT, err)
+	}
+}
+
 func TestUserService_GetAllUsers_Success(t *testing.T) {
 	svc, _, _, _ := newTestUserService()
 	ctx := context.Background()
@@ -552,25 +825,113 @@ func TestUserService_GetAllUsers_Success(t *testing.T) {
 	_, _ = svc.CreateUser(ctx, user1)
 	_, _ = svc.CreateUser(ctx, user2)
 
-	users, err := svc.GetAllUsers(ctx, 10, 0)
+	page, err := svc.GetAllUsers(ctx, models.ProfileQuery{Limit: 10})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	users := page.Users
 	if len(users) != 2 {
 		t.Errorf("expected 2 users, got %d", len(users))
 	}
 }
 
+func seedProfiles(repo *mockUserProfileRepository) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	profiles := []models.UserProfile{
+		{UserID: "id0", Email: "ivanov@example.com", Name: "Иван", Surname: "Иванов", UserType: models.UserType{IsTutor: true}},
+		{UserID: "id1", Email: "petrov@example.com", Name: "Петр", Surname: "Петров", UserType: models.UserType{IsStudent: true}},
+		{UserID: "id2", Email: "anna@example.com", Name: "Анна", Surname: "Иванова", UserType: models.UserType{IsStudent: true}},
+		{UserID: "id3", Email: "sidorov@example.com", Name: "Сидор", Surname: "Сидоров", UserType: models.UserType{IsTutor: true, IsStudent: true}},
+	}
+	for i := range profiles {
+		p := profiles[i]
+		p.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		repo.users[p.UserID] = &p
+		repo.usersByEmail[p.Email] = &p
+	}
+}
+
+func TestUserService_GetAllUsers_Filters(t *testing.T) {
+	svc, repo, _, _ := newTestUserService()
+	ctx := context.Background()
+	seedProfiles(repo)
+
+	page, e := svc.GetAllUsers(ctx, models.ProfileQuery{Role: models.UserRoleTutor})
+	if e != nil || page.Total != 2 {
+		t.Fatalf("expected 2 tutors, got %+v, %v", page, e)
+	}
+
+	// префикс ищется в email, имени и фамилии
+	page, _ = svc.GetAllUsers(ctx, models.ProfileQuery{Search: "иван", SortBy: models.ProfileSortName})
+	if page.Total != 2 || page.Users[0].UserID != "id0" || page.Users[1].UserID != "id2" {
+		t.Errorf("expected Иванов and Иванова sorted by name, got %+v", page.Users)
+	}
+	page, _ = svc.GetAllUsers(ctx, models.ProfileQuery{Search: "ANNA@"})
+	if page.Total != 1 || page.Users[0].UserID != "id2" {
+		t.Errorf("expected search by email prefix, got %+v", page.Users)
+	}
+
+	from := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	page, _ = svc.GetAllUsers(ctx, models.ProfileQuery{Role: models.UserRoleStudent, CreatedFrom: &from, CreatedTo: &to})
+	if page.Total != 2 {
+		t.Errorf("expected 2 students in created range, got %+v", page.Users)
+	}
+
+	if _, e := svc.GetAllUsers(ctx, models.ProfileQuery{CreatedFrom: &to, CreatedTo: &from}); e == nil || e.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for reversed range, got %v", e)
+	}
+
+	if _, e := svc.GetAllUsers(ctx, models.ProfileQuery{ActiveOnly: true}); e == nil || e.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for status filter, got %v", e)
+	}
+}
+
+func TestUserService_GetAllUsers_KeysetPagination(t *testing.T) {
+	svc, repo, _, _ := newTestUserService()
+	ctx := context.Background()
+	seedProfiles(repo)
+
+	q := models.ProfileQuery{SortBy: models.ProfileSortName, Limit: 3}
+	var ids []string
+	for {
+		page, e := svc.GetAllUsers(ctx, q)
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e.Message)
+		}
+		if page.Total != 4 {
+			t.Errorf("expected total 4 on every page, got %d", page.Total)
+		}
+		for _, u := range page.Users {
+			ids = append(ids, u.UserID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		q.PageToken = page.NextPageToken
+	}
+
+	if strings.Join(ids, ",") != "id0,id2,id1,id3" {
+		t.Errorf("expected profiles sorted by surname without gaps, got %v", ids)
+	}
+
+	q.Desc = true
+	if _, e := svc.GetAllUsers(ctx, q); e == nil || e.Code != models.INVALIDINPUT {
+		t.Errorf("expected invalid input for mismatched page token, got %v", e)
+	}
+}
+
 func TestUserService_GetAllUsers_Empty(t *testing.T) {
 	svc, _, _, _ := newTestUserService()
 	ctx := context.Background()
 
-	users, err := svc.GetAllUsers(ctx, 10, 0)
+	page, err := svc.GetAllUsers(ctx, models.ProfileQuery{Limit: 10})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	users := page.Users
 	if users == nil {
 		users = []models.UserProfile{}
 	}
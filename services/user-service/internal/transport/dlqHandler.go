@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"context"
+	"user-service/internal/models"
+	"user-service/internal/service"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DLQService interface {
+	ListMessages(ctx context.Context, userID string, filter models.DLQFilter) ([]models.DLQMessage, int32, *models.Error)
+	GetMessage(ctx context.Context, userID, id string) (*models.DLQMessage, *models.Error)
+	Replay(ctx context.Context, userID string, ids []string, all bool) (int32, *models.Error)
+	Discard(ctx context.Context, userID string, ids []string, all bool, note string) (int32, *models.Error)
+}
+
+// DLQAdminServer - внутренний gRPC сервис администрирования DLQ, через gateway не публикуется
+type DLQAdminServer struct {
+	pb.UnimplementedDLQAdminServiceServer
+
+	dlqService DLQService
+}
+
+func NewDLQAdminServer(dlqService *service.DLQService) *DLQAdminServer {
+	return &DLQAdminServer{dlqService: dlqService}
+}
+
+var dlqStatusToPb = map[models.DLQMessageStatus]pb.DLQMessageStatus{
+	models.DLQPending:   pb.DLQMessageStatus_DLQ_PENDING,
+	models.DLQReplayed:  pb.DLQMessageStatus_DLQ_REPLAYED,
+	models.DLQDiscarded: pb.DLQMessageStatus_DLQ_DISCARDED,
+}
+
+var dlqStatusFromPb = map[pb.DLQMessageStatus]models.DLQMessageStatus{
+	pb.DLQMessageStatus_DLQ_PENDING:   models.DLQPending,
+	pb.DLQMessageStatus_DLQ_REPLAYED:  models.DLQReplayed,
+	pb.DLQMessageStatus_DLQ_DISCARDED: models.DLQDiscarded,
+}
+
+func dlqMessageToPb(msg *models.DLQMessage, withPayload bool) *pb.DLQMessage {
+	res := &pb.DLQMessage{
+		Id:            msg.ID,
+		Topic:         msg.Topic,
+		Partition:     int32(msg.Partition),
+		Offset:        msg.Offset,
+		Key:           msg.Key,
+		OriginalTopic: msg.OriginalTopic,
+		Error:         msg.Error,
+		RetryCount:    int32(msg.RetryCount),
+		Status:        dlqStatusToPb[msg.Status],
+		Note:          msg.Note,
+		ResolvedBy:    msg.ResolvedBy,
+		CreatedAt:     timestamppb.New(msg.CreatedAt),
+	}
+	if withPayload {
+		res.Payload = msg.Payload
+	}
+	if msg.ResolvedAt != nil {
+		res.ResolvedAt = timestamppb.New(*msg.ResolvedAt)
+	}
+	return res
+}
+
+func (h *DLQAdminServer) ListDLQMessages(ctx context.Context, req *pb.ListDLQMessagesRequest) (*pb.ListDLQMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, total, e := h.dlqService.ListMessages(ctx, userID, models.DLQFilter{
+		Status: dlqStatusFromPb[req.Status],
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	res := &pb.ListDLQMessagesResponse{
+		Messages: make([]*pb.DLQMessage, 0, len(messages)),
+		Total:    total,
+	}
+	for i := range messages {
+		res.Messages = append(res.Messages, dlqMessageToPb(&messages[i], false))
+	}
+
+	return res, nil
+}
+
+func (h *DLQAdminServer) GetDLQMessage(ctx context.Context, req *pb.GetDLQMessageRequest) (*pb.DLQMessageResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, e := h.dlqService.GetMessage(ctx, userID, req.Id)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.DLQMessageResponse{Message: dlqMessageToPb(msg, true)}, nil
+}
+
+func (h *DLQAdminServer) ReplayDLQMessages(ctx context.Context, req *pb.ReplayDLQMessagesRequest) (*pb.ReplayDLQMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, e := h.dlqService.Replay(ctx, userID, req.Ids, req.All)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.ReplayDLQMessagesResponse{Replayed: replayed}, nil
+}
+
+func (h *DLQAdminServer) DiscardDLQMessages(ctx context.Context, req *pb.DiscardDLQMessagesRequest) (*pb.DiscardDLQMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	discarded, e := h.dlqService.Discard(ctx, userID, req.Ids, req.All, req.Note)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.DiscardDLQMessagesResponse{Discarded: discarded}, nil
+}
@@ -0,0 +1,106 @@
+package transport
+
+import (
+	"context"
+	"user-service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var guardianStatusToPb = map[models.GuardianLinkStatus]pb.GuardianLinkStatus{
+	models.GuardianPending:  pb.GuardianLinkStatus_GUARDIAN_LINK_PENDING,
+	models.GuardianAccepted: pb.GuardianLinkStatus_GUARDIAN_LINK_ACCEPTED,
+	models.GuardianRevoked:  pb.GuardianLinkStatus_GUARDIAN_LINK_REVOKED,
+}
+
+func guardianLinkToPb(link *models.GuardianLink) *pb.GuardianLink {
+	res := &pb.GuardianLink{
+		Id:         link.ID,
+		StudentId:  link.StudentID,
+		GuardianId: link.GuardianID,
+		Status:     guardianStatusToPb[link.Status],
+		InvitedAt:  timestamppb.New(link.InvitedAt),
+	}
+	if link.ConsentedAt != nil {
+		res.ConsentedAt = timestamppb.New(*link.ConsentedAt)
+	}
+	if link.RevokedAt != nil {
+		res.RevokedAt = timestamppb.New(*link.RevokedAt)
+	}
+	return res
+}
+
+func (h *ApiServer) InviteGuardian(ctx context.Context, req *pb.InviteGuardianRequest) (*pb.GuardianLinkResponse, error) {
+	studentID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	link, e := h.guardianService.InviteGuardian(ctx, studentID, req.GuardianEmail)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.GuardianLinkResponse{Link: guardianLinkToPb(link)}, nil
+}
+
+func (h *ApiServer) AcceptGuardianInvitation(ctx context.Context, req *pb.AcceptGuardianInvitationRequest) (*pb.GuardianLinkResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	link, e := h.guardianService.AcceptInvitation(ctx, userID, req.LinkId, req.Consent)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.GuardianLinkResponse{Link: guardianLinkToPb(link)}, nil
+}
+
+func (h *ApiServer) RevokeGuardianLink(ctx context.Context, req *pb.RevokeGuardianLinkRequest) (*pb.EmptyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e := h.guardianService.RevokeLink(ctx, userID, req.LinkId); e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+func (h *ApiServer) ListGuardianLinks(ctx context.Context, req *pb.ListGuardianLinksRequest) (*pb.ListGuardianLinksResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links, e := h.guardianService.ListLinks(ctx, userID)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	res := &pb.ListGuardianLinksResponse{Links: make([]*pb.GuardianLink, 0, len(links))}
+	for i := range links {
+		res.Links = append(res.Links, guardianLinkToPb(&links[i]))
+	}
+
+	return res, nil
+}
+
+func (h *ApiServer) CheckGuardianAccess(ctx context.Context, req *pb.CheckGuardianAccessRequest) (*pb.CheckGuardianAccessResponse, error) {
+	ok, e := h.guardianService.CheckAccess(ctx, req.GuardianId, req.StudentId)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.CheckGuardianAccessResponse{HasAccess: ok}, nil
+}
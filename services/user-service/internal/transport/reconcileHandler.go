@@ -0,0 +1,46 @@
+package transport
+
+import (
+	"context"
+	"user-service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *ApiServer) ReconcileProfiles(ctx context.Context, req *pb.ReconcileProfilesRequest) (*pb.ReconcileProfilesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, e := h.reconcileService.RunNow(ctx, userID, req.DryRun)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	res := &pb.ReconcileProfilesResponse{
+		StartedAt:     timestamppb.New(report.StartedAt),
+		FinishedAt:    timestamppb.New(report.FinishedAt),
+		DryRun:        report.DryRun,
+		AuthUsers:     int32(report.AuthUsers),
+		Profiles:      int32(report.Profiles),
+		Missing:       int32(report.Count(models.DriftMissing)),
+		Orphaned:      int32(report.Count(models.DriftOrphaned)),
+		EmailMismatch: int32(report.Count(models.DriftEmailMismatch)),
+		Records:       make([]*pb.DriftRecord, 0, len(report.Records)),
+	}
+	for _, rec := range report.Records {
+		res.Records = append(res.Records, &pb.DriftRecord{
+			Kind:         string(rec.Kind),
+			UserId:       rec.UserID,
+			AuthEmail:    rec.AuthEmail,
+			ProfileEmail: rec.ProfileEmail,
+			Repaired:     rec.Repaired,
+			Error:        rec.Error,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"context"
+	"user-service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func reviewToPb(review *models.TutorReview) *pb.TutorReview {
+	res := &pb.TutorReview{
+		Id:           review.ID,
+		TutorId:      review.TutorID,
+		StudentId:    review.StudentID,
+		Rating:       review.Rating,
+		Text:         review.Text,
+		TutorReply:   review.TutorReply,
+		IsHidden:     review.IsHidden,
+		HiddenReason: review.HiddenReason,
+		CreatedAt:    timestamppb.New(review.CreatedAt),
+	}
+	if review.RepliedAt != nil {
+		res.RepliedAt = timestamppb.New(*review.RepliedAt)
+	}
+	if review.UpdatedAt != nil {
+		res.UpdatedAt = timestamppb.New(*review.UpdatedAt)
+	}
+	return res
+}
+
+func (h *ApiServer) CreateTutorReview(ctx context.Context, req *pb.CreateTutorReviewRequest) (*pb.TutorReviewResponse, error) {
+	studentID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, e := h.reviewService.CreateReview(ctx, &models.TutorReview{
+		TutorID:   req.TutorId,
+		StudentID: studentID,
+		Rating:    req.Rating,
+		Text:      req.Text,
+	})
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorReviewResponse{Review: reviewToPb(review)}, nil
+}
+
+func (h *ApiServer) ListTutorReviews(ctx context.Context, req *pb.ListTutorReviewsRequest) (*pb.ListTutorReviewsResponse, error) {
+	reviews, rating, e := h.reviewService.ListTutorReviews(ctx, req.TutorId, req.Limit, req.Offset)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	res := &pb.ListTutorReviewsResponse{
+		Reviews:       make([]*pb.TutorReview, 0, len(reviews)),
+		AverageRating: rating.Average,
+		ReviewsCount:  rating.Count,
+		Offset:        req.Offset,
+		Limit:         req.Limit,
+	}
+	for i := range reviews {
+		res.Reviews = append(res.Reviews, reviewToPb(&reviews[i]))
+	}
+
+	return res, nil
+}
+
+func (h *ApiServer) UpdateTutorReview(ctx context.Context, req *pb.UpdateTutorReviewRequest) (*pb.TutorReviewResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, e := h.reviewService.UpdateReview(ctx, userID, &models.TutorReview{
+		ID:     req.ReviewId,
+		Rating: req.Rating,
+		Text:   req.Text,
+	})
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorReviewResponse{Review: reviewToPb(review)}, nil
+}
+
+func (h *ApiServer) DeleteTutorReview(ctx context.Context, req *pb.DeleteTutorReviewRequest) (*pb.EmptyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e := h.reviewService.DeleteReview(ctx, userID, req.ReviewId); e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+func (h *ApiServer) ReplyToTutorReview(ctx context.Context, req *pb.ReplyToTutorReviewRequest) (*pb.TutorReviewResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, e := h.reviewService.ReplyToReview(ctx, userID, req.ReviewId, req.Reply)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorReviewResponse{Review: reviewToPb(review)}, nil
+}
+
+func (h *ApiServer) ModerateTutorReview(ctx context.Context, req *pb.ModerateTutorReviewRequest) (*pb.TutorReviewResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, e := h.reviewService.ModerateReview(ctx, userID, req.ReviewId, req.Hidden, req.Reason)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorReviewResponse{Review: reviewToPb(review)}, nil
+}
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"database/sql"
+	"user-service/internal/config"
 	"user-service/internal/repository"
 	"user-service/internal/service"
 	"user-service/pkg/kafka"
@@ -12,27 +13,59 @@ import (
 type ApiServer struct {
 	pb.UserServiceServer
 
-	userService    UserService
-	tutorService   TutorService
-	studentService StudentService
+	userService         UserService
+	tutorService        TutorService
+	verificationService TutorVerificationService
+	studentService      StudentService
+	reviewService       ReviewService
+	guardianService     GuardianService
+	reconcileService    ReconcileService
 
 	EventHandler *service.KafkaHandler
+	DLQService   *service.DLQService
+	Reconciler   *service.Reconciler
+	OutboxRelay  *service.OutboxRelay
 }
 
-func NewApiServer(pgDB *sql.DB, producer *kafka.Producer) *ApiServer {
+func NewApiServer(pgDB *sql.DB, producer *kafka.Producer, groupClient service.GroupClient, authClient service.AuthDirectory, cfg *config.Config) *ApiServer {
 	userRepo := repository.NewUserProfileRepository(pgDB)
 	tutorRepo := repository.NewTutorProfileRepository(pgDB)
 	studentRepo := repository.NewStudentProfileRepository(pgDB)
+	reviewRepo := repository.NewReviewRepository(pgDB)
+	guardianRepo := repository.NewGuardianRepository(pgDB)
+	verificationRepo := repository.NewTutorVerificationRepository(pgDB)
+	processedRepo := repository.NewProcessedEventRepository(pgDB)
+	dlqRepo := repository.NewDLQRepository(pgDB)
+	outboxRepo := repository.NewOutboxRepository(pgDB)
+	transactor := repository.NewTransactor(pgDB)
 
-	userService := service.NewUserService(userRepo, tutorRepo, studentRepo)
-	tutorService := service.NewTutorService(userRepo, tutorRepo)
-	studentService := service.NewStudentService(userRepo, studentRepo)
+	// изменения профилей публикуются в USER_EVENTS_TOPIC через outbox
+	profileEvents := service.NewProfileEvents(outboxRepo, cfg.UserEventsTopic)
+	userService := service.NewUserService(userRepo, tutorRepo, studentRepo, transactor, profileEvents)
+	tutorService := service.NewTutorService(userRepo, tutorRepo, reviewRepo, transactor, profileEvents)
+	studentService := service.NewStudentService(userRepo, studentRepo, transactor, profileEvents)
+	reviewService := service.NewReviewService(userRepo, reviewRepo, groupClient,
+		service.NewKeywordModerator(cfg.ReviewBannedWords), cfg.AdminUserIDs)
+	guardianService := service.NewGuardianService(userRepo, guardianRepo)
 
-	eventHandler := service.NewKafkaHandler(userRepo, producer)
+	eventHandler := service.NewKafkaHandler(processedRepo, transactor, userService, producer, producer.Topic)
+	dlqService := service.NewDLQService(dlqRepo, producer, producer.Topic, cfg.AdminUserIDs)
+	reconciler := service.NewReconciler(authClient, userRepo, userService, service.ReconcileOptions{
+		Repair:        cfg.ReconcileRepair,
+		DeleteOrphans: cfg.ReconcileDeleteOrphans,
+		Grace:         cfg.ReconcileGrace,
+	}, cfg.AdminUserIDs)
 	return &ApiServer{
-		userService:    userService,
-		tutorService:   tutorService,
-		studentService: studentService,
-		EventHandler:   eventHandler,
+		userService:         userService,
+		tutorService:        tutorService,
+		verificationService: service.NewTutorVerificationService(verificationRepo, cfg.AdminUserIDs, cfg.UploadHosts),
+		studentService:      studentService,
+		reviewService:       reviewService,
+		guardianService:     guardianService,
+		reconcileService:    reconciler,
+		EventHandler:        eventHandler,
+		DLQService:          dlqService,
+		Reconciler:          reconciler,
+		OutboxRelay:         service.NewOutboxRelay(transactor, outboxRepo, producer),
 	}
 }
@@ -8,10 +8,11 @@ import (
 type UserService interface{
 	CreateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, *models.Error)
 	DeleteUser(ctx context.Context, id string) *models.Error
-	GetAllUsers(ctx context.Context, limit int32, offset int32) ([]models.UserProfile, *models.Error)
+	GetAllUsers(ctx context.Context, q models.ProfileQuery) (*models.ProfilePage, *models.Error)
 	GetUserByEmail(ctx context.Context, email string) (*models.UserProfile, *models.Error)
 	GetUserByID(ctx context.Context, id string) (*models.UserProfile, *models.Error)
 	GetUserTypes(ctx context.Context, id string) (*models.UserType, *models.Error)
+	ResolveUsers(ctx context.Context, ids, emails []string) ([]models.UserProfile, *models.Error)
 	UpdateUser(ctx context.Context, user *models.UserProfile) (*models.UserProfile, *models.Error)
 	GetCompleteUserProfile(ctx context.Context, id string) (*models.CompleteUserProfile, *models.Error)
 }
@@ -24,9 +25,37 @@ type TutorService interface {
 	ValidateTutor(ctx context.Context, id string) (bool, *models.Error)
 }
 
+type TutorVerificationService interface {
+	Get(ctx context.Context, userID, tutorID string) (*models.TutorVerification, *models.Error)
+	List(ctx context.Context, userID string, status models.TutorVerificationStatus, limit, offset int32) ([]models.TutorVerification, int32, *models.Error)
+	Review(ctx context.Context, userID, tutorID string, approve bool, reason string) (*models.TutorVerification, *models.Error)
+	Submit(ctx context.Context, userID, tutorID string, docs []models.VerificationDocument) (*models.TutorVerification, *models.Error)
+}
+
 type StudentService interface {
 	CreateStudentProfile(ctx context.Context, student *models.StudentProfile) (*models.StudentProfile, *models.Error)
 	DeleteStudentProfile(ctx context.Context, id string) *models.Error
 	GetStudentProfile(ctx context.Context, id string) (*models.StudentProfile, *models.Error)
 	UpdateStudentProfile(ctx context.Context, student *models.StudentProfile) (*models.StudentProfile, *models.Error)
-}
\ No newline at end of file
+}
+
+type ReviewService interface {
+	CreateReview(ctx context.Context, review *models.TutorReview) (*models.TutorReview, *models.Error)
+	DeleteReview(ctx context.Context, userID, reviewID string) *models.Error
+	ListTutorReviews(ctx context.Context, tutorID string, limit, offset int32) ([]models.TutorReview, *models.TutorRating, *models.Error)
+	ModerateReview(ctx context.Context, userID, reviewID string, hidden bool, reason string) (*models.TutorReview, *models.Error)
+	ReplyToReview(ctx context.Context, userID, reviewID, reply string) (*models.TutorReview, *models.Error)
+	UpdateReview(ctx context.Context, userID string, review *models.TutorReview) (*models.TutorReview, *models.Error)
+}
+
+type GuardianService interface {
+	AcceptInvitation(ctx context.Context, userID, linkID string, consent bool) (*models.GuardianLink, *models.Error)
+	CheckAccess(ctx context.Context, guardianID, studentID string) (bool, *models.Error)
+	InviteGuardian(ctx context.Context, studentID, guardianEmail string) (*models.GuardianLink, *models.Error)
+	ListLinks(ctx context.Context, userID string) ([]models.GuardianLink, *models.Error)
+	RevokeLink(ctx context.Context, userID, linkID string) *models.Error
+}
+
+type ReconcileService interface {
+	RunNow(ctx context.Context, userID string, dryRun bool) (*models.ReconcileReport, *models.Error)
+}
@@ -14,6 +14,9 @@ var (
 	ALREADYEXISTS = status.New(codes.AlreadyExists, "user/tutor/student already exists")
 	INVALIDINPUT = status.New(codes.InvalidArgument, "invalid input")
 	NOTFOUND = status.New(codes.NotFound, "user/tutor/student not found")
+	PERMISSIONDENIED = status.New(codes.PermissionDenied, "permission denied")
+	ABORTED = status.New(codes.Aborted, "operation is already running")
+	FAILEDPRECONDITION = status.New(codes.FailedPrecondition, "operation is not allowed in the current state")
 	OK = status.New(codes.OK, "ok")
 )
 
@@ -35,6 +38,15 @@ func parseError(err *models.Error) *status.Status{
 
 	case models.STUDENTNOTFOUND:
 		st, _ = NOTFOUND.WithDetails(details)
+
+	case models.REVIEWNOTFOUND:
+		st, _ = NOTFOUND.WithDetails(details)
+
+	case models.GUARDIANNOTFOUND:
+		st, _ = NOTFOUND.WithDetails(details)
+
+	case models.DLQNOTFOUND:
+		st, _ = NOTFOUND.WithDetails(details)
 	
 	case models.USEREXISTS:
 		st, _ = ALREADYEXISTS.WithDetails(details)
@@ -45,6 +57,21 @@ func parseError(err *models.Error) *status.Status{
 	case models.STUDENTEXISTS:
 		st, _ = ALREADYEXISTS.WithDetails(details)
 
+	case models.REVIEWEXISTS:
+		st, _ = ALREADYEXISTS.WithDetails(details)
+
+	case models.GUARDIANEXISTS:
+		st, _ = ALREADYEXISTS.WithDetails(details)
+
+	case models.RECONCILERUNNING:
+		st, _ = ABORTED.WithDetails(details)
+
+	case models.VERIFICATIONCONFLICT:
+		st, _ = FAILEDPRECONDITION.WithDetails(details)
+
+	case models.PERMISSIONDENIED:
+		st, _ = PERMISSIONDENIED.WithDetails(details)
+
 	case models.INVALIDINPUT:
 		st, _ = INVALIDINPUT.WithDetails(details)
 
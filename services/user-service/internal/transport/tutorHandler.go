@@ -26,6 +26,7 @@ func (h *ApiServer) CreateTutorProfile(ctx context.Context, req *pb.CreateTutorP
 			Bio: tutor.Bio,
 			Specialization: tutor.Specialization,
 			ExperienceYears: tutor.Experience,
+			VerificationStatus: verificationStatusToPb[tutor.VerificationStatus],
 		},
 	}, nil
 }
@@ -45,6 +46,9 @@ func (h *ApiServer) GetTutorProfile(ctx context.Context, req *pb.GetTutorProfile
 			Bio: tutor.Bio,
 			Specialization: tutor.Specialization,
 			ExperienceYears: tutor.Experience,
+			AverageRating: tutor.Rating.Average,
+			ReviewsCount: tutor.Rating.Count,
+			VerificationStatus: verificationStatusToPb[tutor.VerificationStatus],
 		},
 	}, nil
 
@@ -71,6 +75,7 @@ func (h *ApiServer) UpdateTutorProfile(ctx context.Context, req *pb.UpdateTutorP
 			Bio: tutor.Bio,
 			Specialization: tutor.Specialization,
 			ExperienceYears: tutor.Experience,
+			VerificationStatus: verificationStatusToPb[tutor.VerificationStatus],
 		},
 	}, nil
 }
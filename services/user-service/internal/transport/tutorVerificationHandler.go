@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"context"
+	"user-service/internal/models"
+
+	pb "github.com/RomanKovalev007/tutors_platform/api/gen/go/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var verificationStatusToPb = map[models.TutorVerificationStatus]pb.TutorVerificationStatus{
+	models.TutorUnverified:          pb.TutorVerificationStatus_TUTOR_UNVERIFIED,
+	models.TutorVerificationPending: pb.TutorVerificationStatus_TUTOR_VERIFICATION_PENDING,
+	models.TutorVerified:            pb.TutorVerificationStatus_TUTOR_VERIFIED,
+	models.TutorRejected:            pb.TutorVerificationStatus_TUTOR_REJECTED,
+}
+
+var verificationStatusFromPb = map[pb.TutorVerificationStatus]models.TutorVerificationStatus{
+	pb.TutorVerificationStatus_TUTOR_UNVERIFIED:           models.TutorUnverified,
+	pb.TutorVerificationStatus_TUTOR_VERIFICATION_PENDING: models.TutorVerificationPending,
+	pb.TutorVerificationStatus_TUTOR_VERIFIED:             models.TutorVerified,
+	pb.TutorVerificationStatus_TUTOR_REJECTED:             models.TutorRejected,
+}
+
+func tutorVerificationToPb(v *models.TutorVerification) *pb.TutorVerification {
+	res := &pb.TutorVerification{
+		TutorId:    v.TutorID,
+		Status:     verificationStatusToPb[v.Status],
+		Reason:     v.Reason,
+		ReviewedBy: v.ReviewedBy,
+		Documents:  make([]*pb.VerificationDocument, 0, len(v.Documents)),
+	}
+	if v.SubmittedAt != nil {
+		res.SubmittedAt = timestamppb.New(*v.SubmittedAt)
+	}
+	if v.ReviewedAt != nil {
+		res.ReviewedAt = timestamppb.New(*v.ReviewedAt)
+	}
+	for _, d := range v.Documents {
+		res.Documents = append(res.Documents, &pb.VerificationDocument{
+			Id:         d.ID,
+			Name:       d.Name,
+			Url:        d.URL,
+			UploadedAt: timestamppb.New(d.UploadedAt),
+		})
+	}
+	return res
+}
+
+func (h *ApiServer) SubmitTutorVerification(ctx context.Context, req *pb.SubmitTutorVerificationRequest) (*pb.TutorVerificationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]models.VerificationDocument, 0, len(req.Documents))
+	for _, d := range req.Documents {
+		docs = append(docs, models.VerificationDocument{Name: d.Name, URL: d.Url})
+	}
+
+	v, e := h.verificationService.Submit(ctx, userID, req.UserId, docs)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorVerificationResponse{Verification: tutorVerificationToPb(v)}, nil
+}
+
+func (h *ApiServer) GetTutorVerification(ctx context.Context, req *pb.GetTutorVerificationRequest) (*pb.TutorVerificationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, e := h.verificationService.Get(ctx, userID, req.UserId)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorVerificationResponse{Verification: tutorVerificationToPb(v)}, nil
+}
+
+func (h *ApiServer) ReviewTutorVerification(ctx context.Context, req *pb.ReviewTutorVerificationRequest) (*pb.TutorVerificationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, e := h.verificationService.Review(ctx, userID, req.UserId, req.Approve, req.Reason)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	return &pb.TutorVerificationResponse{Verification: tutorVerificationToPb(v)}, nil
+}
+
+func (h *ApiServer) ListTutorVerifications(ctx context.Context, req *pb.ListTutorVerificationsRequest) (*pb.ListTutorVerificationsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verifications, total, e := h.verificationService.List(ctx, userID, verificationStatusFromPb[req.Status], req.Limit, req.Offset)
+	if e != nil {
+		st := parseError(e)
+		return nil, st.Err()
+	}
+
+	res := &pb.ListTutorVerificationsResponse{
+		Verifications: make([]*pb.TutorVerification, 0, len(verifications)),
+		Total:         total,
+	}
+	for i := range verifications {
+		res.Verifications = append(res.Verifications, tutorVerificationToPb(&verifications[i]))
+	}
+
+	return res, nil
+}
@@ -8,15 +8,59 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var profileSortFromPb = map[pb.ProfileSortField]models.ProfileSortField{
+	pb.ProfileSortField_PROFILE_SORT_CREATED_AT: models.ProfileSortCreatedAt,
+	pb.ProfileSortField_PROFILE_SORT_EMAIL: models.ProfileSortEmail,
+	pb.ProfileSortField_PROFILE_SORT_NAME: models.ProfileSortName,
+}
+
+var userRoleFromPb = map[pb.UserRoleFilter]models.UserRoleFilter{
+	pb.UserRoleFilter_USER_ROLE_ANY: models.UserRoleAny,
+	pb.UserRoleFilter_USER_ROLE_TUTOR: models.UserRoleTutor,
+	pb.UserRoleFilter_USER_ROLE_STUDENT: models.UserRoleStudent,
+}
+
+func profileQueryFromPb(req *pb.ListUserProfilesRequest) models.ProfileQuery {
+	q := models.ProfileQuery{
+		ActiveOnly: req.IsActive,
+		Role: userRoleFromPb[req.Role],
+		Search: req.Search,
+		SortBy: profileSortFromPb[req.SortBy],
+		PageToken: req.PageToken,
+		Limit: req.Limit,
+		Offset: req.Offset,
+	}
+
+	switch req.SortDirection {
+	case pb.SortDirection_SORT_ASC:
+		q.Desc = false
+	case pb.SortDirection_SORT_DESC:
+		q.Desc = true
+	default:
+		q.Desc = q.SortBy == models.ProfileSortCreatedAt
+	}
+
+	if req.CreatedFrom != nil {
+		t := req.CreatedFrom.AsTime()
+		q.CreatedFrom = &t
+	}
+	if req.CreatedTo != nil {
+		t := req.CreatedTo.AsTime()
+		q.CreatedTo = &t
+	}
+
+	return q
+}
+
 func (h *ApiServer) ListUsers(ctx context.Context, req *pb.ListUserProfilesRequest) (*pb.ListUserProfilesResponse, error){
-	users, err := h.userService.GetAllUsers(ctx, req.Limit, req.Offset)
+	page, err := h.userService.GetAllUsers(ctx, profileQueryFromPb(req))
 	if err != nil{
 		st :=  parseError(err)
 		return nil, st.Err()
 	}
 
 	var usersResp []*pb.UserProfile
-	for _,user := range users{
+	for _,user := range page.Users{
 		usersResp = append(usersResp,
 			&pb.UserProfile{
 				UserId: user.UserID, 
@@ -24,11 +68,20 @@ func (h *ApiServer) ListUsers(ctx context.Context, req *pb.ListUserProfilesReque
 				Name: user.Name,
 				Surname: user.Surname,
 				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
 				CreatedAt: timestamppb.New(user.CreatedAt),
 			})
 	}
 
-	return &pb.ListUserProfilesResponse{Users: usersResp}, nil
+	return &pb.ListUserProfilesResponse{
+		Users: usersResp,
+		Offset: req.Offset,
+		Limit: req.Limit,
+		Total: page.Total,
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
 
 func (h *ApiServer) CreateUserProfile(ctx context.Context, req *pb.CreateUserProfileRequest) (*pb.UserProfileResponse, error){
@@ -38,6 +91,8 @@ func (h *ApiServer) CreateUserProfile(ctx context.Context, req *pb.CreateUserPro
 		Name: req.Name,
 		Surname: req.Surname,
 		Telegram: req.Telegram,
+		Timezone: req.Timezone,
+		Locale: req.Locale,
 	}
 	user, err := h.userService.CreateUser(ctx, &userReq)
 	if err != nil{
@@ -52,6 +107,9 @@ func (h *ApiServer) CreateUserProfile(ctx context.Context, req *pb.CreateUserPro
 				Name: user.Name,
 				Surname: user.Surname,
 				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
 				CreatedAt: timestamppb.New(user.CreatedAt),
 			}}, nil
 }
@@ -72,6 +130,9 @@ func (h *ApiServer) GetUserByID(ctx context.Context, req *pb.GetUserProfileByIDR
 				Name: user.Name,
 				Surname: user.Surname,
 				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
 				CreatedAt: timestamppb.New(user.CreatedAt),
 			}}, nil
 }
@@ -91,6 +152,9 @@ func (h *ApiServer) GetUserByEmail(ctx context.Context, req *pb.GetUserProfileBy
 				Name: user.Name,
 				Surname: user.Surname,
 				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
 				CreatedAt: timestamppb.New(user.CreatedAt),
 			}}, nil
 }
@@ -102,6 +166,9 @@ func (h *ApiServer) UpdateUserProfile(ctx context.Context, req *pb.UpdateUserPro
 		Name: req.Name,
 		Surname: req.Surname,
 		Telegram: req.Telegram,
+		Timezone: req.Timezone,
+		Locale: req.Locale,
+		AvatarURL: req.AvatarUrl,
 	}
 
 	user, err := h.userService.UpdateUser(ctx, &userReq)
@@ -117,6 +184,9 @@ func (h *ApiServer) UpdateUserProfile(ctx context.Context, req *pb.UpdateUserPro
 				Name: user.Name,
 				Surname: user.Surname,
 				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
 				CreatedAt: timestamppb.New(user.CreatedAt),
 			}}, nil
 }
@@ -144,6 +214,9 @@ func (h *ApiServer) GetCompleteUserProfile(ctx context.Context, req *pb.GetCompl
 		Name: user.UserProfile.Name,
 		Surname: user.UserProfile.Surname,
 		Telegram: user.UserProfile.Telegram,
+		Timezone: user.UserProfile.Timezone,
+		Locale: user.UserProfile.Locale,
+		AvatarUrl: user.UserProfile.AvatarURL,
 		CreatedAt: timestamppb.New(user.UserProfile.CreatedAt),
 	}
 
@@ -152,6 +225,7 @@ func (h *ApiServer) GetCompleteUserProfile(ctx context.Context, req *pb.GetCompl
 		Bio: user.TutorProfile.Bio,
 		Specialization: user.TutorProfile.Specialization,
 		ExperienceYears: user.TutorProfile.Experience,
+		VerificationStatus: verificationStatusToPb[user.TutorProfile.VerificationStatus],
 	}
 
 	stdentProfile := pb.StudentProfile{
@@ -165,4 +239,45 @@ func (h *ApiServer) GetCompleteUserProfile(ctx context.Context, req *pb.GetCompl
 		TutorProfile: &tutorProfile,
 		StudentProfile: &stdentProfile,
 	}, nil
-}
\ No newline at end of file
+}
+
+func (h *ApiServer) GetUserPreferences(ctx context.Context, req *pb.GetUserPreferencesRequest) (*pb.GetUserPreferencesResponse, error){
+	user, err := h.userService.GetUserByID(ctx, req.UserId)
+	if err != nil{
+		st :=  parseError(err)
+		return nil, st.Err()
+	}
+
+	return &pb.GetUserPreferencesResponse{
+		Timezone: user.Timezone,
+		Locale: user.Locale,
+	}, nil
+}
+
+func (h *ApiServer) ResolveUsers(ctx context.Context, req *pb.ResolveUsersRequest) (*pb.ResolveUsersResponse, error){
+	users, err := h.userService.ResolveUsers(ctx, req.UserIds, req.Emails)
+	if err != nil{
+		st := parseError(err)
+		return nil, st.Err()
+	}
+
+	resolved := make([]*pb.ResolvedUser, len(users))
+	for i, user := range users {
+		resolved[i] = &pb.ResolvedUser{
+			Profile: &pb.UserProfile{
+				UserId: user.UserID,
+				Email: user.Email,
+				Name: user.Name,
+				Surname: user.Surname,
+				Telegram: user.Telegram,
+				Timezone: user.Timezone,
+				Locale: user.Locale,
+				AvatarUrl: user.AvatarURL,
+				CreatedAt: timestamppb.New(user.CreatedAt),
+			},
+			Types: &pb.UserTypes{IsTutor: user.IsTutor, IsStudent: user.IsStudent},
+		}
+	}
+
+	return &pb.ResolveUsersResponse{Users: resolved}, nil
+}
@@ -0,0 +1,24 @@
+package transport
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// getUserIDFromContext достает id пользователя, проставленный api-gateway
+func getUserIDFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "metadata not found")
+	}
+
+	userIDs := md.Get("x-user-id")
+	if len(userIDs) == 0 || userIDs[0] == "" {
+		return "", status.Error(codes.Unauthenticated, "user id not found in metadata")
+	}
+
+	return userIDs[0], nil
+}
@@ -0,0 +1,16 @@
+CREATE TABLE tutor_reviews (
+    id VARCHAR(255) PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    tutor_id VARCHAR(255) NOT NULL REFERENCES tutor_profiles(user_id) ON DELETE CASCADE,
+    student_id VARCHAR(255) NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text TEXT NOT NULL DEFAULT '',
+    tutor_reply TEXT,
+    replied_at TIMESTAMP,
+    is_hidden BOOLEAN NOT NULL DEFAULT false, -- скрыт модерацией
+    hidden_reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    UNIQUE (tutor_id, student_id) -- не больше одного отзыва на пару ученик-репетитор
+);
+
+CREATE INDEX idx_tutor_reviews_tutor_id ON tutor_reviews(tutor_id) WHERE NOT is_hidden;
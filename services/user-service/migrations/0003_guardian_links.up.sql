@@ -0,0 +1,13 @@
+CREATE TABLE guardian_links (
+    id VARCHAR(255) PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    student_id VARCHAR(255) NOT NULL REFERENCES user_profiles(user_id) ON DELETE CASCADE,
+    guardian_id VARCHAR(255) NOT NULL REFERENCES user_profiles(user_id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACCEPTED', 'REVOKED')),
+    invited_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- приглашение от ученика
+    consented_at TIMESTAMP, -- согласие опекуна
+    revoked_at TIMESTAMP
+);
+
+-- после отзыва связи ученик может пригласить того же опекуна повторно
+CREATE UNIQUE INDEX idx_guardian_links_active ON guardian_links(student_id, guardian_id) WHERE status <> 'REVOKED';
+CREATE INDEX idx_guardian_links_guardian_id ON guardian_links(guardian_id);
@@ -0,0 +1,3 @@
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(16) NOT NULL DEFAULT 'ru';
@@ -0,0 +1,24 @@
+ALTER TABLE tutor_profiles
+    ADD COLUMN IF NOT EXISTS verification_status VARCHAR(20) NOT NULL DEFAULT 'UNVERIFIED'
+        CHECK (verification_status IN ('UNVERIFIED', 'PENDING', 'VERIFIED', 'REJECTED')),
+    ADD COLUMN IF NOT EXISTS verification_reason TEXT NOT NULL DEFAULT '', -- причина отказа
+    ADD COLUMN IF NOT EXISTS verification_submitted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS verification_reviewed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS verification_reviewed_by VARCHAR(255) NOT NULL DEFAULT '';
+
+-- репетиторы, которые уже ведут группы, не теряют доступ; администратор может отозвать проверку
+UPDATE tutor_profiles
+SET verification_status = 'VERIFIED', verification_reviewed_at = NOW(), verification_reviewed_by = 'migration'
+WHERE verification_status = 'UNVERIFIED';
+
+-- документы загружаются через сервис загрузок, здесь хранятся ссылки на них
+CREATE TABLE IF NOT EXISTS tutor_verification_documents (
+    id VARCHAR(255) PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    tutor_id VARCHAR(255) NOT NULL REFERENCES tutor_profiles(user_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    uploaded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tutor_verification_documents_tutor_id ON tutor_verification_documents(tutor_id);
+CREATE INDEX IF NOT EXISTS idx_tutor_profiles_verification_status ON tutor_profiles(verification_status, verification_submitted_at);
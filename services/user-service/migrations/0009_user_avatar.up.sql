@@ -0,0 +1,2 @@
+-- ссылка на аватар, пустая строка - аватара нет
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS avatar_url VARCHAR(2048) NOT NULL DEFAULT '';
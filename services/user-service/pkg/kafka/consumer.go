@@ -2,27 +2,91 @@ package kafka
 
 import (
 	"context"
+	"log"
 
 	"github.com/segmentio/kafka-go"
 )
 
-type Consumer struct {
-	reader *kafka.Reader
+// GroupSource раздает партиции топика, назначенные экземпляру в группе потребителей.
+// Каждая партиция читается своим reader, поэтому пауза одной не останавливает чтение остальных.
+type GroupSource struct {
+	brokers []string
+	topic   string
+	group   string
+}
+
+func NewGroupSource(brokers []string, topic string, group string) *GroupSource {
+	return &GroupSource{
+		brokers: brokers,
+		topic:   topic,
+		group:   group,
+	}
 }
 
-func NewConsumer(brokers []string, topic string, group string) *kafka.Reader {
-	return kafka.NewReader(kafka.ReaderConfig{
-		Brokers:        brokers,
-		Topic:          topic,
-		GroupID:        group,
-		CommitInterval: 0,
+func (s *GroupSource) Consume(ctx context.Context, consume func(ctx context.Context, reader partitionReader)) {
+	cg, err := kafka.NewConsumerGroup(kafka.ConsumerGroupConfig{
+		ID:      s.group,
+		Brokers: s.brokers,
+		Topics:  []string{s.topic},
 	})
+	if err != nil {
+		log.Printf("[KAFKA] create consumer group %s for %s failed: %v", s.group, s.topic, err)
+		return
+	}
+	// Close дожидается завершения обработчиков партиций текущего поколения
+	defer cg.Close()
+
+	for {
+		gen, err := cg.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("[KAFKA] join consumer group %s for %s failed: %v", s.group, s.topic, err)
+			continue
+		}
+
+		for _, assignment := range gen.Assignments[s.topic] {
+			gen.Start(func(genCtx context.Context) {
+				partitionCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				stop := context.AfterFunc(genCtx, cancel)
+				defer stop()
+
+				reader := kafka.NewReader(kafka.ReaderConfig{
+					Brokers:   s.brokers,
+					Topic:     s.topic,
+					Partition: assignment.ID,
+				})
+				defer reader.Close()
+
+				if err := reader.SetOffset(assignment.Offset); err != nil {
+					log.Printf("[KAFKA] seek %s[%d] to %d failed: %v", s.topic, assignment.ID, assignment.Offset, err)
+					return
+				}
+
+				consume(partitionCtx, &generationReader{reader: reader, gen: gen, topic: s.topic})
+			})
+		}
+	}
+}
+
+// generationReader фиксирует смещения через поколение группы:
+// reader отдельной партиции не состоит в группе и коммитить сам не может
+type generationReader struct {
+	reader *kafka.Reader
+	gen    *kafka.Generation
+	topic  string
 }
 
-func (c *Consumer) Read(ctx context.Context) (kafka.Message, error) {
-	return c.reader.ReadMessage(ctx)
+func (r *generationReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	return r.reader.FetchMessage(ctx)
 }
 
-func (c *Consumer) Close() error {
-	return c.reader.Close()
-}
\ No newline at end of file
+func (r *generationReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	offsets := make(map[int]int64, len(msgs))
+	for _, msg := range msgs {
+		offsets[msg.Partition] = msg.Offset + 1
+	}
+	return r.gen.CommitOffsets(map[string]map[int]int64{r.topic: offsets})
+}
@@ -0,0 +1,63 @@
+package kafka
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	HeaderRetryCount    = "x-retry-count"
+	HeaderNotBefore     = "x-not-before"
+	HeaderOriginalTopic = "x-original-topic"
+	HeaderError         = "x-error"
+)
+
+// Header возвращает последнее значение заголовка или пустую строку
+func Header(msg kafka.Message, key string) string {
+	value := ""
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			value = string(h.Value)
+		}
+	}
+	return value
+}
+
+// SetHeader заменяет заголовок, если он уже есть, иначе добавляет
+func SetHeader(headers []kafka.Header, key, value string) []kafka.Header {
+	return append(DeleteHeader(headers, key), kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func DeleteHeader(headers []kafka.Header, key string) []kafka.Header {
+	result := make([]kafka.Header, 0, len(headers))
+	for _, h := range headers {
+		if h.Key != key {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+func RetryCount(msg kafka.Message) int {
+	count, err := strconv.Atoi(Header(msg, HeaderRetryCount))
+	if err != nil || count < 0 {
+		return 0
+	}
+	return count
+}
+
+// NotBefore - момент, раньше которого сообщение нельзя обрабатывать (unix ms).
+// Для сообщений без заголовка возвращается нулевое время.
+func NotBefore(msg kafka.Message) time.Time {
+	ms, err := strconv.ParseInt(Header(msg, HeaderNotBefore), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func FormatNotBefore(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	consumerLag = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafka_consumer_lag_messages",
+			Help: "Number of messages behind the partition high watermark",
+		},
+		[]string{"tier", "partition"},
+	)
+
+	pausedPartitions = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafka_consumer_paused_partitions",
+			Help: "Partitions waiting for the not-before time of their head message",
+		},
+		[]string{"tier"},
+	)
+
+	retryDelayOverrun = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kafka_retry_delay_overrun_seconds",
+			Help:    "How late a delayed message was processed after its not-before time",
+			Buckets: []float64{0.1, 0.5, 1, 5, 15, 60, 300, 900},
+		},
+		[]string{"tier"},
+	)
+
+	messagesProcessed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafka_messages_processed_total",
+			Help: "Total number of consumed messages by tier and result",
+		},
+		[]string{"tier", "result"},
+	)
+)
+
+func recordLag(tier string, partition int, lag int64) {
+	if lag < 0 {
+		lag = 0
+	}
+	consumerLag.WithLabelValues(tier, strconv.Itoa(partition)).Set(float64(lag))
+}
@@ -36,6 +36,16 @@ func (p *Producer) Publish(ctx context.Context, topic string, key string, value
 	})
 }
 
+// PublishMessage отправляет сообщение как есть, вместе с заголовками
+func (p *Producer) PublishMessage(ctx context.Context, msg kafka.Message) error {
+	return p.writer.WriteMessages(ctx, msg)
+}
+
+// PublishMessages отправляет пачку сообщений одним запросом
+func (p *Producer) PublishMessages(ctx context.Context, msgs ...kafka.Message) error {
+	return p.writer.WriteMessages(ctx, msgs...)
+}
+
 func (p *Producer) Close() error {
 	return p.writer.Close()
 }
\ No newline at end of file
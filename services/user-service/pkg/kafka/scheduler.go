@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	initialRetryDelay = time.Second
+	maxRetryDelay     = time.Minute
+)
+
+type MessageHandler func(ctx context.Context, msg kafka.Message) error
+
+// partitionReader читает одну партицию и фиксирует ее смещение в группе потребителей
+type partitionReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// partitionSource вызывает consume в отдельной горутине для каждой назначенной партиции
+// и блокируется до отмены ctx. При ребалансировке контекст consume отменяется,
+// а партиции раздаются заново.
+type partitionSource interface {
+	Consume(ctx context.Context, consume func(ctx context.Context, reader partitionReader))
+}
+
+// DelayedConsumer обрабатывает каждую партицию независимо.
+// Если у головного сообщения партиции не наступил x-not-before, ставится на паузу
+// только эта партиция, остальные продолжают читаться и обрабатываться.
+type DelayedConsumer struct {
+	source     partitionSource
+	tier       string
+	handler    MessageHandler
+	retryDelay time.Duration
+}
+
+func NewDelayedConsumer(source partitionSource, tier string, handler MessageHandler) *DelayedConsumer {
+	return &DelayedConsumer{
+		source:     source,
+		tier:       tier,
+		handler:    handler,
+		retryDelay: initialRetryDelay,
+	}
+}
+
+// Run блокируется до отмены ctx, затем дожидается обработчиков партиций
+func (c *DelayedConsumer) Run(ctx context.Context) {
+	c.source.Consume(ctx, c.processPartition)
+}
+
+func (c *DelayedConsumer) processPartition(ctx context.Context, reader partitionReader) {
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			// выход из обработчика завершает поколение группы, партиции будут назначены заново
+			if ctx.Err() == nil {
+				log.Printf("[KAFKA] fetch from %s failed: %v", c.tier, err)
+			}
+			return
+		}
+
+		recordLag(c.tier, msg.Partition, msg.HighWaterMark-msg.Offset-1)
+
+		if !c.waitNotBefore(ctx, msg) || !c.handle(ctx, msg) {
+			return
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			log.Printf("[KAFKA] commit %s[%d]@%d failed: %v", c.tier, msg.Partition, msg.Offset, err)
+		}
+	}
+}
+
+// waitNotBefore приостанавливает партицию до x-not-before сообщения
+func (c *DelayedConsumer) waitNotBefore(ctx context.Context, msg kafka.Message) bool {
+	notBefore := NotBefore(msg)
+	if wait := time.Until(notBefore); wait > 0 {
+		pausedPartitions.WithLabelValues(c.tier).Inc()
+		defer pausedPartitions.WithLabelValues(c.tier).Dec()
+
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+		}
+	}
+	if !notBefore.IsZero() {
+		retryDelayOverrun.WithLabelValues(c.tier).Observe(time.Since(notBefore).Seconds())
+	}
+	return true
+}
+
+// handle повторяет обработку с растущей задержкой, пока она не пройдет:
+// коммит следующего сообщения молча потерял бы это
+func (c *DelayedConsumer) handle(ctx context.Context, msg kafka.Message) bool {
+	delay := c.retryDelay
+	for {
+		err := c.handler(ctx, msg)
+		if err == nil {
+			messagesProcessed.WithLabelValues(c.tier, "ok").Inc()
+			return true
+		}
+		messagesProcessed.WithLabelValues(c.tier, "error").Inc()
+		log.Printf("[KAFKA] handle %s[%d]@%d failed, retry in %s: %v", c.tier, msg.Partition, msg.Offset, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
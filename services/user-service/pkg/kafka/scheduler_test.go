@@ -0,0 +1,199 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type fakeReader struct {
+	messages chan kafka.Message
+
+	mu        sync.Mutex
+	committed []kafka.Message
+}
+
+func newFakeReader(msgs ...kafka.Message) *fakeReader {
+	r := &fakeReader{messages: make(chan kafka.Message, len(msgs))}
+	for _, msg := range msgs {
+		r.messages <- msg
+	}
+	return r
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	select {
+	case msg := <-r.messages:
+		return msg, nil
+	case <-ctx.Done():
+		return kafka.Message{}, ctx.Err()
+	}
+}
+
+func (r *fakeReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.committed = append(r.committed, msgs...)
+	return nil
+}
+
+func (r *fakeReader) commits() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.committed)
+}
+
+// fakeSource - по reader на каждую партицию, как у GroupSource
+type fakeSource struct {
+	readers []*fakeReader
+}
+
+func (s *fakeSource) Consume(ctx context.Context, consume func(ctx context.Context, reader partitionReader)) {
+	var wg sync.WaitGroup
+	for _, r := range s.readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			consume(ctx, r)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDelayedConsumer_DelayDoesNotBlockOtherPartitions(t *testing.T) {
+	delay := 200 * time.Millisecond
+	delayed := newFakeReader(kafka.Message{
+		Partition: 0,
+		Offset:    1,
+		Headers:   []kafka.Header{{Key: HeaderNotBefore, Value: []byte(FormatNotBefore(time.Now().Add(delay)))}},
+	})
+	immediate := newFakeReader(kafka.Message{Partition: 1, Offset: 1}, kafka.Message{Partition: 1, Offset: 2})
+
+	processed := make(chan kafka.Message, 3)
+	consumer := NewDelayedConsumer(&fakeSource{readers: []*fakeReader{delayed, immediate}}, "retry.1", func(ctx context.Context, msg kafka.Message) error {
+		processed <- msg
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	start := time.Now()
+	go func() {
+		consumer.Run(ctx)
+		close(done)
+	}()
+
+	// пока партиция 0 на паузе, партиция 1 вычитывается целиком
+	for i := 0; i < 2; i++ {
+		msg := <-processed
+		if msg.Partition != 1 {
+			t.Fatalf("expected partition 1 to be processed first, got %d", msg.Partition)
+		}
+	}
+	if time.Since(start) >= delay {
+		t.Error("expected immediate messages to skip the delay of another partition")
+	}
+
+	last := <-processed
+	if last.Partition != 0 {
+		t.Fatalf("expected delayed message from partition 0, got %d", last.Partition)
+	}
+	if time.Now().Before(NotBefore(last)) {
+		t.Error("expected delayed message to wait for its not-before time")
+	}
+
+	cancel()
+	<-done
+
+	if delayed.commits() != 1 || immediate.commits() != 2 {
+		t.Errorf("expected 1 and 2 committed messages, got %d and %d", delayed.commits(), immediate.commits())
+	}
+}
+
+func TestDelayedConsumer_FailedMessageRetried(t *testing.T) {
+	reader := newFakeReader(kafka.Message{Partition: 0, Offset: 1}, kafka.Message{Partition: 0, Offset: 2})
+
+	var (
+		mu       sync.Mutex
+		attempts []int64
+	)
+	processed := make(chan struct{})
+	consumer := NewDelayedConsumer(&fakeSource{readers: []*fakeReader{reader}}, "main", func(ctx context.Context, msg kafka.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, msg.Offset)
+		if len(attempts) < 3 {
+			return errors.New("temporary failure")
+		}
+		if msg.Offset == 2 {
+			close(processed)
+		}
+		return nil
+	})
+	consumer.retryDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		consumer.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-processed:
+	case <-time.After(time.Second):
+		t.Fatal("expected messages to be processed after retries")
+	}
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []int64{1, 1, 1, 2}
+	if len(attempts) != len(want) {
+		t.Fatalf("expected attempts %v, got %v", want, attempts)
+	}
+	for i := range want {
+		if attempts[i] != want[i] {
+			t.Fatalf("expected attempts %v, got %v", want, attempts)
+		}
+	}
+	if reader.commits() != 2 || reader.committed[0].Offset != 1 {
+		t.Errorf("expected offsets committed in order, got %+v", reader.committed)
+	}
+}
+
+func TestDelayedConsumer_FailedMessageNotCommitted(t *testing.T) {
+	reader := newFakeReader(kafka.Message{Partition: 0, Offset: 1}, kafka.Message{Partition: 0, Offset: 2})
+
+	handled := make(chan struct{}, 1)
+	consumer := NewDelayedConsumer(&fakeSource{readers: []*fakeReader{reader}}, "main", func(ctx context.Context, msg kafka.Message) error {
+		select {
+		case handled <- struct{}{}:
+		default:
+		}
+		return context.DeadlineExceeded
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		consumer.Run(ctx)
+		close(done)
+	}()
+
+	<-handled
+	cancel()
+	<-done
+
+	if reader.commits() != 0 {
+		t.Errorf("expected no commits, got %d", reader.commits())
+	}
+	if len(reader.messages) != 1 {
+		t.Error("expected next message not to be fetched while the failed one is retried")
+	}
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"strings"
+	"time"
+)
+
+// TimestampLayout - формат значений для колонок TIMESTAMP без часового пояса
+const TimestampLayout = "2006-01-02 15:04:05.999999"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// LikePrefix возвращает шаблон LIKE для поиска по префиксу, спецсимволы экранируются
+func LikePrefix(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
+
+// Timestamp форматирует время в UTC для сравнения с колонкой TIMESTAMP
+func Timestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}